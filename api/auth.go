@@ -0,0 +1,216 @@
+package api
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// An IP is locked out after maxAuthFailures failed authentications
+	// within authFailureWindow. Each further lockout doubles the duration,
+	// starting from authLockoutBase up to authLockoutMax, until the IP goes
+	// authLockoutReset without failing.
+	maxAuthFailures   = 5
+	authFailureWindow = 15 * time.Minute
+	authLockoutBase   = time.Minute
+	authLockoutMax    = time.Hour
+	authLockoutReset  = 24 * time.Hour
+
+	// Successful authentications are logged once per authSuccessLogInterval
+	// for each IP and credential so polling clients don't flood the log
+	authSuccessLogInterval = time.Hour
+
+	// Events older than authLogRetention are deleted every authLogPruneInterval
+	authLogRetention     = 30 * 24 * time.Hour
+	authLogPruneInterval = time.Hour
+)
+
+// authGuard throttles failed authentications by IP and records
+// authentication events in the auth log
+type authGuard struct {
+	store repo.AuthLogStore
+
+	lock          sync.Mutex
+	clients       map[string]*authClient
+	loggedSuccess map[string]time.Time
+	lastPrune     time.Time
+	now           func() time.Time
+}
+
+type authClient struct {
+	failures    int
+	windowStart time.Time
+	lastFailure time.Time
+	lockouts    uint
+	lockedUntil time.Time
+}
+
+func newAuthGuard(store repo.AuthLogStore) *authGuard {
+	return &authGuard{
+		store:         store,
+		clients:       make(map[string]*authClient),
+		loggedSuccess: make(map[string]time.Time),
+		now:           time.Now,
+	}
+}
+
+// LockedOut returns how long the IP is locked out for, if it is
+func (g *authGuard) LockedOut(ip string) (time.Duration, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	c, ok := g.clients[ip]
+	if !ok {
+		return 0, false
+	}
+	wait := c.lockedUntil.Sub(g.now())
+	return wait, wait > 0
+}
+
+// Fail records a failed authentication and locks the IP out once it has
+// failed too often
+func (g *authGuard) Fail(ip string, method repo.AuthMethod, credential, path, reason string) {
+	g.lock.Lock()
+	now := g.now()
+	c, ok := g.clients[ip]
+	if !ok {
+		c = &authClient{}
+		g.clients[ip] = c
+	}
+	if now.Sub(c.lastFailure) > authLockoutReset {
+		c.lockouts = 0
+	}
+	if now.Sub(c.windowStart) > authFailureWindow {
+		c.failures = 0
+		c.windowStart = now
+	}
+	c.failures++
+	c.lastFailure = now
+	var lockout time.Duration
+	if c.failures >= maxAuthFailures {
+		lockout = authLockoutBase
+		for n := uint(0); n < c.lockouts && lockout < authLockoutMax; n++ {
+			lockout *= 2
+		}
+		if lockout > authLockoutMax {
+			lockout = authLockoutMax
+		}
+		c.lockouts++
+		c.lockedUntil = now.Add(lockout)
+		c.failures = 0
+	}
+	g.lock.Unlock()
+
+	g.record(repo.AuthEvent{Timestamp: now, IP: ip, Method: method, Credential: credential, Path: path, Reason: reason})
+	if lockout > 0 {
+		log.Warningf("Locked out %s from the API for %s after %d failed authentications", ip, lockout, maxAuthFailures)
+		g.record(repo.AuthEvent{Timestamp: now, IP: ip, Method: method, Credential: credential, Path: path, Reason: "locked out for " + lockout.String()})
+	}
+}
+
+// Deny records an authenticated request which was not allowed. It doesn't
+// count towards the lockout.
+func (g *authGuard) Deny(ip string, method repo.AuthMethod, credential, path, reason string) {
+	g.record(repo.AuthEvent{Timestamp: g.now(), IP: ip, Method: method, Credential: credential, Path: path, Reason: reason})
+}
+
+// Succeed clears the failures of the IP and records the authentication
+func (g *authGuard) Succeed(ip string, method repo.AuthMethod, credential, path string) {
+	g.lock.Lock()
+	now := g.now()
+	if c, ok := g.clients[ip]; ok && c.failures > 0 {
+		c.failures = 0
+	}
+	key := ip + " " + string(method) + " " + credential
+	logged, ok := g.loggedSuccess[key]
+	if ok && now.Sub(logged) < authSuccessLogInterval {
+		g.lock.Unlock()
+		return
+	}
+	g.loggedSuccess[key] = now
+	g.lock.Unlock()
+
+	g.record(repo.AuthEvent{Timestamp: now, IP: ip, Method: method, Credential: credential, Path: path, Success: true})
+}
+
+func (g *authGuard) record(event repo.AuthEvent) {
+	if err := g.store.Put(event); err != nil {
+		log.Errorf("Error recording authentication event: %s", err)
+	}
+	g.prune(event.Timestamp)
+}
+
+// prune deletes expired events from the auth log and forgets idle clients
+func (g *authGuard) prune(now time.Time) {
+	g.lock.Lock()
+	if now.Sub(g.lastPrune) < authLogPruneInterval {
+		g.lock.Unlock()
+		return
+	}
+	g.lastPrune = now
+	for ip, c := range g.clients {
+		if now.Sub(c.lastFailure) > authLockoutReset && now.After(c.lockedUntil) {
+			delete(g.clients, ip)
+		}
+	}
+	for key, logged := range g.loggedSuccess {
+		if now.Sub(logged) >= authSuccessLogInterval {
+			delete(g.loggedSuccess, key)
+		}
+	}
+	g.lock.Unlock()
+
+	if err := g.store.DeleteBefore(now.Add(-authLogRetention)); err != nil {
+		log.Errorf("Error pruning auth log: %s", err)
+	}
+}
+
+// checkAuthCookie returns whether the request has the auth cookie
+func checkAuthCookie(r *http.Request, authCookie http.Cookie) bool {
+	cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(authCookie.Value)) == 1
+}
+
+// checkBasicAuth returns the username of the request and whether it matches
+// the username and hex encoded SHA256 password hash
+func checkBasicAuth(r *http.Request, username, passwordHash string) (string, bool) {
+	u, password, ok := r.BasicAuth()
+	if !ok {
+		return "", false
+	}
+	h := sha256.Sum256([]byte(password))
+	usernameMatch := subtle.ConstantTimeCompare([]byte(u), []byte(username))
+	passwordMatch := subtle.ConstantTimeCompare([]byte(hex.EncodeToString(h[:])), []byte(strings.ToLower(passwordHash)))
+	return u, usernameMatch&passwordMatch == 1
+}
+
+// clientIP returns the IP of the client. Requests forwarded by a reverse
+// proxy on the same host use the address the proxy appended to the
+// X-Forwarded-For or X-Real-IP header.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		addrs := strings.Split(fwd, ",")
+		return strings.TrimSpace(addrs[len(addrs)-1])
+	}
+	if real := strings.TrimSpace(r.Header.Get("X-Real-IP")); real != "" {
+		return real
+	}
+	return host
+}
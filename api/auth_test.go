@@ -0,0 +1,129 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type memoryAuthLog struct {
+	repo.AuthLogStore
+	events []repo.AuthEvent
+}
+
+func (m *memoryAuthLog) Put(event repo.AuthEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *memoryAuthLog) DeleteBefore(t time.Time) error {
+	return nil
+}
+
+func TestAuthGuardLockout(t *testing.T) {
+	var (
+		store = &memoryAuthLog{}
+		guard = newAuthGuard(store)
+		now   = time.Now()
+		ip    = "203.0.113.7"
+	)
+	guard.now = func() time.Time { return now }
+
+	fail := func(n int) {
+		for i := 0; i < n; i++ {
+			guard.Fail(ip, repo.AuthMethodBasic, "admin", "/ob/profile", "invalid credentials")
+		}
+	}
+	fail(maxAuthFailures - 1)
+	if _, locked := guard.LockedOut(ip); locked {
+		t.Fatal("Expected IP not to be locked out before reaching the failure limit")
+	}
+	fail(1)
+	if wait, locked := guard.LockedOut(ip); !locked || wait != authLockoutBase {
+		t.Fatalf("Expected IP to be locked out for %s, got %s", authLockoutBase, wait)
+	}
+	if _, locked := guard.LockedOut("203.0.113.8"); locked {
+		t.Error("Expected other IPs not to be locked out")
+	}
+	if len(store.events) != maxAuthFailures+1 || store.events[maxAuthFailures].Reason != "locked out for 1m0s" {
+		t.Errorf("Expected failures and the lockout to be logged, got %+v", store.events)
+	}
+
+	// The next lockout doubles
+	now = now.Add(authLockoutBase)
+	if _, locked := guard.LockedOut(ip); locked {
+		t.Fatal("Expected lockout to expire")
+	}
+	fail(maxAuthFailures)
+	if wait, _ := guard.LockedOut(ip); wait != 2*authLockoutBase {
+		t.Errorf("Expected second lockout to last %s, got %s", 2*authLockoutBase, wait)
+	}
+
+	// Lockouts are capped
+	for i := 0; i < 10; i++ {
+		now = now.Add(authLockoutMax)
+		fail(maxAuthFailures)
+	}
+	if wait, _ := guard.LockedOut(ip); wait != authLockoutMax {
+		t.Errorf("Expected lockout to be capped at %s, got %s", authLockoutMax, wait)
+	}
+
+	// And forgotten after a quiet day
+	now = now.Add(authLockoutReset + time.Second)
+	fail(maxAuthFailures)
+	if wait, _ := guard.LockedOut(ip); wait != authLockoutBase {
+		t.Errorf("Expected lockout to reset to %s, got %s", authLockoutBase, wait)
+	}
+}
+
+func TestAuthGuardSuccess(t *testing.T) {
+	var (
+		store = &memoryAuthLog{}
+		guard = newAuthGuard(store)
+		now   = time.Now()
+		ip    = "203.0.113.7"
+	)
+	guard.now = func() time.Time { return now }
+
+	for i := 0; i < maxAuthFailures-1; i++ {
+		guard.Fail(ip, repo.AuthMethodBasic, "admin", "/ob/profile", "invalid credentials")
+	}
+	guard.Succeed(ip, repo.AuthMethodBasic, "admin", "/ob/profile")
+	guard.Fail(ip, repo.AuthMethodBasic, "admin", "/ob/profile", "invalid credentials")
+	if _, locked := guard.LockedOut(ip); locked {
+		t.Error("Expected success to clear the failures")
+	}
+
+	store.events = nil
+	guard.Succeed(ip, repo.AuthMethodBasic, "admin", "/ob/profile")
+	guard.Succeed(ip, repo.AuthMethodToken, "fulfillment", "/ob/sales")
+	now = now.Add(authSuccessLogInterval)
+	guard.Succeed(ip, repo.AuthMethodBasic, "admin", "/ob/profile")
+	if len(store.events) != 2 || store.events[0].Method != repo.AuthMethodToken || !store.events[1].Success {
+		t.Errorf("Expected successes to be logged once per interval, got %+v", store.events)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	for _, c := range []struct {
+		remoteAddr string
+		headers    map[string]string
+		expected   string
+	}{
+		{"198.51.100.1:52000", nil, "198.51.100.1"},
+		{"198.51.100.1:52000", map[string]string{"X-Forwarded-For": "203.0.113.7"}, "198.51.100.1"},
+		{"127.0.0.1:52000", map[string]string{"X-Forwarded-For": "10.0.0.1, 203.0.113.7"}, "203.0.113.7"},
+		{"[::1]:52000", map[string]string{"X-Real-IP": "203.0.113.7"}, "203.0.113.7"},
+		{"127.0.0.1:52000", nil, "127.0.0.1"},
+	} {
+		r := &http.Request{RemoteAddr: c.remoteAddr, Header: make(http.Header)}
+		for k, v := range c.headers {
+			r.Header.Set(k, v)
+		}
+		if ip := clientIP(r); ip != c.expected {
+			t.Errorf("Expected client IP of %s %v to be %s, got %s", c.remoteAddr, c.headers, c.expected, ip)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// batchPath is the path of the endpoint which serves a batch of requests
+	batchPath = "/ob/batch"
+
+	// maxBatchRequests is the largest number of requests in a batch
+	maxBatchRequests = 50
+
+	// maxBatchConcurrency is how many requests of a batch are served at once
+	maxBatchConcurrency = 8
+
+	// Requests of a batch time out after defaultBatchRequestTimeout unless
+	// they set a timeout, which is at most maxBatchRequestTimeout
+	defaultBatchRequestTimeout = 30 * time.Second
+	maxBatchRequestTimeout     = 2 * time.Minute
+)
+
+// batchRequest is a request of a batch
+type batchRequest struct {
+	Method         string          `json:"method"`
+	Path           string          `json:"path"`
+	Body           json.RawMessage `json:"body,omitempty"`
+	Timeout        string          `json:"timeout,omitempty"`
+	IdempotencyKey string          `json:"idempotencyKey,omitempty"`
+}
+
+// batchResult is the response to a request of a batch. The body is the JSON
+// of the response, or a string if the response isn't JSON.
+type batchResult struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// batchResponseWriter records the response to a request of a batch
+type batchResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBatchResponseWriter() *batchResponseWriter {
+	return &batchResponseWriter{header: make(http.Header)}
+}
+
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *batchResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *batchResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+func (w *batchResponseWriter) result() batchResult {
+	res := batchResult{Status: w.status}
+	if res.Status == 0 {
+		res.Status = http.StatusOK
+	}
+	body := bytes.TrimSpace(w.body.Bytes())
+	switch {
+	case len(body) == 0:
+	case json.Valid(body):
+		res.Body = body
+	default:
+		res.Body, _ = json.Marshal(string(body))
+	}
+	return res
+}
+
+// POSTBatch serves an array of requests and responds with an array of their
+// results in the same order. Each request is routed and authenticated as if
+// it had been made on its own with the credentials of the batch, so an API
+// token needs the scopes of every request. Up to maxBatchConcurrency requests
+// are served at once, and each one fails with 504 once its timeout passes.
+// A request which timed out keeps its slot until its handler returns.
+// Requests get the Idempotency-Key they set, or one derived from the key of
+// the batch, so a retried batch doesn't spend or purchase twice.
+func (i *jsonAPIHandler) POSTBatch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var reqs []batchRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	if len(reqs) == 0 {
+		ErrorResponse(w, http.StatusBadRequest, "a batch must have at least one request")
+		return
+	}
+	if len(reqs) > maxBatchRequests {
+		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("a batch can have at most %d requests", maxBatchRequests))
+		return
+	}
+
+	results := make([]batchResult, len(reqs))
+	sem := make(chan struct{}, maxBatchConcurrency)
+	var wg sync.WaitGroup
+	for n, req := range reqs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(n int, req batchRequest) {
+			defer wg.Done()
+			results[n] = i.serveBatchRequest(r, n, req, sem)
+		}(n, req)
+	}
+	wg.Wait()
+
+	ret, err := json.MarshalIndent(results, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	fmt.Fprint(w, string(ret))
+}
+
+// serveBatchRequest serves the nth request of the batch r through the same
+// routing and authentication as the requests made on their own. It frees the
+// slot it holds in sem once the handler returns, which may be after the
+// request timed out.
+func (i *jsonAPIHandler) serveBatchRequest(r *http.Request, n int, req batchRequest, sem chan struct{}) batchResult {
+	w := newBatchResponseWriter()
+	sub, timeout, err := newBatchSubRequest(r, n, req)
+	if err != nil {
+		<-sem
+		RenderError(w, http.StatusBadRequest, err)
+		return w.result()
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer func() {
+			<-sem
+			close(done)
+		}()
+		i.ServeHTTP(w, sub.WithContext(ctx))
+	}()
+	select {
+	case <-done:
+		return w.result()
+	case <-ctx.Done():
+		// The context is canceled for the handlers which check it, but the
+		// others finish on their own, so the side effects of a request which
+		// timed out may still happen. Its response is dropped.
+		cancel()
+		timedOut := newBatchResponseWriter()
+		ErrorResponse(timedOut, http.StatusGatewayTimeout, fmt.Sprintf("request timed out after %s, it may still complete", timeout))
+		return timedOut.result()
+	}
+}
+
+// newBatchSubRequest returns the HTTP request of the nth request of the batch
+// r, with the headers of the batch, and its timeout
+func newBatchSubRequest(r *http.Request, n int, req batchRequest) (*http.Request, time.Duration, error) {
+	method := strings.ToUpper(req.Method)
+	switch method {
+	case "GET", "POST", "PUT", "PATCH", "DELETE":
+	default:
+		return nil, 0, &apiError{http.StatusBadRequest, "method must be one of GET, POST, PUT, PATCH or DELETE"}
+	}
+	u, err := url.Parse(req.Path)
+	if err != nil || u.IsAbs() || !(strings.HasPrefix(u.Path, "/ob/") || strings.HasPrefix(u.Path, "/wallet/")) {
+		return nil, 0, &apiError{http.StatusBadRequest, "path must start with /ob/ or /wallet/"}
+	}
+	if strings.HasPrefix(u.Path, batchPath) {
+		return nil, 0, &apiError{http.StatusBadRequest, "batches can't be nested"}
+	}
+	timeout := defaultBatchRequestTimeout
+	if req.Timeout != "" {
+		timeout, err = time.ParseDuration(req.Timeout)
+		if err != nil || timeout <= 0 {
+			return nil, 0, &apiError{http.StatusBadRequest, "timeout must be a positive duration, such as 10s"}
+		}
+		if timeout > maxBatchRequestTimeout {
+			timeout = maxBatchRequestTimeout
+		}
+	}
+
+	var body []byte
+	if string(req.Body) != "null" {
+		body = req.Body
+	}
+	sub, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, &apiError{http.StatusBadRequest, err.Error()}
+	}
+	sub.RemoteAddr = r.RemoteAddr
+	for k, v := range r.Header {
+		sub.Header[k] = v
+	}
+	// A key identifies a single request, so each request of the batch gets
+	// its own
+	sub.Header.Del("Idempotency-Key")
+	if req.IdempotencyKey != "" {
+		sub.Header.Set("Idempotency-Key", req.IdempotencyKey)
+	} else if key := r.Header.Get("Idempotency-Key"); key != "" {
+		sub.Header.Set("Idempotency-Key", fmt.Sprintf("%s/%d", key, n))
+	}
+	sub.Header.Del("Content-Length")
+	return sub, timeout, nil
+}
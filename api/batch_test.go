@@ -0,0 +1,215 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/test"
+)
+
+func TestBatch(t *testing.T) {
+	batch := `[
+		{"method": "GET", "path": "/ob/followers"},
+		{"method": "get", "path": "/ob/following?cursor="},
+		{"method": "GET", "path": "/ob/a"},
+		{"method": "POST", "path": "/ob/apitokens", "body": {"name": "bad name", "scopes": ["read-only"]}},
+		{"method": "POST", "path": "/ob/batch", "body": []},
+		{"method": "OPTIONS", "path": "/ob/followers"},
+		{"method": "GET", "path": "/metrics"},
+		{"method": "GET", "path": "/ob/followers", "timeout": "soon"}
+	]`
+	expected := fmt.Sprintf(`[
+		{"status": 200, "body": []},
+		{"status": 200, "body": {"items": [], "nextCursor": ""}},
+		{"status": 404, "body": %s},
+		{"status": 400, "body": %s},
+		{"status": 400, "body": %s},
+		{"status": 400, "body": %s},
+		{"status": 400, "body": %s},
+		{"status": 400, "body": %s}
+	]`,
+		notFoundJSON,
+		`{"success": false, "reason": "name must be 1 to 64 letters, digits, dots, dashes or underscores", "code": "ERR_VALIDATION_FAILED", "errors": [{"field": "name", "code": "ERR_FIELD_INVALID", "reason": "name must be 1 to 64 letters, digits, dots, dashes or underscores"}]}`,
+		errorResponseJSON(&apiError{http.StatusBadRequest, "batches can't be nested"}),
+		errorResponseJSON(&apiError{http.StatusBadRequest, "method must be one of GET, POST, PUT, PATCH or DELETE"}),
+		errorResponseJSON(&apiError{http.StatusBadRequest, "path must start with /ob/ or /wallet/"}),
+		errorResponseJSON(&apiError{http.StatusBadRequest, "timeout must be a positive duration, such as 10s"}),
+	)
+	tooMany := "[" + strings.Repeat(`{"method": "GET", "path": "/ob/followers"},`, maxBatchRequests) + `{"method": "GET", "path": "/ob/followers"}]`
+
+	runAPITests(t, apiTests{
+		{"POST", "/ob/batch", batch, 200, expected},
+		{"POST", "/ob/batch", `[]`, 400, `{"success": false, "reason": "a batch must have at least one request", "code": "ERR_INVALID_REQUEST"}`},
+		{"POST", "/ob/batch", tooMany, 400, fmt.Sprintf(`{"success": false, "reason": "a batch can have at most %d requests", "code": "ERR_INVALID_REQUEST"}`, maxBatchRequests)},
+		{"POST", "/ob/batch", `{`, 400, jsonUnexpectedEOF},
+	})
+}
+
+func TestBatchAPITokenScopes(t *testing.T) {
+	if _, err := test.ResetRepository(); err != nil {
+		t.Fatal(err)
+	}
+	req, err := buildRequest("POST", "/ob/apitokens", `{"name": "batch-read-only", "scopes": ["read-only"]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := testHTTPClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var created struct {
+		Token string `json:"token"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	resp.Body.Close()
+	if err != nil || created.Token == "" {
+		t.Fatalf("Expected a token to be created, got status %d (%v)", resp.StatusCode, err)
+	}
+	defer executeAPITest(t, apiTest{"DELETE", "/ob/apitokens/batch-read-only", "", 200, `{}`})
+
+	req, err = http.NewRequest("POST", testURIRoot+"/ob/batch", strings.NewReader(`[
+		{"method": "GET", "path": "/ob/followers"},
+		{"method": "GET", "path": "/ob/apitokens"},
+		{"method": "POST", "path": "/wallet/spend", "body": {}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+created.Token)
+	resp, err = testHTTPClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a read-only token to be allowed to send a batch, got status %d", resp.StatusCode)
+	}
+	var results []batchResult
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		t.Fatal(err)
+	}
+	expected := []int{http.StatusOK, http.StatusForbidden, http.StatusForbidden}
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d results, got %d", len(expected), len(results))
+	}
+	for n, status := range expected {
+		if results[n].Status != status {
+			t.Errorf("Expected request %d to end with status %d, got %d", n, status, results[n].Status)
+		}
+	}
+}
+
+func TestNewBatchSubRequest(t *testing.T) {
+	r, err := http.NewRequest("POST", batchPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.RemoteAddr = "127.0.0.1:4002"
+	r.Header.Set("Authorization", "Bearer secret")
+	r.Header.Set("Idempotency-Key", "once")
+	r.Header.Set("Content-Length", "128")
+
+	sub, timeout, err := newBatchSubRequest(r, 2, batchRequest{Method: "put", Path: "/ob/listing?lang=de", Body: json.RawMessage(`{"slug": "shirt"}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.Method != "PUT" || sub.URL.Path != "/ob/listing" || sub.URL.Query().Get("lang") != "de" {
+		t.Errorf("Unexpected request %s %s", sub.Method, sub.URL)
+	}
+	if sub.RemoteAddr != r.RemoteAddr || sub.Header.Get("Authorization") != "Bearer secret" {
+		t.Error("Expected the request to have the address and credentials of the batch")
+	}
+	if sub.Header.Get("Content-Length") != "" {
+		t.Error("Expected the Content-Length of the batch to be dropped")
+	}
+	if key := sub.Header.Get("Idempotency-Key"); key != "once/2" {
+		t.Errorf("Expected the Idempotency-Key to be derived from the batch, got %q", key)
+	}
+	sub, _, err = newBatchSubRequest(r, 2, batchRequest{Method: "POST", Path: "/wallet/spend", IdempotencyKey: "spend-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key := sub.Header.Get("Idempotency-Key"); key != "spend-1" {
+		t.Errorf("Expected the Idempotency-Key of the request, got %q", key)
+	}
+	if timeout != defaultBatchRequestTimeout {
+		t.Errorf("Expected the default timeout, got %s", timeout)
+	}
+
+	_, timeout, err = newBatchSubRequest(r, 0, batchRequest{Method: "GET", Path: "/ob/sales", Timeout: "1h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timeout != maxBatchRequestTimeout {
+		t.Errorf("Expected the timeout to be capped at %s, got %s", maxBatchRequestTimeout, timeout)
+	}
+	_, timeout, err = newBatchSubRequest(r, 0, batchRequest{Method: "GET", Path: "/ob/sales", Timeout: "250ms"})
+	if err != nil || timeout != 250*time.Millisecond {
+		t.Errorf("Expected a timeout of 250ms, got %s (%v)", timeout, err)
+	}
+
+	for _, req := range []batchRequest{
+		{Method: "GET", Path: "http://example.com/ob/sales"},
+		{Method: "GET", Path: "/ipfs/QmHash"},
+		{Method: "GET", Path: "/ob/sales", Timeout: "-1s"},
+		{Method: "TRACE", Path: "/ob/sales"},
+	} {
+		if _, _, err := newBatchSubRequest(r, 0, req); err == nil {
+			t.Errorf("Expected %s %s to be rejected", req.Method, req.Path)
+		}
+	}
+}
+
+func TestBatchResponseWriter(t *testing.T) {
+	w := newBatchResponseWriter()
+	fmt.Fprint(w, "403 - Forbidden")
+	w.WriteHeader(http.StatusInternalServerError)
+	res := w.result()
+	if res.Status != http.StatusOK || string(res.Body) != `"403 - Forbidden"` {
+		t.Errorf("Expected a 200 result with the body as a string, got %d %s", res.Status, res.Body)
+	}
+
+	w = newBatchResponseWriter()
+	w.WriteHeader(http.StatusNoContent)
+	if res := w.result(); res.Status != http.StatusNoContent || res.Body != nil {
+		t.Errorf("Expected an empty 204 result, got %d %s", res.Status, res.Body)
+	}
+}
+
+func TestServeBatchRequest(t *testing.T) {
+	r, err := http.NewRequest("POST", batchPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A handler without a node panics on its first request
+	i := &jsonAPIHandler{config: JsonAPIConfig{Enabled: true}}
+	sem := make(chan struct{}, 1)
+
+	sem <- struct{}{}
+	res := i.serveBatchRequest(r, 0, batchRequest{Method: "GET", Path: "/ob/followers"}, sem)
+	if res.Status != http.StatusInternalServerError {
+		t.Errorf("Expected a request which panicked to end with status 500, got %d %s", res.Status, res.Body)
+	}
+	select {
+	case sem <- struct{}{}:
+		<-sem
+	case <-time.After(time.Second):
+		t.Error("Expected the slot of a finished request to be freed")
+	}
+
+	sem <- struct{}{}
+	res = i.serveBatchRequest(r, 0, batchRequest{Method: "TRACE", Path: "/ob/followers"}, sem)
+	if res.Status != http.StatusBadRequest {
+		t.Errorf("Expected an invalid request to end with status 400, got %d", res.Status)
+	}
+	select {
+	case sem <- struct{}{}:
+		<-sem
+	default:
+		t.Error("Expected the slot of an invalid request to be freed")
+	}
+}
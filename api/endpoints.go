@@ -3,6 +3,8 @@ package api
 import (
 	"net/http"
 	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
 )
 
 func put(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
@@ -17,6 +19,8 @@ func put(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.PUTListing(w, r)
 	case strings.HasPrefix(path, "/ob/post"):
 		i.PUTPost(w, r)
+	case strings.HasPrefix(path, "/ob/blocks"):
+		i.PUTBan(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
@@ -26,6 +30,10 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 	switch {
 	case strings.HasPrefix(path, "/ob/listing"):
 		i.POSTListing(w, r)
+	case strings.HasPrefix(path, "/ob/scheduledlisting"):
+		i.POSTScheduledListing(w, r)
+	case strings.HasPrefix(path, "/ob/restorelisting"):
+		i.POSTRestoreListing(w, r)
 	case strings.HasPrefix(path, "/ob/follow"):
 		i.POSTFollow(w, r)
 	case strings.HasPrefix(path, "/ob/unfollow"):
@@ -35,7 +43,7 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 	case strings.HasPrefix(path, "/ob/images"):
 		i.POSTImage(w, r)
 	case strings.HasPrefix(path, "/wallet/spend"):
-		i.POSTSpendCoins(w, r)
+		i.idempotencyKeys.Handle(w, r, i.POSTSpendCoins)
 	case strings.HasPrefix(path, "/ob/settings"):
 		i.POSTSettings(w, r)
 	case strings.HasPrefix(path, "/ob/inventory"):
@@ -80,6 +88,8 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTFetchProfiles(w, r)
 	case strings.HasPrefix(path, "/ob/blocknode"):
 		i.POSTBlockNode(w, r)
+	case strings.HasPrefix(path, "/ob/blocks"):
+		i.POSTBan(w, r)
 	case strings.HasPrefix(path, "/ob/shutdown"):
 		i.POSTShutdown(w, r)
 	case strings.HasPrefix(path, "/ob/estimatetotal"):
@@ -91,7 +101,7 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 	case strings.HasPrefix(path, "/ob/purchases"):
 		i.POSTPurchases(w, r)
 	case strings.HasPrefix(path, "/ob/purchase"):
-		i.POSTPurchase(w, r)
+		i.idempotencyKeys.Handle(w, r, i.POSTPurchase)
 	case strings.HasPrefix(path, "/ob/cases"):
 		i.POSTCases(w, r)
 	case strings.HasPrefix(path, "/ob/publish"):
@@ -104,6 +114,8 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTTestEmailNotifications(w, r)
 	case strings.HasPrefix(path, "/ob/post"):
 		i.POSTPost(w, r)
+	case strings.HasPrefix(path, "/ob/apitokens"):
+		i.POSTAPIToken(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
@@ -115,6 +127,14 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETStatus(w, r)
 	case strings.HasPrefix(path, "/ob/peers"):
 		i.GETPeers(w, r)
+	case strings.HasPrefix(path, "/ob/peerabuse"):
+		i.GETPeerAbuse(w, r)
+	case strings.HasPrefix(path, "/ob/duplicatemessages"):
+		i.GETDuplicateMessages(w, r)
+	case strings.HasPrefix(path, "/ob/pushnodes"):
+		i.GETPushNodes(w, r)
+	case strings.HasPrefix(path, "/ob/blocks"):
+		i.GETBans(w, r)
 	case strings.HasPrefix(path, "/ob/config"):
 		i.GETConfig(w, r)
 	case strings.HasPrefix(path, "/wallet/address"):
@@ -143,6 +163,12 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETListings(w, r)
 	case strings.HasPrefix(path, "/ob/listing"):
 		i.GETListing(w, r)
+	case strings.HasPrefix(path, "/ob/scheduledlistings"):
+		i.GETScheduledListings(w, r)
+	case strings.HasPrefix(path, "/ob/archivedlistings"):
+		i.GETArchivedListings(w, r)
+	case strings.HasPrefix(path, "/ob/search"):
+		i.GETSearch(w, r)
 	case strings.HasPrefix(path, "/ob/followsme"):
 		i.GETFollowsMe(w, r)
 	case strings.HasPrefix(path, "/ob/isfollowing"):
@@ -193,6 +219,14 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETPosts(w, r)
 	case strings.HasPrefix(path, "/ob/post"):
 		i.GETPost(w, r)
+	case strings.HasPrefix(path, "/ob/apitokens"):
+		i.GETAPITokens(w, r)
+	case strings.HasPrefix(path, "/ob/authlog"):
+		i.GETAuthLog(w, r)
+	case strings.HasPrefix(path, "/ob/events"):
+		i.GETEvents(w, r)
+	case path == "/metrics":
+		i.GETMetrics(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
@@ -215,6 +249,10 @@ func deleter(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Requ
 		i.DELETEModerator(w, r)
 	case strings.HasPrefix(path, "/ob/listing"):
 		i.DELETEListing(w, r)
+	case strings.HasPrefix(path, "/ob/scheduledlisting"):
+		i.DELETEScheduledListing(w, r)
+	case strings.HasPrefix(path, "/ob/archivedlisting"):
+		i.DELETEArchivedListing(w, r)
 	case strings.HasPrefix(path, "/ob/chatmessage"):
 		i.DELETEChatMessage(w, r)
 	case strings.HasPrefix(path, "/ob/chatconversation"):
@@ -223,8 +261,12 @@ func deleter(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Requ
 		i.DELETENotification(w, r)
 	case strings.HasPrefix(path, "/ob/blocknode"):
 		i.DELETEBlockNode(w, r)
+	case strings.HasPrefix(path, "/ob/blocks"):
+		i.DELETEBan(w, r)
 	case strings.HasPrefix(path, "/ob/post"):
 		i.DELETEPost(w, r)
+	case strings.HasPrefix(path, "/ob/apitokens"):
+		i.DELETEAPIToken(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
@@ -248,3 +290,66 @@ func gatewayAllowedPath(path, method string) bool {
 	}
 	return false
 }
+
+// apiTokenScopes returns the scopes an API token needs for the request. Order
+// actions which sign a transaction need the wallet-spend scope as well, and
+// anything not listed here needs the admin scope. GET requests are listed
+// too, so that new endpoints which return secrets such as the settings don't
+// become readable by read-only tokens.
+func apiTokenScopes(path, method string) []repo.APITokenScope {
+	var (
+		readOnly      = []repo.APITokenScope{repo.APITokenScopeReadOnly}
+		listingsWrite = []repo.APITokenScope{repo.APITokenScopeListingsWrite}
+		orders        = []repo.APITokenScope{repo.APITokenScopeOrders}
+		walletSpend   = []repo.APITokenScope{repo.APITokenScopeWalletSpend}
+		ordersSpend   = []repo.APITokenScope{repo.APITokenScopeOrders, repo.APITokenScopeWalletSpend}
+		admin         = []repo.APITokenScope{repo.APITokenScopeAdmin}
+	)
+	hasPrefix := func(prefixes ...string) bool {
+		for _, p := range prefixes {
+			if strings.HasPrefix(path, p) {
+				return true
+			}
+		}
+		return false
+	}
+	switch method {
+	case "GET":
+		if path == "/metrics" || hasPrefix(
+			"/ob/profile", "/ob/listing", "/ob/scheduledlistings", "/ob/archivedlistings", "/ob/inventory", "/ob/image", "/ob/avatar", "/ob/header",
+			"/ob/post", "/ob/search", "/ob/moderators", "/ob/followers", "/ob/following", "/ob/followsme", "/ob/isfollowing", "/ob/rating",
+			"/ob/order", "/ob/purchases", "/ob/sales", "/ob/case", "/ob/chatmessages", "/ob/chatconversations", "/ob/notifications", "/ob/events",
+			"/ob/exchangerate", "/ob/status", "/ob/peers", "/ob/peerinfo", "/ob/peerabuse", "/ob/closestpeers", "/ob/duplicatemessages", "/ob/pushnodes",
+			"/ob/blocks", "/ob/resolve", "/ob/ipns", "/ob/healthcheck",
+			"/wallet/address", "/wallet/balance", "/wallet/transactions", "/wallet/estimatefee", "/wallet/fees", "/wallet/status",
+		) {
+			return readOnly
+		}
+		return admin
+	case "POST":
+		switch {
+		case hasPrefix("/ob/fetchprofiles", "/ob/fetchratings", "/ob/estimatetotal", "/ob/sales", "/ob/purchases", "/ob/cases"):
+			return readOnly
+		case hasPrefix("/ob/listing", "/ob/scheduledlisting", "/ob/restorelisting", "/ob/images", "/ob/inventory", "/ob/importlistings", "/ob/publish", "/ob/post"):
+			return listingsWrite
+		case hasPrefix("/ob/orderfulfillment", "/ob/opendispute"):
+			return orders
+		case hasPrefix("/ob/orderconfirmation", "/ob/ordercancel", "/ob/ordercompletion", "/ob/closedispute", "/ob/refund", "/ob/releasefunds", "/ob/releaseescrow", "/ob/purchase"):
+			return ordersSpend
+		case hasPrefix("/wallet/spend", "/wallet/bumpfee"):
+			return walletSpend
+		case path == batchPath:
+			// Each request of the batch is checked for its own scopes
+			return nil
+		}
+	case "PUT":
+		if hasPrefix("/ob/listing", "/ob/post") {
+			return listingsWrite
+		}
+	case "DELETE":
+		if hasPrefix("/ob/listing", "/ob/scheduledlisting", "/ob/archivedlisting", "/ob/post") {
+			return listingsWrite
+		}
+	}
+	return admin
+}
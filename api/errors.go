@@ -0,0 +1,141 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+)
+
+// apiError is an error reported with an HTTP status other than 500
+type apiError struct {
+	status int
+	reason string
+}
+
+func (e *apiError) Error() string {
+	return e.reason
+}
+
+// Errors of the API. The reasons are kept as they were before error codes
+// were added, as clients match them.
+var (
+	errListingNotFound   = &apiError{http.StatusNotFound, "Listing not found."}
+	errListingExists     = &apiError{http.StatusConflict, "Listing already exists. Use PUT."}
+	errInvalidAddress    = &apiError{http.StatusBadRequest, "ERROR_INVALID_ADDRESS"}
+	errInsufficientFunds = &apiError{http.StatusBadRequest, "ERROR_INSUFFICIENT_FUNDS"}
+	errDustAmount        = &apiError{http.StatusBadRequest, "ERROR_DUST_AMOUNT"}
+)
+
+// apiErrorCodes holds the codes of the errors of the API
+var apiErrorCodes = []struct {
+	err  error
+	code core.ErrorCode
+}{
+	{errListingNotFound, core.ErrCodeListingNotFound},
+	{errListingExists, core.ErrCodeListingAlreadyExists},
+	{errInvalidAddress, core.ErrCodeInvalidAddress},
+	{errInsufficientFunds, core.ErrCodeInsufficientFunds},
+	{errDustAmount, core.ErrCodeDustAmount},
+}
+
+// errorCodeStatuses holds the HTTP status of the codes of the catalog.
+// Errors with other codes are reported with the status the handler chose.
+var errorCodeStatuses = map[core.ErrorCode]int{
+	core.ErrCodeValidationFailed:         http.StatusBadRequest,
+	core.ErrCodeListingNotFound:          http.StatusNotFound,
+	core.ErrCodeListingAlreadyExists:     http.StatusConflict,
+	core.ErrCodeListingAlreadyScheduled:  http.StatusConflict,
+	core.ErrCodeArchivedListingNotFound:  http.StatusNotFound,
+	core.ErrCodeBanAlreadyExists:         http.StatusConflict,
+	core.ErrCodeBanNotFound:              http.StatusNotFound,
+	core.ErrCodeAPITokenAlreadyExists:    http.StatusConflict,
+	core.ErrCodeAPITokenNotFound:         http.StatusNotFound,
+	core.ErrCodeFulfillIncorrectDelivery: http.StatusBadRequest,
+}
+
+// statusErrorCodes holds the codes of errors without a more specific code by
+// HTTP status
+var statusErrorCodes = map[int]core.ErrorCode{
+	http.StatusBadRequest:            core.ErrCodeInvalidRequest,
+	http.StatusUnauthorized:          core.ErrCodeUnauthorized,
+	http.StatusForbidden:             core.ErrCodeForbidden,
+	http.StatusNotFound:              core.ErrCodeNotFound,
+	http.StatusMethodNotAllowed:      core.ErrCodeMethodNotAllowed,
+	http.StatusConflict:              core.ErrCodeConflict,
+	http.StatusRequestEntityTooLarge: core.ErrCodeTooLarge,
+	http.StatusTooManyRequests:       core.ErrCodeRateLimited,
+	http.StatusInternalServerError:   core.ErrCodeInternal,
+	http.StatusNotImplemented:        core.ErrCodeNotImplemented,
+	http.StatusServiceUnavailable:    core.ErrCodeUnavailable,
+	http.StatusGatewayTimeout:        core.ErrCodeTimeout,
+}
+
+// errorCode returns the code of an error, falling back to the generic code
+// of the HTTP status it is reported with
+func errorCode(err error, status int) core.ErrorCode {
+	if code := core.ErrorCodeOf(err); code != "" {
+		return code
+	}
+	for _, c := range apiErrorCodes {
+		if c.err == err {
+			return c.code
+		}
+	}
+	return statusCode(status)
+}
+
+// statusCode returns the generic code of errors reported with the HTTP status
+func statusCode(status int) core.ErrorCode {
+	if code, ok := statusErrorCodes[status]; ok {
+		return code
+	}
+	if status < http.StatusInternalServerError {
+		return core.ErrCodeInvalidRequest
+	}
+	return core.ErrCodeInternal
+}
+
+// errorStatus returns the HTTP status an error is reported with, which is
+// the status of its code or of the apiError, or the fallback otherwise
+func errorStatus(err error, fallback int) int {
+	if e, ok := err.(*apiError); ok {
+		return e.status
+	}
+	if status, ok := errorCodeStatuses[core.ErrorCodeOf(err)]; ok {
+		return status
+	}
+	return fallback
+}
+
+// errorResponse is the body of the responses of failed requests
+type errorResponse struct {
+	Success bool              `json:"success"`
+	Reason  string            `json:"reason"`
+	Code    core.ErrorCode    `json:"code"`
+	Errors  []core.FieldError `json:"errors,omitempty"`
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, resp errorResponse) {
+	resp.Reason = strings.Replace(resp.Reason, `"`, `'`, -1)
+	for i := range resp.Errors {
+		resp.Errors[i].Reason = strings.Replace(resp.Errors[i].Reason, `"`, `'`, -1)
+	}
+	ret, _ := json.MarshalIndent(resp, "", "    ")
+	w.WriteHeader(status)
+	fmt.Fprint(w, string(ret))
+}
+
+// RenderError responds with the error, its code and any invalid fields. Errors
+// of the catalog are reported with the status of their code, and other errors
+// with the status passed.
+func RenderError(w http.ResponseWriter, status int, err error) {
+	status = errorStatus(err, status)
+	writeErrorResponse(w, status, errorResponse{
+		Reason: err.Error(),
+		Code:   errorCode(err, status),
+		Errors: core.FieldErrors(err),
+	})
+}
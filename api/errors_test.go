@@ -0,0 +1,57 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestRenderError(t *testing.T) {
+	tests := []struct {
+		err     error
+		handler int
+		status  int
+		code    core.ErrorCode
+		fields  []string
+	}{
+		{errors.New("disk on fire"), 500, 500, core.ErrCodeInternal, nil},
+		{errors.New("bad json"), 400, 400, core.ErrCodeInvalidRequest, nil},
+		{errors.New("gone"), 410, 410, core.ErrCodeInvalidRequest, nil},
+		{core.ErrListingDoesNotExist, 500, 404, core.ErrCodeListingNotFound, nil},
+		{core.ErrListingAlreadyScheduled, 500, 409, core.ErrCodeListingAlreadyScheduled, nil},
+		{repo.ErrAPITokenDoesNotExist, 500, 404, core.ErrCodeAPITokenNotFound, nil},
+		{errListingNotFound, 500, 404, core.ErrCodeListingNotFound, nil},
+		{errInsufficientFunds, 500, 400, core.ErrCodeInsufficientFunds, nil},
+		{core.ErrBanReasonTooLong, 500, 400, core.ErrCodeValidationFailed, []string{"reason"}},
+		{core.ValidationError{
+			{Field: "slug", Code: core.ErrCodeFieldRequired, Reason: "Slug must not be empty"},
+			{Field: "item.title", Code: core.ErrCodeFieldRequired, Reason: "Listing must have a title"},
+		}, 500, 400, core.ErrCodeValidationFailed, []string{"slug", "item.title"}},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		RenderError(w, tt.handler, tt.err)
+		if w.Code != tt.status {
+			t.Errorf("expected status %d for %q, got %d", tt.status, tt.err, w.Code)
+		}
+		var resp errorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Success || resp.Reason != tt.err.Error() || resp.Code != tt.code {
+			t.Errorf("unexpected response for %q: %+v", tt.err, resp)
+		}
+		var fields []string
+		for _, f := range resp.Errors {
+			fields = append(fields, f.Field)
+		}
+		if !reflect.DeepEqual(fields, tt.fields) {
+			t.Errorf("expected invalid fields %v for %q, got %v", tt.fields, tt.err, fields)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// eventBufferCapacity is the number of events kept for clients resuming
+	// the event stream
+	eventBufferCapacity = 10000
+
+	// eventReplayBatch is the number of events read at once when replaying
+	eventReplayBatch = 500
+
+	// eventStreamHeartbeat is how often a comment is sent to idle streams so
+	// proxies don't close them
+	eventStreamHeartbeat = 30 * time.Second
+
+	// eventSubscriberBuffer is the number of events queued for a stream.
+	// Streams which fall further behind are closed and should resume with
+	// their Last-Event-ID.
+	eventSubscriberBuffer = 256
+)
+
+// Frequent or short lived notifications are streamed live but not persisted
+// so they don't push order and payment events out of the buffer. They are
+// sent without an ID.
+var transientEventTopics = map[repo.NotificationType]bool{
+	repo.NotifierTypeChatTyping:            true,
+	repo.NotifierTypeFindModeratorResponse: true,
+	repo.NotifierTypePremarshalledNotifier: true,
+}
+
+type streamEvent struct {
+	ID    uint64
+	Topic string
+	Data  []byte
+}
+
+// eventStream persists notifications in a ring buffer and fans them out to
+// the open Server-Sent Events streams
+type eventStream struct {
+	store repo.EventStore
+
+	lock        sync.Mutex
+	subscribers map[chan streamEvent]struct{}
+}
+
+func newEventStream(store repo.EventStore) *eventStream {
+	return &eventStream{
+		store:       store,
+		subscribers: make(map[chan streamEvent]struct{}),
+	}
+}
+
+// Publish persists the notification data, unless its topic is transient,
+// and sends it to the subscribers
+func (s *eventStream) Publish(topic repo.NotificationType, data []byte) {
+	e := streamEvent{Topic: string(topic), Data: data}
+	if !transientEventTopics[topic] {
+		id, err := s.store.Put(e.Topic, data, time.Now())
+		if err != nil {
+			log.Error("persist event:", err)
+		} else {
+			e.ID = id
+			if err := s.store.Truncate(eventBufferCapacity); err != nil {
+				log.Error("truncate events:", err)
+			}
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for sub := range s.subscribers {
+		select {
+		case sub <- e:
+		default:
+			delete(s.subscribers, sub)
+			close(sub)
+		}
+	}
+}
+
+// Subscribe returns a channel receiving every published event. It is closed
+// if the subscriber falls behind.
+func (s *eventStream) Subscribe() chan streamEvent {
+	sub := make(chan streamEvent, eventSubscriberBuffer)
+	s.lock.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.lock.Unlock()
+	return sub
+}
+
+func (s *eventStream) Unsubscribe(sub chan streamEvent) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub)
+	}
+}
+
+// ServeHTTP streams the events as Server-Sent Events. Clients resuming with
+// the Last-Event-ID header, or the lastEventId query parameter, first get
+// the persisted events after that ID. The topics query parameter takes a
+// comma separated list of notification types to filter by.
+func (s *eventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		ErrorResponse(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+	var lastID uint64
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+	if lastEventID != "" {
+		id, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, "Last-Event-ID must be an event ID")
+			return
+		}
+		lastID = id
+	}
+	var topics []string
+	for _, t := range strings.Split(r.URL.Query().Get("topics"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			topics = append(topics, t)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// Write errors are ignored as the request context is done once the
+	// client disconnects
+	err := s.Stream(r.Context(), lastID, topics, eventStreamHandler{
+		truncated: func(oldest uint64) error {
+			fmt.Fprintf(w, "event: truncated\ndata: {\"oldestId\": %d}\n\n", oldest)
+			flusher.Flush()
+			return nil
+		},
+		send: func(e streamEvent) error {
+			writeStreamEvent(w, e)
+			flusher.Flush()
+			return nil
+		},
+		heartbeat: func() error {
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+			return nil
+		},
+	})
+	if err != nil {
+		log.Error("read events:", err)
+	}
+}
+
+// eventStreamHandler receives the events of a stream
+type eventStreamHandler struct {
+	// truncated is called with the oldest event kept when events after the
+	// one the stream resumes from were dropped
+	truncated func(oldest uint64) error
+	send      func(e streamEvent) error
+
+	// heartbeat is called every eventStreamHeartbeat if set
+	heartbeat func() error
+}
+
+// Stream sends the persisted events after lastID, then the published events,
+// until the context is done, the subscriber falls behind or the handler
+// fails. Only events with one of the topics are sent, unless there are none.
+func (s *eventStream) Stream(ctx context.Context, lastID uint64, topics []string, h eventStreamHandler) error {
+	topicSet := make(map[string]bool)
+	var topicList []string
+	for _, t := range topics {
+		if !topicSet[t] {
+			topicSet[t] = true
+			topicList = append(topicList, t)
+		}
+	}
+	matches := func(e streamEvent) bool {
+		return len(topicSet) == 0 || topicSet[e.Topic]
+	}
+
+	// Subscribe before replaying so nothing published in between is missed.
+	// Events received both ways are skipped by their ID.
+	sub := s.Subscribe()
+	defer s.Unsubscribe(sub)
+
+	if lastID > 0 {
+		oldest, err := s.store.OldestID()
+		if err != nil {
+			return err
+		}
+		if oldest == 0 || oldest > lastID+1 {
+			// Events after lastID were dropped from the buffer, or the
+			// buffer is empty, so the client should refresh its state
+			if err := h.truncated(oldest); err != nil {
+				return err
+			}
+		}
+		for {
+			events, err := s.store.GetSince(lastID, topicList, eventReplayBatch)
+			if err != nil {
+				return err
+			}
+			for _, e := range events {
+				if err := h.send(streamEvent{e.ID, e.Topic, e.Data}); err != nil {
+					return err
+				}
+				lastID = e.ID
+			}
+			if len(events) < eventReplayBatch {
+				break
+			}
+		}
+	}
+
+	var heartbeat <-chan time.Time
+	if h.heartbeat != nil {
+		ticker := time.NewTicker(eventStreamHeartbeat)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+	for {
+		select {
+		case e, ok := <-sub:
+			if !ok {
+				return nil
+			}
+			if (e.ID != 0 && e.ID <= lastID) || !matches(e) {
+				continue
+			}
+			if err := h.send(e); err != nil {
+				return err
+			}
+			if e.ID != 0 {
+				lastID = e.ID
+			}
+		case <-heartbeat:
+			if err := h.heartbeat(); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func writeStreamEvent(w io.Writer, e streamEvent) {
+	var buf bytes.Buffer
+	if e.ID != 0 {
+		fmt.Fprintf(&buf, "id: %d\n", e.ID)
+	}
+	fmt.Fprintf(&buf, "event: %s\n", e.Topic)
+	for _, line := range bytes.Split(e.Data, []byte("\n")) {
+		fmt.Fprintf(&buf, "data: %s\n", bytes.TrimRight(line, "\r"))
+	}
+	buf.WriteString("\n")
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func newTestEventStream(t *testing.T) (*eventStream, func()) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newEventStream(db.NewEventStore(database, new(sync.Mutex))), appSchema.DestroySchemaDirectories
+}
+
+type sseEvent struct {
+	id, event, data string
+}
+
+// readSSEEvent reads the next event of the stream, skipping comments
+func readSSEEvent(t *testing.T, r *bufio.Reader) sseEvent {
+	var (
+		e    sseEvent
+		done = make(chan struct{})
+	)
+	go func() {
+		defer close(done)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\n")
+			switch {
+			case line == "" && e.event != "":
+				return
+			case strings.HasPrefix(line, "id: "):
+				e.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				e.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				if e.data != "" {
+					e.data += "\n"
+				}
+				e.data += strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for an event")
+	}
+	return e
+}
+
+func TestEventStreamResume(t *testing.T) {
+	events, teardown := newTestEventStream(t)
+	defer teardown()
+	server := httptest.NewServer(events)
+	defer server.Close()
+
+	events.Publish(repo.NotifierTypeOrderNewNotification, []byte(`{"order": 1}`))
+	events.Publish(repo.NotifierTypeChatMessage, []byte(`{"chat": 1}`))
+	events.Publish(repo.NotifierTypePaymentNotification, []byte("{\n    \"payment\": 1\n}"))
+	first, err := events.store.OldestID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest("GET", server.URL+"?topics=order,payment,chatTyping", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Last-Event-ID", strconv.FormatUint(first, 10))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Expected content type text/event-stream, got %s", ct)
+	}
+	r := bufio.NewReader(resp.Body)
+
+	// The chat message is filtered and the payment is replayed
+	e := readSSEEvent(t, r)
+	if e.id != strconv.FormatUint(first+2, 10) || e.event != "payment" || e.data != "{\n    \"payment\": 1\n}" {
+		t.Errorf("Unexpected replayed event %+v", e)
+	}
+
+	// Live events follow, transient ones without an ID
+	events.Publish(repo.NotifierTypeChatMessage, []byte(`{"chat": 2}`))
+	events.Publish(repo.NotifierTypeChatTyping, []byte(`{"typing": 1}`))
+	events.Publish(repo.NotifierTypeOrderNewNotification, []byte(`{"order": 2}`))
+	if e := readSSEEvent(t, r); e.id != "" || e.event != "chatTyping" {
+		t.Errorf("Unexpected transient event %+v", e)
+	}
+	if e := readSSEEvent(t, r); e.id != strconv.FormatUint(first+4, 10) || e.event != "order" || e.data != `{"order": 2}` {
+		t.Errorf("Unexpected live event %+v", e)
+	}
+}
+
+func TestEventStreamTruncated(t *testing.T) {
+	events, teardown := newTestEventStream(t)
+	defer teardown()
+	server := httptest.NewServer(events)
+	defer server.Close()
+
+	for i := 0; i < 3; i++ {
+		events.Publish(repo.NotifierTypeOrderNewNotification, []byte(`{}`))
+	}
+	oldest, err := events.store.OldestID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := events.store.Truncate(1); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(server.URL + "?lastEventId=" + strconv.FormatUint(oldest, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	r := bufio.NewReader(resp.Body)
+	if e := readSSEEvent(t, r); e.event != "truncated" || e.data != `{"oldestId": `+strconv.FormatUint(oldest+2, 10)+`}` {
+		t.Errorf("Expected truncated event, got %+v", e)
+	}
+	if e := readSSEEvent(t, r); e.id != strconv.FormatUint(oldest+2, 10) {
+		t.Errorf("Expected the remaining event to be replayed, got %+v", e)
+	}
+
+	resp, err = http.Get(server.URL + "?lastEventId=abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for an invalid event ID, got %d", resp.StatusCode)
+	}
+}
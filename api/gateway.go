@@ -8,6 +8,8 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	"github.com/ipfs/go-ipfs/core/corehttp"
 	"github.com/op/go-logging"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 var log = logging.MustGetLogger("api")
@@ -25,13 +27,17 @@ func NewGateway(n *core.OpenBazaarNode, authCookie http.Cookie, l net.Listener,
 	log.SetBackend(logging.AddModuleLevel(logger))
 	topMux := http.NewServeMux()
 
-	jsonAPI := newJsonAPIHandler(n, authCookie, config)
-	wsAPI := newWSAPIHandler(n, authCookie, config)
-	n.Broadcast = manageNotifications(n, wsAPI.h.Broadcast)
+	guard := newAuthGuard(n.Datastore.AuthLog())
+	events := newEventStream(n.Datastore.Events())
+	jsonAPI := newJsonAPIHandler(n, authCookie, config, guard, events)
+	wsAPI := newWSAPIHandler(n, authCookie, config, guard)
+	n.Broadcast = manageNotifications(n, wsAPI.h.Broadcast, events)
 
 	topMux.Handle("/ob/", jsonAPI)
 	topMux.Handle("/wallet/", jsonAPI)
+	topMux.Handle("/metrics", jsonAPI)
 	topMux.Handle("/ws", wsAPI)
+	topMux.Handle(grpcServicePath, newGRPCAPIHandler(jsonAPI))
 
 	var (
 		err error
@@ -57,13 +63,15 @@ func (g *Gateway) Close() error {
 	return g.listener.Close()
 }
 
-// Serve begins listening on the configured address
+// Serve begins listening on the configured address. HTTP/2 is served
+// alongside HTTP/1 for the gRPC API, over cleartext connections (h2c) as well
+// when SSL is disabled.
 func (g *Gateway) Serve() error {
 	var err error
 	if g.config.SSL {
 		err = http.ListenAndServeTLS(g.listener.Addr().String(), g.config.SSLCert, g.config.SSLKey, g.handler)
 	} else {
-		err = http.Serve(g.listener, g.handler)
+		err = http.Serve(g.listener, h2c.NewHandler(g.handler, &http2.Server{}))
 	}
 	return err
 }
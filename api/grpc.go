@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// grpcServicePath is the path prefix of the methods of the OpenBazaar
+	// service defined in pb/protos/service.proto
+	grpcServicePath = "/OpenBazaar/"
+)
+
+// grpcRequestKey is the context key of the HTTP request a call was made
+// with, which the interceptors authenticate
+type grpcRequestKey struct{}
+
+// grpcErrorStatus returns the error a call failing with err ends with. Errors
+// of the JSON API and of the error catalog are mapped from their HTTP status.
+func grpcErrorStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+	switch err {
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(grpcCodeForHTTPStatus(errorStatus(err, http.StatusInternalServerError)), err.Error())
+}
+
+func grpcCodeForHTTPStatus(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed, http.StatusUnprocessableEntity:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
+
+// grpcAPIHandler serves the gRPC API through the gRPC server, behind the
+// same checks as the JSON API
+type grpcAPIHandler struct {
+	api    *jsonAPIHandler
+	server *grpc.Server
+}
+
+func newGRPCAPIHandler(api *jsonAPIHandler) *grpcAPIHandler {
+	return newGRPCAPIHandlerWithService(api, &grpcService{api})
+}
+
+// newGRPCAPIHandlerWithService serves the service, which tests replace with
+// a partial implementation
+func newGRPCAPIHandlerWithService(api *jsonAPIHandler, service pb.OpenBazaarServer) *grpcAPIHandler {
+	g := &grpcAPIHandler{api: api}
+	g.server = grpc.NewServer(
+		grpc.UnaryInterceptor(g.unaryInterceptor),
+		grpc.StreamInterceptor(g.streamInterceptor),
+	)
+	pb.RegisterOpenBazaarServer(g.server, service)
+	return g
+}
+
+func (g *grpcAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		ErrorResponse(w, http.StatusUnsupportedMediaType, "gRPC requests must be POSTed with the application/grpc content type")
+		return
+	}
+	if r.ProtoMajor != 2 {
+		ErrorResponse(w, http.StatusHTTPVersionNotSupported, "gRPC requires HTTP/2")
+		return
+	}
+	g.server.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), grpcRequestKey{}, r)))
+}
+
+// authorize runs the checks of the JSON API for the call, with the scopes of
+// the equivalent JSON API request
+func (g *grpcAPIHandler) authorize(ctx context.Context, fullMethod string) error {
+	r, ok := ctx.Value(grpcRequestKey{}).(*http.Request)
+	if !ok {
+		return status.Error(codes.Internal, "missing request")
+	}
+	if !g.api.config.Enabled {
+		return status.Error(codes.PermissionDenied, "the API is disabled")
+	}
+	if len(g.api.config.AllowedIPs) > 0 {
+		remoteAddr := strings.Split(r.RemoteAddr, ":")
+		if !g.api.config.AllowedIPs[remoteAddr[0]] {
+			return status.Error(codes.PermissionDenied, "IP not allowed")
+		}
+	}
+	endpoint, ok := grpcMethodEndpoints[strings.TrimPrefix(fullMethod, grpcServicePath)]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", fullMethod)
+	}
+	switch code, wait := g.api.checkAuth(r, r.URL.Path, apiTokenScopes(endpoint.path, endpoint.method)); code {
+	case 0:
+		return nil
+	case http.StatusTooManyRequests:
+		return status.Errorf(codes.ResourceExhausted, "too many failed authentications, retry in %s", wait.Round(time.Second))
+	default:
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+}
+
+func (g *grpcAPIHandler) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer grpcRecover(info.FullMethod, &err)
+	if err := g.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	resp, err = handler(ctx, req)
+	return resp, grpcErrorStatus(err)
+}
+
+func (g *grpcAPIHandler) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer grpcRecover(info.FullMethod, &err)
+	if err := g.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return grpcErrorStatus(handler(srv, ss))
+}
+
+// grpcRecover ends a call which panicked with the Internal status, as the
+// gRPC server doesn't recover from panics
+func grpcRecover(method string, err *error) {
+	if r := recover(); r != nil {
+		log.Errorf("A panic occurred in the gRPC api handler serving %s!", method)
+		log.Error(r)
+		log.Errorf("%s", debug.Stack())
+		*err = status.Error(codes.Internal, "internal error")
+	}
+}
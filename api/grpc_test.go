@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newTestGRPCServer serves the service over cleartext HTTP/2 and returns a
+// client connected to it
+func newTestGRPCServer(t *testing.T, api *jsonAPIHandler, service pb.OpenBazaarServer) (pb.OpenBazaarClient, func()) {
+	server := httptest.NewServer(h2c.NewHandler(newGRPCAPIHandlerWithService(api, service), &http2.Server{}))
+	conn, err := grpc.Dial(strings.TrimPrefix(server.URL, "http://"), grpc.WithInsecure())
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	return pb.NewOpenBazaarClient(conn), func() {
+		conn.Close()
+		server.Close()
+	}
+}
+
+// testListingService implements GetListing, and panics on the other methods
+type testListingService struct {
+	pb.OpenBazaarServer
+}
+
+func (s *testListingService) GetListing(ctx context.Context, req *pb.ListingRequest) (*pb.SignedListing, error) {
+	if req.Slug == "missing" {
+		return nil, &apiError{http.StatusNotFound, "Listing not found: 100%"}
+	}
+	return &pb.SignedListing{Hash: req.Slug}, nil
+}
+
+func TestGRPCUnaryCall(t *testing.T) {
+	api := &jsonAPIHandler{config: JsonAPIConfig{Enabled: true}}
+	client, teardown := newTestGRPCServer(t, api, &testListingService{})
+	defer teardown()
+	ctx := context.Background()
+
+	listing, err := client.GetListing(ctx, &pb.ListingRequest{Slug: "ron-swanson-shirt"})
+	if err != nil || listing.Hash != "ron-swanson-shirt" {
+		t.Errorf("Unexpected response %v (%v)", listing, err)
+	}
+
+	_, err = client.GetListing(ctx, &pb.ListingRequest{Slug: "missing"})
+	if s, _ := status.FromError(err); s.Code() != codes.NotFound || s.Message() != "Listing not found: 100%" {
+		t.Errorf("Expected the JSON API error to map to NotFound, got %v", err)
+	}
+
+	_, err = client.GetListings(ctx, &pb.Empty{})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("Expected a panic to end the call with Internal, got %v", err)
+	}
+
+	api.config.Enabled = false
+	_, err = client.GetListing(ctx, &pb.ListingRequest{Slug: "ron-swanson-shirt"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected the disabled API to deny the call, got %v", err)
+	}
+}
+
+func TestGRPCNotifications(t *testing.T) {
+	events, teardown := newTestEventStream(t)
+	defer teardown()
+	api := &jsonAPIHandler{config: JsonAPIConfig{Enabled: true}, events: events}
+	client, closeServer := newTestGRPCServer(t, api, &grpcService{api})
+	defer closeServer()
+
+	events.Publish(repo.NotifierTypeOrderNewNotification, []byte(`{"order": 1}`))
+	events.Publish(repo.NotifierTypeChatMessage, []byte(`{"chat": 1}`))
+	events.Publish(repo.NotifierTypePaymentNotification, []byte(`{"payment": 1}`))
+	first, err := events.store.OldestID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	stream, err := client.Notifications(ctx, &pb.NotificationsRequest{Topics: []string{"order", "payment"}, LastEventId: first})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var messages []*pb.NotificationEvent
+	for {
+		e, err := stream.Recv()
+		if err != nil {
+			if status.Code(err) != codes.DeadlineExceeded {
+				t.Errorf("Expected the stream to end with DeadlineExceeded, got %v", err)
+			}
+			break
+		}
+		messages = append(messages, e)
+	}
+	if elapsed := time.Since(start); elapsed < 300*time.Millisecond {
+		t.Errorf("Expected the stream to stay open until the deadline, closed after %s", elapsed)
+	}
+	if len(messages) != 1 {
+		t.Fatalf("Expected one replayed event, got %v", messages)
+	}
+	if e := messages[0]; e.Id != first+2 || e.Topic != "payment" || e.Json != `{"payment": 1}` {
+		t.Errorf("Unexpected replayed event %v", e)
+	}
+}
+
+func TestGRPCIdempotencyKey(t *testing.T) {
+	store := &memoryIdempotencyKeys{responses: make(map[string]repo.IdempotentResponse)}
+	api := &jsonAPIHandler{idempotencyKeys: newIdempotencyKeys(store, time.Hour)}
+	s := &grpcService{api}
+	calls := 0
+	spend := func(req *pb.SpendRequest) func() (proto.Message, error) {
+		return func() (proto.Message, error) {
+			calls++
+			if req.Amount == 0 {
+				return nil, errInsufficientFunds
+			}
+			return &pb.SpendResponse{Txid: "tx1", Amount: req.Amount}, nil
+		}
+	}
+	call := func(key string, req *pb.SpendRequest) (*pb.SpendResponse, error) {
+		ctx := context.Background()
+		if key != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("idempotency-key", key))
+		}
+		resp := new(pb.SpendResponse)
+		err := s.idempotent(ctx, "Spend", req, resp, spend(req))
+		return resp, grpcErrorStatus(err)
+	}
+
+	for n := 0; n < 2; n++ {
+		resp, err := call("spend-1", &pb.SpendRequest{Address: "addr", Amount: 1000})
+		if err != nil || resp.Txid != "tx1" || resp.Amount != 1000 {
+			t.Errorf("Expected the original response, got %v (%v)", resp, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected the spend to run once, ran %d times", calls)
+	}
+
+	if _, err := call("spend-1", &pb.SpendRequest{Address: "addr", Amount: 2000}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected reusing the key for a different request to fail, got %v", err)
+	}
+
+	for n := 0; n < 2; n++ {
+		_, err := call("spend-2", &pb.SpendRequest{Address: "addr"})
+		if s, _ := status.FromError(err); s.Code() != codes.InvalidArgument || s.Message() != errInsufficientFunds.Error() {
+			t.Errorf("Expected the stored error, got %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected the failed spend to run once, ran %d times", calls-1)
+	}
+
+	call("", &pb.SpendRequest{Address: "addr", Amount: 1000})
+	call("", &pb.SpendRequest{Address: "addr", Amount: 1000})
+	if calls != 4 {
+		t.Errorf("Expected calls without a key to always run, ran %d times", calls-2)
+	}
+}
@@ -0,0 +1,509 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcEndpoint is the JSON API request with the same effect as a method of
+// the gRPC service
+type grpcEndpoint struct {
+	method string
+	path   string
+}
+
+// grpcMethodEndpoints maps the methods of the OpenBazaar service to their JSON
+// API requests, which set the scopes an API token needs to call them
+var grpcMethodEndpoints = map[string]grpcEndpoint{
+	// Listings
+	"GetListings":   {"GET", "/ob/listings"},
+	"GetListing":    {"GET", "/ob/listing"},
+	"CreateListing": {"POST", "/ob/listing"},
+	"UpdateListing": {"PUT", "/ob/listing"},
+	"DeleteListing": {"DELETE", "/ob/listing"},
+
+	// Orders
+	"Purchase":     {"POST", "/ob/purchase"},
+	"GetOrder":     {"GET", "/ob/order"},
+	"ConfirmOrder": {"POST", "/ob/orderconfirmation"},
+	"CancelOrder":  {"POST", "/ob/ordercancel"},
+	"FulfillOrder": {"POST", "/ob/orderfulfillment"},
+	"RefundOrder":  {"POST", "/ob/refund"},
+
+	// Sales, purchases and cases
+	"GetSales":     {"GET", "/ob/sales"},
+	"GetPurchases": {"GET", "/ob/purchases"},
+	"GetCases":     {"GET", "/ob/cases"},
+	"GetCase":      {"GET", "/ob/case"},
+
+	// Chat
+	"SendChatMessage":      {"POST", "/ob/chat"},
+	"GetChatMessages":      {"GET", "/ob/chatmessages"},
+	"GetChatConversations": {"GET", "/ob/chatconversations"},
+	"MarkChatAsRead":       {"POST", "/ob/markchatasread"},
+
+	// Wallet
+	"GetAddress": {"GET", "/wallet/address"},
+	"GetBalance": {"GET", "/wallet/balance"},
+	"Spend":      {"POST", "/wallet/spend"},
+
+	// Notifications
+	"Notifications": {"GET", "/ob/events"},
+}
+
+// grpcService implements the OpenBazaar service with the JSON API handler
+type grpcService struct {
+	i *jsonAPIHandler
+}
+
+func (s *grpcService) GetListings(ctx context.Context, req *pb.Empty) (*pb.ListingIndex, error) {
+	return s.i.grpcGetListings()
+}
+
+func (s *grpcService) GetListing(ctx context.Context, req *pb.ListingRequest) (*pb.SignedListing, error) {
+	return s.i.getOwnListing(req.Slug)
+}
+
+func (s *grpcService) CreateListing(ctx context.Context, listing *pb.Listing) (*pb.ListingRequest, error) {
+	if err := s.i.node.CreateListing(listing); err != nil {
+		if err == core.ErrListingAlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "Listing already exists. Use UpdateListing.")
+		}
+		return nil, err
+	}
+	return &pb.ListingRequest{Slug: listing.Slug}, nil
+}
+
+func (s *grpcService) UpdateListing(ctx context.Context, listing *pb.Listing) (*pb.Empty, error) {
+	if err := s.i.node.UpdateListing(listing); err != nil {
+		if err == core.ErrListingDoesNotExist {
+			return nil, status.Error(codes.NotFound, "Listing not found.")
+		}
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *grpcService) DeleteListing(ctx context.Context, req *pb.ListingRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, s.i.deleteListing(req.Slug)
+}
+
+func (s *grpcService) Purchase(ctx context.Context, req *pb.PurchaseRequest) (*pb.PurchaseResponse, error) {
+	resp := new(pb.PurchaseResponse)
+	err := s.idempotent(ctx, "Purchase", req, resp, func() (proto.Message, error) {
+		return s.i.grpcPurchase(req)
+	})
+	return resp, err
+}
+
+func (s *grpcService) GetOrder(ctx context.Context, req *pb.OrderRequest) (*pb.OrderRespApi, error) {
+	return s.i.getOrder(req.OrderId)
+}
+
+func (s *grpcService) ConfirmOrder(ctx context.Context, req *pb.OrderConfirmationRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, s.i.confirmOrder(req.OrderId, req.Reject)
+}
+
+func (s *grpcService) CancelOrder(ctx context.Context, req *pb.OrderRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, s.i.cancelOrder(req.OrderId)
+}
+
+func (s *grpcService) FulfillOrder(ctx context.Context, req *pb.OrderFulfillment) (*pb.Empty, error) {
+	return &pb.Empty{}, s.i.fulfillOrder(req)
+}
+
+func (s *grpcService) RefundOrder(ctx context.Context, req *pb.OrderRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, s.i.refundOrder(req.OrderId)
+}
+
+func (s *grpcService) GetSales(ctx context.Context, query *pb.OrderQuery) (*pb.OrderList, error) {
+	return s.i.grpcGetSales(query)
+}
+
+func (s *grpcService) GetPurchases(ctx context.Context, query *pb.OrderQuery) (*pb.OrderList, error) {
+	return s.i.grpcGetPurchases(query)
+}
+
+func (s *grpcService) GetCases(ctx context.Context, query *pb.OrderQuery) (*pb.CaseList, error) {
+	return s.i.grpcGetCases(query)
+}
+
+func (s *grpcService) GetCase(ctx context.Context, req *pb.OrderRequest) (*pb.CaseRespApi, error) {
+	return s.i.getCase(req.OrderId)
+}
+
+func (s *grpcService) SendChatMessage(ctx context.Context, req *pb.ChatMessageRequest) (*pb.ChatMessageResponse, error) {
+	messageId, err := s.i.sendChat(req.PeerId, req.Subject, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChatMessageResponse{MessageId: messageId}, nil
+}
+
+func (s *grpcService) GetChatMessages(ctx context.Context, req *pb.ChatMessagesRequest) (*pb.ChatMessageList, error) {
+	return s.i.grpcGetChatMessages(req)
+}
+
+func (s *grpcService) GetChatConversations(ctx context.Context, req *pb.Empty) (*pb.ChatConversationList, error) {
+	return s.i.grpcGetChatConversations()
+}
+
+func (s *grpcService) MarkChatAsRead(ctx context.Context, req *pb.ChatReadRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, s.i.markChatAsRead(req.PeerId, req.Subject)
+}
+
+func (s *grpcService) GetAddress(ctx context.Context, req *pb.Empty) (*pb.WalletAddress, error) {
+	addr := s.i.node.Wallet.CurrentAddress(wallet.EXTERNAL)
+	return &pb.WalletAddress{Address: addr.EncodeAddress()}, nil
+}
+
+func (s *grpcService) GetBalance(ctx context.Context, req *pb.Empty) (*pb.WalletBalance, error) {
+	height, _ := s.i.node.Wallet.ChainTip()
+	confirmed, unconfirmed := s.i.node.Wallet.Balance()
+	return &pb.WalletBalance{Confirmed: confirmed, Unconfirmed: unconfirmed, Height: height}, nil
+}
+
+func (s *grpcService) Spend(ctx context.Context, req *pb.SpendRequest) (*pb.SpendResponse, error) {
+	resp := new(pb.SpendResponse)
+	err := s.idempotent(ctx, "Spend", req, resp, func() (proto.Message, error) {
+		return s.i.grpcSpend(req)
+	})
+	return resp, err
+}
+
+func (s *grpcService) Notifications(req *pb.NotificationsRequest, stream pb.OpenBazaar_NotificationsServer) error {
+	return s.i.grpcNotifications(stream.Context(), req, func(m *pb.NotificationEvent) error {
+		return stream.Send(m)
+	})
+}
+
+// idempotent calls run, which returns resp, through the idempotency keys of
+// the JSON API when the call has idempotency-key metadata. A retry with the
+// same key and request gets the stored response, or fails with the stored
+// error unless it was a server error, and the idempotent-replayed header.
+func (s *grpcService) idempotent(ctx context.Context, method string, req, resp proto.Message, run func() (proto.Message, error)) error {
+	var key string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get("idempotency-key"); len(keys) > 0 {
+			key = keys[0]
+		}
+	}
+	if key == "" || s.i.idempotencyKeys == nil {
+		m, err := run()
+		if err != nil {
+			return err
+		}
+		proto.Merge(resp, m)
+		return nil
+	}
+
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	h.Write([]byte("gRPC " + grpcServicePath + method + "\n"))
+	h.Write(reqBytes)
+
+	var runErr error
+	stored, replayed, err := s.i.idempotencyKeys.do(key, hex.EncodeToString(h.Sum(nil)), func() (int, []byte) {
+		m, err := run()
+		if err != nil {
+			runErr = err
+			return errorStatus(err, http.StatusInternalServerError), []byte(err.Error())
+		}
+		b, err := proto.Marshal(m)
+		if err != nil {
+			runErr = err
+			return http.StatusInternalServerError, []byte(err.Error())
+		}
+		return http.StatusOK, b
+	})
+	if err != nil {
+		return err
+	}
+	if replayed {
+		grpc.SetHeader(ctx, metadata.Pairs("idempotent-replayed", "true"))
+	} else if runErr != nil {
+		return runErr
+	}
+	if stored.StatusCode != http.StatusOK {
+		return status.Error(grpcCodeForHTTPStatus(stored.StatusCode), string(stored.Body))
+	}
+	return proto.Unmarshal(stored.Body, resp)
+}
+
+func (i *jsonAPIHandler) grpcGetListings() (*pb.ListingIndex, error) {
+	listingsBytes, err := i.node.GetListings()
+	if err != nil {
+		return nil, &apiError{http.StatusNotFound, err.Error()}
+	}
+	var listings []core.ListingData
+	if err := json.Unmarshal(listingsBytes, &listings); err != nil {
+		return nil, err
+	}
+	index := new(pb.ListingIndex)
+	for _, l := range listings {
+		index.Listings = append(index.Listings, &pb.ListingIndexEntry{
+			Hash:         l.Hash,
+			Slug:         l.Slug,
+			Title:        l.Title,
+			Categories:   l.Categories,
+			Tags:         l.Tags,
+			Nsfw:         l.NSFW,
+			ContractType: l.ContractType,
+			Description:  l.Description,
+			Thumbnail: &pb.ListingIndexEntry_Thumbnail{
+				Tiny:   l.Thumbnail.Tiny,
+				Small:  l.Thumbnail.Small,
+				Medium: l.Thumbnail.Medium,
+			},
+			Price: &pb.ListingIndexEntry_Price{
+				CurrencyCode: l.Price.CurrencyCode,
+				Amount:       l.Price.Amount,
+				Modifier:     l.Price.Modifier,
+			},
+			ShipsTo:            l.ShipsTo,
+			FreeShipping:       l.FreeShipping,
+			Language:           l.Language,
+			Languages:          l.Languages,
+			AverageRating:      l.AverageRating,
+			RatingCount:        l.RatingCount,
+			Moderators:         l.ModeratorIDs,
+			AcceptedCurrencies: l.AcceptedCurrencies,
+			CoinType:           l.CoinType,
+		})
+	}
+	return index, nil
+}
+
+func (i *jsonAPIHandler) grpcPurchase(req *pb.PurchaseRequest) (*pb.PurchaseResponse, error) {
+	// PurchaseRequest has the JSON field names of core.PurchaseData
+	m := jsonpb.Marshaler{}
+	var buf bytes.Buffer
+	if err := m.Marshal(&buf, req); err != nil {
+		return nil, err
+	}
+	var data core.PurchaseData
+	if err := json.Unmarshal(buf.Bytes(), &data); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	orderId, paymentAddr, amount, online, err := i.node.Purchase(&data)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PurchaseResponse{
+		OrderId:        orderId,
+		PaymentAddress: paymentAddr,
+		Amount:         amount,
+		VendorOnline:   online,
+	}, nil
+}
+
+func orderQueryLimit(query *pb.OrderQuery) int {
+	if query.Limit <= 0 {
+		return -1
+	}
+	return int(query.Limit)
+}
+
+func (i *jsonAPIHandler) grpcGetSales(query *pb.OrderQuery) (*pb.OrderList, error) {
+	sales, queryCount, err := i.node.Datastore.Sales().GetAll(query.OrderStates, query.SearchTerm, query.SortByAscending, query.SortByRead, orderQueryLimit(query), query.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	list := &pb.OrderList{QueryCount: uint32(queryCount)}
+	for _, s := range sales {
+		unread, _ := i.node.Datastore.Chat().GetUnreadCount(s.OrderId)
+		list.Orders = append(list.Orders, &pb.OrderSummary{
+			OrderId:            s.OrderId,
+			Slug:               s.Slug,
+			Timestamp:          grpcTimestamp(s.Timestamp),
+			Title:              s.Title,
+			Thumbnail:          s.Thumbnail,
+			Total:              s.Total,
+			PeerId:             s.BuyerId,
+			Handle:             s.BuyerHandle,
+			ShippingName:       s.ShippingName,
+			ShippingAddress:    s.ShippingAddress,
+			CoinType:           s.CoinType,
+			PaymentCoin:        s.PaymentCoin,
+			State:              pb.OrderState(pb.OrderState_value[s.State]),
+			Read:               s.Read,
+			Moderated:          s.Moderated,
+			UnreadChatMessages: uint32(unread),
+		})
+	}
+	return list, nil
+}
+
+func (i *jsonAPIHandler) grpcGetPurchases(query *pb.OrderQuery) (*pb.OrderList, error) {
+	purchases, queryCount, err := i.node.Datastore.Purchases().GetAll(query.OrderStates, query.SearchTerm, query.SortByAscending, query.SortByRead, orderQueryLimit(query), query.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	list := &pb.OrderList{QueryCount: uint32(queryCount)}
+	for _, p := range purchases {
+		unread, _ := i.node.Datastore.Chat().GetUnreadCount(p.OrderId)
+		list.Orders = append(list.Orders, &pb.OrderSummary{
+			OrderId:            p.OrderId,
+			Slug:               p.Slug,
+			Timestamp:          grpcTimestamp(p.Timestamp),
+			Title:              p.Title,
+			Thumbnail:          p.Thumbnail,
+			Total:              p.Total,
+			PeerId:             p.VendorId,
+			Handle:             p.VendorHandle,
+			ShippingName:       p.ShippingName,
+			ShippingAddress:    p.ShippingAddress,
+			CoinType:           p.CoinType,
+			PaymentCoin:        p.PaymentCoin,
+			State:              pb.OrderState(pb.OrderState_value[p.State]),
+			Read:               p.Read,
+			Moderated:          p.Moderated,
+			UnreadChatMessages: uint32(unread),
+		})
+	}
+	return list, nil
+}
+
+func (i *jsonAPIHandler) grpcGetCases(query *pb.OrderQuery) (*pb.CaseList, error) {
+	cases, queryCount, err := i.node.Datastore.Cases().GetAll(query.OrderStates, query.SearchTerm, query.SortByAscending, query.SortByRead, orderQueryLimit(query), query.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	list := &pb.CaseList{QueryCount: uint32(queryCount)}
+	for _, c := range cases {
+		unread, _ := i.node.Datastore.Chat().GetUnreadCount(c.CaseId)
+		list.Cases = append(list.Cases, &pb.CaseSummary{
+			CaseId:             c.CaseId,
+			Slug:               c.Slug,
+			Timestamp:          grpcTimestamp(c.Timestamp),
+			Title:              c.Title,
+			Thumbnail:          c.Thumbnail,
+			Total:              c.Total,
+			BuyerId:            c.BuyerId,
+			BuyerHandle:        c.BuyerHandle,
+			VendorId:           c.VendorId,
+			VendorHandle:       c.VendorHandle,
+			CoinType:           c.CoinType,
+			PaymentCoin:        c.PaymentCoin,
+			BuyerOpened:        c.BuyerOpened,
+			State:              pb.OrderState(pb.OrderState_value[c.State]),
+			Read:               c.Read,
+			UnreadChatMessages: uint32(unread),
+		})
+	}
+	return list, nil
+}
+
+func (i *jsonAPIHandler) grpcGetChatMessages(req *pb.ChatMessagesRequest) (*pb.ChatMessageList, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = -1
+	}
+	list := new(pb.ChatMessageList)
+	for _, m := range i.node.Datastore.Chat().GetMessages(req.PeerId, req.Subject, req.OffsetId, limit) {
+		list.Messages = append(list.Messages, grpcChatMessage(m))
+	}
+	return list, nil
+}
+
+func grpcChatMessage(m repo.ChatMessage) *pb.ChatMessage {
+	return &pb.ChatMessage{
+		MessageId: m.MessageId,
+		PeerId:    m.PeerId,
+		Subject:   m.Subject,
+		Message:   m.Message,
+		Read:      m.Read,
+		Outgoing:  m.Outgoing,
+		Timestamp: grpcTimestamp(m.Timestamp),
+	}
+}
+
+func (i *jsonAPIHandler) grpcGetChatConversations() (*pb.ChatConversationList, error) {
+	list := new(pb.ChatConversationList)
+	for _, c := range i.node.Datastore.Chat().GetConversations() {
+		list.Conversations = append(list.Conversations, &pb.ChatConversation{
+			PeerId:      c.PeerId,
+			Unread:      uint32(c.Unread),
+			LastMessage: c.Last,
+			Timestamp:   grpcTimestamp(c.Timestamp),
+			Outgoing:    c.Outgoing,
+		})
+	}
+	return list, nil
+}
+
+func (i *jsonAPIHandler) grpcSpend(req *pb.SpendRequest) (*pb.SpendResponse, error) {
+	var feeLevel wallet.FeeLevel
+	switch req.FeeLevel {
+	case pb.SpendRequest_PRIORITY:
+		feeLevel = wallet.PRIOIRTY
+	case pb.SpendRequest_ECONOMIC:
+		feeLevel = wallet.ECONOMIC
+	default:
+		feeLevel = wallet.NORMAL
+	}
+	resp, err := i.spendCoins(req.Address, req.Amount, feeLevel, req.Memo)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SpendResponse{
+		Txid:               resp.Txid,
+		Amount:             resp.Amount,
+		ConfirmedBalance:   resp.ConfirmedBalance,
+		UnconfirmedBalance: resp.UnconfirmedBalance,
+		Timestamp:          grpcTimestamp(resp.Timestamp),
+		Memo:               resp.Memo,
+	}, nil
+}
+
+// grpcNotifications streams the notifications from the event stream, the
+// same as /ob/events
+func (i *jsonAPIHandler) grpcNotifications(ctx context.Context, req *pb.NotificationsRequest, send func(*pb.NotificationEvent) error) error {
+	if i.events == nil {
+		return status.Error(codes.Unavailable, "notifications are not available")
+	}
+	return i.events.Stream(ctx, req.LastEventId, req.Topics, eventStreamHandler{
+		truncated: func(oldest uint64) error {
+			return send(&pb.NotificationEvent{
+				Topic: "truncated",
+				Json:  fmt.Sprintf(`{"oldestId": %d}`, oldest),
+			})
+		},
+		send: func(e streamEvent) error {
+			return send(&pb.NotificationEvent{Id: e.ID, Topic: e.Topic, Json: string(e.Data)})
+		},
+	})
+}
+
+func grpcTimestamp(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
@@ -1,11 +1,19 @@
 package api
 
+// wsMessage is a message to the websocket connections. Connections which
+// subscribed to topics only get the messages of those topics, messages
+// without topics go to every connection.
+type wsMessage struct {
+	data   []byte
+	topics []string
+}
+
 type hub struct {
 	// Registered connections
 	connections map[*connection]bool
 
 	// Inbound messages from the connections
-	Broadcast chan []byte
+	Broadcast chan wsMessage
 
 	// Register requests from the connections
 	register chan *connection
@@ -16,7 +24,7 @@ type hub struct {
 
 func newHub() *hub {
 	return &hub{
-		Broadcast:   make(chan []byte),
+		Broadcast:   make(chan wsMessage),
 		register:    make(chan *connection),
 		unregister:  make(chan *connection),
 		connections: make(map[*connection]bool),
@@ -37,8 +45,11 @@ func (h *hub) run() {
 			log.Debug("Unregistered websocket connection")
 		case m := <-h.Broadcast:
 			for c := range h.connections {
+				if !c.wants(m.topics) {
+					continue
+				}
 				select {
-				case c.send <- m:
+				case c.send <- m.data:
 				default:
 					delete(h.connections, c)
 					close(c.send)
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestNotificationTopics(t *testing.T) {
+	for _, c := range []struct {
+		notifier repo.Notifier
+		expected []string
+	}{
+		{repo.ChatTyping{PeerId: "QmPeer"}, []string{"chat:QmPeer", "chatTyping"}},
+		{repo.IncomingTransaction{Txid: "txid", OrderId: "QmOrder"}, []string{"incomingTransaction", "order:QmOrder", "wallet"}},
+		{repo.NewNotification(repo.PaymentNotification{ID: "id", Type: repo.NotifierTypePaymentNotification, OrderId: "QmOrder"}, time.Now(), false), []string{"order:QmOrder", "payment"}},
+		{repo.PremarshalledNotifier{Payload: []byte(`{"walletUpdate": {"height": 1}}`)}, []string{"wallet", "walletUpdate"}},
+		{repo.PremarshalledNotifier{Payload: []byte(`not json`)}, []string{string(repo.NotifierTypePremarshalledNotifier)}},
+	} {
+		data, err := c.notifier.WebsocketData()
+		if err != nil {
+			t.Fatal(err)
+		}
+		topics := notificationTopics(c.notifier.GetType(), data)
+		sort.Strings(topics)
+		if !reflect.DeepEqual(topics, c.expected) {
+			t.Errorf("Expected topics of %T to be %v, got %v", c.notifier, c.expected, topics)
+		}
+	}
+}
+
+func TestHubRoutesSubscribedTopics(t *testing.T) {
+	h := newHub()
+	go h.run()
+
+	var (
+		all       = &connection{send: make(chan []byte, 10), h: h}
+		orders    = &connection{send: make(chan []byte, 10), h: h}
+		nothing   = &connection{send: make(chan []byte, 10), h: h}
+		chatPeers = &connection{send: make(chan []byte, 10), h: h}
+	)
+	orders.updateSubscriptions(subscriptionFrame{Subscribe: []string{"order", "order:QmOrder"}})
+	nothing.updateSubscriptions(subscriptionFrame{Subscribe: []string{"payment"}, Unsubscribe: []string{"payment"}})
+	chatPeers.updateSubscriptions(subscriptionFrame{Subscribe: []string{"chat:QmPeer"}})
+	for _, c := range []*connection{all, orders, nothing, chatPeers} {
+		h.register <- c
+	}
+
+	h.Broadcast <- wsMessage{data: []byte("typing"), topics: []string{"chatTyping", "chat:QmOther"}}
+	h.Broadcast <- wsMessage{data: []byte("payment"), topics: []string{"payment", "order:QmOrder"}}
+	h.Broadcast <- wsMessage{data: []byte("chat"), topics: []string{"chatMessage", "chat:QmPeer"}}
+	h.Broadcast <- wsMessage{data: []byte("echo")}
+	// Wait for the hub to handle the broadcasts
+	h.unregister <- &connection{}
+
+	for name, c := range map[string]struct {
+		conn     *connection
+		expected []string
+	}{
+		"unfiltered":   {all, []string{"typing", "payment", "chat", "echo"}},
+		"orders":       {orders, []string{"payment", "echo"}},
+		"unsubscribed": {nothing, []string{"echo"}},
+		"chat peer":    {chatPeers, []string{"chat", "echo"}},
+	} {
+		var got []string
+		for len(c.conn.send) > 0 {
+			got = append(got, string(<-c.conn.send))
+		}
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("Expected %s connection to get %v, got %v", name, c.expected, got)
+		}
+	}
+}
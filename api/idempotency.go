@@ -0,0 +1,192 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// defaultIdempotencyKeyWindow is how long responses are kept when the
+	// config doesn't set IdempotencyKeyWindow
+	defaultIdempotencyKeyWindow = 24 * time.Hour
+
+	// maxIdempotencyKeyLength is the longest Idempotency-Key accepted
+	maxIdempotencyKeyLength = 255
+
+	// Expired responses are deleted every idempotencyKeyPruneInterval
+	idempotencyKeyPruneInterval = time.Hour
+)
+
+// idempotencyKeys stores the responses to requests made with an
+// Idempotency-Key header so retries get the original response instead of
+// repeating the request
+type idempotencyKeys struct {
+	store  repo.IdempotencyKeyStore
+	window time.Duration
+
+	lock      sync.Mutex
+	inFlight  map[string]bool
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+func newIdempotencyKeys(store repo.IdempotencyKeyStore, window time.Duration) *idempotencyKeys {
+	if window <= 0 {
+		window = defaultIdempotencyKeyWindow
+	}
+	return &idempotencyKeys{
+		store:    store,
+		window:   window,
+		inFlight: make(map[string]bool),
+		now:      time.Now,
+	}
+}
+
+// Handle calls the handler unless the request has an Idempotency-Key which
+// was used within the window. A retry of that request gets the stored
+// response with the Idempotent-Replayed header set. Reusing the key for a
+// different request, or while the first request is in progress, is an error.
+func (k *idempotencyKeys) Handle(w http.ResponseWriter, r *http.Request, handler http.HandlerFunc) {
+	key := r.Header.Get("Idempotency-Key")
+	if key == "" {
+		handler(w, r)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	stored, replayed, err := k.do(key, requestFingerprint(r, body), func() (int, []byte) {
+		rec := &recordingResponseWriter{ResponseWriter: w}
+		handler(rec, r)
+		if rec.statusCode == 0 {
+			rec.statusCode = http.StatusOK
+		}
+		return rec.statusCode, rec.body.Bytes()
+	})
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if replayed {
+		w.Header().Set("Idempotent-Replayed", "true")
+		w.WriteHeader(stored.StatusCode)
+		w.Write(stored.Body)
+	}
+}
+
+// do calls run and stores the status and body it returns under the key,
+// unless a response with the same fingerprint was stored within the window,
+// which is returned with replayed set instead. Only successful and client
+// error responses are stored. It's shared by the JSON and gRPC APIs.
+func (k *idempotencyKeys) do(key, fingerprint string, run func() (int, []byte)) (response *repo.IdempotentResponse, replayed bool, err error) {
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, false, &apiError{http.StatusBadRequest, "Idempotency-Key is too long"}
+	}
+
+	k.lock.Lock()
+	if k.inFlight[key] {
+		k.lock.Unlock()
+		return nil, false, &apiError{http.StatusConflict, "a request with this Idempotency-Key is in progress"}
+	}
+	k.inFlight[key] = true
+	k.lock.Unlock()
+	defer func() {
+		k.lock.Lock()
+		delete(k.inFlight, key)
+		k.lock.Unlock()
+	}()
+
+	now := k.now()
+	stored, err := k.store.Get(key)
+	if err != nil {
+		return nil, false, err
+	}
+	if stored != nil && now.Sub(stored.CreatedAt) < k.window {
+		if stored.Fingerprint != fingerprint {
+			return nil, false, &apiError{http.StatusUnprocessableEntity, "Idempotency-Key was used for a different request"}
+		}
+		return stored, true, nil
+	}
+
+	statusCode, body := run()
+	response = &repo.IdempotentResponse{
+		Key:         key,
+		Fingerprint: fingerprint,
+		StatusCode:  statusCode,
+		Body:        body,
+		CreatedAt:   now,
+	}
+	if !storesIdempotentResponse(statusCode) {
+		return response, false, nil
+	}
+	if err := k.store.Put(*response); err != nil {
+		log.Errorf("Error storing response for idempotency key: %s", err)
+	}
+	k.prune(now)
+	return response, false, nil
+}
+
+// storesIdempotentResponse reports whether a response with the status is
+// replayed to retries. Server errors may be transient, so the key is dropped
+// and a retry is handled again.
+func storesIdempotentResponse(statusCode int) bool {
+	return (statusCode >= 200 && statusCode < 300) || (statusCode >= 400 && statusCode < 500)
+}
+
+// prune deletes the responses which are older than the window
+func (k *idempotencyKeys) prune(now time.Time) {
+	k.lock.Lock()
+	if now.Sub(k.lastPrune) < idempotencyKeyPruneInterval {
+		k.lock.Unlock()
+		return
+	}
+	k.lastPrune = now
+	k.lock.Unlock()
+
+	if err := k.store.DeleteBefore(now.Add(-k.window)); err != nil {
+		log.Errorf("Error pruning idempotency keys: %s", err)
+	}
+}
+
+// requestFingerprint returns a hash of the method, URI and body of the
+// request
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordingResponseWriter keeps a copy of the status code and body written
+// to the response
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *recordingResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *recordingResponseWriter) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type memoryIdempotencyKeys struct {
+	repo.IdempotencyKeyStore
+	responses map[string]repo.IdempotentResponse
+}
+
+func (m *memoryIdempotencyKeys) Put(response repo.IdempotentResponse) error {
+	m.responses[response.Key] = response
+	return nil
+}
+
+func (m *memoryIdempotencyKeys) Get(key string) (*repo.IdempotentResponse, error) {
+	response, ok := m.responses[key]
+	if !ok {
+		return nil, nil
+	}
+	return &response, nil
+}
+
+func (m *memoryIdempotencyKeys) DeleteBefore(t time.Time) error {
+	for key, response := range m.responses {
+		if response.CreatedAt.Before(t) {
+			delete(m.responses, key)
+		}
+	}
+	return nil
+}
+
+func TestIdempotencyKeysReplay(t *testing.T) {
+	var (
+		store = &memoryIdempotencyKeys{responses: make(map[string]repo.IdempotentResponse)}
+		keys  = newIdempotencyKeys(store, time.Hour)
+		now   = time.Now()
+		calls = 0
+	)
+	keys.now = func() time.Time { return now }
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"orderId": "1"}`))
+	}
+	send := func(key, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/ob/purchase", strings.NewReader(body))
+		if key != "" {
+			r.Header.Set("Idempotency-Key", key)
+		}
+		w := httptest.NewRecorder()
+		keys.Handle(w, r, handler)
+		return w
+	}
+
+	for i := 0; i < 2; i++ {
+		w := send("order-1", `{"items": []}`)
+		if w.Code != http.StatusCreated || w.Body.String() != `{"orderId": "1"}` {
+			t.Errorf("Expected the original response, got %d %s", w.Code, w.Body.String())
+		}
+		if replayed := w.Header().Get("Idempotent-Replayed"); (i > 0) != (replayed == "true") {
+			t.Errorf("Unexpected Idempotent-Replayed header %q on request %d", replayed, i)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected the handler to be called once, got %d", calls)
+	}
+
+	if w := send("order-1", `{"items": [1]}`); w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected reusing the key for a different request to get status 422, got %d", w.Code)
+	}
+
+	send("", `{"items": []}`)
+	send("", `{"items": []}`)
+	if calls != 3 {
+		t.Errorf("Expected requests without a key to always be handled, got %d calls", calls)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if w := send("order-1", `{"items": [1]}`); w.Code != http.StatusCreated || w.Header().Get("Idempotent-Replayed") != "" {
+		t.Errorf("Expected an expired key to be handled again, got %d", w.Code)
+	}
+	if calls != 4 {
+		t.Errorf("Expected the handler to be called for the expired key, got %d calls", calls)
+	}
+}
+
+func TestIdempotencyKeysServerError(t *testing.T) {
+	var (
+		store  = &memoryIdempotencyKeys{responses: make(map[string]repo.IdempotentResponse)}
+		keys   = newIdempotencyKeys(store, time.Hour)
+		status = http.StatusInternalServerError
+		calls  = 0
+	)
+	send := func() *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/wallet/spend", strings.NewReader("{}"))
+		r.Header.Set("Idempotency-Key", "spend-1")
+		w := httptest.NewRecorder()
+		keys.Handle(w, r, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(status)
+		})
+		return w
+	}
+
+	if w := send(); w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", w.Code)
+	}
+	if response, _ := store.Get("spend-1"); response != nil {
+		t.Errorf("Expected a server error not to be stored, got %v", response)
+	}
+
+	status = http.StatusBadRequest
+	for i := 0; i < 2; i++ {
+		if w := send(); w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", w.Code)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected the retry after a server error to be handled and the client error replayed, got %d calls", calls)
+	}
+}
+
+func TestIdempotencyKeysInFlight(t *testing.T) {
+	var (
+		store   = &memoryIdempotencyKeys{responses: make(map[string]repo.IdempotentResponse)}
+		keys    = newIdempotencyKeys(store, time.Hour)
+		started = make(chan struct{})
+		release = make(chan struct{})
+		done    = make(chan struct{})
+	)
+	go func() {
+		r := httptest.NewRequest("POST", "/wallet/spend", strings.NewReader("{}"))
+		r.Header.Set("Idempotency-Key", "spend-1")
+		keys.Handle(httptest.NewRecorder(), r, func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+		})
+		close(done)
+	}()
+	<-started
+
+	r := httptest.NewRequest("POST", "/wallet/spend", strings.NewReader("{}"))
+	r.Header.Set("Idempotency-Key", "spend-1")
+	w := httptest.NewRecorder()
+	keys.Handle(w, r, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the concurrent request not to be handled")
+	})
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected a concurrent request with the same key to get status 409, got %d", w.Code)
+	}
+	close(release)
+	<-done
+
+	if response, _ := store.Get("spend-1"); response == nil || response.StatusCode != http.StatusOK {
+		t.Errorf("Expected the response to be stored with status 200, got %v", response)
+	}
+}
@@ -48,6 +48,7 @@ import (
 	ipnspath "github.com/ipfs/go-ipfs/path"
 	fsrepo "github.com/ipfs/go-ipfs/repo/fsrepo"
 	"gx/ipfs/QmTmqJGRQfuH8eKWD1FjThwPRipt1QhqJQNZ8MpzmfAAxo/go-ipfs-ds-help"
+	prometheus "gx/ipfs/QmX3QZ5jHEPidwUrymXV1iSCSUhdGxj15sm2gP4jKMef7B/client_golang/prometheus"
 )
 
 type JsonAPIConfig struct {
@@ -62,15 +63,22 @@ type JsonAPIConfig struct {
 }
 
 type jsonAPIHandler struct {
-	config JsonAPIConfig
-	node   *core.OpenBazaarNode
+	config  JsonAPIConfig
+	node    *core.OpenBazaarNode
+	guard   *authGuard
+	events  *eventStream
+	metrics prometheus.Gatherer
+
+	idempotencyKeys *idempotencyKeys
 }
 
-func newJsonAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config schema.APIConfig) *jsonAPIHandler {
+func newJsonAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config schema.APIConfig, guard *authGuard, events *eventStream) *jsonAPIHandler {
 	allowedIPs := make(map[string]bool)
 	for _, ip := range config.AllowedIPs {
 		allowedIPs[ip] = true
 	}
+	// The window is validated when the config is read
+	idempotencyKeyWindow, _ := time.ParseDuration(config.IdempotencyKeyWindow)
 	i := &jsonAPIHandler{
 		config: JsonAPIConfig{
 			Enabled:       config.Enabled,
@@ -82,7 +90,12 @@ func newJsonAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config
 			Username:      config.Username,
 			Password:      config.Password,
 		},
-		node: node,
+		node:    node,
+		guard:   guard,
+		events:  events,
+		metrics: newMetricsGatherer(node),
+
+		idempotencyKeys: newIdempotencyKeys(node.Datastore.IdempotencyKeys(), idempotencyKeyWindow),
 	}
 	return i
 }
@@ -110,49 +123,29 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if i.config.Cors != nil {
 		w.Header().Set("Access-Control-Allow-Origin", *i.config.Cors)
 		w.Header().Set("Access-Control-Allow-Methods", "PUT,POST,PATCH,DELETE,GET,OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, Idempotency-Key")
 	}
 
 	for k, v := range i.config.Headers {
 		w.Header()[k] = v.([]string)
 	}
 
-	if i.config.Authenticated {
-		if i.config.Username == "" || i.config.Password == "" {
-			cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
-			if err != nil {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
-				return
-			}
-			if i.config.Cookie.Value != cookie.Value {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
-				return
-			}
-		} else {
-
-			if r.Method == "OPTIONS" {
-				w.WriteHeader(http.StatusOK)
-				fmt.Fprint(w, "200 - OK")
-				return
-			}
-
-			username, password, ok := r.BasicAuth()
-			h := sha256.Sum256([]byte(password))
-			password = hex.EncodeToString(h[:])
-			if !ok || username != i.config.Username || strings.ToLower(password) != strings.ToLower(i.config.Password) {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
-				return
-			}
-		}
+	if !i.authenticate(w, r, u.Path) {
+		return
 	}
 
 	// Stop here if its Preflighted OPTIONS request
 	if r.Method == "OPTIONS" {
 		return
 	}
+	// The requests of a batch are authenticated with its credentials, so
+	// they're kept for it before being removed from the request
+	credentials := make(http.Header)
+	for _, k := range []string{"Cookie", "Authorization"} {
+		if v, ok := r.Header[k]; ok {
+			credentials[k] = v
+		}
+	}
 	r.Header.Del("Cookie")
 	r.Header.Del("Authorization")
 	dump, err := httputil.DumpRequest(r, false)
@@ -164,10 +157,19 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		if r := recover(); r != nil {
 			log.Error("A panic occurred in the rest api handler!")
 			log.Error(r)
-			debug.PrintStack()
+			log.Errorf("%s", debug.Stack())
+			ErrorResponse(w, http.StatusInternalServerError, "internal server error")
 		}
 	}()
 
+	if r.Method == "POST" && u.Path == batchPath {
+		for k, v := range credentials {
+			r.Header[k] = v
+		}
+		i.POSTBatch(w, r)
+		return
+	}
+
 	w.Header().Add("Content-Type", "application/json")
 	switch r.Method {
 	case "GET":
@@ -183,16 +185,12 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ErrorResponse responds with the reason and the generic code of the status
 func ErrorResponse(w http.ResponseWriter, errorCode int, reason string) {
-	type ApiError struct {
-		Success bool   `json:"success"`
-		Reason  string `json:"reason"`
-	}
-	reason = strings.Replace(reason, `"`, `'`, -1)
-	err := ApiError{false, reason}
-	resp, _ := json.MarshalIndent(err, "", "    ")
-	w.WriteHeader(errorCode)
-	fmt.Fprint(w, string(resp))
+	writeErrorResponse(w, errorCode, errorResponse{
+		Reason: reason,
+		Code:   statusCode(errorCode),
+	})
 }
 
 func JSONErrorResponse(w http.ResponseWriter, errorCode int, err error) {
@@ -208,13 +206,13 @@ func RenderJSONOrStringError(w http.ResponseWriter, errorCode int, err error) {
 		return
 	}
 
-	ErrorResponse(w, http.StatusInternalServerError, errStr)
+	RenderError(w, errorCode, err)
 }
 
 func SanitizedResponse(w http.ResponseWriter, response string) {
 	ret, err := SanitizeJSON([]byte(response))
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	fmt.Fprint(w, string(ret))
@@ -223,7 +221,7 @@ func SanitizedResponse(w http.ResponseWriter, response string) {
 func SanitizedResponseM(w http.ResponseWriter, response string, m proto.Message) {
 	out, err := SanitizeProtobuf(response, m)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	fmt.Fprint(w, string(out))
@@ -243,21 +241,21 @@ func (i *jsonAPIHandler) POSTProfile(w http.ResponseWriter, r *http.Request) {
 	profile := new(pb.Profile)
 	err := jsonpb.Unmarshal(r.Body, profile)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	// Save to file
 	err = i.node.UpdateProfile(profile)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	// Maybe set as moderator
 	if profile.Moderator {
 		if err := i.node.SetSelfAsModerator(profile.ModeratorInfo); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
@@ -284,7 +282,7 @@ func (i *jsonAPIHandler) POSTProfile(w http.ResponseWriter, r *http.Request) {
 	}
 	out, err := m.MarshalToString(profile)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponseM(w, out, new(pb.Profile))
@@ -303,26 +301,26 @@ func (i *jsonAPIHandler) PUTProfile(w http.ResponseWriter, r *http.Request) {
 	profile := new(pb.Profile)
 	err = jsonpb.Unmarshal(r.Body, profile)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	// Save to file
 	err = i.node.UpdateProfile(profile)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	// Update moderator
 	if profile.Moderator && currentProfile.Moderator != profile.Moderator {
 		if err := i.node.SetSelfAsModerator(nil); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	} else if !profile.Moderator && currentProfile.Moderator != profile.Moderator {
 		if err := i.node.RemoveSelfAsModerator(); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
@@ -330,13 +328,13 @@ func (i *jsonAPIHandler) PUTProfile(w http.ResponseWriter, r *http.Request) {
 	// Update followers/following
 	err = i.node.UpdateFollow()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	// Republish to IPNS
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -349,7 +347,7 @@ func (i *jsonAPIHandler) PUTProfile(w http.ResponseWriter, r *http.Request) {
 	}
 	out, err := m.MarshalToString(profile)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponseM(w, out, new(pb.Profile))
@@ -371,27 +369,27 @@ func (i *jsonAPIHandler) PATCHProfile(w http.ResponseWriter, r *http.Request) {
 	var patch interface{}
 	err := d.Decode(&patch)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	// Apply patch
 	err = i.node.PatchProfile(patch.(map[string]interface{}))
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	// Update followers/following
 	err = i.node.UpdateFollow()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	// Republish to IPNS
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -406,30 +404,30 @@ func (i *jsonAPIHandler) POSTAvatar(w http.ResponseWriter, r *http.Request) {
 	data := new(ImgData)
 	err := decoder.Decode(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	hashes, err := i.node.SetAvatarImages(data.Avatar)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	// Update followers/following
 	err = i.node.UpdateFollow()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	jsonHashes, err := json.MarshalIndent(hashes, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(jsonHashes))
@@ -444,12 +442,12 @@ func (i *jsonAPIHandler) POSTHeader(w http.ResponseWriter, r *http.Request) {
 	err := decoder.Decode(&data)
 
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	hashes, err := i.node.SetHeaderImages(data.Header)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -461,12 +459,12 @@ func (i *jsonAPIHandler) POSTHeader(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	jsonHashes, err := json.MarshalIndent(hashes, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(jsonHashes))
@@ -481,7 +479,7 @@ func (i *jsonAPIHandler) POSTImage(w http.ResponseWriter, r *http.Request) {
 	var images []ImgData
 	err := decoder.Decode(&images)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	type retImage struct {
@@ -492,7 +490,7 @@ func (i *jsonAPIHandler) POSTImage(w http.ResponseWriter, r *http.Request) {
 	for _, img := range images {
 		hashes, err := i.node.SetProductImages(img.Image, img.Filename)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		rtimg := retImage{img.Filename, *hashes}
@@ -500,7 +498,7 @@ func (i *jsonAPIHandler) POSTImage(w http.ResponseWriter, r *http.Request) {
 	}
 	jsonHashes, err := json.MarshalIndent(retData, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(jsonHashes))
@@ -510,18 +508,18 @@ func (i *jsonAPIHandler) POSTListing(w http.ResponseWriter, r *http.Request) {
 	ld := new(pb.Listing)
 	err := jsonpb.Unmarshal(r.Body, ld)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	err = i.node.CreateListing(ld)
 	if err != nil {
 		if err == core.ErrListingAlreadyExists {
-			ErrorResponse(w, http.StatusConflict, "Listing already exists. Use PUT.")
+			RenderError(w, http.StatusConflict, errListingExists)
 			return
 		}
 
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -532,18 +530,18 @@ func (i *jsonAPIHandler) PUTListing(w http.ResponseWriter, r *http.Request) {
 	ld := new(pb.Listing)
 	err := jsonpb.Unmarshal(r.Body, ld)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	err = i.node.UpdateListing(ld)
 	if err != nil {
 		if err == core.ErrListingDoesNotExist {
-			ErrorResponse(w, http.StatusNotFound, "Listing not found.")
+			RenderError(w, http.StatusNotFound, errListingNotFound)
 			return
 		}
 
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -552,35 +550,179 @@ func (i *jsonAPIHandler) PUTListing(w http.ResponseWriter, r *http.Request) {
 
 func (i *jsonAPIHandler) DELETEListing(w http.ResponseWriter, r *http.Request) {
 	_, slug := path.Split(r.URL.Path)
+	if err := i.deleteListing(slug); err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+// deleteListing deletes the listing and republishes the node
+func (i *jsonAPIHandler) deleteListing(slug string) error {
 	listingPath := path.Join(i.node.RepoPath, "root", "listings", slug+".json")
 	_, ferr := os.Stat(listingPath)
 	if os.IsNotExist(ferr) {
-		ErrorResponse(w, http.StatusNotFound, "Listing not found.")
-		return
+		return errListingNotFound
 	}
 	err := i.node.DeleteListing(slug)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return err
 	}
 	err = i.node.UpdateFollow()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, "File Write Error: "+err.Error())
+		return fmt.Errorf("File Write Error: %s", err.Error())
+	}
+	return i.node.SeedNode()
+}
+
+func (i *jsonAPIHandler) POSTScheduledListing(w http.ResponseWriter, r *http.Request) {
+	type scheduledListing struct {
+		PublishAt time.Time       `json:"publishAt"`
+		Listing   json.RawMessage `json:"listing"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var s scheduledListing
+	err := decoder.Decode(&s)
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	ld := new(pb.Listing)
+	err = jsonpb.UnmarshalString(string(s.Listing), ld)
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = i.node.ScheduleListing(ld, s.PublishAt)
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	SanitizedResponse(w, fmt.Sprintf(`{"slug": "%s"}`, ld.Slug))
+}
+
+func (i *jsonAPIHandler) GETScheduledListings(w http.ResponseWriter, r *http.Request) {
+	type scheduledListing struct {
+		Slug         string    `json:"slug"`
+		Title        string    `json:"title"`
+		PublishAt    time.Time `json:"publishAt"`
+		Expiry       time.Time `json:"expiry"`
+		PublishError string    `json:"publishError,omitempty"`
+	}
+	scheduled, err := i.node.Datastore.ScheduledListings().GetAll()
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ret := []scheduledListing{}
+	for _, s := range scheduled {
+		sl := scheduledListing{Slug: s.Slug, PublishAt: s.PublishAt.UTC(), PublishError: s.PublishError}
+		if s.Listing.Item != nil {
+			sl.Title = s.Listing.Item.Title
+		}
+		if s.Listing.Metadata != nil && s.Listing.Metadata.Expiry != nil {
+			sl.Expiry = time.Unix(s.Listing.Metadata.Expiry.Seconds, 0).UTC()
+		}
+		ret = append(ret, sl)
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) DELETEScheduledListing(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	_, err := i.node.Datastore.ScheduledListings().Get(slug)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Scheduled listing not found.")
+		return
+	}
+	err = i.node.Datastore.ScheduledListings().Delete(slug)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETArchivedListings(w http.ResponseWriter, r *http.Request) {
+	type archivedListing struct {
+		Slug       string    `json:"slug"`
+		Title      string    `json:"title"`
+		Expiry     time.Time `json:"expiry"`
+		ArchivedAt time.Time `json:"archivedAt"`
+	}
+	archived, err := i.node.Datastore.ArchivedListings().GetAll()
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ret := []archivedListing{}
+	for _, a := range archived {
+		al := archivedListing{Slug: a.Slug, ArchivedAt: a.ArchivedAt.UTC()}
+		if a.Listing.Listing != nil && a.Listing.Listing.Item != nil {
+			al.Title = a.Listing.Listing.Item.Title
+		}
+		if a.Listing.Listing != nil && a.Listing.Listing.Metadata != nil && a.Listing.Listing.Metadata.Expiry != nil {
+			al.Expiry = time.Unix(a.Listing.Listing.Metadata.Expiry.Seconds, 0).UTC()
+		}
+		ret = append(ret, al)
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) DELETEArchivedListing(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	_, err := i.node.Datastore.ArchivedListings().Get(slug)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Archived listing not found.")
+		return
+	}
+	err = i.node.Datastore.ArchivedListings().Delete(slug)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
 }
 
+func (i *jsonAPIHandler) POSTRestoreListing(w http.ResponseWriter, r *http.Request) {
+	type restoreListing struct {
+		Slug   string    `json:"slug"`
+		Expiry time.Time `json:"expiry"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var rl restoreListing
+	err := decoder.Decode(&rl)
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = i.node.RestoreListing(rl.Slug, rl.Expiry)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, fmt.Sprintf(`{"slug": "%s"}`, rl.Slug))
+}
+
 func (i *jsonAPIHandler) POSTPurchase(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
 	var data core.PurchaseData
 	err := decoder.Decode(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	orderId, paymentAddr, amount, online, err := i.node.Purchase(&data)
@@ -597,7 +739,7 @@ func (i *jsonAPIHandler) POSTPurchase(w http.ResponseWriter, r *http.Request) {
 	ret := purchaseReturn{paymentAddr, amount, online, orderId}
 	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(b))
@@ -607,7 +749,7 @@ func (i *jsonAPIHandler) GETStatus(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
 	status, err := i.node.GetPeerStatus(peerId)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	SanitizedResponse(w, fmt.Sprintf(`{"status": "%s"}`, status))
@@ -621,12 +763,57 @@ func (i *jsonAPIHandler) GETPeers(w http.ResponseWriter, r *http.Request) {
 	}
 	peerJson, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(peerJson))
 }
 
+func (i *jsonAPIHandler) GETPeerAbuse(w http.ResponseWriter, r *http.Request) {
+	scores := i.node.AbuseManager.Scores()
+	for n, score := range scores {
+		if score.BannedUntil != nil {
+			bannedUntil := score.BannedUntil.UTC()
+			scores[n].BannedUntil = &bannedUntil
+		}
+	}
+	ret, err := json.MarshalIndent(scores, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETDuplicateMessages(w http.ResponseWriter, r *http.Request) {
+	ret, err := json.MarshalIndent(i.node.Deduplicator.Stats(), "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETPushNodes(w http.ResponseWriter, r *http.Request) {
+	nodes := i.node.PushNodeManager.Status()
+	for n, node := range nodes {
+		if node.LastSuccess != nil {
+			lastSuccess := node.LastSuccess.UTC()
+			nodes[n].LastSuccess = &lastSuccess
+		}
+		if node.LastFailure != nil {
+			lastFailure := node.LastFailure.UTC()
+			nodes[n].LastFailure = &lastFailure
+		}
+	}
+	ret, err := json.MarshalIndent(nodes, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
 func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
 	type PeerId struct {
 		ID string `json:"id"`
@@ -636,11 +823,11 @@ func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
 	var pid PeerId
 	err := decoder.Decode(&pid)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	if err := i.node.Follow(pid.ID); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -654,11 +841,11 @@ func (i *jsonAPIHandler) POSTUnfollow(w http.ResponseWriter, r *http.Request) {
 	var pid PeerId
 	err := decoder.Decode(&pid)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	if err := i.node.Unfollow(pid.ID); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -672,7 +859,7 @@ func (i *jsonAPIHandler) GETAddress(w http.ResponseWriter, r *http.Request) {
 func (i *jsonAPIHandler) GETMnemonic(w http.ResponseWriter, r *http.Request) {
 	mn, err := i.node.Datastore.Config().GetMnemonic()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, fmt.Sprintf(`{"mnemonic": "%s"}`, mn))
@@ -704,6 +891,16 @@ func (i *jsonAPIHandler) GETBalance(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, fmt.Sprintf(`{"confirmed": %d, "unconfirmed": %d, "height": %d}`, int(confirmed), int(unconfirmed), height))
 }
 
+// spendResponse is the result of spending coins from the wallet
+type spendResponse struct {
+	Txid               string    `json:"txid"`
+	Amount             int64     `json:"amount"`
+	ConfirmedBalance   int64     `json:"confirmedBalance"`
+	UnconfirmedBalance int64     `json:"unconfirmedBalance"`
+	Timestamp          time.Time `json:"timestamp"`
+	Memo               string    `json:"memo"`
+}
+
 func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request) {
 	type Send struct {
 		Address  string `json:"address"`
@@ -715,7 +912,7 @@ func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request)
 	var snd Send
 	err := decoder.Decode(&snd)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	var feeLevel wallet.FeeLevel
@@ -729,29 +926,41 @@ func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request)
 	default:
 		feeLevel = wallet.NORMAL
 	}
-	addr, err := i.node.Wallet.DecodeAddress(snd.Address)
+	resp, err := i.spendCoins(snd.Address, snd.Amount, feeLevel, snd.Memo)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "ERROR_INVALID_ADDRESS")
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
-	txid, err := i.node.Wallet.Spend(snd.Amount, addr, feeLevel)
+	ser, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+// spendCoins sends the amount to the address and records the transaction
+// metadata. The memo is replaced by the listing title when paying for an
+// order.
+func (i *jsonAPIHandler) spendCoins(address string, amount int64, feeLevel wallet.FeeLevel, memo string) (*spendResponse, error) {
+	addr, err := i.node.Wallet.DecodeAddress(address)
+	if err != nil {
+		return nil, errInvalidAddress
+	}
+	txid, err := i.node.Wallet.Spend(amount, addr, feeLevel)
 	if err != nil {
 		switch {
 		case err == wallet.ErrorInsuffientFunds:
-			ErrorResponse(w, http.StatusBadRequest, `ERROR_INSUFFICIENT_FUNDS`)
-			return
+			return nil, errInsufficientFunds
 		case err == wallet.ErrorDustAmount:
-			ErrorResponse(w, http.StatusBadRequest, `ERROR_DUST_AMOUNT`)
-			return
+			return nil, errDustAmount
 		default:
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+			return nil, err
 		}
 	}
 
 	var orderId string
 	var thumbnail string
-	var memo string
 	var title string
 	contract, _, _, _, err := i.node.Datastore.Purchases().GetByPaymentAddress(addr)
 	if contract != nil && err == nil {
@@ -761,51 +970,33 @@ func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request)
 			title = contract.VendorListings[0].Item.Title
 		}
 	}
-	if title == "" {
-		memo = snd.Memo
-	} else {
+	if title != "" {
 		memo = title
 	}
 
 	if err := i.node.Datastore.TxMetadata().Put(repo.Metadata{
 		Txid:       txid.String(),
-		Address:    snd.Address,
+		Address:    address,
 		Memo:       memo,
 		OrderId:    orderId,
 		Thumbnail:  thumbnail,
 		CanBumpFee: false,
 	}); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	type response struct {
-		Txid               string    `json:"txid"`
-		Amount             int64     `json:"amount"`
-		ConfirmedBalance   int64     `json:"confirmedBalance"`
-		UnconfirmedBalance int64     `json:"unconfirmedBalance"`
-		Timestamp          time.Time `json:"timestamp"`
-		Memo               string    `json:"memo"`
+		return nil, err
 	}
 	confirmed, unconfirmed := i.node.Wallet.Balance()
 	txn, err := i.node.Wallet.GetTransaction(*txid)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return nil, err
 	}
-	resp := &response{
+	return &spendResponse{
 		Txid:               txid.String(),
 		ConfirmedBalance:   confirmed,
 		UnconfirmedBalance: unconfirmed,
 		Amount:             -(txn.Value),
 		Timestamp:          txn.Timestamp,
 		Memo:               memo,
-	}
-	ser, err := json.MarshalIndent(resp, "", "    ")
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	SanitizedResponse(w, string(ser))
+	}, nil
 }
 
 func (i *jsonAPIHandler) GETConfig(w http.ResponseWriter, r *http.Request) {
@@ -826,7 +1017,7 @@ func (i *jsonAPIHandler) GETConfig(w http.ResponseWriter, r *http.Request) {
 	}
 	ser, err := json.MarshalIndent(c, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(ser))
@@ -837,11 +1028,11 @@ func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
 	err := decoder.Decode(&settings)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	if err = validateSMTPSettings(settings); err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	_, err = i.node.Datastore.Settings().Get()
@@ -868,21 +1059,21 @@ func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
 	if settings.StoreModerators != nil {
 		go i.node.NotifyModerators(*settings.StoreModerators)
 		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 		if err := i.node.SeedNode(); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 	}
 	err = i.node.Datastore.Settings().Put(settings)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	settings.Version = &i.node.UserAgent
 	ser, err := json.MarshalIndent(settings, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(ser))
@@ -893,11 +1084,11 @@ func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
 	err := decoder.Decode(&settings)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	if err = validateSMTPSettings(settings); err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	_, err = i.node.Datastore.Settings().Get()
@@ -919,16 +1110,16 @@ func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
 	if settings.StoreModerators != nil {
 		go i.node.NotifyModerators(*settings.StoreModerators)
 		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 		if err := i.node.SeedNode(); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 	}
 
 	err = i.node.Datastore.Settings().Put(settings)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -937,13 +1128,13 @@ func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
 func (i *jsonAPIHandler) GETSettings(w http.ResponseWriter, r *http.Request) {
 	settings, err := i.node.Datastore.Settings().Get()
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		RenderError(w, http.StatusNotFound, err)
 		return
 	}
 	settings.Version = &i.node.UserAgent
 	settingsJson, err := json.MarshalIndent(&settings, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(settingsJson))
@@ -956,23 +1147,23 @@ func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		switch err.Error() {
 		case "Not Found":
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 		default:
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			RenderError(w, http.StatusBadRequest, err)
 		}
 		return
 	}
 	if err = validateSMTPSettings(settings); err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	if settings.StoreModerators != nil {
 		go i.node.NotifyModerators(*settings.StoreModerators)
 		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 		if err := i.node.SeedNode(); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 	}
 	if settings.BlockedNodes != nil {
@@ -988,7 +1179,7 @@ func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
 	}
 	err = i.node.Datastore.Settings().Update(settings)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -1017,12 +1208,12 @@ func (i *jsonAPIHandler) GETExchangeRate(w http.ResponseWriter, r *http.Request)
 		// Remove "BCH" from currency list
 		delete(currencyMap, "BCH")
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		exchangeRateJson, err := json.MarshalIndent(currencyMap, "", "    ")
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponse(w, string(exchangeRateJson))
@@ -1030,7 +1221,7 @@ func (i *jsonAPIHandler) GETExchangeRate(w http.ResponseWriter, r *http.Request)
 	} else {
 		rate, err := i.node.ExchangeRates.GetExchangeRate(currencyCode)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		fmt.Fprintf(w, `%.2f`, rate)
@@ -1040,108 +1231,113 @@ func (i *jsonAPIHandler) GETExchangeRate(w http.ResponseWriter, r *http.Request)
 func (i *jsonAPIHandler) GETFollowers(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
 	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
+	p, err := parsePageRequest(r.URL.Query())
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
 	if peerId == "" || strings.ToLower(peerId) == "followers" || peerId == i.node.IPFSIdentityString() {
-		offset := r.URL.Query().Get("offsetId")
-		limit := r.URL.Query().Get("limit")
-		if limit == "" {
-			limit = "-1"
-		}
-		l, err := strconv.ParseInt(limit, 10, 32)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+		var (
+			followers []repo.Follower
+			next      string
+		)
+		if p.offsetID != "" {
+			followers, err = i.node.Datastore.Followers().Get(p.offsetID, p.limit)
+		} else {
+			followers, next, err = i.node.Datastore.Followers().GetPage(p.cursor, p.limit)
 		}
-		followers, err := i.node.Datastore.Followers().Get(offset, int(l))
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			renderPageError(w, err)
 			return
 		}
-		var followList []string
+		followList := []string{}
 		for _, f := range followers {
 			followList = append(followList, f.PeerId)
 		}
-		ret, err := json.MarshalIndent(followList, "", "    ")
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-		if string(ret) == "null" {
-			ret = []byte("[]")
-		}
-		SanitizedResponse(w, string(ret))
+		writeList(w, p, followList, encodeCursor(next))
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		peerId = pid.Pretty()
 		followBytes, err := i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "followers.json")), time.Minute, useCache)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		var followers []repo.Follower
 		err = json.Unmarshal(followBytes, &followers)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
-		var followList []string
-		for _, f := range followers {
-			followList = append(followList, f.PeerId)
-		}
-		ret, err := json.MarshalIndent(followList, "", "    ")
+		start, end, next, err := p.slice(len(followers), func(i int) string {
+			return followers[i].PeerId
+		})
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusBadRequest, err)
 			return
 		}
-		if string(ret) == "null" {
-			ret = []byte("[]")
+		followList := []string{}
+		for _, f := range followers[start:end] {
+			followList = append(followList, f.PeerId)
 		}
 		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
-		SanitizedResponse(w, string(ret))
+		writeList(w, p, followList, next)
 	}
 }
 
 func (i *jsonAPIHandler) GETFollowing(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
 	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
+	p, err := parsePageRequest(r.URL.Query())
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
 	if peerId == "" || strings.ToLower(peerId) == "following" || peerId == i.node.IPFSIdentityString() {
-		offset := r.URL.Query().Get("offsetId")
-		limit := r.URL.Query().Get("limit")
-		if limit == "" {
-			limit = "-1"
-		}
-		l, err := strconv.ParseInt(limit, 10, 32)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+		var (
+			following []string
+			next      string
+		)
+		if p.offsetID != "" {
+			following, err = i.node.Datastore.Following().Get(p.offsetID, p.limit)
+		} else {
+			following, next, err = i.node.Datastore.Following().GetPage(p.cursor, p.limit)
 		}
-		followers, err := i.node.Datastore.Following().Get(offset, int(l))
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			renderPageError(w, err)
 			return
 		}
-		ret, _ := json.MarshalIndent(followers, "", "    ")
-		if string(ret) == "null" {
-			ret = []byte("[]")
-		}
-		SanitizedResponse(w, string(ret))
+		writeList(w, p, append([]string{}, following...), encodeCursor(next))
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		peerId = pid.Pretty()
 		followBytes, err := i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "following.json")), time.Minute, useCache)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
+			return
+		}
+		var following []string
+		if err := json.Unmarshal(followBytes, &following); err != nil {
+			RenderError(w, http.StatusNotFound, err)
+			return
+		}
+		start, end, next, err := p.slice(len(following), func(i int) string {
+			return following[i]
+		})
+		if err != nil {
+			RenderError(w, http.StatusBadRequest, err)
 			return
 		}
 		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
-		SanitizedResponse(w, string(followBytes))
+		writeList(w, p, append([]string{}, following[start:end]...), next)
 	}
 }
 
@@ -1174,13 +1370,13 @@ func (i *jsonAPIHandler) GETInventory(w http.ResponseWriter, r *http.Request) {
 			inventory, err = i.node.GetLocalInventoryForSlug(slug)
 		}
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 
 		ret, err := json.MarshalIndent(inventory, "", "    ")
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 
@@ -1199,21 +1395,21 @@ func (i *jsonAPIHandler) GETInventory(w http.ResponseWriter, r *http.Request) {
 	if len(useCacheString) > 0 {
 		useCacheBool, err = strconv.ParseBool(useCacheString)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
 
 	peerID, err := peer.IDB58Decode(peerIDString)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	if slug == "" {
 		inventoryBytes, err := i.node.GetPublishedInventoryBytes(peerID, useCacheBool)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponse(w, string(inventoryBytes))
@@ -1222,7 +1418,7 @@ func (i *jsonAPIHandler) GETInventory(w http.ResponseWriter, r *http.Request) {
 
 	inventoryBytes, err := i.node.GetPublishedInventoryBytesForSlug(peerID, slug, useCacheBool)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(inventoryBytes))
@@ -1238,20 +1434,20 @@ func (i *jsonAPIHandler) POSTInventory(w http.ResponseWriter, r *http.Request) {
 	var invList []inv
 	err := decoder.Decode(&invList)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	for _, in := range invList {
 		err = i.node.Datastore.Inventory().Put(in.Slug, in.Variant, in.Quantity)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
 
 	err = i.node.PublishInventory()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -1270,14 +1466,14 @@ func (i *jsonAPIHandler) PUTModerator(w http.ResponseWriter, r *http.Request) {
 	moderator := new(pb.Moderator)
 	err := jsonpb.Unmarshal(r.Body, moderator)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	// Save self as moderator
 	err = i.node.SetSelfAsModerator(moderator)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -1299,27 +1495,27 @@ func (i *jsonAPIHandler) PUTModerator(w http.ResponseWriter, r *http.Request) {
 func (i *jsonAPIHandler) DELETEModerator(w http.ResponseWriter, r *http.Request) {
 	profile, err := i.node.GetProfile()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	profile.Moderator = false
 	profile.ModeratorInfo = nil
 	err = i.node.UpdateProfile(&profile)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	// Update followers/following
 	err = i.node.UpdateFollow()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	// Republish to IPNS
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, "{}")
@@ -1338,27 +1534,35 @@ func (i *jsonAPIHandler) GETListings(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	p, err := parsePageRequest(r.URL.Query())
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
 	if peerId == "" || strings.ToLower(peerId) == "listings" || peerId == i.node.IPFSIdentityString() {
 		listingsBytes, err := i.node.GetListings()
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
-		SanitizedResponse(w, string(listingsBytes))
+		writeJSONList(w, p, listingsBytes, "slug")
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		peerId = pid.Pretty()
 		listingsBytes, err := i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "listings.json")), time.Minute, useCache)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
+		if err := i.node.IndexPeerListings(peerId, "", listingsBytes); err != nil {
+			log.Errorf("indexing listings of %s: %s", peerId, err.Error())
+		}
 		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%s, immutable", maxAge))
-		SanitizedResponse(w, string(listingsBytes))
+		writeJSONList(w, p, listingsBytes, "slug")
 	}
 }
 
@@ -1373,52 +1577,16 @@ func (i *jsonAPIHandler) GETListing(w http.ResponseWriter, r *http.Request) {
 		OrigName:     false,
 	}
 	if peerId == "" || strings.ToLower(peerId) == "listing" || peerId == i.node.IPFSIdentityString() {
-		var sl *pb.SignedListing
-		_, err := cid.Decode(listingId)
-		if err == nil {
-			sl, err = i.node.GetListingFromHash(listingId)
-			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, "Listing not found.")
-				return
-			}
-			sl.Hash = listingId
-		} else {
-			sl, err = i.node.GetListingFromSlug(listingId)
-			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, "Listing not found.")
-				return
-			}
-			hash, err := ipfs.GetHashOfFile(i.node.IpfsNode, path.Join(i.node.RepoPath, "root", "listings", listingId+".json"))
-			if err != nil {
-				ErrorResponse(w, http.StatusInternalServerError, err.Error())
-				return
-			}
-			sl.Hash = hash
-		}
-		savedCoupons, err := i.node.Datastore.Coupons().Get(sl.Listing.Slug)
+		sl, err := i.getOwnListing(listingId)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
-		for _, coupon := range sl.Listing.Coupons {
-			for _, c := range savedCoupons {
-				if coupon.GetHash() == c.Hash {
-					coupon.Code = &pb.Listing_Coupon_DiscountCode{c.Code}
-					break
-				}
-			}
-		}
-		if sl.Listing.Metadata != nil && sl.Listing.Metadata.Version == 1 {
-			for _, so := range sl.Listing.ShippingOptions {
-				for _, ser := range so.Services {
-					ser.AdditionalItemPrice = ser.Price
-				}
-			}
-		}
+		localizeListing(w, r, sl)
 
 		out, err := m.MarshalToString(sl)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponseM(w, string(out), new(pb.SignedListing))
@@ -1430,7 +1598,7 @@ func (i *jsonAPIHandler) GETListing(w http.ResponseWriter, r *http.Request) {
 		if err == nil {
 			listingBytes, err = ipfs.Cat(i.node.IpfsNode, listingId, time.Minute)
 			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
+				RenderError(w, http.StatusNotFound, err)
 				return
 			}
 			hash = listingId
@@ -1438,18 +1606,18 @@ func (i *jsonAPIHandler) GETListing(w http.ResponseWriter, r *http.Request) {
 		} else {
 			pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
+				RenderError(w, http.StatusNotFound, err)
 				return
 			}
 			peerId = pid.Pretty()
 			listingBytes, err = i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "listings", listingId+".json")), time.Minute, useCache)
 			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
+				RenderError(w, http.StatusNotFound, err)
 				return
 			}
 			hash, err = ipfs.GetHash(i.node.IpfsNode, bytes.NewReader(listingBytes))
 			if err != nil {
-				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				RenderError(w, http.StatusInternalServerError, err)
 				return
 			}
 			w.Header().Set("Cache-Control", "public, max-age=600, immutable")
@@ -1457,19 +1625,116 @@ func (i *jsonAPIHandler) GETListing(w http.ResponseWriter, r *http.Request) {
 		sl := new(pb.SignedListing)
 		err = jsonpb.UnmarshalString(string(listingBytes), sl)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		sl.Hash = hash
+		if err := i.node.IndexListing(sl, hash); err != nil {
+			log.Errorf("indexing listing %s: %s", hash, err.Error())
+		}
+		localizeListing(w, r, sl)
 		out, err := m.MarshalToString(sl)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponseM(w, out, new(pb.SignedListing))
 	}
 }
 
+// getOwnListing returns the node's listing with the slug or hash, with the
+// codes of its coupons
+func (i *jsonAPIHandler) getOwnListing(listingId string) (*pb.SignedListing, error) {
+	var sl *pb.SignedListing
+	_, err := cid.Decode(listingId)
+	if err == nil {
+		sl, err = i.node.GetListingFromHash(listingId)
+		if err != nil {
+			return nil, errListingNotFound
+		}
+		sl.Hash = listingId
+	} else {
+		sl, err = i.node.GetListingFromSlug(listingId)
+		if err != nil {
+			return nil, errListingNotFound
+		}
+		hash, err := ipfs.GetHashOfFile(i.node.IpfsNode, path.Join(i.node.RepoPath, "root", "listings", listingId+".json"))
+		if err != nil {
+			return nil, err
+		}
+		sl.Hash = hash
+	}
+	savedCoupons, err := i.node.Datastore.Coupons().Get(sl.Listing.Slug)
+	if err != nil {
+		return nil, err
+	}
+	for _, coupon := range sl.Listing.Coupons {
+		for _, c := range savedCoupons {
+			if coupon.GetHash() == c.Hash {
+				coupon.Code = &pb.Listing_Coupon_DiscountCode{c.Code}
+				break
+			}
+		}
+	}
+	if sl.Listing.Metadata != nil && sl.Listing.Metadata.Version == 1 {
+		for _, so := range sl.Listing.ShippingOptions {
+			for _, ser := range so.Services {
+				ser.AdditionalItemPrice = ser.Price
+			}
+		}
+	}
+	return sl, nil
+}
+
+func (i *jsonAPIHandler) GETSearch(w http.ResponseWriter, r *http.Request) {
+	query := repo.SearchQuery{
+		Terms:    r.URL.Query().Get("q"),
+		Currency: r.URL.Query().Get("currency"),
+		ShipsTo:  r.URL.Query().Get("ships_to"),
+	}
+	for _, tag := range strings.Split(r.URL.Query().Get("tags"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			query.Tags = append(query.Tags, tag)
+		}
+	}
+	if priceMax := r.URL.Query().Get("price_max"); priceMax != "" {
+		p, err := strconv.ParseUint(priceMax, 10, 64)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, "price_max must be an integer")
+			return
+		}
+		// Prices of listings are only comparable within their currency
+		if query.Currency == "" {
+			ErrorResponse(w, http.StatusBadRequest, "price_max requires a currency")
+			return
+		}
+		query.PriceMax = p
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, "limit must be an integer")
+			return
+		}
+		query.Limit = l
+	}
+
+	results, err := i.node.Datastore.Search().Search(query)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	for n := range results {
+		results[n].IndexedAt = results[n].IndexedAt.UTC()
+	}
+	out, err := json.MarshalIndent(results, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
 func (i *jsonAPIHandler) GETProfile(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
 	var profile pb.Profile
@@ -1480,26 +1745,26 @@ func (i *jsonAPIHandler) GETProfile(w http.ResponseWriter, r *http.Request) {
 	if peerId == "" || strings.ToLower(peerId) == "profile" || peerId == i.node.IPFSIdentityString() {
 		profile, err = i.node.GetProfile()
 		if err != nil && err == core.ErrorProfileNotFound {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		} else if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		peerId = pid.Pretty()
 		profile, err = i.node.FetchProfile(peerId, useCache)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		if profile.PeerID != peerId {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
@@ -1512,7 +1777,7 @@ func (i *jsonAPIHandler) GETProfile(w http.ResponseWriter, r *http.Request) {
 	}
 	out, err := m.MarshalToString(&profile)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponseM(w, out, new(pb.Profile))
@@ -1537,36 +1802,32 @@ func (i *jsonAPIHandler) POSTOrderConfirmation(w http.ResponseWriter, r *http.Re
 	var conf orderConf
 	err := decoder.Decode(&conf)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	contract, state, funded, records, _, err := i.node.Datastore.Sales().GetByOrderId(conf.OrderId)
-	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+	if err := i.confirmOrder(conf.OrderId, conf.Reject); err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
+	SanitizedResponse(w, `{}`)
+}
+
+// confirmOrder confirms or rejects a pending sale
+func (i *jsonAPIHandler) confirmOrder(orderId string, reject bool) error {
+	contract, state, funded, records, _, err := i.node.Datastore.Sales().GetByOrderId(orderId)
+	if err != nil {
+		return &apiError{http.StatusNotFound, err.Error()}
+	}
 	if state != pb.OrderState_PENDING {
-		ErrorResponse(w, http.StatusBadRequest, "order has already been confirmed")
-		return
+		return &apiError{http.StatusBadRequest, "order has already been confirmed"}
 	}
-	if !funded && !conf.Reject {
-		ErrorResponse(w, http.StatusBadRequest, "payment address must be funded before confirmation")
-		return
+	if !funded && !reject {
+		return &apiError{http.StatusBadRequest, "payment address must be funded before confirmation"}
 	}
-	if !conf.Reject {
-		err := i.node.ConfirmOfflineOrder(contract, records)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-	} else {
-		err := i.node.RejectOfflineOrder(contract, records)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
+	if reject {
+		return i.node.RejectOfflineOrder(contract, records)
 	}
-	SanitizedResponse(w, `{}`)
+	return i.node.ConfirmOfflineOrder(contract, records)
 }
 
 func (i *jsonAPIHandler) POSTOrderCancel(w http.ResponseWriter, r *http.Request) {
@@ -1577,31 +1838,33 @@ func (i *jsonAPIHandler) POSTOrderCancel(w http.ResponseWriter, r *http.Request)
 	var can orderCancel
 	err := decoder.Decode(&can)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	contract, state, _, records, _, err := i.node.Datastore.Purchases().GetByOrderId(can.OrderId)
-	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, "order not found")
+	if err := i.cancelOrder(can.OrderId); err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
+	SanitizedResponse(w, `{}`)
+}
 
-	if !((state == pb.OrderState_PENDING || state == pb.OrderState_PROCESSING_ERROR) && len(records) > 0) || !(state == pb.OrderState_PENDING || state == pb.OrderState_PROCESSING_ERROR) || contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
-		ErrorResponse(w, http.StatusBadRequest, "order must be PENDING or PROCESSING_ERROR and only a direct payment to cancel")
-		return
-	}
-	err = i.node.CancelOfflineOrder(contract, records)
+// cancelOrder cancels a pending purchase paid directly to the vendor
+func (i *jsonAPIHandler) cancelOrder(orderId string) error {
+	contract, state, _, records, _, err := i.node.Datastore.Purchases().GetByOrderId(orderId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return &apiError{http.StatusNotFound, "order not found"}
 	}
-	SanitizedResponse(w, `{}`)
+
+	if !((state == pb.OrderState_PENDING || state == pb.OrderState_PROCESSING_ERROR) && len(records) > 0) || !(state == pb.OrderState_PENDING || state == pb.OrderState_PROCESSING_ERROR) || contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
+		return &apiError{http.StatusBadRequest, "order must be PENDING or PROCESSING_ERROR and only a direct payment to cancel"}
+	}
+	return i.node.CancelOfflineOrder(contract, records)
 }
 
 func (i *jsonAPIHandler) POSTResyncBlockchain(w http.ResponseWriter, r *http.Request) {
 	creationDate, err := i.node.Datastore.Config().GetCreationDate()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	i.node.Wallet.ReSyncBlockchain(creationDate)
@@ -1610,6 +1873,27 @@ func (i *jsonAPIHandler) POSTResyncBlockchain(w http.ResponseWriter, r *http.Req
 
 func (i *jsonAPIHandler) GETOrder(w http.ResponseWriter, r *http.Request) {
 	_, orderId := path.Split(r.URL.Path)
+	resp, err := i.getOrder(orderId)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(resp)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.OrderRespApi))
+}
+
+// getOrder returns the purchase or sale with the order ID and marks it as read
+func (i *jsonAPIHandler) getOrder(orderId string) (*pb.OrderRespApi, error) {
 	var err error
 	var isSale bool
 	var contract *pb.RicardianContract
@@ -1621,8 +1905,7 @@ func (i *jsonAPIHandler) GETOrder(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		contract, state, funded, records, read, err = i.node.Datastore.Sales().GetByOrderId(orderId)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, "Order not found")
-			return
+			return nil, &apiError{http.StatusNotFound, "Order not found"}
 		}
 		isSale = true
 	}
@@ -1634,36 +1917,23 @@ func (i *jsonAPIHandler) GETOrder(w http.ResponseWriter, r *http.Request) {
 
 	paymentTxs, refundTx, err := i.node.BuildTransactionRecords(contract, records, state)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return nil, err
 	}
 	resp.PaymentAddressTransactions = paymentTxs
 	resp.RefundAddressTransaction = refundTx
 
 	unread, err := i.node.Datastore.Chat().GetUnreadCount(orderId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return nil, err
 	}
 	resp.UnreadChatMessages = uint64(unread)
 
-	m := jsonpb.Marshaler{
-		EnumsAsInts:  false,
-		EmitDefaults: true,
-		Indent:       "    ",
-		OrigName:     false,
-	}
-	out, err := m.MarshalToString(resp)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
 	if isSale {
 		i.node.Datastore.Sales().MarkAsRead(orderId)
 	} else {
 		i.node.Datastore.Purchases().MarkAsRead(orderId)
 	}
-	SanitizedResponseM(w, out, new(pb.OrderRespApi))
+	return resp, nil
 }
 
 func (i *jsonAPIHandler) POSTShutdown(w http.ResponseWriter, r *http.Request) {
@@ -1691,24 +1961,26 @@ func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
 	var can orderCancel
 	err := decoder.Decode(&can)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	contract, state, _, records, _, err := i.node.Datastore.Sales().GetByOrderId(can.OrderId)
-	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, "order not found")
+	if err := i.refundOrder(can.OrderId); err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
-	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
-		ErrorResponse(w, http.StatusBadRequest, "order must be AWAITING_FULFILLMENT, or PARTIALLY_FULFILLED")
-		return
-	}
-	err = i.node.RefundOrder(contract, records)
+	SanitizedResponse(w, `{}`)
+}
+
+// refundOrder refunds a sale which hasn't been fulfilled
+func (i *jsonAPIHandler) refundOrder(orderId string) error {
+	contract, state, _, records, _, err := i.node.Datastore.Sales().GetByOrderId(orderId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return &apiError{http.StatusNotFound, "order not found"}
 	}
-	SanitizedResponse(w, `{}`)
+	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
+		return &apiError{http.StatusBadRequest, "order must be AWAITING_FULFILLMENT, or PARTIALLY_FULFILLED"}
+	}
+	return i.node.RefundOrder(contract, records)
 }
 
 func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
@@ -1732,7 +2004,7 @@ func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 		}
 		peerInfoList, err := ipfs.FindPointers(i.node.IpfsNode.Routing.(*routing.IpfsDHT), ctx, core.ModeratorPointerID, 64)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		var mods []string
@@ -1792,7 +2064,7 @@ func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 		} else {
 			res, err := json.MarshalIndent(mods, "", "    ")
 			if err != nil {
-				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				RenderError(w, http.StatusInternalServerError, err)
 				return
 			}
 			resp = string(res)
@@ -1881,24 +2153,26 @@ func (i *jsonAPIHandler) POSTOrderFulfill(w http.ResponseWriter, r *http.Request
 	var fulfill pb.OrderFulfillment
 	err := decoder.Decode(&fulfill)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
+	if err := i.fulfillOrder(&fulfill); err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+// fulfillOrder sends the fulfillment of a sale to the buyer
+func (i *jsonAPIHandler) fulfillOrder(fulfill *pb.OrderFulfillment) error {
 	contract, state, _, records, _, err := i.node.Datastore.Sales().GetByOrderId(fulfill.OrderId)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, "order not found")
-		return
+		return &apiError{http.StatusNotFound, "order not found"}
 	}
 	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
-		ErrorResponse(w, http.StatusBadRequest, "order must be in state AWAITING_FULFILLMENT or PARTIALLY_FULFILLED to fulfill")
-		return
+		return &apiError{http.StatusBadRequest, "order must be in state AWAITING_FULFILLMENT or PARTIALLY_FULFILLED to fulfill"}
 	}
-	err = i.node.FulfillOrder(&fulfill, contract, records)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	SanitizedResponse(w, `{}`)
+	return i.node.FulfillOrder(fulfill, contract, records)
 }
 
 func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Request) {
@@ -1913,7 +2187,7 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 	var or core.OrderRatings
 	err := decoder.Decode(&or)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	contract, state, _, records, _, err := i.node.Datastore.Purchases().GetByOrderId(or.OrderID)
@@ -1967,7 +2241,7 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 
 	err = i.node.CompleteOrder(&or, contract, records)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -1982,7 +2256,7 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 	var d dispute
 	err := decoder.Decode(&d)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	var isSale bool
@@ -2019,7 +2293,7 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 
 	err = i.node.OpenDispute(d.OrderID, contract, records, d.Claim)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -2036,7 +2310,7 @@ func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request
 	var d dispute
 	err := decoder.Decode(&d)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -2044,11 +2318,11 @@ func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request
 	if err != nil {
 		switch err {
 		case core.ErrCaseNotFound:
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 		case core.ErrCloseFailureCaseExpired:
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			RenderError(w, http.StatusBadRequest, err)
 		default:
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 		return
 	}
@@ -2057,17 +2331,36 @@ func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request
 
 func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
 	_, orderId := path.Split(r.URL.Path)
-	buyerContract, vendorContract, buyerErrors, vendorErrors, state, read, date, buyerOpened, claim, resolution, err := i.node.Datastore.Cases().GetCaseMetadata(orderId)
+	resp, err := i.getCase(orderId)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(resp)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
+	SanitizedResponseM(w, out, new(pb.CaseRespApi))
+}
+
+// getCase returns the dispute case with the order ID and marks it as read
+func (i *jsonAPIHandler) getCase(orderId string) (*pb.CaseRespApi, error) {
+	buyerContract, vendorContract, buyerErrors, vendorErrors, state, read, date, buyerOpened, claim, resolution, err := i.node.Datastore.Cases().GetCaseMetadata(orderId)
+	if err != nil {
+		return nil, &apiError{http.StatusNotFound, err.Error()}
+	}
 
 	resp := new(pb.CaseRespApi)
 	ts, err := ptypes.TimestampProto(date)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return nil, err
 	}
 	resp.BuyerContract = buyerContract
 	resp.VendorContract = vendorContract
@@ -2082,25 +2375,12 @@ func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
 
 	unread, err := i.node.Datastore.Chat().GetUnreadCount(orderId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return nil, err
 	}
 	resp.UnreadChatMessages = uint64(unread)
 
-	m := jsonpb.Marshaler{
-		EnumsAsInts:  false,
-		EmitDefaults: true,
-		Indent:       "    ",
-		OrigName:     false,
-	}
-	out, err := m.MarshalToString(resp)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-
 	i.node.Datastore.Cases().MarkAsRead(orderId)
-	SanitizedResponseM(w, out, new(pb.CaseRespApi))
+	return resp, nil
 }
 
 func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request) {
@@ -2111,7 +2391,7 @@ func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request
 	var rel release
 	err := decoder.Decode(&rel)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	var contract *pb.RicardianContract
@@ -2128,7 +2408,7 @@ func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request
 	if state == pb.OrderState_DECIDED {
 		err = i.node.ReleaseFunds(contract, records)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	} else {
@@ -2151,7 +2431,7 @@ func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Reques
 	decoder := json.NewDecoder(r.Body)
 	err := decoder.Decode(&rel)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -2168,7 +2448,7 @@ func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Reques
 
 	activeDispute, err := i.node.DisputeIsActive(contract)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if activeDispute {
@@ -2185,13 +2465,13 @@ func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Reques
 	if err != nil {
 		switch err {
 		case core.ErrPrematureReleaseOfTimedoutEscrowFunds:
-			ErrorResponse(w, http.StatusUnauthorized, err.Error())
+			RenderError(w, http.StatusUnauthorized, err)
 			return
 		case core.EscrowTimeLockedError:
-			ErrorResponse(w, http.StatusUnauthorized, err.Error())
+			RenderError(w, http.StatusUnauthorized, err)
 			return
 		default:
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			RenderError(w, http.StatusBadRequest, err)
 			return
 		}
 	}
@@ -2210,63 +2490,67 @@ func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 	var chat repo.ChatMessage
 	err := decoder.Decode(&chat)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	if len(chat.Subject) > 500 {
-		ErrorResponse(w, http.StatusBadRequest, "Subject line is too long")
+	msgId, err := i.sendChat(chat.PeerId, chat.Subject, chat.Message)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
-	if len(chat.Message) > 20000 {
-		ErrorResponse(w, http.StatusBadRequest, "Message is too long")
-		return
+	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgId))
+}
+
+// sendChat sends a chat message to the peer and returns its ID. An empty
+// message is sent as a typing notification and not saved.
+func (i *jsonAPIHandler) sendChat(peerId, subject, message string) (string, error) {
+	if len(subject) > 500 {
+		return "", &apiError{http.StatusBadRequest, "Subject line is too long"}
+	}
+	if len(message) > 20000 {
+		return "", &apiError{http.StatusBadRequest, "Message is too long"}
 	}
 
 	t := time.Now()
 	ts, err := ptypes.TimestampProto(t)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+		return "", &apiError{http.StatusBadRequest, err.Error()}
 	}
 	var flag pb.Chat_Flag
-	if chat.Message == "" {
+	if message == "" {
 		flag = pb.Chat_TYPING
 	} else {
 		flag = pb.Chat_MESSAGE
 	}
-	h := sha256.Sum256([]byte(chat.Message + chat.Subject + ptypes.TimestampString(ts)))
+	h := sha256.Sum256([]byte(message + subject + ptypes.TimestampString(ts)))
 	encoded, err := mh.Encode(h[:], mh.SHA2_256)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+		return "", &apiError{http.StatusBadRequest, err.Error()}
 	}
 	msgId, err := mh.Cast(encoded)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+		return "", &apiError{http.StatusBadRequest, err.Error()}
 	}
 
 	chatPb := &pb.Chat{
 		MessageId: msgId.B58String(),
-		Subject:   chat.Subject,
-		Message:   chat.Message,
+		Subject:   subject,
+		Message:   message,
 		Timestamp: ts,
 		Flag:      flag,
 	}
-	err = i.node.SendChat(chat.PeerId, chatPb)
+	err = i.node.SendChat(peerId, chatPb)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return "", err
 	}
 	// Put to database
 	if chatPb.Flag == pb.Chat_MESSAGE {
-		err = i.node.Datastore.Chat().Put(msgId.B58String(), chat.PeerId, chat.Subject, chat.Message, t, false, true)
+		err = i.node.Datastore.Chat().Put(msgId.B58String(), peerId, subject, message, t, false, true)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+			return "", err
 		}
 	}
-	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgId.B58String()))
+	return msgId.B58String(), nil
 }
 
 func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
@@ -2274,7 +2558,7 @@ func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
 	var chat repo.GroupChatMessage
 	err := decoder.Decode(&chat)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	if len(chat.Subject) > 500 {
@@ -2293,7 +2577,7 @@ func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
 	t := time.Now()
 	ts, err := ptypes.TimestampProto(t)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	var flag pb.Chat_Flag
@@ -2305,12 +2589,12 @@ func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
 	h := sha256.Sum256([]byte(chat.Message + chat.Subject + ptypes.TimestampString(ts)))
 	encoded, err := mh.Encode(h[:], mh.SHA2_256)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	msgId, err := mh.Cast(encoded)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -2324,7 +2608,7 @@ func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
 	for _, pid := range chat.PeerIds {
 		err = i.node.SendChat(pid, chatPb)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
@@ -2332,7 +2616,7 @@ func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
 	if chatPb.Flag == pb.Chat_MESSAGE {
 		err = i.node.Datastore.Chat().Put(msgId.B58String(), "", chat.Subject, chat.Message, t, false, true)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
@@ -2344,40 +2628,37 @@ func (i *jsonAPIHandler) GETChatMessages(w http.ResponseWriter, r *http.Request)
 	if strings.ToLower(peerId) == "chatmessages" {
 		peerId = ""
 	}
-	limit := r.URL.Query().Get("limit")
-	if limit == "" {
-		limit = "-1"
-	}
-	l, err := strconv.Atoi(limit)
+	p, err := parsePageRequest(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
-	offsetId := r.URL.Query().Get("offsetId")
-	messages := i.node.Datastore.Chat().GetMessages(peerId, r.URL.Query().Get("subject"), offsetId, int(l))
-
-	ret, err := json.MarshalIndent(messages, "", "    ")
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	subject := r.URL.Query().Get("subject")
+	if p.offsetID != "" {
+		messages := i.node.Datastore.Chat().GetMessages(peerId, subject, p.offsetID, p.limit)
+		writeList(w, p, append([]repo.ChatMessage{}, messages...), "")
 		return
 	}
-	if string(ret) == "null" {
-		ret = []byte("[]")
+	messages, next, err := i.node.Datastore.Chat().GetMessagesPage(peerId, subject, p.cursor, p.limit)
+	if err != nil {
+		renderPageError(w, err)
+		return
 	}
-	SanitizedResponse(w, string(ret))
+	writeList(w, p, append([]repo.ChatMessage{}, messages...), encodeCursor(next))
 }
 
 func (i *jsonAPIHandler) GETChatConversations(w http.ResponseWriter, r *http.Request) {
-	conversations := i.node.Datastore.Chat().GetConversations()
-	ret, err := json.MarshalIndent(conversations, "", "    ")
+	p, err := parsePageRequest(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
-	if string(ret) == "null" {
-		ret = []byte("[]")
+	conversations, next, err := i.node.Datastore.Chat().GetConversationsPage(p.cursor, p.limit)
+	if err != nil {
+		renderPageError(w, err)
+		return
 	}
-	SanitizedResponse(w, string(ret))
+	writeList(w, p, conversations, encodeCursor(next))
 }
 
 func (i *jsonAPIHandler) POSTMarkChatAsRead(w http.ResponseWriter, r *http.Request) {
@@ -2385,11 +2666,19 @@ func (i *jsonAPIHandler) POSTMarkChatAsRead(w http.ResponseWriter, r *http.Reque
 	if strings.ToLower(peerId) == "markchatasread" {
 		peerId = ""
 	}
-	subject := r.URL.Query().Get("subject")
+	if err := i.markChatAsRead(peerId, r.URL.Query().Get("subject")); err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+// markChatAsRead marks the messages from the peer, or from everyone if the
+// peer ID is empty, as read and sends the peer a read receipt
+func (i *jsonAPIHandler) markChatAsRead(peerId, subject string) error {
 	lastId, updated, err := i.node.Datastore.Chat().MarkAsRead(peerId, subject, false, "")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return err
 	}
 	if updated && peerId != "" {
 		chatPb := &pb.Chat{
@@ -2399,8 +2688,7 @@ func (i *jsonAPIHandler) POSTMarkChatAsRead(w http.ResponseWriter, r *http.Reque
 		}
 		err = i.node.SendChat(peerId, chatPb)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+			return err
 		}
 	}
 	if subject != "" {
@@ -2410,14 +2698,14 @@ func (i *jsonAPIHandler) POSTMarkChatAsRead(w http.ResponseWriter, r *http.Reque
 			i.node.Datastore.Cases().MarkAsRead(subject)
 		}()
 	}
-	SanitizedResponse(w, `{}`)
+	return nil
 }
 
 func (i *jsonAPIHandler) DELETEChatMessage(w http.ResponseWriter, r *http.Request) {
 	_, messageId := path.Split(r.URL.Path)
 	err := i.node.Datastore.Chat().DeleteMessage(messageId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -2427,23 +2715,18 @@ func (i *jsonAPIHandler) DELETEChatConversation(w http.ResponseWriter, r *http.R
 	_, peerId := path.Split(r.URL.Path)
 	err := i.node.Datastore.Chat().DeleteConversation(peerId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
 }
 
 func (i *jsonAPIHandler) GETNotifications(w http.ResponseWriter, r *http.Request) {
-	limit := r.URL.Query().Get("limit")
-	if limit == "" {
-		limit = "-1"
-	}
-	l, err := strconv.Atoi(limit)
+	p, err := parsePageRequest(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
-	offsetId := r.URL.Query().Get("offsetId")
 	filter := r.URL.Query().Get("filter")
 
 	types := strings.Split(filter, ",")
@@ -2458,29 +2741,39 @@ func (i *jsonAPIHandler) GETNotifications(w http.ResponseWriter, r *http.Request
 		Unread        int               `json:"unread"`
 		Total         int               `json:"total"`
 		Notifications []json.RawMessage `json:"notifications"`
+		NextCursor    string            `json:"nextCursor"`
+	}
+	var (
+		notifs []*repo.Notification
+		total  int
+		next   string
+	)
+	if p.offsetID != "" {
+		notifs, total, err = i.node.Datastore.Notifications().GetAll(p.offsetID, p.limit, filters)
+	} else {
+		notifs, total, next, err = i.node.Datastore.Notifications().GetPage(p.cursor, p.limit, filters)
 	}
-	notifs, total, err := i.node.Datastore.Notifications().GetAll(offsetId, int(l), filters)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		renderPageError(w, err)
 		return
 	}
 	unread, err := i.node.Datastore.Notifications().GetUnreadCount()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	payload := notifData{unread, total, []json.RawMessage{}}
+	payload := notifData{unread, total, []json.RawMessage{}, encodeCursor(next)}
 	for _, n := range notifs {
 		data, err := n.Data()
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 		payload.Notifications = append(payload.Notifications, data)
 	}
 	ret, err := json.MarshalIndent(payload, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	retString := string(ret)
@@ -2494,7 +2787,7 @@ func (i *jsonAPIHandler) POSTMarkNotificationAsRead(w http.ResponseWriter, r *ht
 	_, notifId := path.Split(r.URL.Path)
 	err := i.node.Datastore.Notifications().MarkAsRead(notifId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -2503,7 +2796,7 @@ func (i *jsonAPIHandler) POSTMarkNotificationAsRead(w http.ResponseWriter, r *ht
 func (i *jsonAPIHandler) POSTMarkNotificationsAsRead(w http.ResponseWriter, r *http.Request) {
 	err := i.node.Datastore.Notifications().MarkAllAsRead()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -2513,7 +2806,7 @@ func (i *jsonAPIHandler) DELETENotification(w http.ResponseWriter, r *http.Reque
 	_, notifId := path.Split(r.URL.Path)
 	err := i.node.Datastore.Notifications().Delete(notifId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -2525,7 +2818,7 @@ func (i *jsonAPIHandler) GETImage(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	dr, err := coreunix.Cat(ctx, i.node.IpfsNode, "/ipfs/"+imageHash)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	defer dr.Close()
@@ -2543,7 +2836,7 @@ func (i *jsonAPIHandler) GETAvatar(w http.ResponseWriter, r *http.Request) {
 
 	dr, err := i.node.FetchAvatar(peerId, size, useCache)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	defer dr.Close()
@@ -2561,7 +2854,7 @@ func (i *jsonAPIHandler) GETHeader(w http.ResponseWriter, r *http.Request) {
 
 	dr, err := i.node.FetchHeader(peerId, size, useCache)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	defer dr.Close()
@@ -2580,7 +2873,7 @@ func (i *jsonAPIHandler) POSTFetchProfiles(w http.ResponseWriter, r *http.Reques
 	decoder := json.NewDecoder(r.Body)
 	err := decoder.Decode(&pids)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	if !async {
@@ -2696,7 +2989,7 @@ func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request)
 	}
 	limit, err := strconv.Atoi(l)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	offsetID := r.URL.Query().Get("offsetId")
@@ -2716,12 +3009,12 @@ func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request)
 	}
 	transactions, err := i.node.Wallet.Transactions()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	metadata, err := i.node.Datastore.TxMetadata().GetAll()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	var txs []Tx
@@ -2766,21 +3059,26 @@ func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request)
 	txns := txWithCount{txs, len(transactions)}
 	ret, err := json.MarshalIndent(txns, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(ret))
 }
 
 func (i *jsonAPIHandler) GETPurchases(w http.ResponseWriter, r *http.Request) {
-	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	orderStates, searchTerm, sortByAscending, sortByRead, _, err := parseSearchTerms(r.URL.Query())
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	p, err := parsePageRequest(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	purchases, queryCount, err := i.node.Datastore.Purchases().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	purchases, queryCount, next, err := i.node.Datastore.Purchases().GetPage(orderStates, searchTerm, sortByAscending, sortByRead, p.cursor, p.limit)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		renderPageError(w, err)
 		return
 	}
 	for n, p := range purchases {
@@ -2793,11 +3091,12 @@ func (i *jsonAPIHandler) GETPurchases(w http.ResponseWriter, r *http.Request) {
 	type purchasesResponse struct {
 		QueryCount int             `json:"queryCount"`
 		Purchases  []repo.Purchase `json:"purchases"`
+		NextCursor string          `json:"nextCursor"`
 	}
-	pr := purchasesResponse{queryCount, purchases}
+	pr := purchasesResponse{queryCount, purchases, encodeCursor(next)}
 	ret, err := json.MarshalIndent(pr, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if string(ret) == "null" {
@@ -2807,14 +3106,19 @@ func (i *jsonAPIHandler) GETPurchases(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) GETSales(w http.ResponseWriter, r *http.Request) {
-	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	orderStates, searchTerm, sortByAscending, sortByRead, _, err := parseSearchTerms(r.URL.Query())
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	p, err := parsePageRequest(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	sales, queryCount, err := i.node.Datastore.Sales().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	sales, queryCount, next, err := i.node.Datastore.Sales().GetPage(orderStates, searchTerm, sortByAscending, sortByRead, p.cursor, p.limit)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		renderPageError(w, err)
 		return
 	}
 	for n, s := range sales {
@@ -2827,12 +3131,13 @@ func (i *jsonAPIHandler) GETSales(w http.ResponseWriter, r *http.Request) {
 	type salesResponse struct {
 		QueryCount int         `json:"queryCount"`
 		Sales      []repo.Sale `json:"sales"`
+		NextCursor string      `json:"nextCursor"`
 	}
-	sr := salesResponse{queryCount, sales}
+	sr := salesResponse{queryCount, sales, encodeCursor(next)}
 
 	ret, err := json.MarshalIndent(sr, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if string(ret) == "null" {
@@ -2842,14 +3147,19 @@ func (i *jsonAPIHandler) GETSales(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
-	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	orderStates, searchTerm, sortByAscending, sortByRead, _, err := parseSearchTerms(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	cases, queryCount, err := i.node.Datastore.Cases().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	p, err := parsePageRequest(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	cases, queryCount, next, err := i.node.Datastore.Cases().GetPage(orderStates, searchTerm, sortByAscending, sortByRead, p.cursor, p.limit)
+	if err != nil {
+		renderPageError(w, err)
 		return
 	}
 	for n, c := range cases {
@@ -2862,11 +3172,12 @@ func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
 	type casesResponse struct {
 		QueryCount int         `json:"queryCount"`
 		Cases      []repo.Case `json:"cases"`
+		NextCursor string      `json:"nextCursor"`
 	}
-	cr := casesResponse{queryCount, cases}
+	cr := casesResponse{queryCount, cases, encodeCursor(next)}
 	ret, err := json.MarshalIndent(cr, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if string(ret) == "null" {
@@ -2880,12 +3191,26 @@ func (i *jsonAPIHandler) POSTPurchases(w http.ResponseWriter, r *http.Request) {
 	var query TransactionQuery
 	err := decoder.Decode(&query)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	purchases, queryCount, err := i.node.Datastore.Purchases().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	var (
+		purchases  []repo.Purchase
+		queryCount int
+		next       string
+	)
+	if len(query.Exclude) > 0 {
+		purchases, queryCount, err = i.node.Datastore.Purchases().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	} else {
+		var cursor string
+		if cursor, err = decodeCursor(query.Cursor); err != nil {
+			RenderError(w, http.StatusBadRequest, err)
+			return
+		}
+		purchases, queryCount, next, err = i.node.Datastore.Purchases().GetPage(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, cursor, query.Limit)
+	}
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		renderPageError(w, err)
 		return
 	}
 	for n, p := range purchases {
@@ -2898,11 +3223,12 @@ func (i *jsonAPIHandler) POSTPurchases(w http.ResponseWriter, r *http.Request) {
 	type purchasesResponse struct {
 		QueryCount int             `json:"queryCount"`
 		Purchases  []repo.Purchase `json:"purchases"`
+		NextCursor string          `json:"nextCursor"`
 	}
-	pr := purchasesResponse{queryCount, purchases}
+	pr := purchasesResponse{queryCount, purchases, encodeCursor(next)}
 	ret, err := json.MarshalIndent(pr, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if string(ret) == "null" {
@@ -2916,12 +3242,26 @@ func (i *jsonAPIHandler) POSTSales(w http.ResponseWriter, r *http.Request) {
 	var query TransactionQuery
 	err := decoder.Decode(&query)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	sales, queryCount, err := i.node.Datastore.Sales().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	var (
+		sales      []repo.Sale
+		queryCount int
+		next       string
+	)
+	if len(query.Exclude) > 0 {
+		sales, queryCount, err = i.node.Datastore.Sales().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	} else {
+		var cursor string
+		if cursor, err = decodeCursor(query.Cursor); err != nil {
+			RenderError(w, http.StatusBadRequest, err)
+			return
+		}
+		sales, queryCount, next, err = i.node.Datastore.Sales().GetPage(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, cursor, query.Limit)
+	}
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		renderPageError(w, err)
 		return
 	}
 	for n, s := range sales {
@@ -2934,12 +3274,13 @@ func (i *jsonAPIHandler) POSTSales(w http.ResponseWriter, r *http.Request) {
 	type salesResponse struct {
 		QueryCount int         `json:"queryCount"`
 		Sales      []repo.Sale `json:"sales"`
+		NextCursor string      `json:"nextCursor"`
 	}
-	sr := salesResponse{queryCount, sales}
+	sr := salesResponse{queryCount, sales, encodeCursor(next)}
 
 	ret, err := json.MarshalIndent(sr, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if string(ret) == "null" {
@@ -2953,12 +3294,26 @@ func (i *jsonAPIHandler) POSTCases(w http.ResponseWriter, r *http.Request) {
 	var query TransactionQuery
 	err := decoder.Decode(&query)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	cases, queryCount, err := i.node.Datastore.Cases().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	var (
+		cases      []repo.Case
+		queryCount int
+		next       string
+	)
+	if len(query.Exclude) > 0 {
+		cases, queryCount, err = i.node.Datastore.Cases().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	} else {
+		var cursor string
+		if cursor, err = decodeCursor(query.Cursor); err != nil {
+			RenderError(w, http.StatusBadRequest, err)
+			return
+		}
+		cases, queryCount, next, err = i.node.Datastore.Cases().GetPage(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, cursor, query.Limit)
+	}
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		renderPageError(w, err)
 		return
 	}
 	for n, c := range cases {
@@ -2971,11 +3326,12 @@ func (i *jsonAPIHandler) POSTCases(w http.ResponseWriter, r *http.Request) {
 	type casesResponse struct {
 		QueryCount int         `json:"queryCount"`
 		Cases      []repo.Case `json:"cases"`
+		NextCursor string      `json:"nextCursor"`
 	}
-	cr := casesResponse{queryCount, cases}
+	cr := casesResponse{queryCount, cases, encodeCursor(next)}
 	ret, err := json.MarshalIndent(cr, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if string(ret) == "null" {
@@ -2988,7 +3344,7 @@ func (i *jsonAPIHandler) POSTBlockNode(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
 	settings, err := i.node.Datastore.Settings().Get()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	var nodes []string
@@ -3005,12 +3361,12 @@ func (i *jsonAPIHandler) POSTBlockNode(w http.ResponseWriter, r *http.Request) {
 	nodes = append(nodes, peerId)
 	settings.BlockedNodes = &nodes
 	if err := i.node.Datastore.Settings().Put(settings); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	pid, err := peer.IDB58Decode(peerId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	i.node.BanManager.AddBlockedId(pid)
@@ -3021,7 +3377,7 @@ func (i *jsonAPIHandler) DELETEBlockNode(w http.ResponseWriter, r *http.Request)
 	_, peerId := path.Split(r.URL.Path)
 	settings, err := i.node.Datastore.Settings().Get()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if settings.BlockedNodes != nil {
@@ -3034,35 +3390,306 @@ func (i *jsonAPIHandler) DELETEBlockNode(w http.ResponseWriter, r *http.Request)
 		settings.BlockedNodes = &nodes
 	}
 	if err := i.node.Datastore.Settings().Put(settings); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	pid, err := peer.IDB58Decode(peerId)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	i.node.BanManager.RemoveBlockedId(pid)
 	SanitizedResponse(w, `{}`)
 }
 
+func (i *jsonAPIHandler) GETBans(w http.ResponseWriter, r *http.Request) {
+	var (
+		bans []repo.Ban
+		err  error
+	)
+	_, peerID := path.Split(r.URL.Path)
+	if peerID == "" || peerID == "blocks" {
+		bans, err = i.node.Datastore.Bans().GetAll()
+	} else {
+		bans, err = i.node.Datastore.Bans().Get(peerID)
+		if err == nil && len(bans) == 0 {
+			ErrorResponse(w, http.StatusNotFound, "Ban not found.")
+			return
+		}
+	}
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ret := []repo.Ban{}
+	for _, ban := range bans {
+		ret = append(ret, utcBan(ban))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTBan(w http.ResponseWriter, r *http.Request) {
+	var ban repo.Ban
+	if err := json.NewDecoder(r.Body).Decode(&ban); err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	saved, err := i.node.AddBan(ban)
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	banResponse(w, *saved)
+}
+
+func (i *jsonAPIHandler) PUTBan(w http.ResponseWriter, r *http.Request) {
+	var ban repo.Ban
+	if err := json.NewDecoder(r.Body).Decode(&ban); err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	saved, err := i.node.UpdateBan(ban)
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	banResponse(w, *saved)
+}
+
+func (i *jsonAPIHandler) DELETEBan(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	scope := repo.BanScope(r.URL.Query().Get("scope"))
+	if err := i.node.RemoveBan(peerID, scope); err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func banResponse(w http.ResponseWriter, ban repo.Ban) {
+	out, err := json.MarshalIndent(utcBan(ban), "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func utcBan(ban repo.Ban) repo.Ban {
+	ban.CreatedAt = ban.CreatedAt.UTC()
+	if ban.Expires != nil {
+		expires := ban.Expires.UTC()
+		ban.Expires = &expires
+	}
+	return ban
+}
+
+// authenticate checks the credentials of the request and returns whether it
+// should be handled. API tokens are checked whenever one is presented and
+// are limited to their scopes. The cookie and basic auth credentials allow
+// every request.
+func (i *jsonAPIHandler) authenticate(w http.ResponseWriter, r *http.Request, path string) bool {
+	status, retryAfter := i.checkAuth(r, path, apiTokenScopes(path, r.Method))
+	switch status {
+	case 0:
+		return true
+	case http.StatusOK:
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "200 - OK")
+	case http.StatusTooManyRequests:
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, "429 - Too Many Requests")
+	default:
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, "403 - Forbidden")
+	}
+	return false
+}
+
+// checkAuth authenticates the request, and checks an API token has the
+// scopes, recording the outcome in the auth log under the path. It returns
+// the HTTP status to stop the request with, and how long a locked out client
+// must wait, or 0 if the request is allowed. Preflight requests are stopped
+// with 200 when basic auth is used.
+func (i *jsonAPIHandler) checkAuth(r *http.Request, path string, scopes []repo.APITokenScope) (int, time.Duration) {
+	secret, hasToken := bearerToken(r)
+	if !hasToken && !i.config.Authenticated {
+		return 0, 0
+	}
+	ip := clientIP(r)
+	if wait, locked := i.guard.LockedOut(ip); locked {
+		return http.StatusTooManyRequests, wait
+	}
+
+	switch {
+	case hasToken:
+		token, err := repo.AuthenticateAPIToken(i.node.Datastore.APITokens(), secret)
+		if err != nil {
+			if err == repo.ErrAPITokenInvalid {
+				i.guard.Fail(ip, repo.AuthMethodToken, "", path, err.Error())
+			} else {
+				log.Error("Error reading API token:", err)
+			}
+			return http.StatusForbidden, 0
+		}
+		for _, scope := range scopes {
+			if !token.HasScope(scope) {
+				i.guard.Deny(ip, repo.AuthMethodToken, token.Name, path, "missing scope "+string(scope))
+				return http.StatusForbidden, 0
+			}
+		}
+		i.guard.Succeed(ip, repo.AuthMethodToken, token.Name, path)
+	case i.config.Username == "" || i.config.Password == "":
+		if !checkAuthCookie(r, i.config.Cookie) {
+			i.guard.Fail(ip, repo.AuthMethodCookie, "", path, "invalid cookie")
+			return http.StatusForbidden, 0
+		}
+		i.guard.Succeed(ip, repo.AuthMethodCookie, "", path)
+	default:
+		if r.Method == "OPTIONS" {
+			return http.StatusOK, 0
+		}
+		username, ok := checkBasicAuth(r, i.config.Username, i.config.Password)
+		if !ok {
+			i.guard.Fail(ip, repo.AuthMethodBasic, username, path, "invalid credentials")
+			return http.StatusForbidden, 0
+		}
+		i.guard.Succeed(ip, repo.AuthMethodBasic, username, path)
+	}
+	return 0, 0
+}
+
+func (i *jsonAPIHandler) GETEvents(w http.ResponseWriter, r *http.Request) {
+	i.events.ServeHTTP(w, r)
+}
+
+func (i *jsonAPIHandler) GETAuthLog(w http.ResponseWriter, r *http.Request) {
+	limit := 100
+	if l := r.URL.Query().Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n <= 0 {
+			ErrorResponse(w, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		limit = n
+	}
+	events, err := i.node.Datastore.AuthLog().Get(r.URL.Query().Get("ip"), limit)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ret := []repo.AuthEvent{}
+	for _, event := range events {
+		event.Timestamp = event.Timestamp.UTC()
+		ret = append(ret, event)
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+type apiTokenRequest struct {
+	Name    string               `json:"name"`
+	Scopes  []repo.APITokenScope `json:"scopes"`
+	Expires *time.Time           `json:"expires,omitempty"`
+}
+
+type apiTokenResponse struct {
+	repo.APIToken
+	Token string `json:"token"`
+}
+
+func (i *jsonAPIHandler) POSTAPIToken(w http.ResponseWriter, r *http.Request) {
+	var req apiTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	secret, token, err := repo.CreateAPIToken(i.node.Datastore.APITokens(), req.Name, req.Scopes, req.Expires)
+	if err != nil {
+		RenderError(w, http.StatusBadRequest, err)
+		return
+	}
+	out, err := json.MarshalIndent(apiTokenResponse{utcAPIToken(*token), secret}, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) GETAPITokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := i.node.Datastore.APITokens().GetAll()
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ret := []repo.APIToken{}
+	for _, token := range tokens {
+		ret = append(ret, utcAPIToken(token))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) DELETEAPIToken(w http.ResponseWriter, r *http.Request) {
+	_, name := path.Split(r.URL.Path)
+	if err := repo.RevokeAPIToken(i.node.Datastore.APITokens(), name); err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func utcAPIToken(token repo.APIToken) repo.APIToken {
+	token.CreatedAt = token.CreatedAt.UTC()
+	if token.Expires != nil {
+		expires := token.Expires.UTC()
+		token.Expires = &expires
+	}
+	return token
+}
+
+// bearerToken returns the API token of the Authorization header
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(auth[len(prefix):]), true
+}
+
 func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
 	_, txid := path.Split(r.URL.Path)
 	txHash, err := chainhash.NewHashFromStr(txid)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	newTxid, err := i.node.Wallet.BumpFee(*txHash)
 	if err != nil {
 		if err == spvwallet.BumpFeeAlreadyConfirmedError {
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			RenderError(w, http.StatusBadRequest, err)
 		} else if err == spvwallet.BumpFeeTransactionDeadError {
-			ErrorResponse(w, http.StatusMethodNotAllowed, err.Error())
+			RenderError(w, http.StatusMethodNotAllowed, err)
 		} else if err == spvwallet.BumpFeeNotFoundError {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 		} else {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 		}
 		return
 	}
@@ -3073,7 +3700,7 @@ func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
 	m.Txid = txid
 	m.CanBumpFee = false
 	if err := i.node.Datastore.TxMetadata().Put(m); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if err := i.node.Datastore.TxMetadata().Put(repo.Metadata{
@@ -3084,7 +3711,7 @@ func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
 		Thumbnail:  "",
 		CanBumpFee: true,
 	}); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	type response struct {
@@ -3098,7 +3725,7 @@ func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
 	confirmed, unconfirmed := i.node.Wallet.Balance()
 	txn, err := i.node.Wallet.GetTransaction(*newTxid)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	resp := &response{
@@ -3111,7 +3738,7 @@ func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
 	}
 	ser, err := json.MarshalIndent(resp, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(ser))
@@ -3122,7 +3749,7 @@ func (i *jsonAPIHandler) GETEstimateFee(w http.ResponseWriter, r *http.Request)
 	amt := r.URL.Query().Get("amount")
 	amount, err := strconv.Atoi(amt)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -3149,7 +3776,7 @@ func (i *jsonAPIHandler) GETEstimateFee(w http.ResponseWriter, r *http.Request)
 			ErrorResponse(w, http.StatusBadRequest, `ERROR_DUST_AMOUNT`)
 			return
 		default:
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
@@ -3168,12 +3795,12 @@ func (i *jsonAPIHandler) POSTEstimateTotal(w http.ResponseWriter, r *http.Reques
 	var data core.PurchaseData
 	err := decoder.Decode(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	amount, err := i.node.EstimateOrderTotal(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	fmt.Fprintf(w, "%d", int(amount))
@@ -3201,7 +3828,7 @@ func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 		rating.Ratings = []string{}
 		ret, err := json.MarshalIndent(rating, "", "    ")
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponse(w, string(ret))
@@ -3211,7 +3838,7 @@ func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 	var ratingList []core.SavedRating
 	err := json.Unmarshal(indexBytes, &ratingList)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -3225,15 +3852,21 @@ func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 		}
 		ret, err := json.MarshalIndent(rating, "", "    ")
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponse(w, string(ret))
 	} else {
+		p, err := parsePageRequest(r.URL.Query())
+		if err != nil {
+			RenderError(w, http.StatusInternalServerError, err)
+			return
+		}
 		type resp struct {
-			Count   int      `json:"count"`
-			Average float32  `json:"average"`
-			Ratings []string `json:"ratings"`
+			Count      int      `json:"count"`
+			Average    float32  `json:"average"`
+			Ratings    []string `json:"ratings"`
+			NextCursor string   `json:"nextCursor"`
 		}
 		ratingRet := new(resp)
 		total := float32(0)
@@ -3245,9 +3878,18 @@ func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 		}
 		ratingRet.Count = count
 		ratingRet.Average = total / float32(count)
+		start, end, next, err := p.slice(len(ratingRet.Ratings), func(i int) string {
+			return ratingRet.Ratings[i]
+		})
+		if err != nil {
+			RenderError(w, http.StatusBadRequest, err)
+			return
+		}
+		ratingRet.Ratings = append([]string{}, ratingRet.Ratings[start:end]...)
+		ratingRet.NextCursor = next
 		ret, err := json.MarshalIndent(ratingRet, "", "    ")
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponse(w, string(ret))
@@ -3259,24 +3901,24 @@ func (i *jsonAPIHandler) GETRating(w http.ResponseWriter, r *http.Request) {
 
 	ratingBytes, err := ipfs.Cat(i.node.IpfsNode, ratingID, time.Minute)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		RenderError(w, http.StatusNotFound, err)
 		return
 	}
 
 	rating := new(pb.Rating)
 	err = jsonpb.UnmarshalString(string(ratingBytes), rating)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	valid, err := core.ValidateRating(rating)
 	if !valid || err != nil {
-		ErrorResponse(w, http.StatusExpectationFailed, err.Error())
+		RenderError(w, http.StatusExpectationFailed, err)
 		return
 	}
 	ret, err := json.MarshalIndent(rating, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(ret))
@@ -3287,7 +3929,7 @@ func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request
 	var rp []string
 	err := decoder.Decode(&rp)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -3422,22 +4064,43 @@ func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request
 func (i *jsonAPIHandler) POSTImportListings(w http.ResponseWriter, r *http.Request) {
 	file, _, err := r.FormFile("file")
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	defer file.Close()
 
-	err = i.node.ImportListings(file)
+	options := core.ImportOptions{
+		Format:          r.FormValue("format"),
+		PricingCurrency: r.FormValue("currency"),
+	}
+	if shippingOptions := r.FormValue("shippingOptions"); shippingOptions != "" {
+		l := new(pb.Listing)
+		err = jsonpb.UnmarshalString(`{"shippingOptions": `+shippingOptions+`}`, l)
+		if err != nil {
+			RenderError(w, http.StatusBadRequest, err)
+			return
+		}
+		options.ShippingOptions = l.ShippingOptions
+	}
+
+	report, err := i.node.ImportListings(file, options)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
-	// Republish to IPNS
-	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	if report.Converted > 0 {
+		// Republish to IPNS
+		if err := i.node.SeedNode(); err != nil {
+			RenderError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	out, err := json.MarshalIndent(report, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
-	SanitizedResponse(w, "{}")
+	SanitizedResponse(w, string(out))
 }
 
 func (i *jsonAPIHandler) GETHealthCheck(w http.ResponseWriter, r *http.Request) {
@@ -3471,7 +4134,7 @@ func (i *jsonAPIHandler) GETHealthCheck(w http.ResponseWriter, r *http.Request)
 func (i *jsonAPIHandler) POSTPublish(w http.ResponseWriter, r *http.Request) {
 	// Republish to IPNS
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, "{}")
@@ -3481,19 +4144,19 @@ func (i *jsonAPIHandler) POSTPurgeCache(w http.ResponseWriter, r *http.Request)
 
 	ch, err := i.node.IpfsNode.Blockstore.AllKeysChan(context.Background())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	for id := range ch {
 		if err := i.node.IpfsNode.Blockstore.DeleteBlock(id); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
 
 	// Republish to IPNS
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, "{}")
@@ -3508,7 +4171,7 @@ func (i *jsonAPIHandler) GETWalletStatus(w http.ResponseWriter, r *http.Request)
 	hh := status{height, hash.String()}
 	ret, err := json.MarshalIndent(&hh, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(ret))
@@ -3518,7 +4181,7 @@ func (i *jsonAPIHandler) GETResolve(w http.ResponseWriter, r *http.Request) {
 	_, name := path.Split(r.URL.Path)
 	pid, err := i.node.NameSystem.Resolve(context.Background(), name)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		RenderError(w, http.StatusNotFound, err)
 		return
 	}
 	fmt.Fprint(w, pid.Pretty())
@@ -3529,12 +4192,12 @@ func (i *jsonAPIHandler) GETIPNS(w http.ResponseWriter, r *http.Request) {
 
 	val, err := i.node.IpfsNode.Repo.Datastore().Get(dshelp.NewKeyFromBinary([]byte("/ipns/" + peerId)))
 	if err != nil { // No record in datastore
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		RenderError(w, http.StatusNotFound, err)
 		return
 	}
 	pid, err := peer.IDB58Decode(peerId)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	var keyBytes []byte
@@ -3542,14 +4205,14 @@ func (i *jsonAPIHandler) GETIPNS(w http.ResponseWriter, r *http.Request) {
 	if pubkey == nil || !pid.MatchesPublicKey(pubkey) {
 		keyval, err := i.node.IpfsNode.Repo.Datastore().Get(ds.NewKey(core.KeyCachePrefix + peerId))
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		keyBytes = keyval.([]byte)
 	} else {
 		keyBytes, err = pubkey.Bytes()
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
@@ -3562,18 +4225,18 @@ func (i *jsonAPIHandler) GETIPNS(w http.ResponseWriter, r *http.Request) {
 	entry := new(ipnspb.IpnsEntry)
 	err = proto.Unmarshal(val.([]byte), entry)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	b, err := proto.Marshal(entry)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	ret := KeyAndRecord{hex.EncodeToString(keyBytes), hex.EncodeToString(b)}
 	retBytes, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	go ipfs.Resolve(i.node.IpfsNode, pid, time.Minute, false)
@@ -3585,13 +4248,13 @@ func (i *jsonAPIHandler) POSTTestEmailNotifications(w http.ResponseWriter, r *ht
 	var settings repo.SMTPSettings
 	err := decoder.Decode(&settings)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	notifier := smtpNotifier{&settings}
 	err = notifier.notify(repo.TestNotification{})
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -3602,18 +4265,18 @@ func (i *jsonAPIHandler) GETPeerInfo(w http.ResponseWriter, r *http.Request) {
 	_, idb58 := path.Split(r.URL.Path)
 	pid, err := peer.IDB58Decode(idb58)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	ctx, _ := context.WithTimeout(context.Background(), time.Second*30)
 	pi, err := i.node.IpfsNode.Routing.FindPeer(ctx, pid)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		RenderError(w, http.StatusNotFound, err)
 		return
 	}
 	out, err := pi.MarshalJSON()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, string(out))
@@ -3626,7 +4289,7 @@ func (i *jsonAPIHandler) POSTPost(w http.ResponseWriter, r *http.Request) {
 	ld := new(pb.Post)
 	err := jsonpb.Unmarshal(r.Body, ld)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -3643,27 +4306,27 @@ func (i *jsonAPIHandler) POSTPost(w http.ResponseWriter, r *http.Request) {
 		// Generate a slug from the title
 		ld.Slug, err = i.node.GeneratePostSlug(ld.Title)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 	}
 	// Add the timestamp
 	ld.Timestamp, err = ptypes.TimestampProto(time.Now())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	// Sign the post
 	signedPost, err := i.node.SignPost(ld)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	// Add to path
 	postPath = path.Join(i.node.RepoPath, "root", "posts", signedPost.Post.Slug+".json")
 	f, err := os.Create(postPath)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	m := jsonpb.Marshaler{
@@ -3674,27 +4337,27 @@ func (i *jsonAPIHandler) POSTPost(w http.ResponseWriter, r *http.Request) {
 	}
 	out, err := m.MarshalToString(signedPost)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	if _, err := f.WriteString(out); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	err = i.node.UpdatePostIndex(signedPost)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	// Update followers/following
 	err = i.node.UpdateFollow()
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, fmt.Sprintf(`{"slug": "%s"}`, signedPost.Post.Slug))
@@ -3705,7 +4368,7 @@ func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
 	ld := new(pb.Post)
 	err := jsonpb.Unmarshal(r.Body, ld)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderError(w, http.StatusBadRequest, err)
 		return
 	}
 	// Check if the post exists
@@ -3718,18 +4381,18 @@ func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
 	// Add the timestamp
 	ld.Timestamp, err = ptypes.TimestampProto(time.Now())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	// Sign the post
 	signedPost, err := i.node.SignPost(ld)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	f, err := os.Create(postPath)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	m := jsonpb.Marshaler{
@@ -3740,17 +4403,17 @@ func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
 	}
 	out, err := m.MarshalToString(signedPost)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
 	if _, err := f.WriteString(out); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	err = i.node.UpdatePostIndex(signedPost)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -3761,7 +4424,7 @@ func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -3778,7 +4441,7 @@ func (i *jsonAPIHandler) DELETEPost(w http.ResponseWriter, r *http.Request) {
 	}
 	err := i.node.DeletePost(slug)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	err = i.node.UpdateFollow()
@@ -3787,7 +4450,7 @@ func (i *jsonAPIHandler) DELETEPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		RenderError(w, http.StatusInternalServerError, err)
 		return
 	}
 	SanitizedResponse(w, `{}`)
@@ -3800,20 +4463,20 @@ func (i *jsonAPIHandler) GETPosts(w http.ResponseWriter, r *http.Request) {
 	if peerId == "" || strings.ToLower(peerId) == "posts" || peerId == i.node.IPFSIdentityString() {
 		postsBytes, err := i.node.GetPosts()
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		SanitizedResponse(w, string(postsBytes))
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		peerId = pid.Pretty()
 		postsBytes, err := i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "posts.json")), time.Minute, useCache)
 		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
+			RenderError(w, http.StatusNotFound, err)
 			return
 		}
 		SanitizedResponse(w, string(postsBytes))
@@ -3850,7 +4513,7 @@ func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
 			}
 			hash, err := ipfs.GetHashOfFile(i.node.IpfsNode, path.Join(i.node.RepoPath, "root", "posts", postId+".json"))
 			if err != nil {
-				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				RenderError(w, http.StatusInternalServerError, err)
 				return
 			}
 			sl.Hash = hash
@@ -3858,7 +4521,7 @@ func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
 
 		out, err := m.MarshalToString(sl)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponseM(w, string(out), new(pb.SignedPost))
@@ -3870,7 +4533,7 @@ func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
 		if err == nil {
 			postBytes, err = ipfs.Cat(i.node.IpfsNode, postId, time.Minute)
 			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
+				RenderError(w, http.StatusNotFound, err)
 				return
 			}
 			hash = postId
@@ -3878,18 +4541,18 @@ func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
 		} else {
 			pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
+				RenderError(w, http.StatusNotFound, err)
 				return
 			}
 			peerId = pid.Pretty()
 			postBytes, err = i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "posts", postId+".json")), time.Minute, useCache)
 			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
+				RenderError(w, http.StatusNotFound, err)
 				return
 			}
 			hash, err = ipfs.GetHash(i.node.IpfsNode, bytes.NewReader(postBytes))
 			if err != nil {
-				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				RenderError(w, http.StatusInternalServerError, err)
 				return
 			}
 			w.Header().Set("Cache-Control", "public, max-age=600, immutable")
@@ -3897,13 +4560,13 @@ func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
 		sl := new(pb.SignedPost)
 		err = jsonpb.UnmarshalString(string(postBytes), sl)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		sl.Hash = hash
 		out, err := m.MarshalToString(sl)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RenderError(w, http.StatusInternalServerError, err)
 			return
 		}
 		SanitizedResponseM(w, out, new(pb.SignedPost))
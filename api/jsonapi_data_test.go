@@ -2,26 +2,30 @@ package api
 
 import (
 	"fmt"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
 )
 
-const notFoundJSON = `{"success": false,"reason": "Not Found"}`
+const notFoundJSON = `{"success": false,"reason": "Not Found","code": "ERR_NOT_FOUND"}`
 
-const jsonUnexpectedEOF = `{"success": false,"reason": "unexpected EOF"}`
+const jsonUnexpectedEOF = `{"success": false,"reason": "unexpected EOF","code": "ERR_INVALID_REQUEST"}`
 
 // AlreadyExistsUsePUTJSON generates an error message expected when
 // attempted to recreate a resource
-func AlreadyExistsUsePUTJSON(resource string) string {
+func AlreadyExistsUsePUTJSON(resource string, code core.ErrorCode) string {
 	return fmt.Sprintf(`{
         "success": false,
-        "reason": "%s already exists. Use PUT."
-    }`, resource)
+        "reason": "%s already exists. Use PUT.",
+        "code": "%s"
+    }`, resource, code)
 }
 
-func NotFoundJSON(resource string) string {
+func NotFoundJSON(resource string, code core.ErrorCode) string {
 	return fmt.Sprintf(`{
         "success": false,
-        "reason": "%s not found."
-    }`, resource)
+        "reason": "%s not found.",
+        "code": "%s"
+    }`, resource, code)
 }
 
 //
@@ -142,12 +146,14 @@ const settingsMalformedJSON = `{
 
 const settingsMalformedJSONResponse = `{
     "success": false,
-    "reason": "invalid character '/' looking for beginning of object key string"
+    "reason": "invalid character '/' looking for beginning of object key string",
+    "code": "ERR_INVALID_REQUEST"
 }`
 
 const settingsAlreadyExistsJSON = `{
     "success": false,
-    "reason": "Settings is already set. Use PUT."
+    "reason": "Settings is already set. Use PUT.",
+    "code": "ERR_CONFLICT"
 }`
 
 //
@@ -228,14 +234,16 @@ const avatarUnexpectedEOFJSON = `{
 
 const avatarUnexpectedEOFJSONResponse = `{
     "success": false,
-    "reason": "unexpected EOF"
+    "reason": "unexpected EOF",
+    "code": "ERR_INTERNAL"
 }`
 
 var avatarInvalidTQJSON = avatarValidJSON[:100] + `0` + avatarValidJSON[100:]
 
 const avatarInvalidTQJSONResponse = `{
     "success": false,
-    "reason": "invalid JPEG format: bad Tq value"
+    "reason": "invalid JPEG format: bad Tq value",
+    "code": "ERR_INTERNAL"
 }`
 
 // nolint lll
@@ -318,7 +326,8 @@ const spendJSON = `{
 
 const insuffientFundsJSON = `{
 	"success": false,
-	"reason": "ERROR_INSUFFICIENT_FUNDS"
+	"reason": "ERROR_INSUFFICIENT_FUNDS",
+	"code": "ERR_INSUFFICIENT_FUNDS"
 }`
 
 //
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/test"
 	"github.com/golang/protobuf/proto"
 
@@ -183,8 +184,16 @@ func buildRequest(method string, path string, body string) (*http.Request, error
 	return req, nil
 }
 
+// errorResponseJSON returns the body of the response of a failed request
+// with the status and code of the error in the catalog
 func errorResponseJSON(err error) string {
-	return `{"success": false, "reason": "` + err.Error() + `"}`
+	status := errorStatus(err, http.StatusInternalServerError)
+	ret, _ := json.Marshal(errorResponse{
+		Reason: err.Error(),
+		Code:   errorCode(err, status),
+		Errors: core.FieldErrors(err),
+	})
+	return string(ret)
 }
 
 func httpGet(endpoint string) ([]byte, error) {
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
@@ -68,7 +70,7 @@ func TestProfile(t *testing.T) {
 	// Create, Update
 	runAPITests(t, apiTests{
 		{"POST", "/ob/profile", profileJSON, 200, anyResponseJSON},
-		{"POST", "/ob/profile", profileJSON, 409, AlreadyExistsUsePUTJSON("Profile")},
+		{"POST", "/ob/profile", profileJSON, 409, AlreadyExistsUsePUTJSON("Profile", core.ErrCodeConflict)},
 		{"PUT", "/ob/profile", profileUpdateJSON, 200, anyResponseJSON},
 		{"PUT", "/ob/profile", profileUpdatedJSON, 200, anyResponseJSON},
 	})
@@ -252,10 +254,10 @@ func TestListings(t *testing.T) {
 		// change each test run due to signatures
 
 		// Create/Get
-		{"GET", "/ob/listing/ron-swanson-tshirt", "", 404, NotFoundJSON("Listing")},
+		{"GET", "/ob/listing/ron-swanson-tshirt", "", 404, NotFoundJSON("Listing", core.ErrCodeListingNotFound)},
 		{"POST", "/ob/listing", goodListingJSON, 200, `{"slug": "ron-swanson-tshirt"}`},
 		{"GET", "/ob/listing/ron-swanson-tshirt", "", 200, anyResponseJSON},
-		{"POST", "/ob/listing", updatedListingJSON, 409, AlreadyExistsUsePUTJSON("Listing")},
+		{"POST", "/ob/listing", updatedListingJSON, 409, AlreadyExistsUsePUTJSON("Listing", core.ErrCodeListingAlreadyExists)},
 
 		// TODO: Add support for improved JSON matching to since contracts
 		// change each test run due to signatures
@@ -274,12 +276,12 @@ func TestListings(t *testing.T) {
 
 		// Delete/Get
 		{"DELETE", "/ob/listing/ron-swanson-tshirt", "", 200, `{}`},
-		{"DELETE", "/ob/listing/ron-swanson-tshirt", "", 404, NotFoundJSON("Listing")},
-		{"GET", "/ob/listing/ron-swanson-tshirt", "", 404, NotFoundJSON("Listing")},
+		{"DELETE", "/ob/listing/ron-swanson-tshirt", "", 404, NotFoundJSON("Listing", core.ErrCodeListingNotFound)},
+		{"GET", "/ob/listing/ron-swanson-tshirt", "", 404, NotFoundJSON("Listing", core.ErrCodeListingNotFound)},
 
 		// Mutate non-existing listings
-		{"PUT", "/ob/listing", updatedListingJSON, 404, NotFoundJSON("Listing")},
-		{"DELETE", "/ob/listing/ron-swanson-tshirt", "", 404, NotFoundJSON("Listing")},
+		{"PUT", "/ob/listing", updatedListingJSON, 404, NotFoundJSON("Listing", core.ErrCodeListingNotFound)},
+		{"DELETE", "/ob/listing/ron-swanson-tshirt", "", 404, NotFoundJSON("Listing", core.ErrCodeListingNotFound)},
 	})
 }
 
@@ -296,8 +298,8 @@ func TestCryptoListings(t *testing.T) {
 		{"GET", "/ob/listing/crypto", jsonFor(t, &updatedListing), 200, anyResponseJSON},
 
 		{"DELETE", "/ob/listing/crypto", "", 200, `{}`},
-		{"DELETE", "/ob/listing/crypto", "", 404, NotFoundJSON("Listing")},
-		{"GET", "/ob/listing/crypto", "", 404, NotFoundJSON("Listing")},
+		{"DELETE", "/ob/listing/crypto", "", 404, NotFoundJSON("Listing", core.ErrCodeListingNotFound)},
+		{"GET", "/ob/listing/crypto", "", 404, NotFoundJSON("Listing", core.ErrCodeListingNotFound)},
 	})
 }
 
@@ -321,7 +323,7 @@ func TestCryptoListingsPriceModifier(t *testing.T) {
 
 	listing.Metadata.PriceModifier = core.PriceModifierMax + 0.01
 	runAPITest(t, apiTest{
-		"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(outOfRangeErr),
+		"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(outOfRangeErr),
 	})
 
 	listing.Metadata.PriceModifier = core.PriceModifierMin - 0.001
@@ -331,7 +333,300 @@ func TestCryptoListingsPriceModifier(t *testing.T) {
 
 	listing.Metadata.PriceModifier = core.PriceModifierMin - 1
 	runAPITest(t, apiTest{
-		"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(outOfRangeErr),
+		"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(outOfRangeErr),
+	})
+}
+
+func TestListingsPriceTiers(t *testing.T) {
+	listing := factory.NewListing("wholesale")
+	listing.Item.PriceTiers = []*pb.Listing_Item_PriceTier{
+		{MinQuantity: 100, Price: 10},
+		{MinQuantity: 10, Price: 11},
+	}
+	unorderedJSON := jsonFor(t, listing)
+	listing.Item.PriceTiers = []*pb.Listing_Item_PriceTier{
+		{MinQuantity: 10, Price: 11},
+		{MinQuantity: 100, Price: 10},
+	}
+	goodJSON := jsonFor(t, listing)
+
+	runAPITests(t, apiTests{
+		{"POST", "/ob/listing", unorderedJSON, 400, errorResponseJSON(core.FieldError{
+			Field:  "item.priceTiers[1].minQuantity",
+			Code:   core.ErrCodeFieldOutOfRange,
+			Reason: "Price tier minimum quantities must be greater than one and in ascending order",
+		})},
+		{"POST", "/ob/listing", goodJSON, 200, `{"slug": "wholesale"}`},
+	})
+}
+
+func TestListingsTranslations(t *testing.T) {
+	listing := factory.NewListing("translated")
+	listing.Translations = []*pb.Listing_Translation{
+		{Language: "de", OptionNames: []*pb.Listing_Translation_OptionName{{Option: "Weight", Name: "Gewicht"}}},
+	}
+	unknownOptionJSON := jsonFor(t, listing)
+	listing.Translations = []*pb.Listing_Translation{
+		{Language: "de", Title: "Ron Swanson T-Shirt"},
+		{Language: "DE", Title: "Ron Swanson T-Shirt"},
+	}
+	duplicateJSON := jsonFor(t, listing)
+	listing.Translations = []*pb.Listing_Translation{
+		{Language: "de", Title: "Ron Swanson T-Shirt", OptionNames: []*pb.Listing_Translation_OptionName{{Option: "Size", Name: "Größe"}}},
+	}
+	goodJSON := jsonFor(t, listing)
+
+	runAPITests(t, apiTests{
+		{"POST", "/ob/listing", unknownOptionJSON, 400, errorResponseJSON(core.FieldError{
+			Field:  "translations[0].optionNames[0].option",
+			Code:   core.ErrCodeFieldInvalid,
+			Reason: "Translated option Weight does not exist in the listing",
+		})},
+		{"POST", "/ob/listing", duplicateJSON, 400, errorResponseJSON(core.FieldError{
+			Field:  "translations[1].language",
+			Code:   core.ErrCodeFieldNotUnique,
+			Reason: "Translation languages must be unique and differ from the listing language",
+		})},
+		{"POST", "/ob/listing", goodJSON, 200, `{"slug": "translated"}`},
+	})
+}
+
+func TestRequestLanguages(t *testing.T) {
+	r := httptest.NewRequest("GET", "/ob/listing/translated?lang=pt", nil)
+	r.Header.Set("Accept-Language", "fr;q=0.5, de-CH, en;q=0.8, *;q=0.1, it;q=0")
+	languages := requestLanguages(r)
+	expected := []string{"pt", "de-CH", "en", "fr"}
+	if !reflect.DeepEqual(languages, expected) {
+		t.Errorf("expected %v, got %v", expected, languages)
+	}
+}
+
+func TestLocalizeListing(t *testing.T) {
+	listing := factory.NewListing("translated")
+	listing.Metadata.Language = "en"
+	listing.Translations = []*pb.Listing_Translation{
+		{Language: "de", Title: "Ron Swanson T-Shirt", OptionNames: []*pb.Listing_Translation_OptionName{{Option: "Size", Name: "Größe"}}},
+	}
+	sl := &pb.SignedListing{Listing: listing, Signature: []byte("signature")}
+	title, option := listing.Item.Title, listing.Item.Options[0].Name
+
+	r := httptest.NewRequest("GET", "/ob/listing/translated", nil)
+	r.Header.Set("Accept-Language", "de-CH")
+	w := httptest.NewRecorder()
+	localizeListing(w, r, sl)
+	if sl.Translation != listing.Translations[0] || w.Header().Get("Content-Language") != "de" {
+		t.Errorf("expected the de translation, got %v with Content-Language %q", sl.Translation, w.Header().Get("Content-Language"))
+	}
+	if listing.Item.Title != title || listing.Item.Options[0].Name != option || listing.Metadata.Language != "en" {
+		t.Error("expected the signed listing to be left untouched")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/search?q=shirt&tags=clothing&ships_to=UNITED_STATES&price_max=1000&currency=USD", "", 200, `[]`},
+		{"GET", "/ob/search?price_max=1000", "", 400, `{"success": false, "reason": "price_max requires a currency", "code": "ERR_INVALID_REQUEST"}`},
+		{"GET", "/ob/search?price_max=cheap", "", 400, `{"success": false, "reason": "price_max must be an integer", "code": "ERR_INVALID_REQUEST"}`},
+		{"GET", "/ob/search?limit=ten", "", 400, `{"success": false, "reason": "limit must be an integer", "code": "ERR_INVALID_REQUEST"}`},
+	})
+}
+
+func TestPeerAbuse(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/peerabuse", "", 200, `[]`},
+	})
+}
+
+func TestDuplicateMessages(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/duplicatemessages", "", 200, `{"total": 0, "byMessageType": {}}`},
+	})
+}
+
+func TestPushNodes(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/pushnodes", "", 200, `[]`},
+	})
+}
+
+func TestBans(t *testing.T) {
+	const peerID = "QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ"
+	chatBan := fmt.Sprintf(`{"peerID": "%s", "scope": "chat", "reason": "spam"}`, peerID)
+	expires := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	runAPITests(t, apiTests{
+		{"GET", "/ob/blocks", "", 200, `[]`},
+		{"POST", "/ob/blocks", chatBan, 200, anyResponseJSON},
+		{"POST", "/ob/blocks", chatBan, 409, errorResponseJSON(core.ErrBanAlreadyExists)},
+		{"POST", "/ob/blocks", fmt.Sprintf(`{"peerID": "%s", "scope": "everything"}`, peerID), 400, errorResponseJSON(core.ErrBanInvalidScope)},
+		{"POST", "/ob/blocks", fmt.Sprintf(`{"peerID": "%s", "expires": "2000-01-01T00:00:00Z"}`, peerID), 400, errorResponseJSON(core.ErrBanExpiryInPast)},
+		{"PUT", "/ob/blocks", fmt.Sprintf(`{"peerID": "%s", "scope": "chat", "reason": "abuse", "expires": "%s"}`, peerID, expires), 200, anyResponseJSON},
+		{"PUT", "/ob/blocks", fmt.Sprintf(`{"peerID": "%s", "scope": "order"}`, peerID), 404, errorResponseJSON(core.ErrBanDoesNotExist)},
+		{"GET", "/ob/blocks/" + peerID, "", 200, anyResponseJSON},
+		{"DELETE", "/ob/blocks/" + peerID + "?scope=order", "", 404, errorResponseJSON(core.ErrBanDoesNotExist)},
+		{"DELETE", "/ob/blocks/" + peerID + "?scope=chat", "", 200, `{}`},
+		{"GET", "/ob/blocks/" + peerID, "", 404, NotFoundJSON("Ban", core.ErrCodeNotFound)},
+	})
+}
+
+func TestAPITokens(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/apitokens", "", 200, `[]`},
+		{"POST", "/ob/apitokens", `{"name": "fulfillment", "scopes": ["read-only", "orders"]}`, 200, anyResponseJSON},
+		{"POST", "/ob/apitokens", `{"name": "fulfillment", "scopes": ["read-only"]}`, 409, errorResponseJSON(repo.ErrAPITokenAlreadyExists)},
+		{"POST", "/ob/apitokens", `{"name": "bad name", "scopes": ["read-only"]}`, 400, errorResponseJSON(repo.ErrAPITokenInvalidName)},
+		{"POST", "/ob/apitokens", `{"name": "spender", "scopes": ["everything"]}`, 400, errorResponseJSON(repo.ErrAPITokenInvalidScope)},
+		{"POST", "/ob/apitokens", `{"name": "spender", "scopes": []}`, 400, errorResponseJSON(repo.ErrAPITokenNoScopes)},
+		{"POST", "/ob/apitokens", `{"name": "spender", "scopes": ["admin"], "expires": "2000-01-01T00:00:00Z"}`, 400, errorResponseJSON(repo.ErrAPITokenExpiryInPast)},
+		{"DELETE", "/ob/apitokens/fulfillment", "", 200, `{}`},
+		{"DELETE", "/ob/apitokens/fulfillment", "", 404, errorResponseJSON(repo.ErrAPITokenDoesNotExist)},
+		{"GET", "/ob/apitokens", "", 200, `[]`},
+	})
+}
+
+func TestAPITokenScopes(t *testing.T) {
+	if _, err := test.ResetRepository(); err != nil {
+		t.Fatal(err)
+	}
+	createToken := func(name string, scopes string) string {
+		req, err := buildRequest("POST", "/ob/apitokens", fmt.Sprintf(`{"name": "%s", "scopes": %s}`, name, scopes))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := testHTTPClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var created struct {
+			Token string `json:"token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil || created.Token == "" {
+			t.Fatalf("Expected a token to be created, got status %d (%v)", resp.StatusCode, err)
+		}
+		return created.Token
+	}
+	fulfillment := createToken("fulfillment-scopes", `["read-only", "orders"]`)
+	admin := createToken("admin-scopes", `["admin"]`)
+	defer func() {
+		for _, name := range []string{"fulfillment-scopes", "admin-scopes"} {
+			executeAPITest(t, apiTest{"DELETE", "/ob/apitokens/" + name, "", 200, `{}`})
+		}
+	}()
+
+	for _, c := range []struct {
+		token     string
+		method    string
+		path      string
+		forbidden bool
+	}{
+		{fulfillment, "GET", "/ob/sales", false},
+		{fulfillment, "POST", "/ob/orderfulfillment", false},
+		{fulfillment, "GET", "/wallet/mnemonic", true},
+		{fulfillment, "GET", "/wallet/balance", false},
+		{fulfillment, "GET", "/ob/settings", true},
+		{fulfillment, "GET", "/ob/config", true},
+		{fulfillment, "GET", "/ob/listings", false},
+		{fulfillment, "POST", "/wallet/spend", true},
+		{fulfillment, "POST", "/ob/refund", true},
+		{fulfillment, "POST", "/ob/releaseescrow", true},
+		{fulfillment, "POST", "/ob/listing", true},
+		{fulfillment, "GET", "/ob/apitokens", true},
+		{fulfillment, "POST", "/ob/apitokens", true},
+		{admin, "GET", "/ob/apitokens", false},
+		{"unknown", "GET", "/ob/sales", true},
+	} {
+		req, err := http.NewRequest(c.method, testURIRoot+c.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+c.token)
+		resp, err := testHTTPClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if forbidden := resp.StatusCode == http.StatusForbidden; forbidden != c.forbidden {
+			t.Errorf("%s %s: expected forbidden to be %t, got status %d", c.method, c.path, c.forbidden, resp.StatusCode)
+		}
+	}
+}
+
+func TestAuthLockout(t *testing.T) {
+	if _, err := test.ResetRepository(); err != nil {
+		t.Fatal(err)
+	}
+	const ip = "203.0.113.7"
+	request := func(password string) int {
+		req, err := http.NewRequest("GET", testURIRoot+"/ob/config", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth("test", password)
+		req.Header.Set("X-Forwarded-For", ip)
+		resp, err := testHTTPClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if status := request("test"); status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	for i := 0; i < maxAuthFailures; i++ {
+		if status := request("guess"); status != http.StatusForbidden {
+			t.Errorf("Expected status 403, got %d", status)
+		}
+	}
+	if status := request("test"); status != http.StatusTooManyRequests {
+		t.Errorf("Expected locked out IP to get status 429, got %d", status)
+	}
+
+	// The auth log outlives the test repository so only read this run
+	b, err := httpGet(fmt.Sprintf("/ob/authlog?ip=%s&limit=%d", ip, maxAuthFailures+2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []repo.AuthEvent
+	if err := json.Unmarshal(b, &events); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != maxAuthFailures+2 || !events[len(events)-1].Success || events[0].Reason != "locked out for 1m0s" {
+		t.Errorf("Unexpected auth log %s", string(b))
+	}
+}
+
+func TestScheduledListings(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	scheduledListingJSON := fmt.Sprintf(`{"publishAt": "%s", "listing": %s}`, publishAt, jsonFor(t, factory.NewListing("flash-sale")))
+	pastListingJSON := fmt.Sprintf(`{"publishAt": "%s", "listing": %s}`, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), jsonFor(t, factory.NewListing("too-late")))
+	invalid := factory.NewListing("invalid")
+	invalid.Item.PriceTiers = []*pb.Listing_Item_PriceTier{{MinQuantity: 1, Price: 10}}
+	invalidListingJSON := fmt.Sprintf(`{"publishAt": "%s", "listing": %s}`, publishAt, jsonFor(t, invalid))
+
+	runAPITests(t, apiTests{
+		{"GET", "/ob/scheduledlistings", "", 200, `[]`},
+		{"POST", "/ob/scheduledlisting", pastListingJSON, 400, errorResponseJSON(core.ErrListingPublishTimeInPast)},
+		{"POST", "/ob/scheduledlisting", invalidListingJSON, 400, errorResponseJSON(core.FieldError{
+			Field:  "item.priceTiers[0].minQuantity",
+			Code:   core.ErrCodeFieldOutOfRange,
+			Reason: "Price tier minimum quantities must be greater than one and in ascending order",
+		})},
+		{"POST", "/ob/scheduledlisting", scheduledListingJSON, 200, `{"slug": "flash-sale"}`},
+		{"POST", "/ob/scheduledlisting", scheduledListingJSON, 409, errorResponseJSON(core.ErrListingAlreadyScheduled)},
+		{"GET", "/ob/scheduledlistings", "", 200, fmt.Sprintf(`[{"slug": "flash-sale", "title": "Ron Swanson Tshirt", "publishAt": "%s", "expiry": "2038-01-19T03:14:07Z"}]`, publishAt)},
+		{"GET", "/ob/listing/flash-sale", "", 404, NotFoundJSON("Listing", core.ErrCodeListingNotFound)},
+		{"DELETE", "/ob/scheduledlisting/flash-sale", "", 200, `{}`},
+		{"DELETE", "/ob/scheduledlisting/flash-sale", "", 404, NotFoundJSON("Scheduled listing", core.ErrCodeNotFound)},
+		{"GET", "/ob/scheduledlistings", "", 200, `[]`},
+	})
+}
+
+func TestArchivedListings(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/archivedlistings", "", 200, `[]`},
+		{"POST", "/ob/restorelisting", `{"slug": "seasonal"}`, 404, errorResponseJSON(core.ErrArchivedListingDoesNotExist)},
+		{"DELETE", "/ob/archivedlisting/seasonal", "", 404, NotFoundJSON("Archived listing", core.ErrCodeNotFound)},
 	})
 }
 
@@ -360,12 +655,12 @@ func TestCryptoListingsQuantity(t *testing.T) {
 
 	listing.Item.Skus[0].Quantity = 0
 	runAPITest(t, apiTest{
-		"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(core.ErrCryptocurrencySkuQuantityInvalid),
+		"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(core.ErrCryptocurrencySkuQuantityInvalid),
 	})
 
 	listing.Item.Skus[0].Quantity = -1
 	runAPITest(t, apiTest{
-		"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(core.ErrCryptocurrencySkuQuantityInvalid),
+		"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(core.ErrCryptocurrencySkuQuantityInvalid),
 	})
 }
 
@@ -374,7 +669,7 @@ func TestCryptoListingsNoCoinType(t *testing.T) {
 	listing.Metadata.CoinType = ""
 
 	runAPITests(t, apiTests{
-		{"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(core.ErrCryptocurrencyListingCoinTypeRequired)},
+		{"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(core.ErrCryptocurrencyListingCoinTypeRequired)},
 	})
 }
 
@@ -386,19 +681,19 @@ func TestCryptoListingsCoinDivisibilityIncorrect(t *testing.T) {
 
 	listing.Metadata.CoinDivisibility = 1e7
 	runAPITests(t, apiTests{
-		{"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(core.ErrListingCoinDivisibilityIncorrect)},
+		{"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(core.ErrListingCoinDivisibilityIncorrect)},
 	})
 
 	listing.Metadata.CoinDivisibility = 0
 	runAPITests(t, apiTests{
-		{"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(core.ErrListingCoinDivisibilityIncorrect)},
+		{"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(core.ErrListingCoinDivisibilityIncorrect)},
 	})
 }
 
 func TestCryptoListingsIllegalFields(t *testing.T) {
 	runTest := func(listing *pb.Listing, err error) {
 		runAPITests(t, apiTests{
-			{"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(err)},
+			{"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(err)},
 		})
 	}
 
@@ -431,7 +726,7 @@ func TestMarketRatePrice(t *testing.T) {
 	listing.Item.Price = 1
 
 	runAPITests(t, apiTests{
-		{"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(core.ErrMarketPriceListingIllegalField("item.price"))},
+		{"POST", "/ob/listing", jsonFor(t, listing), 400, errorResponseJSON(core.ErrMarketPriceListingIllegalField("item.price"))},
 	})
 }
 
@@ -480,10 +775,10 @@ func TestPosts(t *testing.T) {
 		{"GET", "/ob/posts", "", 200, `[]`},
 
 		// Create/Get
-		{"GET", "/ob/post/test1", "", 404, NotFoundJSON("Post")},
+		{"GET", "/ob/post/test1", "", 404, NotFoundJSON("Post", core.ErrCodeNotFound)},
 		{"POST", "/ob/post", postJSON, 200, postJSONResponse},
 		{"GET", "/ob/post/test1", "", 200, anyResponseJSON},
-		{"POST", "/ob/post", postUpdateJSON, 409, AlreadyExistsUsePUTJSON("Post")},
+		{"POST", "/ob/post", postUpdateJSON, 409, AlreadyExistsUsePUTJSON("Post", core.ErrCodeConflict)},
 
 		{"GET", "/ob/posts", "", 200, anyResponseJSON},
 
@@ -493,12 +788,12 @@ func TestPosts(t *testing.T) {
 
 		// Delete/Get
 		{"DELETE", "/ob/post/test1", "", 200, `{}`},
-		{"DELETE", "/ob/post/test1", "", 404, NotFoundJSON("Post")},
-		{"GET", "/ob/post/test1", "", 404, NotFoundJSON("Post")},
+		{"DELETE", "/ob/post/test1", "", 404, NotFoundJSON("Post", core.ErrCodeNotFound)},
+		{"GET", "/ob/post/test1", "", 404, NotFoundJSON("Post", core.ErrCodeNotFound)},
 
 		// Mutate non-existing listings
-		{"PUT", "/ob/post", postUpdateJSON, 404, NotFoundJSON("Post")},
-		{"DELETE", "/ob/post/test1", "", 404, NotFoundJSON("Post")},
+		{"PUT", "/ob/post", postUpdateJSON, 404, NotFoundJSON("Post", core.ErrCodeNotFound)},
+		{"DELETE", "/ob/post/test1", "", 404, NotFoundJSON("Post", core.ErrCodeNotFound)},
 	})
 }
 
@@ -683,6 +978,7 @@ func TestCasesGet(t *testing.T) {
 
 func TestNotificationsAreReturnedInExpectedOrder(t *testing.T) {
 	const sameTimestampsAreReturnedInReverse = `{
+    "nextCursor": "",
     "notifications": [
         {
             "notification": {
@@ -720,6 +1016,7 @@ func TestNotificationsAreReturnedInExpectedOrder(t *testing.T) {
 }`
 
 	const sameTimestampsAreReturnedInReverseAndRespectOffsetID = `{
+    "nextCursor": "",
     "notifications": [
         {
             "notification": {
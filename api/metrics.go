@@ -0,0 +1,104 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"gx/ipfs/QmSERhEpow33rKAUMJq8yfJVQjLmdABGg899cXg7GcX1Bk/common/expfmt"
+	prometheus "gx/ipfs/QmX3QZ5jHEPidwUrymXV1iSCSUhdGxj15sm2gP4jKMef7B/client_golang/prometheus"
+)
+
+var (
+	peersDesc = prometheus.NewDesc(
+		"openbazaar_peers",
+		"Number of connected peers", nil, nil)
+	walletBalanceDesc = prometheus.NewDesc(
+		"openbazaar_wallet_balance",
+		"Wallet balance in the smallest unit of the coin", []string{"status"}, nil)
+	walletHeightDesc = prometheus.NewDesc(
+		"openbazaar_wallet_chain_height",
+		"Height of the chain tip known to the wallet", nil, nil)
+	ordersDesc = prometheus.NewDesc(
+		"openbazaar_orders",
+		"Number of orders by type and state", []string{"type", "state"}, nil)
+)
+
+// nodeCollector exposes the state of the node as read when the metrics are
+// scraped
+type nodeCollector struct {
+	node *core.OpenBazaarNode
+}
+
+func (c nodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- peersDesc
+	ch <- walletBalanceDesc
+	ch <- walletHeightDesc
+	ch <- ordersDesc
+}
+
+func (c nodeCollector) Collect(ch chan<- prometheus.Metric) {
+	if c.node.IpfsNode != nil && c.node.IpfsNode.PeerHost != nil {
+		peers := ipfs.ConnectedPeers(c.node.IpfsNode)
+		ch <- prometheus.MustNewConstMetric(peersDesc, prometheus.GaugeValue, float64(len(peers)))
+	}
+
+	if c.node.Wallet != nil {
+		confirmed, unconfirmed := c.node.Wallet.Balance()
+		ch <- prometheus.MustNewConstMetric(walletBalanceDesc, prometheus.GaugeValue, float64(confirmed), "confirmed")
+		ch <- prometheus.MustNewConstMetric(walletBalanceDesc, prometheus.GaugeValue, float64(unconfirmed), "unconfirmed")
+		height, _ := c.node.Wallet.ChainTip()
+		ch <- prometheus.MustNewConstMetric(walletHeightDesc, prometheus.GaugeValue, float64(height))
+	}
+
+	stores := []struct {
+		orderType string
+		store     interface {
+			CountByState() (map[pb.OrderState]int, error)
+		}
+	}{
+		{"sale", c.node.Datastore.Sales()},
+		{"purchase", c.node.Datastore.Purchases()},
+		{"case", c.node.Datastore.Cases()},
+	}
+	for _, s := range stores {
+		counts, err := s.store.CountByState()
+		if err != nil {
+			log.Errorf("Error counting %s orders for metrics: %s", s.orderType, err)
+			continue
+		}
+		for state, count := range counts {
+			ch <- prometheus.MustNewConstMetric(ordersDesc, prometheus.GaugeValue, float64(count), s.orderType, state.String())
+		}
+	}
+}
+
+// newMetricsGatherer returns the gatherer of the metrics of the node
+// together with the default registry, which holds the metrics of the
+// metrics package and the IPFS node
+func newMetricsGatherer(node *core.OpenBazaarNode) prometheus.Gatherer {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(nodeCollector{node})
+	return prometheus.Gatherers{prometheus.DefaultGatherer, registry}
+}
+
+func (i *jsonAPIHandler) GETMetrics(w http.ResponseWriter, r *http.Request) {
+	mfs, err := i.metrics.Gather()
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	contentType := expfmt.Negotiate(r.Header)
+	var buf bytes.Buffer
+	enc := expfmt.NewEncoder(&buf, contentType)
+	for _, mf := range mfs {
+		if err := enc.Encode(mf); err != nil {
+			RenderError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", string(contentType))
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/metrics"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/test"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+)
+
+func TestMetrics(t *testing.T) {
+	resp, err := http.Get(testURIRoot + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected the metrics to require authentication, got status %d", resp.StatusCode)
+	}
+
+	repository, err := test.ResetRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sale := factory.NewSaleRecord()
+	if err := repository.DB.Sales().Put(sale.OrderID, *sale.Contract, pb.OrderState_FULFILLED, false); err != nil {
+		t.Fatal(err)
+	}
+	defer repository.DB.Sales().Delete(sale.OrderID)
+	metrics.MessagesReceived.WithLabelValues(pb.Message_CHAT.String(), metrics.ResultHandled).Inc()
+
+	body, err := httpGet("/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"openbazaar_peers ",
+		`openbazaar_wallet_balance{status="confirmed"} `,
+		"openbazaar_wallet_chain_height ",
+		`openbazaar_orders{state="FULFILLED",type="sale"} `,
+		`openbazaar_service_messages_received_total{result="handled",type="CHAT"} `,
+		"go_goroutines ",
+	} {
+		if !strings.Contains(string(body), expected) {
+			t.Errorf("Expected the metrics to contain %q", expected)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/smtp"
 	"strings"
@@ -12,13 +13,13 @@ import (
 
 // Notification manager intercepts data form 'inChan' which is embedded
 // in different parts of the system and retransmits to the 'outChan',
-// which is listened by websocket API, while adding specific handling for
-// each received object.
+// which is listened by websocket API, and to the event stream, while adding
+// specific handling for each received object.
 type notificationManager struct {
 	node *core.OpenBazaarNode
 }
 
-func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan repo.Notifier {
+func manageNotifications(node *core.OpenBazaarNode, out chan wsMessage, events *eventStream) chan repo.Notifier {
 	manager := &notificationManager{node: node}
 	nodeBroadcast := make(chan repo.Notifier)
 	go func() {
@@ -38,12 +39,51 @@ func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan repo.N
 				log.Error("sanitize notification:", err)
 				continue
 			}
-			out <- sanitized
+			events.Publish(n.GetType(), sanitized)
+			out <- wsMessage{data: sanitized, topics: notificationTopics(n.GetType(), sanitized)}
 		}
 	}()
 	return nodeBroadcast
 }
 
+// notificationTopics returns the websocket topics of a notification: its
+// type, "order:<order ID>" and "chat:<peer ID>" if it refers to an order or a
+// chat, and "wallet" for wallet events. Premarshalled notifications have no
+// type so the key wrapping their data is used instead.
+func notificationTopics(notificationType repo.NotificationType, data []byte) []string {
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return []string{string(notificationType)}
+	}
+	var topics []string
+	if notificationType != repo.NotifierTypePremarshalledNotifier {
+		topics = append(topics, string(notificationType))
+	}
+	for key, raw := range wrapper {
+		switch key {
+		case "wallet", "walletUpdate":
+			topics = append(topics, "wallet")
+		case "message", "messageRead", "messageTyping":
+			var chat struct {
+				PeerID string `json:"peerId"`
+			}
+			if json.Unmarshal(raw, &chat) == nil && chat.PeerID != "" {
+				topics = append(topics, "chat:"+chat.PeerID)
+			}
+		}
+		if notificationType == repo.NotifierTypePremarshalledNotifier {
+			topics = append(topics, key)
+		}
+		var order struct {
+			OrderID string `json:"orderId"`
+		}
+		if json.Unmarshal(raw, &order) == nil && order.OrderID != "" {
+			topics = append(topics, "order:"+order.OrderID)
+		}
+	}
+	return topics
+}
+
 type notifier interface {
 	notify(n repo.Notifier) error
 }
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// defaultPageLimit is the number of items in a page when a client pages
+// through a list without a limit
+const defaultPageLimit = 50
+
+// pageRequest is the page of a list requested by a client. Clients page
+// through a list by passing the nextCursor of each response as the cursor of
+// the next request.
+type pageRequest struct {
+	// cursor is the decoded cursor of the page, or empty for the first page.
+	// Lists read from a store are paged by the cursors of the store, and
+	// lists held in memory by the position and key of the last item of the
+	// previous page.
+	cursor string
+
+	// offsetID is the key of the last item of the previous page passed by
+	// clients which predate cursors
+	offsetID string
+
+	// limit is the maximum number of items in the page, or -1 for all of them
+	limit int
+
+	// paged is set when the client passed a cursor, which asks for arrays to
+	// be returned together with the next cursor
+	paged bool
+}
+
+// parsePageRequest reads the cursor and limit query parameters. The legacy
+// offsetId parameter is accepted in place of the cursor for the endpoints
+// which supported it, without changing the shape of the response.
+func parsePageRequest(q url.Values) (pageRequest, error) {
+	p := pageRequest{limit: -1}
+	if cursor, ok := q["cursor"]; ok {
+		p.paged = true
+		p.limit = defaultPageLimit
+		decoded, err := decodeCursor(cursor[0])
+		if err != nil {
+			return p, err
+		}
+		p.cursor = decoded
+	} else {
+		p.offsetID = q.Get("offsetId")
+	}
+	if limit := q.Get("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil {
+			return p, &apiError{http.StatusBadRequest, "limit must be an integer"}
+		}
+		if l < 0 {
+			l = -1
+		}
+		p.limit = l
+	}
+	return p, nil
+}
+
+// encodeCursor returns the opaque cursor passed to clients for the cursor of
+// a store or a list, which is empty on the last page
+func encodeCursor(cursor string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+// decodeCursor returns the cursor of a store or a list passed by a client
+func decodeCursor(cursor string) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(decoded) == 0 {
+		return "", &apiError{http.StatusBadRequest, "invalid cursor"}
+	}
+	return string(decoded), nil
+}
+
+// renderPageError responds with the error of reading a page from a store
+func renderPageError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if err == repo.ErrInvalidCursor {
+		status = http.StatusBadRequest
+	}
+	RenderError(w, status, err)
+}
+
+// slice returns the bounds of the page within a list of n items held in
+// memory, and the cursor of the next page. The cursor holds the position
+// after the last item of the page together with its key, so the list is
+// resumed where that item was if it has been removed since.
+func (p pageRequest) slice(n int, key func(i int) string) (start, end int, next string, err error) {
+	switch {
+	case p.cursor != "":
+		parts := strings.SplitN(p.cursor, ":", 2)
+		pos, perr := strconv.Atoi(parts[0])
+		if len(parts) != 2 || perr != nil || pos < 1 {
+			return 0, 0, "", &apiError{http.StatusBadRequest, "invalid cursor"}
+		}
+		start = indexAfter(n, key, parts[1], pos)
+	case p.offsetID != "":
+		// Legacy offsets don't hold a position, so an unknown one
+		// returns an empty page
+		start = n
+		for i := 0; i < n; i++ {
+			if key(i) == p.offsetID {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end = n
+	if p.limit >= 0 && n-start > p.limit {
+		end = start + p.limit
+		if end > 0 {
+			next = encodeCursor(fmt.Sprintf("%d:%s", end, key(end-1)))
+		}
+	}
+	return start, end, next, nil
+}
+
+// indexAfter returns the index of the item following the one with the key,
+// which was at pos-1 when its cursor was returned
+func indexAfter(n int, key func(i int) string, k string, pos int) int {
+	if pos <= n && key(pos-1) == k {
+		return pos
+	}
+	for i := 0; i < n; i++ {
+		if key(i) == k {
+			return i + 1
+		}
+	}
+	// The item was removed and the ones after it moved up
+	if pos-1 < n {
+		return pos - 1
+	}
+	return n
+}
+
+// pageJSONList returns the page of a JSON array of objects, using the string
+// field of the objects named by key as the cursor. The items are returned as
+// they are, so fields unknown to this node are kept.
+func pageJSONList(p pageRequest, list []byte, key string) ([]json.RawMessage, string, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(list, &items); err != nil {
+		return nil, "", err
+	}
+	var keys []map[string]interface{}
+	if err := json.Unmarshal(list, &keys); err != nil {
+		return nil, "", err
+	}
+	start, end, next, err := p.slice(len(items), func(i int) string {
+		k, _ := keys[i][key].(string)
+		return k
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return items[start:end], next, nil
+}
+
+// listPage is a page of a list together with the cursor of the next page
+type listPage struct {
+	Items      interface{} `json:"items"`
+	NextCursor string      `json:"nextCursor"`
+}
+
+// writeList responds with the items of a list. When the client is paging
+// with a cursor the items are wrapped with the cursor of the next page,
+// otherwise the array is returned on its own as before.
+func writeList(w http.ResponseWriter, p pageRequest, items interface{}, next string) {
+	var resp interface{} = items
+	if p.paged {
+		resp = listPage{items, next}
+	}
+	ret, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+// writeJSONList responds with the page of a JSON array of objects paged by
+// the field named by key. The array is passed through unchanged when the
+// client asked for all of it.
+func writeJSONList(w http.ResponseWriter, p pageRequest, list []byte, key string) {
+	if !p.paged && p.limit < 0 && p.offsetID == "" {
+		SanitizedResponse(w, string(list))
+		return
+	}
+	items, next, err := pageJSONList(p, list, key)
+	if err != nil {
+		RenderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeList(w, p, items, next)
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/test"
+)
+
+func TestParsePageRequest(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected pageRequest
+		err      bool
+	}{
+		{"", pageRequest{limit: -1}, false},
+		{"offsetId=abc&limit=5", pageRequest{offsetID: "abc", limit: 5}, false},
+		{"cursor=", pageRequest{limit: defaultPageLimit, paged: true}, false},
+		{"cursor=" + encodeCursor("abc") + "&limit=10", pageRequest{cursor: "abc", limit: 10, paged: true}, false},
+		{"cursor=" + encodeCursor("abc") + "&offsetId=def", pageRequest{cursor: "abc", limit: defaultPageLimit, paged: true}, false},
+		{"cursor=&limit=-5", pageRequest{limit: -1, paged: true}, false},
+		{"cursor=!!", pageRequest{}, true},
+		{"limit=ten", pageRequest{}, true},
+	}
+	for _, tt := range tests {
+		q, err := url.ParseQuery(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := parsePageRequest(q)
+		if tt.err {
+			if err == nil {
+				t.Errorf("Expected an error for %q", tt.query)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %s", tt.query, err)
+			continue
+		}
+		if p != tt.expected {
+			t.Errorf("Expected %+v for %q, got %+v", tt.expected, tt.query, p)
+		}
+	}
+}
+
+func TestPageRequestSlice(t *testing.T) {
+	keys := []string{"a", "b", "c", "d", "e"}
+	key := func(i int) string { return keys[i] }
+	tests := []struct {
+		p          pageRequest
+		start, end int
+		next       string
+	}{
+		{pageRequest{limit: -1}, 0, 5, ""},
+		{pageRequest{limit: 2}, 0, 2, encodeCursor("2:b")},
+		{pageRequest{cursor: "2:b", limit: 2}, 2, 4, encodeCursor("4:d")},
+		{pageRequest{cursor: "3:c", limit: 2}, 3, 5, ""},
+		{pageRequest{cursor: "5:e", limit: 2}, 5, 5, ""},
+		// The items moved since the cursor was returned
+		{pageRequest{cursor: "1:b", limit: 2}, 2, 4, encodeCursor("4:d")},
+		// The last item of the previous page was removed
+		{pageRequest{cursor: "3:z", limit: 2}, 2, 4, encodeCursor("4:d")},
+		{pageRequest{cursor: "9:z", limit: 2}, 5, 5, ""},
+		{pageRequest{offsetID: "b", limit: 2}, 2, 4, encodeCursor("4:d")},
+		{pageRequest{offsetID: "z", limit: 2}, 5, 5, ""},
+	}
+	for _, tt := range tests {
+		start, end, next, err := tt.p.slice(len(keys), key)
+		if err != nil {
+			t.Errorf("Unexpected error for %+v: %s", tt.p, err)
+			continue
+		}
+		if start != tt.start || end != tt.end || next != tt.next {
+			t.Errorf("Expected %d, %d, %q for %+v, got %d, %d, %q", tt.start, tt.end, tt.next, tt.p, start, end, next)
+		}
+	}
+	for _, cursor := range []string{"b", "0:a", "x:b"} {
+		if _, _, _, err := (pageRequest{cursor: cursor, limit: 2}).slice(len(keys), key); err == nil {
+			t.Errorf("Expected cursor %q to be invalid", cursor)
+		}
+	}
+}
+
+func TestPageJSONList(t *testing.T) {
+	list := []byte(`[{"slug":"one","extra":true},{"slug":"two"},{"slug":"three"}]`)
+	items, next, err := pageJSONList(pageRequest{cursor: "1:one", limit: 1}, list, "slug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || string(items[0]) != `{"slug":"two"}` {
+		t.Errorf("Unexpected page %s", items)
+	}
+	if next != encodeCursor("2:two") {
+		t.Errorf("Expected the next cursor after two, got %q", next)
+	}
+}
+
+func TestFollowersPagination(t *testing.T) {
+	repository, err := test.ResetRepository()
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerIDs := []string{"QmPeer1", "QmPeer2", "QmPeer3"}
+	for _, peerID := range peerIDs {
+		if err := repository.DB.Followers().Put(peerID, []byte("proof")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func() {
+		for _, peerID := range peerIDs {
+			repository.DB.Followers().Delete(peerID)
+		}
+	}()
+	_, next, err := repository.DB.Followers().GetPage("", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor := encodeCursor(next)
+
+	tests := apiTests{
+		{"GET", "/ob/followers", "", 200, `["QmPeer3", "QmPeer2", "QmPeer1"]`},
+		{"GET", "/ob/followers?cursor=&limit=2", "", 200, `{"items": ["QmPeer3", "QmPeer2"], "nextCursor": "` + cursor + `"}`},
+		{"GET", "/ob/followers?cursor=" + cursor + "&limit=2", "", 200, `{"items": ["QmPeer1"], "nextCursor": ""}`},
+		{"GET", "/ob/followers?offsetId=QmPeer3&limit=1", "", 200, `["QmPeer2"]`},
+		{"GET", "/ob/followers?cursor=%25", "", 400, errorResponseJSON(&apiError{http.StatusBadRequest, "invalid cursor"})},
+		{"GET", "/ob/followers?cursor=" + encodeCursor("QmPeer2"), "", 400, errorResponseJSON(&apiError{http.StatusBadRequest, "invalid cursor"})},
+	}
+	for _, tt := range tests {
+		executeAPITest(t, tt)
+	}
+
+	// Removing the last follower of a page doesn't end the list early
+	if err := repository.DB.Followers().Delete("QmPeer2"); err != nil {
+		t.Fatal(err)
+	}
+	executeAPITest(t, apiTest{"GET", "/ob/followers?cursor=" + cursor + "&limit=2", "", 200, `{"items": ["QmPeer1"], "nextCursor": ""}`})
+}
+
+func TestOrderListsPagination(t *testing.T) {
+	invalidCursor := errorResponseJSON(&apiError{http.StatusBadRequest, "invalid cursor"})
+	runAPITests(t, apiTests{
+		{"GET", "/ob/sales?cursor=!!", "", 400, invalidCursor},
+		{"GET", "/ob/purchases?cursor=" + encodeCursor("QmPeer2"), "", 400, invalidCursor},
+		{"POST", "/ob/cases", `{"cursor": "!!"}`, 400, invalidCursor},
+		{"GET", "/ob/chatconversations?cursor=" + encodeCursor("1:2"), "", 400, invalidCursor},
+	})
+}
+
+func TestListingsPagination(t *testing.T) {
+	body, err := httpGet("/ob/listings?cursor=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `"nextCursor": ""`) {
+		t.Errorf("Expected a page of listings, got %s", body)
+	}
+}
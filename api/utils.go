@@ -1,10 +1,14 @@
 package api
 
 import (
-	"github.com/OpenBazaar/openbazaar-go/pb"
+	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
 )
 
 type TransactionQuery struct {
@@ -14,6 +18,7 @@ type TransactionQuery struct {
 	SortByRead      bool     `json:"sortByRead"`
 	Limit           int      `json:"limit"`
 	Exclude         []string `json:"exclude"`
+	Cursor          string   `json:"cursor"`
 }
 
 func parseSearchTerms(q url.Values) (orderStates []pb.OrderState, searchTerm string, sortByAscending, sortByRead bool, limit int, err error) {
@@ -58,3 +63,55 @@ func convertOrderStates(states []int) []pb.OrderState {
 	}
 	return orderStates
 }
+
+// requestLanguages returns the languages requested by the client in order of
+// preference. The lang query parameter takes precedence over the
+// Accept-Language header.
+func requestLanguages(r *http.Request) []string {
+	var languages []string
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		languages = append(languages, lang)
+	}
+
+	type weightedLanguage struct {
+		lang string
+		q    float64
+	}
+	var weighted []weightedLanguage
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		lang := strings.TrimSpace(fields[0])
+		if lang == "" || lang == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > 0 {
+			weighted = append(weighted, weightedLanguage{lang, q})
+		}
+	}
+	sort.SliceStable(weighted, func(i, j int) bool { return weighted[i].q > weighted[j].q })
+	for _, wl := range weighted {
+		languages = append(languages, wl.lang)
+	}
+	return languages
+}
+
+// localizeListing sets the translation of the listing matching the request
+// languages, and the Content-Language header accordingly. The signed listing
+// is left as it is so it still verifies.
+func localizeListing(w http.ResponseWriter, r *http.Request, sl *pb.SignedListing) {
+	w.Header().Add("Vary", "Accept-Language")
+	t, lang := core.ListingTranslation(sl.Listing, requestLanguages(r))
+	sl.Translation = t
+	if lang != "" {
+		w.Header().Set("Content-Language", lang)
+	}
+}
@@ -1,13 +1,15 @@
 package api
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	"github.com/gorilla/websocket"
 )
@@ -21,6 +23,21 @@ type connection struct {
 
 	// The hub
 	h *hub
+
+	// Topics the connection subscribed to. Connections which never sent a
+	// subscription frame get every message.
+	subscriptionLock sync.RWMutex
+	filtered         bool
+	subscriptions    map[string]bool
+}
+
+// subscriptionFrame subscribes a connection to, or unsubscribes it from,
+// topics. Topics are notification types, such as "chatMessage" or "payment",
+// "order:<order ID>" for the notifications of an order, "chat:<peer ID>" for
+// the chat with a peer and "wallet" for wallet events.
+type subscriptionFrame struct {
+	Subscribe   []string `json:"subscribe"`
+	Unsubscribe []string `json:"unsubscribe"`
 }
 
 func (c *connection) reader() {
@@ -31,12 +48,51 @@ func (c *connection) reader() {
 		}
 		log.Debugf("Incoming websocket message: %s", string(message))
 
+		var frame subscriptionFrame
+		if err := json.Unmarshal(message, &frame); err == nil && (frame.Subscribe != nil || frame.Unsubscribe != nil) {
+			c.updateSubscriptions(frame)
+			continue
+		}
+
 		// Just echo for now until we set up the API
-		c.h.Broadcast <- message
+		c.h.Broadcast <- wsMessage{data: message}
 	}
 	c.ws.Close()
 }
 
+func (c *connection) updateSubscriptions(frame subscriptionFrame) {
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+	if c.subscriptions == nil {
+		c.subscriptions = make(map[string]bool)
+	}
+	c.filtered = true
+	for _, topic := range frame.Subscribe {
+		c.subscriptions[topic] = true
+	}
+	for _, topic := range frame.Unsubscribe {
+		delete(c.subscriptions, topic)
+	}
+}
+
+// wants returns whether the connection should get a message of the topics
+func (c *connection) wants(topics []string) bool {
+	if len(topics) == 0 {
+		return true
+	}
+	c.subscriptionLock.RLock()
+	defer c.subscriptionLock.RUnlock()
+	if !c.filtered {
+		return true
+	}
+	for _, topic := range topics {
+		if c.subscriptions[topic] {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *connection) writer() {
 	for message := range c.send {
 		err := c.ws.WriteMessage(websocket.TextMessage, message)
@@ -64,9 +120,10 @@ type wsHandler struct {
 	cookie        http.Cookie
 	username      string
 	password      string
+	guard         *authGuard
 }
 
-func newWSAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config schema.APIConfig) *wsHandler {
+func newWSAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config schema.APIConfig, guard *authGuard) *wsHandler {
 	hub := newHub()
 	go hub.run()
 	allowedIps := make(map[string]bool)
@@ -82,16 +139,12 @@ func newWSAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config s
 		cookie:        authCookie,
 		username:      config.Username,
 		password:      config.Password,
+		guard:         guard,
 	}
 	return &handler
 }
 
 func (wsh wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	ws, err := upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		log.Error("Error upgrading to websockets:", err)
-		return
-	}
 	if !wsh.enabled {
 		w.WriteHeader(http.StatusForbidden)
 		fmt.Fprint(w, "403 - Forbidden")
@@ -106,29 +159,37 @@ func (wsh wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	if wsh.authenticated {
+		ip := clientIP(r)
+		if wait, locked := wsh.guard.LockedOut(ip); locked {
+			w.Header().Set("Retry-After", strconv.Itoa(int(wait.Seconds())+1))
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, "429 - Too Many Requests")
+			return
+		}
 		if wsh.username == "" || wsh.password == "" {
-			cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
-			if err != nil {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
-				return
-			}
-			if wsh.cookie.Value != cookie.Value {
+			if !checkAuthCookie(r, wsh.cookie) {
+				wsh.guard.Fail(ip, repo.AuthMethodCookie, "", r.URL.Path, "invalid cookie")
 				w.WriteHeader(http.StatusForbidden)
 				fmt.Fprint(w, "403 - Forbidden")
 				return
 			}
+			wsh.guard.Succeed(ip, repo.AuthMethodCookie, "", r.URL.Path)
 		} else {
-			username, password, ok := r.BasicAuth()
-			h := sha256.Sum256([]byte(password))
-			password = hex.EncodeToString(h[:])
-			if !ok || username != wsh.username || strings.ToLower(password) != strings.ToLower(wsh.password) {
+			username, ok := checkBasicAuth(r, wsh.username, wsh.password)
+			if !ok {
+				wsh.guard.Fail(ip, repo.AuthMethodBasic, username, r.URL.Path, "invalid credentials")
 				w.WriteHeader(http.StatusForbidden)
 				fmt.Fprint(w, "403 - Forbidden")
 				return
 			}
+			wsh.guard.Succeed(ip, repo.AuthMethodBasic, username, r.URL.Path)
 		}
 	}
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Error("Error upgrading to websockets:", err)
+		return
+	}
 	c := &connection{send: make(chan []byte, 256), ws: ws, h: wsh.h}
 	c.h.register <- c
 	defer func() { c.h.unregister <- c }()
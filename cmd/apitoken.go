@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type APIToken struct {
+	Create APITokenCreate `command:"create" description:"create a scoped API token and print it"`
+	Revoke APITokenRevoke `command:"revoke" description:"revoke an API token"`
+	List   APITokenList   `command:"list" description:"list the API tokens"`
+}
+
+type apiTokenRepoOptions struct {
+	DataDir string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet bool   `short:"t" long:"testnet" description:"use the testnet database"`
+}
+
+type APITokenCreate struct {
+	apiTokenRepoOptions
+	Name    string        `short:"n" long:"name" description:"name of the token" required:"true"`
+	Scopes  []string      `short:"s" long:"scope" description:"scope granted to the token: read-only, listings-write, orders, wallet-spend or admin. May be repeated."`
+	Expires time.Duration `short:"e" long:"expires" description:"revoke the token after this duration, for example 720h"`
+}
+
+type APITokenRevoke struct {
+	apiTokenRepoOptions
+	Name string `short:"n" long:"name" description:"name of the token" required:"true"`
+}
+
+type APITokenList struct {
+	apiTokenRepoOptions
+}
+
+func (x *APITokenCreate) Execute(args []string) error {
+	store, closeDB, err := x.openAPITokenStore()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	var scopes []repo.APITokenScope
+	for _, s := range x.Scopes {
+		for _, scope := range strings.Split(s, ",") {
+			scopes = append(scopes, repo.APITokenScope(strings.TrimSpace(scope)))
+		}
+	}
+	var expires *time.Time
+	if x.Expires > 0 {
+		t := time.Now().Add(x.Expires)
+		expires = &t
+	}
+	secret, _, err := repo.CreateAPIToken(store, x.Name, scopes, expires)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Created API token. It will not be shown again, send it as 'Authorization: Bearer <token>':")
+	fmt.Println(secret)
+	return nil
+}
+
+func (x *APITokenRevoke) Execute(args []string) error {
+	store, closeDB, err := x.openAPITokenStore()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	if err := repo.RevokeAPIToken(store, x.Name); err != nil {
+		return err
+	}
+	fmt.Println("Revoked API token", x.Name)
+	return nil
+}
+
+func (x *APITokenList) Execute(args []string) error {
+	store, closeDB, err := x.openAPITokenStore()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	tokens, err := store.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, t := range tokens {
+		scopes := make([]string, 0, len(t.Scopes))
+		for _, s := range t.Scopes {
+			scopes = append(scopes, string(s))
+		}
+		expires := "never"
+		if t.Expires != nil {
+			expires = t.Expires.Format(time.RFC3339)
+		}
+		fmt.Printf("%s\tscopes: %s\tcreated: %s\texpires: %s\n", t.Name, strings.Join(scopes, ","), t.CreatedAt.Format(time.RFC3339), expires)
+	}
+	return nil
+}
+
+// openAPITokenStore opens the database of the repo, asking for the password
+// if it is encrypted
+func (x *apiTokenRepoOptions) openAPITokenStore() (repo.APITokenStore, func(), error) {
+	repoPath, err := repo.GetRepoPath(x.Testnet)
+	if err != nil {
+		return nil, nil, err
+	}
+	if x.DataDir != "" {
+		repoPath = x.DataDir
+	}
+	if !fsrepo.IsInitialized(repoPath) {
+		return nil, nil, errors.New("repo is not initialized, run the node at least once first")
+	}
+	sqliteDB, err := db.Create(repoPath, "", x.Testnet, wallet.Bitcoin)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sqliteDB.Config().IsEncrypted() {
+		sqliteDB.Close()
+		fmt.Print("Database is encrypted, enter your password: ")
+		bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
+		fmt.Println("")
+		sqliteDB, err = db.Create(repoPath, string(bytePassword), x.Testnet, wallet.Bitcoin)
+		if err != nil {
+			return nil, nil, err
+		}
+		if sqliteDB.Config().IsEncrypted() {
+			sqliteDB.Close()
+			return nil, nil, errors.New("invalid password")
+		}
+	}
+	return sqliteDB.APITokens(), sqliteDB.Close, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	obnet "github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/net/onion"
 	ipfscore "github.com/ipfs/go-ipfs/core"
 	bitswap "github.com/ipfs/go-ipfs/exchange/bitswap/network"
 	"io/ioutil"
@@ -39,7 +40,6 @@ import (
 	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
 	"gx/ipfs/QmcZfnkapfECQGcLZaf9B79NRg7cRa9EnZh4LSbkCzwNvY/go-cid"
 	metrics "gx/ipfs/QmdeBtQGXjSt7cb97nx9JyLHHv5va2LyEAue7Q5tDFzpLy/go-libp2p-metrics"
-	oniontp "gx/ipfs/Qmdh86HZtNap3ktHvjyiVhBnp4uRpQWMCRAASieh8fDH8J/go-onion-transport"
 	ipld "gx/ipfs/Qme5bWv7wtjUNGsK2BNGVUFPKiuxWrsqrtvYwCLRw8YFES/go-ipld-format"
 	"sync"
 	"syscall"
@@ -161,7 +161,7 @@ func (x *Restore) Execute(args []string) error {
 		log.Error(err)
 		return err
 	}
-	onionAddrString := "/onion/" + onionAddr + ":4003"
+	onionAddrString := onion.Multiaddr(onionAddr, 4003)
 	if x.Tor {
 		cfg.Addresses.Swarm = []string{}
 		cfg.Addresses.Swarm = append(cfg.Addresses.Swarm, onionAddrString)
@@ -180,10 +180,10 @@ func (x *Restore) Execute(args []string) error {
 			return err
 		}
 		p := m.Protocols()
-		if p[0].Name == "onion" {
+		if p[0].Name == "onion" || p[0].Name == "onion3" {
 			usingTor = true
-			addrutil.SupportedTransportStrings = append(addrutil.SupportedTransportStrings, "/onion")
-			t, err := ma.ProtocolsWithString("/onion")
+			addrutil.SupportedTransportStrings = append(addrutil.SupportedTransportStrings, "/"+p[0].Name)
+			t, err := ma.ProtocolsWithString("/" + p[0].Name)
 			if err != nil {
 				PrintError(err.Error())
 				return err
@@ -198,7 +198,7 @@ func (x *Restore) Execute(args []string) error {
 		}
 	}
 	// Create Tor transport
-	var onionTransport *oniontp.OnionTransport
+	var onionTransport *onion.OnionTransport
 	if usingTor {
 		torControl := torConfig.TorControl
 		if torControl == "" {
@@ -216,7 +216,7 @@ func (x *Restore) Execute(args []string) error {
 		if x.TorPassword != "" {
 			torPw = x.TorPassword
 		}
-		onionTransport, err = oniontp.NewOnionTransport("tcp4", torControl, torPw, nil, repoPath, (usingTor && usingClearnet))
+		onionTransport, err = onion.NewOnionTransport("tcp4", torControl, torPw, nil, repoPath, (usingTor && usingClearnet))
 		if err != nil {
 			PrintError(err.Error())
 			return err
@@ -27,6 +27,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	obns "github.com/OpenBazaar/openbazaar-go/namesys"
 	obnet "github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/net/onion"
 	"github.com/OpenBazaar/openbazaar-go/net/service"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/repo/db"
@@ -34,6 +35,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/OpenBazaar/openbazaar-go/storage/dropbox"
+	"github.com/OpenBazaar/openbazaar-go/storage/s3"
 	"github.com/OpenBazaar/openbazaar-go/storage/selfhosted"
 	"github.com/OpenBazaar/spvwallet"
 	exchange "github.com/OpenBazaar/spvwallet/exchangerates"
@@ -74,7 +76,6 @@ import (
 	"gx/ipfs/QmZPrWxuM8GHr4cGKbyF5CCT11sFUP9hgqpeUHALvx2nUr/go-libp2p-interface-pnet"
 	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
 	metrics "gx/ipfs/QmdeBtQGXjSt7cb97nx9JyLHHv5va2LyEAue7Q5tDFzpLy/go-libp2p-metrics"
-	oniontp "gx/ipfs/Qmdh86HZtNap3ktHvjyiVhBnp4uRpQWMCRAASieh8fDH8J/go-onion-transport"
 	"io"
 	"syscall"
 	"time"
@@ -109,7 +110,7 @@ type Start struct {
 	DualStack            bool     `long:"dualstack" description:"Automatically configure the daemon to run as a Tor hidden service IN ADDITION to using the clear internet. Requires Tor to be running. WARNING: this mode is not private"`
 	DisableWallet        bool     `long:"disablewallet" description:"disable the wallet functionality of the node"`
 	DisableExchangeRates bool     `long:"disableexchangerates" description:"disable the exchange rate service to prevent api queries"`
-	Storage              string   `long:"storage" description:"set the outgoing message storage option [self-hosted, dropbox] default=self-hosted"`
+	Storage              string   `long:"storage" description:"set the outgoing message storage option [self-hosted, dropbox, s3] default=self-hosted"`
 	ZCash                string   `long:"zcash" description:"use a ZCash wallet in a dedicated data directory. To use this you must pass in the location of the zcashd binary."`
 }
 
@@ -347,7 +348,7 @@ func (x *Start) Execute(args []string) error {
 		log.Error("create onion key:", err)
 		return err
 	}
-	onionAddrString := "/onion/" + onionAddr + ":4003"
+	onionAddrString := onion.Multiaddr(onionAddr, 4003)
 	if x.Tor {
 		cfg.Addresses.Swarm = []string{}
 		cfg.Addresses.Swarm = append(cfg.Addresses.Swarm, onionAddrString)
@@ -360,7 +361,7 @@ func (x *Start) Execute(args []string) error {
 		cfg.Addresses.Swarm = append(cfg.Addresses.Swarm, "/ip4/0.0.0.0/tcp/9005/ws")
 	}
 	// Iterate over our address and process them as needed
-	var onionTransport *oniontp.OnionTransport
+	var onionTransport *onion.OnionTransport
 	var torDialer proxy.Dialer
 	var usingTor, usingClearnet bool
 	var controlPort int
@@ -382,10 +383,10 @@ func (x *Start) Execute(args []string) error {
 			cfg.Addresses.Swarm = append(cfg.Addresses.Swarm[:i], cfg.Addresses.Swarm[i+1:]...)
 			cfg.Addresses.Swarm = append(cfg.Addresses.Swarm, "/ip4/0.0.0.0/udp/"+strconv.Itoa(port)+"/utp")
 			break
-		} else if p[0].Name == "onion" {
+		} else if p[0].Name == "onion" || p[0].Name == "onion3" {
 			usingTor = true
-			addrutil.SupportedTransportStrings = append(addrutil.SupportedTransportStrings, "/onion")
-			t, err := ma.ProtocolsWithString("/onion")
+			addrutil.SupportedTransportStrings = append(addrutil.SupportedTransportStrings, "/"+p[0].Name)
+			t, err := ma.ProtocolsWithString("/" + p[0].Name)
 			if err != nil {
 				log.Error("wrapping onion protocol:", err)
 				return err
@@ -410,7 +411,7 @@ func (x *Start) Execute(args []string) error {
 		if x.TorPassword != "" {
 			torPw = x.TorPassword
 		}
-		onionTransport, err = oniontp.NewOnionTransport("tcp4", torControl, torPw, nil, repoPath, (usingTor && usingClearnet))
+		onionTransport, err = onion.NewOnionTransport("tcp4", torControl, torPw, nil, repoPath, (usingTor && usingClearnet))
 		if err != nil {
 			log.Error("setup tor transport:", err)
 			return err
@@ -650,6 +651,15 @@ func (x *Start) Execute(args []string) error {
 		}
 		pushNodes = append(pushNodes, p)
 	}
+	var fallbackPushNodes []peer.ID
+	for _, pnd := range dataSharing.FallbackPushTo {
+		p, err := peer.IDB58Decode(pnd)
+		if err != nil {
+			log.Error("Invalid fallback peerID in DataSharing config")
+			return err
+		}
+		fallbackPushNodes = append(fallbackPushNodes, p)
+	}
 
 	// Authenticated gateway
 	gatewayMaddr, err := ma.NewMultiaddr(cfg.Addresses.Gateway)
@@ -725,11 +735,20 @@ func (x *Start) Execute(args []string) error {
 		}
 	}
 	bm := obnet.NewBanManager(blockedNodes)
+	bans, err := sqliteDB.Bans().GetAll()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	bm.SetBans(bans)
 
 	// Create namesys resolvers
 	resolvers := []obns.Resolver{
 		bstk.NewBlockStackClient(resolverConfig.Id, torDialer),
 	}
+	if resolverConfig.Eth != "" {
+		resolvers = append(resolvers, obns.NewENSResolver(resolverConfig.Eth, torDialer))
+	}
 	if !(usingTor && !usingClearnet) {
 		resolvers = append(resolvers, obns.NewDNSResolver())
 	}
@@ -758,10 +777,15 @@ func (x *Start) Execute(args []string) error {
 		NameSystem:           ns,
 		ExchangeRates:        exchangeRates,
 		PushNodes:            pushNodes,
+		PushNodeManager:      obnet.NewPushNodeManager(pushNodes, fallbackPushNodes),
 		AcceptStoreRequests:  dataSharing.AcceptStoreRequests,
 		TorDialer:            torDialer,
 		UserAgent:            core.USERAGENT,
 		BanManager:           bm,
+		AbuseManager:         obnet.NewAbuseManager(),
+		PeerCapabilities:     obnet.NewCapabilityCache(),
+		Deduplicator:         obnet.NewMessageDeduplicator(sqliteDB.ReceivedMessages()),
+		Sessions:             obnet.NewSessionManager(nd.PrivateKey, sqliteDB.Sessions()),
 		IPNSBackupAPI:        cfg.Ipns.BackUpAPI,
 		Pubsub:               ps,
 		TestnetEnable:        x.Testnet,
@@ -772,7 +796,7 @@ func (x *Start) Execute(args []string) error {
 	// Offline messaging storage
 	var storage sto.OfflineMessagingStorage
 	if x.Storage == "self-hosted" || x.Storage == "" {
-		storage = selfhosted.NewSelfHostedStorage(repoPath, core.Node.IpfsNode, pushNodes, core.Node.SendStore)
+		storage = selfhosted.NewSelfHostedStorage(repoPath, core.Node.IpfsNode, core.Node.PushNodeManager.Active, core.Node.SendStore)
 	} else if x.Storage == "dropbox" {
 		if usingTor && !usingClearnet {
 			log.Error("Dropbox can not be used with Tor")
@@ -789,6 +813,22 @@ func (x *Start) Execute(args []string) error {
 			log.Error(err)
 			return err
 		}
+	} else if x.Storage == "s3" {
+		if usingTor && !usingClearnet {
+			log.Error("S3 can not be used with Tor")
+			return errors.New("S3 can not be used with Tor")
+		}
+
+		s3Config, err := schema.GetS3Config(configFile)
+		if err != nil {
+			log.Error("scan s3 config:", err)
+			return err
+		}
+		storage, err = s3.NewS3Storage(*s3Config)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
 	} else {
 		err = errors.New("Invalid storage option")
 		log.Error(err)
@@ -823,6 +863,9 @@ func (x *Start) Execute(args []string) error {
 		core.Node.StartMessageRetriever()
 		core.Node.StartPointerRepublisher()
 		core.Node.StartRecordAgingNotifier()
+		core.Node.StartListingScheduler()
+		core.Node.StartSearchIndexer()
+		core.Node.StartStoreAnnouncer()
 
 		if !x.DisableWallet {
 			// If the wallet doesn't allow resyncing from a specific height to scan for unpaid orders, wait for all messages to process before continuing.
@@ -846,6 +889,11 @@ func (x *Start) Execute(args []string) error {
 			}
 		}
 		core.PublishLock.Unlock()
+		err = core.Node.UpdatePreKeyBundle()
+		if err != nil {
+			log.Error(err)
+		}
+		core.Node.StartPreKeyRotator()
 		err = core.Node.UpdateFollow()
 		if err != nil {
 			log.Error(err)
@@ -0,0 +1,295 @@
+package core
+
+import (
+	"path"
+	"sync"
+	"time"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+	libp2p "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/op/go-logging"
+	"golang.org/x/net/context"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const storeAnnouncerTimeout = time.Duration(30) * time.Second
+
+type storeAnnouncer struct {
+	node   *OpenBazaarNode
+	logger *logging.Logger
+
+	mx       sync.Mutex
+	snapshot *storeSnapshot
+	lastSeen map[string]time.Time
+}
+
+type storeSnapshot struct {
+	listings map[string]ListingData
+	posts    map[string]postData
+}
+
+// StoreAnnouncementTopic returns the pubsub topic a store announces its
+// updates on
+func StoreAnnouncementTopic(peerID string) string {
+	return ipfs.StoreTopicPrefix + peerID
+}
+
+// StartStoreAnnouncer - start announcing updates of our store over pubsub and
+// subscribe to the announcements of followed stores
+func (n *OpenBazaarNode) StartStoreAnnouncer() {
+	if n.Pubsub.Publisher == nil || n.Pubsub.Subscriber == nil {
+		return
+	}
+	announcer := &storeAnnouncer{
+		node:     n,
+		logger:   logging.MustGetLogger("storeAnnouncer"),
+		lastSeen: make(map[string]time.Time),
+	}
+	snapshot, err := n.storeSnapshot()
+	if err != nil {
+		announcer.logger.Errorf("reading store index: %s", err.Error())
+	}
+	announcer.snapshot = snapshot
+	n.StoreAnnouncer = announcer
+
+	following, err := n.Datastore.Following().Get("", -1)
+	if err != nil {
+		announcer.logger.Errorf("loading followed stores: %s", err.Error())
+		return
+	}
+	for _, f := range following {
+		announcer.Subscribe(f)
+	}
+}
+
+func (n *OpenBazaarNode) storeSnapshot() (*storeSnapshot, error) {
+	snapshot := &storeSnapshot{
+		listings: make(map[string]ListingData),
+		posts:    make(map[string]postData),
+	}
+	listings, err := n.getListingIndex()
+	if err != nil {
+		return nil, err
+	}
+	for _, ld := range listings {
+		snapshot.listings[ld.Slug] = ld
+	}
+	posts, err := n.getPostIndex()
+	if err != nil {
+		return nil, err
+	}
+	for _, pd := range posts {
+		snapshot.posts[pd.Slug] = pd
+	}
+	return snapshot, nil
+}
+
+// diffStoreSnapshots returns the changes between two snapshots of our store
+func diffStoreSnapshots(old, current *storeSnapshot) []*pb.StoreAnnouncement_Change {
+	var changes []*pb.StoreAnnouncement_Change
+	change := func(t pb.StoreAnnouncement_ChangeType, slug, title string) {
+		changes = append(changes, &pb.StoreAnnouncement_Change{Type: t, Slug: slug, Title: title})
+	}
+	for slug, ld := range current.listings {
+		prev, ok := old.listings[slug]
+		switch {
+		case !ok:
+			change(pb.StoreAnnouncement_LISTING_ADDED, slug, ld.Title)
+		case prev.Price.Amount != ld.Price.Amount || prev.Price.CurrencyCode != ld.Price.CurrencyCode || prev.Price.Modifier != ld.Price.Modifier:
+			change(pb.StoreAnnouncement_PRICE_CHANGED, slug, ld.Title)
+		case prev.Hash != ld.Hash:
+			change(pb.StoreAnnouncement_LISTING_UPDATED, slug, ld.Title)
+		}
+	}
+	for slug, ld := range old.listings {
+		if _, ok := current.listings[slug]; !ok {
+			change(pb.StoreAnnouncement_LISTING_REMOVED, slug, ld.Title)
+		}
+	}
+	for slug, pd := range current.posts {
+		if _, ok := old.posts[slug]; !ok {
+			change(pb.StoreAnnouncement_POST_ADDED, slug, pd.Title)
+		}
+	}
+	for slug, pd := range old.posts {
+		if _, ok := current.posts[slug]; !ok {
+			change(pb.StoreAnnouncement_POST_REMOVED, slug, pd.Title)
+		}
+	}
+	return changes
+}
+
+// Announce publishes the changes made to our store since the last announcement
+func (a *storeAnnouncer) Announce(rootHash string) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	snapshot, err := a.node.storeSnapshot()
+	if err != nil {
+		a.logger.Errorf("reading store index: %s", err.Error())
+		return
+	}
+	// Without a baseline every listing would be announced as new
+	if a.snapshot == nil {
+		a.snapshot = snapshot
+		return
+	}
+	changes := diffStoreSnapshots(a.snapshot, snapshot)
+	a.snapshot = snapshot
+	if len(changes) == 0 {
+		return
+	}
+
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		a.logger.Error(err)
+		return
+	}
+	announcement := &pb.StoreAnnouncement{
+		PeerID:    a.node.IPFSIdentityString(),
+		RootHash:  rootHash,
+		Timestamp: ts,
+		Changes:   changes,
+	}
+	data, err := signStoreAnnouncement(a.node.IpfsNode.PrivateKey, announcement)
+	if err != nil {
+		a.logger.Error(err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), storeAnnouncerTimeout)
+	defer cancel()
+	if err := a.node.Pubsub.Publisher.Publish(ctx, StoreAnnouncementTopic(announcement.PeerID), data); err != nil {
+		a.logger.Errorf("publishing store announcement: %s", err.Error())
+		return
+	}
+	a.logger.Debugf("announced %d store changes", len(changes))
+}
+
+// Subscribe starts listening to the announcements of a store
+func (a *storeAnnouncer) Subscribe(peerID string) {
+	topic := StoreAnnouncementTopic(peerID)
+	for _, sub := range a.node.Pubsub.Subscriber.GetSubscriptions() {
+		if sub == topic {
+			return
+		}
+	}
+	announcements, err := a.node.Pubsub.Subscriber.Subscribe(context.Background(), topic)
+	if err != nil {
+		a.logger.Errorf("subscribing to %s: %s", peerID, err.Error())
+		return
+	}
+	go func() {
+		for data := range announcements {
+			if err := a.handleAnnouncement(peerID, data); err != nil {
+				a.logger.Debugf("announcement from %s: %s", peerID, err.Error())
+			}
+		}
+	}()
+}
+
+// Unsubscribe stops listening to the announcements of a store
+func (a *storeAnnouncer) Unsubscribe(peerID string) {
+	a.node.Pubsub.Subscriber.Cancel(StoreAnnouncementTopic(peerID))
+}
+
+func (a *storeAnnouncer) handleAnnouncement(peerID string, data []byte) error {
+	announcement, err := verifyStoreAnnouncement(data, peerID)
+	if err != nil {
+		return err
+	}
+	ts, err := ptypes.Timestamp(announcement.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	// Floodsub may deliver announcements more than once and out of order
+	a.mx.Lock()
+	if !ts.After(a.lastSeen[peerID]) {
+		a.mx.Unlock()
+		return nil
+	}
+	a.lastSeen[peerID] = ts
+	a.mx.Unlock()
+
+	n := a.node
+	if _, err := n.IPNSResolve(peerID, storeAnnouncerTimeout, false); err != nil {
+		a.logger.Debugf("refreshing IPNS record of %s: %s", peerID, err.Error())
+	}
+	listingsJSON, err := ipfs.Cat(n.IpfsNode, path.Join(announcement.RootHash, "listings.json"), storeAnnouncerTimeout)
+	if err == nil {
+		err = n.IndexPeerListings(peerID, announcement.RootHash, listingsJSON)
+	}
+	if err != nil {
+		a.logger.Debugf("reindexing listings of %s: %s", peerID, err.Error())
+	}
+
+	for _, c := range announcement.Changes {
+		n.Broadcast <- repo.StoreUpdateNotification{
+			Type:       repo.NotifierTypeStoreUpdateNotification,
+			PeerId:     peerID,
+			RootHash:   announcement.RootHash,
+			ChangeType: c.Type.String(),
+			Slug:       c.Slug,
+			Title:      c.Title,
+		}
+	}
+	return nil
+}
+
+func signStoreAnnouncement(key libp2p.PrivKey, announcement *pb.StoreAnnouncement) ([]byte, error) {
+	ser, err := proto.Marshal(announcement)
+	if err != nil {
+		return nil, err
+	}
+	pubkeyBytes, err := key.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := key.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&pb.SignedData{
+		SerializedData: ser,
+		SenderPubkey:   pubkeyBytes,
+		Signature:      sig,
+	})
+}
+
+// verifyStoreAnnouncement checks the announcement was signed by the store
+// whose topic it was received on
+func verifyStoreAnnouncement(data []byte, peerID string) (*pb.StoreAnnouncement, error) {
+	sd := new(pb.SignedData)
+	if err := proto.Unmarshal(data, sd); err != nil {
+		return nil, err
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(sd.SenderPubkey)
+	if err != nil {
+		return nil, err
+	}
+	id, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if id.Pretty() != peerID {
+		return nil, ErrInvalidStoreAnnouncement
+	}
+	valid, err := pubkey.Verify(sd.SerializedData, sd.Signature)
+	if err != nil || !valid {
+		return nil, ErrInvalidStoreAnnouncement
+	}
+	announcement := new(pb.StoreAnnouncement)
+	if err := proto.Unmarshal(sd.SerializedData, announcement); err != nil {
+		return nil, err
+	}
+	if announcement.PeerID != peerID || announcement.Timestamp == nil {
+		return nil, ErrInvalidStoreAnnouncement
+	}
+	return announcement, nil
+}
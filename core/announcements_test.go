@@ -0,0 +1,106 @@
+package core
+
+import (
+	"crypto/rand"
+	"testing"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+	libp2p "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestDiffStoreSnapshots(t *testing.T) {
+	old := &storeSnapshot{
+		listings: map[string]ListingData{
+			"same":     {Slug: "same", Hash: "Qm1", Title: "Same"},
+			"edited":   {Slug: "edited", Hash: "Qm2", Title: "Edited"},
+			"repriced": {Slug: "repriced", Hash: "Qm3", Title: "Repriced", Price: price{CurrencyCode: "BTC", Amount: 100}},
+			"removed":  {Slug: "removed", Hash: "Qm4", Title: "Removed"},
+		},
+		posts: map[string]postData{"old-post": {Slug: "old-post", Title: "Old post"}},
+	}
+	current := &storeSnapshot{
+		listings: map[string]ListingData{
+			"same":     {Slug: "same", Hash: "Qm1", Title: "Same"},
+			"edited":   {Slug: "edited", Hash: "Qm5", Title: "Edited"},
+			"repriced": {Slug: "repriced", Hash: "Qm6", Title: "Repriced", Price: price{CurrencyCode: "BTC", Amount: 200}},
+			"added":    {Slug: "added", Hash: "Qm7", Title: "Added"},
+		},
+		posts: map[string]postData{"new-post": {Slug: "new-post", Title: "New post"}},
+	}
+
+	expected := map[string]pb.StoreAnnouncement_ChangeType{
+		"edited":   pb.StoreAnnouncement_LISTING_UPDATED,
+		"repriced": pb.StoreAnnouncement_PRICE_CHANGED,
+		"removed":  pb.StoreAnnouncement_LISTING_REMOVED,
+		"added":    pb.StoreAnnouncement_LISTING_ADDED,
+		"old-post": pb.StoreAnnouncement_POST_REMOVED,
+		"new-post": pb.StoreAnnouncement_POST_ADDED,
+	}
+	changes := diffStoreSnapshots(old, current)
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d", len(expected), len(changes))
+	}
+	for _, c := range changes {
+		if c.Type != expected[c.Slug] {
+			t.Errorf("expected %s for %s, got %s", expected[c.Slug], c.Slug, c.Type)
+		}
+	}
+	if changes := diffStoreSnapshots(current, current); len(changes) != 0 {
+		t.Errorf("expected no changes, got %d", len(changes))
+	}
+}
+
+func TestVerifyStoreAnnouncement(t *testing.T) {
+	newKey := func() (libp2p.PrivKey, string) {
+		priv, _, err := libp2p.GenerateKeyPairWithReader(libp2p.Ed25519, 256, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pid, err := peer.IDFromPrivateKey(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return priv, pid.Pretty()
+	}
+	key, peerID := newKey()
+	_, otherID := newKey()
+
+	announcement := &pb.StoreAnnouncement{
+		PeerID:    peerID,
+		RootHash:  "QmRoot",
+		Timestamp: ptypes.TimestampNow(),
+		Changes:   []*pb.StoreAnnouncement_Change{{Type: pb.StoreAnnouncement_LISTING_ADDED, Slug: "added"}},
+	}
+	data, err := signStoreAnnouncement(key, announcement)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verified, err := verifyStoreAnnouncement(data, peerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(verified, announcement) {
+		t.Error("expected the verified announcement to match")
+	}
+	if _, err := verifyStoreAnnouncement(data, otherID); err != ErrInvalidStoreAnnouncement {
+		t.Errorf("expected announcement on another store's topic to fail, got %v", err)
+	}
+
+	sd := new(pb.SignedData)
+	if err := proto.Unmarshal(data, sd); err != nil {
+		t.Fatal(err)
+	}
+	sd.SerializedData[len(sd.SerializedData)-1]++
+	tampered, err := proto.Marshal(sd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifyStoreAnnouncement(tampered, peerID); err != ErrInvalidStoreAnnouncement {
+		t.Errorf("expected tampered announcement to fail, got %v", err)
+	}
+}
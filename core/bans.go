@@ -0,0 +1,132 @@
+package core
+
+import (
+	"time"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// MaxBanReasonLength - the maximum length of the reason of a ban
+const MaxBanReasonLength = 500
+
+// AddBan saves a new ban and applies it to incoming messages. An empty scope
+// bans every message from the peer.
+func (n *OpenBazaarNode) AddBan(ban repo.Ban) (*repo.Ban, error) {
+	pid, err := n.validateBan(&ban)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := n.getBan(ban.PeerID, ban.Scope)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrBanAlreadyExists
+	}
+	ban.CreatedAt = time.Now().Truncate(time.Second)
+	if err := n.Datastore.Bans().Put(ban); err != nil {
+		return nil, err
+	}
+	n.BanManager.AddBan(ban)
+	if ban.Scope == repo.BanScopeAll {
+		go ipfs.RemoveAll(n.IpfsNode, pid.Pretty())
+	}
+	return &ban, nil
+}
+
+// UpdateBan changes the reason and expiry of an existing ban
+func (n *OpenBazaarNode) UpdateBan(ban repo.Ban) (*repo.Ban, error) {
+	if _, err := n.validateBan(&ban); err != nil {
+		return nil, err
+	}
+	existing, err := n.getBan(ban.PeerID, ban.Scope)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrBanDoesNotExist
+	}
+	ban.CreatedAt = existing.CreatedAt
+	if err := n.Datastore.Bans().Put(ban); err != nil {
+		return nil, err
+	}
+	n.BanManager.AddBan(ban)
+	return &ban, nil
+}
+
+// RemoveBan lifts the ban of a peer with the given scope, or every ban of the
+// peer if the scope is empty
+func (n *OpenBazaarNode) RemoveBan(peerID string, scope repo.BanScope) error {
+	pid, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		return err
+	}
+	bans, err := n.Datastore.Bans().Get(peerID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, ban := range bans {
+		if scope == "" || ban.Scope == scope {
+			found = true
+		}
+	}
+	if !found {
+		return ErrBanDoesNotExist
+	}
+	if err := n.Datastore.Bans().Delete(peerID, scope); err != nil {
+		return err
+	}
+	n.BanManager.RemoveBan(pid, scope)
+	return nil
+}
+
+func (n *OpenBazaarNode) getBan(peerID string, scope repo.BanScope) (*repo.Ban, error) {
+	bans, err := n.Datastore.Bans().Get(peerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ban := range bans {
+		if ban.Scope == scope {
+			return &ban, nil
+		}
+	}
+	return nil, nil
+}
+
+func (n *OpenBazaarNode) validateBan(ban *repo.Ban) (peer.ID, error) {
+	pid, err := peer.IDB58Decode(ban.PeerID)
+	if err != nil {
+		return "", err
+	}
+	ban.PeerID = pid.Pretty()
+	if ban.Scope == "" {
+		ban.Scope = repo.BanScopeAll
+	}
+	if !isValidBanScope(ban.Scope) {
+		return "", ErrBanInvalidScope
+	}
+	if len(ban.Reason) > MaxBanReasonLength {
+		return "", ErrBanReasonTooLong
+	}
+	if ban.Expires != nil {
+		if !ban.Expires.After(time.Now()) {
+			return "", ErrBanExpiryInPast
+		}
+		expires := ban.Expires.Truncate(time.Second)
+		ban.Expires = &expires
+	}
+	return pid, nil
+}
+
+func isValidBanScope(scope repo.BanScope) bool {
+	for _, s := range repo.BanScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
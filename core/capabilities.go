@@ -0,0 +1,90 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// LocalCapabilities - the message types and listing versions this node handles
+func (n *OpenBazaarNode) LocalCapabilities() *pb.Capabilities {
+	capabilities := &pb.Capabilities{
+		PeerID:    n.IPFSIdentityString(),
+		UserAgent: n.UserAgent,
+	}
+	for t := range pb.Message_MessageType_name {
+		messageType := pb.Message_MessageType(t)
+		if n.Service != nil && n.Service.HandlerForMsgType(messageType) != nil {
+			capabilities.MessageTypes = append(capabilities.MessageTypes, messageType)
+		}
+	}
+	sort.Slice(capabilities.MessageTypes, func(i, j int) bool {
+		return capabilities.MessageTypes[i] < capabilities.MessageTypes[j]
+	})
+	for v := uint32(1); v <= ListingVersion; v++ {
+		capabilities.ListingVersions = append(capabilities.ListingVersions, v)
+	}
+	return capabilities
+}
+
+// ExchangeCapabilities pings the peer with our capabilities and caches the
+// capabilities it responds with
+func (n *OpenBazaarNode) ExchangeCapabilities(p peer.ID) (*pb.Capabilities, error) {
+	a, err := ptypes.MarshalAny(n.LocalCapabilities())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp, err := n.Service.SendRequest(ctx, p, &pb.Message{MessageType: pb.Message_PING, Payload: a})
+	if err != nil {
+		return nil, err
+	}
+	return n.CachePeerCapabilities(p, resp), nil
+}
+
+// CachePeerCapabilities caches the capabilities carried by a PING from the
+// peer. Peers which predate capability negotiation send no capabilities or
+// echo ours back, and are cached with the legacy capabilities.
+func (n *OpenBazaarNode) CachePeerCapabilities(p peer.ID, pmes *pb.Message) *pb.Capabilities {
+	capabilities := new(pb.Capabilities)
+	if pmes.Payload == nil || ptypes.UnmarshalAny(pmes.Payload, capabilities) != nil || capabilities.PeerID != p.Pretty() {
+		capabilities = net.LegacyCapabilities(p)
+	}
+	n.PeerCapabilities.Put(p, capabilities)
+	return capabilities
+}
+
+// checkPeerSupportsListings refuses to send an order for listings of a
+// version the peer doesn't understand. Peers whose capabilities are unknown
+// are offline, and are left to refuse the order when it reaches them.
+func (n *OpenBazaarNode) checkPeerSupportsListings(p peer.ID, listings []*pb.Listing) error {
+	if n.PeerCapabilities.Get(p) == nil {
+		return nil
+	}
+	for _, listing := range listings {
+		if listing.Metadata != nil && !n.PeerCapabilities.SupportsListingVersion(p, listing.Metadata.Version) {
+			return ErrPeerUnsupportedListingVersion
+		}
+	}
+	return nil
+}
+
+// checkPeerSupports refuses to send a message type the peer doesn't handle.
+// Messages for peers whose capabilities are unknown are sent, so they can
+// fall back to the offline path.
+func (n *OpenBazaarNode) checkPeerSupports(p peer.ID, messageType pb.Message_MessageType) error {
+	if n.PeerCapabilities.Get(p) == nil {
+		return nil
+	}
+	if !n.PeerCapabilities.SupportsMessageType(p, messageType) {
+		return fmt.Errorf("%s: %s", ErrPeerUnsupportedMessageType.Error(), messageType.String())
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestCheckPeerSupports(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmeGHbCHfHE5DmPLf4qzDNUKkYFoaxtPxAAvYeCTdrZuU8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := &OpenBazaarNode{PeerCapabilities: net.NewCapabilityCache()}
+	listings := []*pb.Listing{{Metadata: &pb.Listing_Metadata{Version: ListingVersion}}}
+
+	// Unknown peers are offline and receive everything
+	if err := n.checkPeerSupportsListings(pid, listings); err != nil {
+		t.Errorf("listing refused for a peer with unknown capabilities: %s", err)
+	}
+	if err := n.checkPeerSupports(pid, pb.Message_CHAT); err != nil {
+		t.Errorf("message refused for a peer with unknown capabilities: %s", err)
+	}
+
+	n.PeerCapabilities.Put(pid, net.LegacyCapabilities(pid))
+	if err := n.checkPeerSupportsListings(pid, listings); err != ErrPeerUnsupportedListingVersion {
+		t.Errorf("expected the current listing version to be refused for a legacy peer, got %v", err)
+	}
+	listings[0].Metadata.Version = 4
+	if err := n.checkPeerSupportsListings(pid, listings); err != nil {
+		t.Errorf("legacy listing refused for a legacy peer: %s", err)
+	}
+
+	n.PeerCapabilities.RemoveMessageType(pid, pb.Message_CHAT)
+	if err := n.checkPeerSupports(pid, pb.Message_CHAT); err == nil {
+		t.Error("expected a message type the peer refused to be refused")
+	}
+	if err := n.checkPeerSupports(pid, pb.Message_ORDER); err != nil {
+		t.Errorf("message refused for a peer which handles it: %s", err)
+	}
+}
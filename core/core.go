@@ -20,6 +20,7 @@ import (
 	"gx/ipfs/QmcZfnkapfECQGcLZaf9B79NRg7cRa9EnZh4LSbkCzwNvY/go-cid"
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	"github.com/OpenBazaar/openbazaar-go/namesys"
 	"github.com/OpenBazaar/openbazaar-go/net"
 	rep "github.com/OpenBazaar/openbazaar-go/net/repointer"
@@ -85,6 +86,9 @@ type OpenBazaarNode struct {
 	// Optional nodes to push user data to
 	PushNodes []peer.ID
 
+	// Tracks the health of the push nodes and the fallback nodes
+	PushNodeManager *net.PushNodeManager
+
 	// The user-agent for this node
 	UserAgent string
 
@@ -94,6 +98,18 @@ type OpenBazaarNode struct {
 	// Manage blocked peers
 	BanManager *net.BanManager
 
+	// Rate limit and score misbehaving peers
+	AbuseManager *net.AbuseManager
+
+	// Forward secret sessions for offline messages
+	Sessions *net.SessionManager
+
+	// Capabilities advertised by other peers
+	PeerCapabilities *net.CapabilityCache
+
+	// Drops replayed and duplicate incoming messages
+	Deduplicator *net.MessageDeduplicator
+
 	// Allow other nodes to push data to this node for storage
 	AcceptStoreRequests bool
 
@@ -104,6 +120,18 @@ type OpenBazaarNode struct {
 	// notify the user as disputes age past certain thresholds
 	RecordAgingNotifier *recordAgingNotifier
 
+	// ListingScheduler is a worker that publishes scheduled listings when
+	// they are due and archives listings once they have expired
+	ListingScheduler *listingScheduler
+
+	// SearchIndexer is a worker that keeps the local search index in sync
+	// with the listings of followed and browsed stores
+	SearchIndexer *searchIndexer
+
+	// StoreAnnouncer publishes updates of our store over pubsub and handles
+	// the announcements of followed stores
+	StoreAnnouncer *storeAnnouncer
+
 	// Generic pubsub interface
 	Pubsub ipfs.Pubsub
 
@@ -147,6 +175,9 @@ func (n *OpenBazaarNode) SeedNode() error {
 	seedLock.Unlock()
 	InitalPublishComplete = true
 	go n.publish(rootHash)
+	if n.StoreAnnouncer != nil {
+		go n.StoreAnnouncer.Announce(rootHash)
+	}
 	return nil
 }
 
@@ -166,11 +197,17 @@ func (n *OpenBazaarNode) publish(hash string) {
 	err := n.sendToPushNodes(hash)
 	if err != nil {
 		log.Error(err)
+		metrics.PublishFailures.Inc()
 		return
 	}
 
 	inflightPublishRequests++
+	start := time.Now()
 	err = ipfs.Publish(n.IpfsNode, hash)
+	metrics.PublishDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.PublishFailures.Inc()
+	}
 
 	inflightPublishRequests--
 	if inflightPublishRequests == 0 {
@@ -190,7 +227,8 @@ func (n *OpenBazaarNode) sendToPushNodes(hash string) error {
 	}
 
 	var graph []cid.Cid
-	if len(n.PushNodes) > 0 {
+	pushNodes := n.PushNodeManager.Active()
+	if len(pushNodes) > 0 {
 		graph, err = ipfs.FetchGraph(n.IpfsNode, id)
 		if err != nil {
 			return err
@@ -214,7 +252,7 @@ func (n *OpenBazaarNode) sendToPushNodes(hash string) error {
 			}
 		}
 	}
-	for _, p := range n.PushNodes {
+	for _, p := range pushNodes {
 		go func(pid peer.ID) {
 			err := n.SendStore(pid.Pretty(), graph)
 			if err != nil {
@@ -240,8 +278,9 @@ func (n *OpenBazaarNode) SetUpRepublisher(interval time.Duration) {
 	}()
 }
 
-/*EncryptMessage This is a placeholder until the libsignal is operational.
-  For now we will just encrypt outgoing offline messages with the long lived identity key.
+/*EncryptMessage Encrypts an offline message with the session for the peer, starting one from
+  the peer's published prekey bundle if needed. The result is encrypted to the peer's identity key
+  which also covers peers without a prekey bundle.
   Optionally you may provide a public key, to avoid doing an IPFS lookup */
 func (n *OpenBazaarNode) EncryptMessage(peerID peer.ID, peerKey *libp2p.PubKey, message []byte) (ct []byte, rerr error) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -265,6 +304,13 @@ func (n *OpenBazaarNode) EncryptMessage(peerID peer.ID, peerKey *libp2p.PubKey,
 		peerKey = &pubKey
 	}
 	if peerID.MatchesPublicKey(*peerKey) {
+		if n.establishSession(peerID) {
+			sessionMessage, err := n.Sessions.Encrypt(peerID, message)
+			if err != nil {
+				return nil, err
+			}
+			message = sessionMessage
+		}
 		ciphertext, err := net.Encrypt(*peerKey, message)
 		if err != nil {
 			return nil, err
@@ -5,39 +5,321 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
 )
 
 var (
 	// ErrPurchaseUnknownListing - unavailable listing err
 	ErrPurchaseUnknownListing = errors.New("order contains a hash of a listing that is not currently for sale")
+	// ErrPurchasePriceTierNotApplied - ordered quantity doesn't meet its price tier err
+	ErrPurchasePriceTierNotApplied = errors.New("ordered quantity does not meet the price tier of the order")
 
 	// ErrListingDoesNotExist - non-existent listing err
 	ErrListingDoesNotExist = errors.New("listing doesn't exist")
 	// ErrListingAlreadyExists - duplicate listing err
 	ErrListingAlreadyExists = errors.New("listing already exists")
+	// ErrListingAlreadyScheduled - duplicate scheduled listing err
+	ErrListingAlreadyScheduled = errors.New("listing is already scheduled")
+	// ErrListingPublishTimeInPast - scheduled publish time is not in the future
+	ErrListingPublishTimeInPast error = FieldError{"publishAt", ErrCodeFieldOutOfRange, "publishAt must be in the future"}
+	// ErrArchivedListingDoesNotExist - non-existent archived listing err
+	ErrArchivedListingDoesNotExist = errors.New("archived listing doesn't exist")
 	// ErrListingCoinDivisibilityIncorrect - coin divisibility err
-	ErrListingCoinDivisibilityIncorrect = errors.New("incorrect coinDivisibility")
+	ErrListingCoinDivisibilityIncorrect error = FieldError{"metadata.coinDivisibility", ErrCodeFieldInvalid, "incorrect coinDivisibility"}
 	// ErrPriceCalculationRequiresExchangeRates - exchange rates dependency err
 	ErrPriceCalculationRequiresExchangeRates = errors.New("can't calculate price with exchange rates disabled")
 
+	// ErrBanAlreadyExists - duplicate ban err
+	ErrBanAlreadyExists = errors.New("peer is already banned with this scope")
+	// ErrBanDoesNotExist - non-existent ban err
+	ErrBanDoesNotExist = errors.New("ban doesn't exist")
+	// ErrBanInvalidScope - unknown ban scope err
+	ErrBanInvalidScope error = FieldError{"scope", ErrCodeFieldInvalid, "scope must be one of all, chat, follow or order"}
+	// ErrBanReasonTooLong - ban reason length err
+	ErrBanReasonTooLong error = FieldError{"reason", ErrCodeFieldTooLong, "reason should be no longer than " + strconv.Itoa(MaxBanReasonLength)}
+	// ErrBanExpiryInPast - ban expiry is not in the future
+	ErrBanExpiryInPast error = FieldError{"expires", ErrCodeFieldOutOfRange, "expires must be in the future"}
+
+	// ErrPeerUnsupportedMessageType - the peer doesn't handle the message type
+	ErrPeerUnsupportedMessageType = errors.New("peer does not support message type")
+	// ErrPeerUnsupportedListingVersion - the peer doesn't understand the listing version
+	ErrPeerUnsupportedListingVersion = errors.New("peer does not support the listing version, it must upgrade to receive this order")
+
+	// ErrInvalidStoreAnnouncement - the announcement is not signed by the store of its topic
+	ErrInvalidStoreAnnouncement = errors.New("invalid store announcement")
+
 	// ErrCryptocurrencyListingCoinTypeRequired - missing coinType err
-	ErrCryptocurrencyListingCoinTypeRequired = errors.New("cryptocurrency listings require a coinType")
+	ErrCryptocurrencyListingCoinTypeRequired error = FieldError{"metadata.coinType", ErrCodeFieldRequired, "cryptocurrency listings require a coinType"}
 	// ErrCryptocurrencyPurchasePaymentAddressRequired - missing payment address err
-	ErrCryptocurrencyPurchasePaymentAddressRequired = errors.New("paymentAddress required for cryptocurrency items")
+	ErrCryptocurrencyPurchasePaymentAddressRequired error = FieldError{"items.paymentAddress", ErrCodeFieldRequired, "paymentAddress required for cryptocurrency items"}
 	// ErrCryptocurrencyPurchasePaymentAddressTooLong - invalid payment address
-	ErrCryptocurrencyPurchasePaymentAddressTooLong = errors.New("paymentAddress required is too long")
+	ErrCryptocurrencyPurchasePaymentAddressTooLong error = FieldError{"items.paymentAddress", ErrCodeFieldTooLong, "paymentAddress required is too long"}
 
 	// ErrCryptocurrencySkuQuantityInvalid - invalid sku qty err
-	ErrCryptocurrencySkuQuantityInvalid = errors.New("cryptocurrency listing quantity must be a non-negative integer")
+	ErrCryptocurrencySkuQuantityInvalid error = FieldError{"item.skus.quantity", ErrCodeFieldOutOfRange, "cryptocurrency listing quantity must be a non-negative integer"}
 
 	// ErrFulfillIncorrectDeliveryType - incorrect delivery type err
 	ErrFulfillIncorrectDeliveryType = errors.New("incorrect delivery type for order")
 	// ErrFulfillCryptocurrencyTXIDNotFound - missing txn id err
-	ErrFulfillCryptocurrencyTXIDNotFound = errors.New("a transactionID is required to fulfill crypto listings")
+	ErrFulfillCryptocurrencyTXIDNotFound error = FieldError{"cryptocurrencyDelivery.transactionID", ErrCodeFieldRequired, "a transactionID is required to fulfill crypto listings"}
 	// ErrFulfillCryptocurrencyTXIDTooLong - invalid txn id err
-	ErrFulfillCryptocurrencyTXIDTooLong = errors.New("transactionID should be no longer than " + strconv.Itoa(MaxTXIDSize))
+	ErrFulfillCryptocurrencyTXIDTooLong error = FieldError{"cryptocurrencyDelivery.transactionID", ErrCodeFieldTooLong, "transactionID should be no longer than " + strconv.Itoa(MaxTXIDSize)}
+)
+
+// Errors of orders found invalid by ValidateOrder. They are sent to the buyer
+// as the reason the order was rejected.
+var (
+	ErrOrderMissingOrder        error = FieldError{"buyerOrder", ErrCodeFieldRequired, "contract doesn't contain an order"}
+	ErrOrderMissingPayment      error = FieldError{"buyerOrder.payment", ErrCodeFieldRequired, "order doesn't contain a payment"}
+	ErrOrderMissingBuyerID      error = FieldError{"buyerOrder.buyerID", ErrCodeFieldRequired, "order doesn't contain a buyer ID"}
+	ErrOrderMissingItems        error = FieldError{"buyerOrder.items", ErrCodeFieldRequired, "order hasn't selected any items"}
+	ErrOrderRatingKeysMismatch  error = FieldError{"buyerOrder.ratingKeys", ErrCodeFieldInvalid, "number of rating keys do not match number of items"}
+	ErrOrderInvalidRatingKey    error = FieldError{"buyerOrder.ratingKeys", ErrCodeFieldInvalid, "invalid rating key in order"}
+	ErrOrderMissingTimestamp    error = FieldError{"buyerOrder.timestamp", ErrCodeFieldRequired, "order is missing a timestamp"}
+	ErrOrderInvalidModerator    error = FieldError{"buyerOrder.payment.moderator", ErrCodeFieldInvalid, "invalid moderator"}
+	ErrOrderListingHashMismatch error = FieldError{"buyerOrder.items.listingHash", ErrCodeFieldInvalid, "item hashes in the order do not match the included listings"}
+	ErrOrderDuplicateCoupon     error = FieldError{"buyerOrder.items.couponCodes", ErrCodeFieldNotUnique, "duplicate coupon code in order"}
+	ErrOrderInvalidVariant      error = FieldError{"buyerOrder.items.options", ErrCodeFieldInvalid, "selected variant not in listing"}
+	ErrOrderMissingOptions      error = FieldError{"buyerOrder.items.options", ErrCodeFieldRequired, "not all options were selected"}
+	ErrOrderInvalidShipping     error = FieldError{"buyerOrder.items.shippingOption.name", ErrCodeFieldInvalid, "shipping option not found in listing"}
+	ErrOrderShippingRegion      error = FieldError{"buyerOrder.shipping.country", ErrCodeFieldInvalid, "listing does ship to selected country"}
+	ErrOrderInvalidService      error = FieldError{"buyerOrder.items.shippingOption.service", ErrCodeFieldInvalid, "shipping service not found in listing"}
+	ErrOrderNoInventory         error = FieldError{"buyerOrder.items.options", ErrCodeFieldInvalid, "vendor has no inventory for the selected variant"}
+	ErrOrderMissingShipping     error = FieldError{"buyerOrder.shipping", ErrCodeFieldRequired, "order is missing shipping object"}
+	ErrOrderMissingAddress      error = FieldError{"buyerOrder.shipping.address", ErrCodeFieldRequired, "shipping address is empty"}
+	ErrOrderMissingShipTo       error = FieldError{"buyerOrder.shipping.shipTo", ErrCodeFieldRequired, "ship to name is empty"}
+	orderErrors                       = []error{
+		ErrOrderMissingOrder, ErrOrderMissingPayment, ErrOrderMissingBuyerID, ErrOrderMissingItems,
+		ErrOrderRatingKeysMismatch, ErrOrderInvalidRatingKey, ErrOrderMissingTimestamp, ErrOrderInvalidModerator,
+		ErrOrderListingHashMismatch, ErrOrderDuplicateCoupon, ErrOrderInvalidVariant, ErrOrderMissingOptions,
+		ErrOrderInvalidShipping, ErrOrderShippingRegion, ErrOrderInvalidService, ErrOrderNoInventory,
+		ErrOrderMissingShipping, ErrOrderMissingAddress, ErrOrderMissingShipTo,
+		ErrPurchaseUnknownListing, ErrPurchasePriceTierNotApplied,
+	}
+)
+
+// ErrorCode is the stable, machine readable code of an error. Clients should
+// match errors by their code, as the reasons may change.
+type ErrorCode string
+
+// Generic codes of errors without a more specific code, by HTTP status
+const (
+	ErrCodeInvalidRequest   ErrorCode = "ERR_INVALID_REQUEST"
+	ErrCodeUnauthorized     ErrorCode = "ERR_UNAUTHORIZED"
+	ErrCodeForbidden        ErrorCode = "ERR_FORBIDDEN"
+	ErrCodeNotFound         ErrorCode = "ERR_NOT_FOUND"
+	ErrCodeMethodNotAllowed ErrorCode = "ERR_METHOD_NOT_ALLOWED"
+	ErrCodeConflict         ErrorCode = "ERR_CONFLICT"
+	ErrCodeTooLarge         ErrorCode = "ERR_REQUEST_TOO_LARGE"
+	ErrCodeRateLimited      ErrorCode = "ERR_RATE_LIMITED"
+	ErrCodeInternal         ErrorCode = "ERR_INTERNAL"
+	ErrCodeNotImplemented   ErrorCode = "ERR_NOT_IMPLEMENTED"
+	ErrCodeUnavailable      ErrorCode = "ERR_UNAVAILABLE"
+	ErrCodeTimeout          ErrorCode = "ERR_TIMEOUT"
 )
 
+// Codes of validation errors. A request with invalid fields fails with
+// ErrCodeValidationFailed and the code of each invalid field.
+const (
+	ErrCodeValidationFailed ErrorCode = "ERR_VALIDATION_FAILED"
+	ErrCodeFieldRequired    ErrorCode = "ERR_FIELD_REQUIRED"
+	ErrCodeFieldTooLong     ErrorCode = "ERR_FIELD_TOO_LONG"
+	ErrCodeFieldTooMany     ErrorCode = "ERR_FIELD_TOO_MANY_ITEMS"
+	ErrCodeFieldInvalid     ErrorCode = "ERR_FIELD_INVALID"
+	ErrCodeFieldNotUnique   ErrorCode = "ERR_FIELD_NOT_UNIQUE"
+	ErrCodeFieldOutOfRange  ErrorCode = "ERR_FIELD_OUT_OF_RANGE"
+	ErrCodeFieldNotAllowed  ErrorCode = "ERR_FIELD_NOT_ALLOWED"
+)
+
+// Codes of specific errors
+const (
+	ErrCodeListingNotFound            ErrorCode = "ERR_LISTING_NOT_FOUND"
+	ErrCodeListingAlreadyExists       ErrorCode = "ERR_LISTING_ALREADY_EXISTS"
+	ErrCodeListingAlreadyScheduled    ErrorCode = "ERR_LISTING_ALREADY_SCHEDULED"
+	ErrCodeArchivedListingNotFound    ErrorCode = "ERR_ARCHIVED_LISTING_NOT_FOUND"
+	ErrCodeExchangeRatesDisabled      ErrorCode = "ERR_EXCHANGE_RATES_DISABLED"
+	ErrCodeBanAlreadyExists           ErrorCode = "ERR_BAN_ALREADY_EXISTS"
+	ErrCodeBanNotFound                ErrorCode = "ERR_BAN_NOT_FOUND"
+	ErrCodeAPITokenAlreadyExists      ErrorCode = "ERR_API_TOKEN_ALREADY_EXISTS"
+	ErrCodeAPITokenNotFound           ErrorCode = "ERR_API_TOKEN_NOT_FOUND"
+	ErrCodePeerUnsupportedMessageType ErrorCode = "ERR_PEER_UNSUPPORTED_MESSAGE_TYPE"
+	ErrCodePeerUnsupportedListing     ErrorCode = "ERR_PEER_UNSUPPORTED_LISTING_VERSION"
+	ErrCodeInvalidStoreAnnouncement   ErrorCode = "ERR_INVALID_STORE_ANNOUNCEMENT"
+	ErrCodeFulfillIncorrectDelivery   ErrorCode = "ERR_FULFILL_INCORRECT_DELIVERY_TYPE"
+	ErrCodePurchaseUnknownListing     ErrorCode = "ERR_PURCHASE_UNKNOWN_LISTING"
+	ErrCodePriceTierNotApplied        ErrorCode = "ERR_PRICE_TIER_NOT_APPLIED"
+	ErrCodeInsufficientInventory      ErrorCode = "ERR_INSUFFICIENT_INVENTORY"
+	ErrCodeOrderRejected              ErrorCode = "ERR_ORDER_REJECTED"
+	ErrCodeInsufficientFunds          ErrorCode = "ERR_INSUFFICIENT_FUNDS"
+	ErrCodeDustAmount                 ErrorCode = "ERR_DUST_AMOUNT"
+	ErrCodeInvalidAddress             ErrorCode = "ERR_INVALID_ADDRESS"
+)
+
+// errorCodes holds the codes of the errors of the catalog which are not
+// validation errors
+var errorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{ErrPurchaseUnknownListing, ErrCodePurchaseUnknownListing},
+	{ErrPurchasePriceTierNotApplied, ErrCodePriceTierNotApplied},
+	{ErrListingDoesNotExist, ErrCodeListingNotFound},
+	{ErrListingAlreadyExists, ErrCodeListingAlreadyExists},
+	{ErrListingAlreadyScheduled, ErrCodeListingAlreadyScheduled},
+	{ErrArchivedListingDoesNotExist, ErrCodeArchivedListingNotFound},
+	{ErrPriceCalculationRequiresExchangeRates, ErrCodeExchangeRatesDisabled},
+	{ErrBanAlreadyExists, ErrCodeBanAlreadyExists},
+	{ErrBanDoesNotExist, ErrCodeBanNotFound},
+	{repo.ErrAPITokenAlreadyExists, ErrCodeAPITokenAlreadyExists},
+	{repo.ErrAPITokenDoesNotExist, ErrCodeAPITokenNotFound},
+	{ErrPeerUnsupportedMessageType, ErrCodePeerUnsupportedMessageType},
+	{ErrPeerUnsupportedListingVersion, ErrCodePeerUnsupportedListing},
+	{ErrInvalidStoreAnnouncement, ErrCodeInvalidStoreAnnouncement},
+	{ErrFulfillIncorrectDeliveryType, ErrCodeFulfillIncorrectDelivery},
+}
+
+// repoFieldErrors holds the invalid fields reported by validation errors of
+// the repo, which can't depend on the catalog
+var repoFieldErrors = []struct {
+	err   error
+	field string
+	code  ErrorCode
+}{
+	{repo.ErrAPITokenInvalidName, "name", ErrCodeFieldInvalid},
+	{repo.ErrAPITokenInvalidScope, "scopes", ErrCodeFieldInvalid},
+	{repo.ErrAPITokenNoScopes, "scopes", ErrCodeFieldRequired},
+	{repo.ErrAPITokenExpiryInPast, "expires", ErrCodeFieldOutOfRange},
+}
+
+// ErrorCodeOf returns the code of an error of the catalog, or an empty code
+// if the error has none
+func ErrorCodeOf(err error) ErrorCode {
+	switch e := err.(type) {
+	case OrderRejectedError:
+		if e.Cause != nil && FieldErrors(e.Cause) == nil {
+			return ErrorCodeOf(e.Cause)
+		}
+		return ErrCodeOrderRejected
+	case ErrOutOfInventory:
+		return ErrorCode(e.Code)
+	}
+	if FieldErrors(err) != nil {
+		return ErrCodeValidationFailed
+	}
+	for _, c := range errorCodes {
+		if c.err == err {
+			return c.code
+		}
+	}
+	return ""
+}
+
+// FieldError is an invalid field of a request. The field is the path of the
+// field in the JSON of the request, such as item.images[0].tiny.
+type FieldError struct {
+	Field  string    `json:"field"`
+	Code   ErrorCode `json:"code"`
+	Reason string    `json:"reason"`
+}
+
+func (e FieldError) Error() string {
+	return e.Reason
+}
+
+// ValidationError holds every invalid field found validating a request
+type ValidationError []FieldError
+
+func (e ValidationError) Error() string {
+	reasons := make([]string, 0, len(e))
+	for _, f := range e {
+		reasons = append(reasons, f.Reason)
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// add records an invalid field
+func (e *ValidationError) add(field string, code ErrorCode, reason string) {
+	*e = append(*e, FieldError{field, code, reason})
+}
+
+// addf records an invalid field with a formatted reason
+func (e *ValidationError) addf(field string, code ErrorCode, format string, a ...interface{}) {
+	e.add(field, code, fmt.Sprintf(format, a...))
+}
+
+// addError records the invalid fields of an error, or the error as an
+// invalid field if it isn't a validation error
+func (e *ValidationError) addError(field string, err error) {
+	if fields := FieldErrors(err); fields != nil {
+		*e = append(*e, fields...)
+		return
+	}
+	e.add(field, ErrCodeFieldInvalid, err.Error())
+}
+
+// err returns the validation error, or nil if every field is valid
+func (e ValidationError) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// FieldErrors returns the invalid fields reported by an error, or nil if it
+// isn't a validation error
+func FieldErrors(err error) []FieldError {
+	switch e := err.(type) {
+	case ValidationError:
+		return e
+	case FieldError:
+		return []FieldError{e}
+	case ErrCryptocurrencyListingIllegalField:
+		return []FieldError{{string(e), ErrCodeFieldNotAllowed, e.Error()}}
+	case ErrCryptocurrencyPurchaseIllegalField:
+		return []FieldError{{string(e), ErrCodeFieldNotAllowed, e.Error()}}
+	case ErrMarketPriceListingIllegalField:
+		return []FieldError{{string(e), ErrCodeFieldNotAllowed, e.Error()}}
+	case ErrPriceModifierOutOfRange:
+		return []FieldError{{"metadata.priceModifier", ErrCodeFieldOutOfRange, e.Error()}}
+	case OrderRejectedError:
+		if e.Cause != nil {
+			return FieldErrors(e.Cause)
+		}
+	}
+	for _, f := range repoFieldErrors {
+		if f.err == err {
+			return []FieldError{{f.field, f.code, err.Error()}}
+		}
+	}
+	return nil
+}
+
+// OrderRejectedError is returned when a vendor rejects an order. The cause
+// is the error of the catalog matching the reason of the vendor, if any.
+type OrderRejectedError struct {
+	Reason string
+	Cause  error
+}
+
+func (e OrderRejectedError) Error() string {
+	return fmt.Sprintf("vendor rejected order, reason: %s", e.Reason)
+}
+
+// newOrderRejectedError returns the error of an order rejected by the vendor
+// for the reason
+func newOrderRejectedError(reason string) OrderRejectedError {
+	rejection := OrderRejectedError{Reason: reason}
+	for _, err := range orderErrors {
+		if err.Error() == reason {
+			rejection.Cause = err
+			break
+		}
+	}
+	return rejection
+}
+
 // CodedError is an error that is machine readable
 type CodedError struct {
 	Reason string `json:"reason,omitempty"`
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+)
+
+func TestValidateListingReportsEveryInvalidField(t *testing.T) {
+	listing := factory.NewListing("bad slug")
+	listing.Item.Title = ""
+	listing.Item.Images[0].Tiny = "not a cid"
+	listing.Item.PriceTiers = []*pb.Listing_Item_PriceTier{
+		{MinQuantity: 100, Price: 10},
+		{MinQuantity: 10, Price: 11},
+	}
+
+	err := validateListing(listing, true)
+	if ErrorCodeOf(err) != ErrCodeValidationFailed {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	fields := make(map[string]ErrorCode)
+	for _, f := range FieldErrors(err) {
+		fields[f.Field] = f.Code
+	}
+	expected := map[string]ErrorCode{
+		"slug":                           ErrCodeFieldInvalid,
+		"item.title":                     ErrCodeFieldRequired,
+		"item.images[0].tiny":            ErrCodeFieldInvalid,
+		"item.priceTiers[1].minQuantity": ErrCodeFieldOutOfRange,
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected invalid fields %v, got %v", expected, fields)
+	}
+}
+
+func TestErrorCodeOf(t *testing.T) {
+	tests := []struct {
+		err  error
+		code ErrorCode
+	}{
+		{errors.New("unknown"), ""},
+		{ErrListingDoesNotExist, ErrCodeListingNotFound},
+		{ErrBanAlreadyExists, ErrCodeBanAlreadyExists},
+		{ErrBanInvalidScope, ErrCodeValidationFailed},
+		{repo.ErrAPITokenNoScopes, ErrCodeValidationFailed},
+		{ErrCryptocurrencyListingIllegalField("item.condition"), ErrCodeValidationFailed},
+		{NewErrOutOfInventory(1), ErrCodeInsufficientInventory},
+		{newOrderRejectedError(ErrPurchaseUnknownListing.Error()), ErrCodePurchaseUnknownListing},
+		{newOrderRejectedError(ErrOrderMissingShipTo.Error()), ErrCodeOrderRejected},
+		{newOrderRejectedError("out of stock"), ErrCodeOrderRejected},
+	}
+	for _, tt := range tests {
+		if code := ErrorCodeOf(tt.err); code != tt.code {
+			t.Errorf("expected code %q for %q, got %q", tt.code, tt.err, code)
+		}
+	}
+}
+
+func TestOrderRejectedErrorFields(t *testing.T) {
+	err := newOrderRejectedError(ErrOrderMissingShipTo.Error())
+	if err.Error() != "vendor rejected order, reason: "+ErrOrderMissingShipTo.Error() {
+		t.Errorf("unexpected reason %q", err.Error())
+	}
+	fields := FieldErrors(err)
+	if len(fields) != 1 || fields[0] != ErrOrderMissingShipTo {
+		t.Errorf("expected the invalid field of the rejection, got %v", fields)
+	}
+}
@@ -2,19 +2,16 @@ package core
 
 import (
 	"encoding/csv"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/url"
-	"os"
-	"path"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 
 	"github.com/OpenBazaar/openbazaar-go/pb"
@@ -22,655 +19,479 @@ import (
 
 const bufferSize = 5
 
-// ImportListings - upload/read listings
-func (n *OpenBazaarNode) ImportListings(r io.ReadCloser) error {
+// Import formats understood by ImportListings
+const (
+	ImportFormatOpenBazaar  = "openbazaar"
+	ImportFormatShopify     = "shopify"
+	ImportFormatWooCommerce = "woocommerce"
+	ImportFormatEtsy        = "etsy"
+)
+
+// Import record statuses
+const (
+	ImportStatusConverted = "converted"
+	ImportStatusSkipped   = "skipped"
+)
+
+// ImportOptions - options for ImportListings
+type ImportOptions struct {
+	// Format of the csv file, detected from the header when empty
+	Format string
+
+	// PricingCurrency is used for formats which don't include a currency,
+	// defaults to the local currency from the settings
+	PricingCurrency string
+
+	// ShippingOptions are added to physical goods imported from formats
+	// which don't include shipping information
+	ShippingOptions []*pb.Listing_ShippingOption
+}
+
+// ImportRecord - the outcome of importing the listing built from the given
+// csv rows. Rows are numbered from one, not counting the header.
+type ImportRecord struct {
+	Rows   []int  `json:"rows"`
+	Title  string `json:"title,omitempty"`
+	Slug   string `json:"slug,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ImportReport - the result of ImportListings
+type ImportReport struct {
+	Format    string         `json:"format"`
+	Converted int            `json:"converted"`
+	Skipped   int            `json:"skipped"`
+	Records   []ImportRecord `json:"records"`
+}
+
+// importRow is a single csv record along with its row number
+type importRow struct {
+	number int
+	fields map[string]int
+	record []string
+}
+
+func (r importRow) has(column string) bool {
+	_, ok := r.fields[column]
+	return ok
+}
+
+func (r importRow) get(column string) string {
+	pos, ok := r.fields[column]
+	if !ok || pos >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[pos])
+}
+
+// importedListing is a listing converted from one or more csv rows whose
+// images still need to be fetched
+type importedListing struct {
+	rows    []int
+	listing *pb.Listing
+	images  []string
+	err     error
+}
+
+// ImportListings - read listings from a csv file in one of the supported
+// formats. Rows which can't be converted are skipped and reported rather than
+// aborting the import.
+func (n *OpenBazaarNode) ImportListings(r io.ReadCloser, options ImportOptions) (*ImportReport, error) {
 	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
 	columns, err := reader.Read()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	fields := make(map[string]int)
 	for i, c := range columns {
-		fields[strings.ToLower(c)] = i
+		fields[strings.ToLower(strings.TrimSpace(c))] = i
+	}
+
+	format := strings.ToLower(options.Format)
+	if format == "" {
+		format = detectImportFormat(fields)
+	}
+	currency := strings.ToUpper(options.PricingCurrency)
+	if currency == "" {
+		currency = n.defaultImportCurrency()
 	}
 
-	done := make(chan struct{})
-	buf := make(chan struct{}, bufferSize)
-	errChan := make(chan error, bufferSize)
+	var (
+		rows     []importRow
+		imported []importedListing
+	)
+	for i := 1; ; i++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			imported = append(imported, importedListing{rows: []int{i}, err: err})
+			continue
+		}
+		rows = append(rows, importRow{number: i, fields: fields, record: record})
+	}
 
-	countLock := new(sync.Mutex)
-	count := 0
+	switch format {
+	case ImportFormatOpenBazaar:
+		imported = append(imported, importOpenBazaarRows(rows)...)
+	case ImportFormatShopify:
+		imported = append(imported, importShopifyRows(rows, currency, options.ShippingOptions)...)
+	case ImportFormatWooCommerce:
+		imported = append(imported, importWooCommerceRows(rows, currency, options.ShippingOptions)...)
+	case ImportFormatEtsy:
+		imported = append(imported, importEtsyRows(rows, options.ShippingOptions)...)
+	default:
+		return nil, fmt.Errorf("Unknown import format %s", format)
+	}
 
-	var ld []ListingData
-	indexLock := new(sync.Mutex)
-	wg := new(sync.WaitGroup)
+	report := &ImportReport{Format: format, Records: make([]ImportRecord, len(imported))}
+	usedSlugs := make(map[string]bool)
+	for i, imp := range imported {
+		report.Records[i] = ImportRecord{Rows: imp.rows, Status: ImportStatusSkipped}
+		if imp.err != nil {
+			report.Records[i].Error = imp.err.Error()
+			continue
+		}
+		report.Records[i].Title = imp.listing.Item.Title
+		slug, err := n.generateImportSlug(imp.listing.Item.Title, usedSlugs)
+		if err != nil {
+			imported[i].err = err
+			report.Records[i].Error = err.Error()
+			continue
+		}
+		imp.listing.Slug = slug
+		report.Records[i].Slug = slug
+	}
 
-listingLoop:
-	for {
-		select {
-		case err := <-errChan:
-			return err
-		case <-done:
-			break listingLoop
-		default:
+	var (
+		lock sync.Mutex
+		wg   sync.WaitGroup
+		buf  = make(chan struct{}, bufferSize)
+	)
+	for i := range imported {
+		if imported[i].err != nil {
+			continue
 		}
+		wg.Add(1)
 		buf <- struct{}{}
-		go func() {
+		go func(i int) {
 			defer func() {
 				<-buf
+				wg.Done()
 			}()
+			err := n.saveImportedListing(imported[i], &lock)
 
-			countLock.Lock()
-			i := count
-			record, err := reader.Read()
-			count++
-			countLock.Unlock()
-			if err == io.EOF {
-				done <- struct{}{}
-				return
-			}
+			lock.Lock()
+			defer lock.Unlock()
 			if err != nil {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
+				report.Records[i].Error = err.Error()
 				return
 			}
+			report.Records[i].Status = ImportStatusConverted
+		}(i)
+	}
+	wg.Wait()
 
-			wg.Add(1)
+	for _, record := range report.Records {
+		if record.Status == ImportStatusConverted {
+			report.Converted++
+		} else {
+			report.Skipped++
+		}
+	}
+	return report, nil
+}
 
-			listing := new(pb.Listing)
-			metadata := new(pb.Listing_Metadata)
-			item := new(pb.Listing_Item)
-			shipping := []*pb.Listing_ShippingOption{}
-			listing.Metadata = metadata
-			listing.Item = item
-			listing.ShippingOptions = shipping
-
-			pos, ok := fields["contract_type"]
-			if ok {
-				e, ok := pb.Listing_Metadata_ContractType_value[strings.ToUpper(record[pos])]
-				if ok {
-					listing.Metadata.ContractType = pb.Listing_Metadata_ContractType(e)
-				}
-			}
-			pos, ok = fields["format"]
-			if ok {
-				e, ok := pb.Listing_Metadata_Format_value[strings.ToUpper(record[pos])]
-				if ok {
-					listing.Metadata.Format = pb.Listing_Metadata_Format(e)
-				}
-			}
-			pos, ok = fields["expiry"]
-			if ok {
-				t, err := time.Parse(time.RFC3339, record[pos])
-				if err != nil {
-					errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-					return
-				}
-				ts, err := ptypes.TimestampProto(t)
-				if err != nil {
-					errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-					return
-				}
-				listing.Metadata.Expiry = ts
-			} else {
-				t, err := time.Parse(time.RFC3339, "2037-12-31T05:00:00.000Z")
-				if err != nil {
-					errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-					return
-				}
-				ts, err := ptypes.TimestampProto(t)
-				if err != nil {
-					errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-					return
-				}
-				listing.Metadata.Expiry = ts
-			}
-			pos, ok = fields["pricing_currency"]
-			if !ok {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, "pricing_currency is a mandatory field")
-				return
+func detectImportFormat(fields map[string]int) string {
+	has := func(columns ...string) bool {
+		for _, c := range columns {
+			if _, ok := fields[c]; !ok {
+				return false
 			}
-			listing.Metadata.PricingCurrency = strings.ToUpper(record[pos])
-			pos, ok = fields["language"]
-			if ok {
-				listing.Metadata.Language = record[pos]
-			}
-			pos, ok = fields["title"]
-			if !ok {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, "title is a mandatory field")
-				return
-			}
-			listing.Item.Title = record[pos]
+		}
+		return true
+	}
+	switch {
+	case has("handle", "variant price"):
+		return ImportFormatShopify
+	case has("type", "name", "regular price"):
+		return ImportFormatWooCommerce
+	case has("title", "price", "currency_code", "image1"):
+		return ImportFormatEtsy
+	default:
+		return ImportFormatOpenBazaar
+	}
+}
 
-			listing.Slug, err = n.GenerateSlug(listing.Item.Title)
-			if err != nil {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-				return
-			}
+func (n *OpenBazaarNode) defaultImportCurrency() string {
+	sd, err := n.Datastore.Settings().Get()
+	if err == nil && sd.LocalCurrency != nil && *sd.LocalCurrency != "" {
+		return strings.ToUpper(*sd.LocalCurrency)
+	}
+	return "USD"
+}
 
-			pos, ok = fields["description"]
-			if ok {
-				listing.Item.Description = record[pos]
-			}
-			pos, ok = fields["processing_time"]
-			if ok {
-				listing.Item.ProcessingTime = record[pos]
-			}
-			pos, ok = fields["price"]
-			if !ok {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, "price is a mandatory field")
-				return
-			}
-			if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-				f, err := strconv.ParseFloat(record[pos], 64)
-				if err != nil {
-					errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-					return
-				}
-				listing.Item.Price = uint64(f * 100)
-			} else {
-				listing.Item.Price, err = strconv.ParseUint(record[pos], 10, 64)
-				if err != nil {
-					errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-					return
-				}
-			}
-			pos, ok = fields["nsfw"]
-			if ok {
-				listing.Item.Nsfw, err = strconv.ParseBool(record[pos])
-				if err != nil {
-					errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-					return
-				}
-			}
-			pos, ok = fields["tags"]
-			if ok {
-				listing.Item.Tags = strings.Split(record[pos], ",")
-			}
-			pos, ok = fields["image_urls"]
-			if ok {
-				listing.Item.Images = []*pb.Listing_Item_Image{}
-				imageUrls := strings.Split(record[pos], ",")
-				var l sync.Mutex
-				var wg sync.WaitGroup
-				for x, img := range imageUrls {
-					wg.Add(1)
-					go func(x int, img string) {
-						defer wg.Done()
-						var b64 string
-						var filename string
-						testURL, err := url.Parse(img)
-						if err == nil && (testURL.Scheme == "http" || testURL.Scheme == "https") {
-							b64, filename, err = n.GetBase64Image(img)
-							if err != nil {
-								errChan <- fmt.Errorf("Error in record %d: image %d failed to download", i, x)
-								return
-							}
-						} else {
-							filename = listing.Slug + "_" + strconv.Itoa(x)
-							b64 = img
-						}
-						images, err := n.SetProductImages(b64, filename)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: image %d invalid", i, x)
-							return
-						}
-						imgpb := &pb.Listing_Item_Image{
-							Filename: filename,
-							Tiny:     images.Tiny,
-							Small:    images.Small,
-							Medium:   images.Medium,
-							Large:    images.Large,
-							Original: images.Original,
-						}
-						l.Lock()
-						listing.Item.Images = append(listing.Item.Images, imgpb)
-						l.Unlock()
-					}(x, img)
-				}
-				wg.Wait()
-			}
-			pos, ok = fields["categories"]
-			if ok {
+// generateImportSlug generates a slug which is unique among existing
+// listings as well as the listings of the current import
+func (n *OpenBazaarNode) generateImportSlug(title string, used map[string]bool) (string, error) {
+	slug, err := n.GenerateSlug(title)
+	if err != nil {
+		return "", err
+	}
+	base := slug
+	for counter := 1; used[slug]; counter++ {
+		slug = base + "-" + strconv.Itoa(counter)
+	}
+	used[slug] = true
+	return slug, nil
+}
 
-				cats := strings.Split(record[pos], ",")
-				for _, cat := range cats {
-					if cat != "" {
-						listing.Item.Categories = append(listing.Item.Categories, cat)
-					}
-				}
-			}
-			pos, ok = fields["condition"]
-			if ok {
-				listing.Item.Condition = record[pos]
-			}
-			quantityPos, quantityOK := fields["quantity"]
-			skuPos, skuOK := fields["sku_number"]
-			listing.Item.Skus = []*pb.Listing_Item_Sku{}
-			if quantityOK || skuOK {
-				sku := new(pb.Listing_Item_Sku)
-				sku.ProductID = record[skuPos]
-				if quantityOK {
-					quantity, err := strconv.ParseInt(record[quantityPos], 10, 64)
+// saveImportedListing fetches the listing images, then writes the listing
+// and adds it to the index while holding the lock, as the index is shared
+// by the listings of the import
+func (n *OpenBazaarNode) saveImportedListing(imp importedListing, lock *sync.Mutex) error {
+	listing := imp.listing
+	if len(imp.images) > 0 {
+		images := make([]*pb.Listing_Item_Image, len(imp.images))
+		errs := make([]error, len(imp.images))
+		var wg sync.WaitGroup
+		for x, img := range imp.images {
+			wg.Add(1)
+			go func(x int, img string) {
+				defer wg.Done()
+				var b64 string
+				var filename string
+				testURL, err := url.Parse(img)
+				if err == nil && (testURL.Scheme == "http" || testURL.Scheme == "https") {
+					b64, filename, err = n.GetBase64Image(img)
 					if err != nil {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
+						errs[x] = fmt.Errorf("image %d failed to download", x)
 						return
 					}
-					sku.Quantity = quantity
-				}
-				listing.Item.Skus = append(listing.Item.Skus, sku)
-			}
-			listing.ShippingOptions = []*pb.Listing_ShippingOption{}
-			pos, ok = fields["shipping_option1_name"]
-			if ok && record[pos] != "" {
-				so := new(pb.Listing_ShippingOption)
-				so.Name = record[pos]
-				so.Type = pb.Listing_ShippingOption_FIXED_PRICE
-				so.Regions = []pb.CountryCode{}
-				so.Services = []*pb.Listing_ShippingOption_Service{}
-				pos, ok = fields["shipping_option1_countries"]
-				if ok {
-					countries := strings.Split(record[pos], ",")
-					for _, c := range countries {
-						e, ok := pb.CountryCode_value[strings.ToUpper(c)]
-						if ok {
-							so.Regions = append(so.Regions, pb.CountryCode(e))
-						}
-					}
 				} else {
-					so.Regions = append(so.Regions, pb.CountryCode_ALL)
-				}
-				pos, ok = fields["shipping_option1_service1_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option1_service1_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option1_service1_estimated_price"]
-					if !ok {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, "shipping_option1_service1_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
-				}
-				pos, ok = fields["shipping_option1_service2_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option1_service2_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option1_service2_estimated_price"]
-					if !ok {
-						errChan <- errors.New("shipping_option1_service2_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
-				}
-				pos, ok = fields["shipping_option1_service3_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option1_service3_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option1_service3_estimated_price"]
-					if !ok {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, "shipping_option1_service3_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
+					filename = listing.Slug + "_" + strconv.Itoa(x)
+					b64 = img
 				}
-				listing.ShippingOptions = append(listing.ShippingOptions, so)
-			}
-			pos, ok = fields["shipping_option2_name"]
-			if ok && record[pos] != "" {
-				so := new(pb.Listing_ShippingOption)
-				so.Name = record[pos]
-				so.Type = pb.Listing_ShippingOption_FIXED_PRICE
-				so.Regions = []pb.CountryCode{}
-				so.Services = []*pb.Listing_ShippingOption_Service{}
-				pos, ok = fields["shipping_option2_countries"]
-				if ok {
-					countries := strings.Split(record[pos], ",")
-					for _, c := range countries {
-						e, ok := pb.CountryCode_value[strings.ToUpper(c)]
-						if ok {
-							so.Regions = append(so.Regions, pb.CountryCode(e))
-						}
-					}
-				} else {
-					so.Regions = append(so.Regions, pb.CountryCode_ALL)
-				}
-				pos, ok = fields["shipping_option2_service1_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option2_service1_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option2_service1_estimated_price"]
-					if !ok {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, "shipping_option2_service1_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
-				}
-				pos, ok = fields["shipping_option2_service2_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option2_service2_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option2_service2_estimated_price"]
-					if !ok {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, "shipping_option2_service2_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
-				}
-				pos, ok = fields["shipping_option2_service3_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option2_service3_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option2_service3_estimated_price"]
-					if !ok {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, "shipping_option2_service3_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
-				}
-				listing.ShippingOptions = append(listing.ShippingOptions, so)
-			}
-			pos, ok = fields["shipping_option3_name"]
-			if ok && record[pos] != "" {
-				so := new(pb.Listing_ShippingOption)
-				so.Name = record[pos]
-				so.Type = pb.Listing_ShippingOption_FIXED_PRICE
-				so.Regions = []pb.CountryCode{}
-				so.Services = []*pb.Listing_ShippingOption_Service{}
-				pos, ok = fields["shipping_option3_countries"]
-				if ok {
-					countries := strings.Split(record[pos], ",")
-					for _, c := range countries {
-						e, ok := pb.CountryCode_value[strings.ToUpper(c)]
-						if ok {
-							so.Regions = append(so.Regions, pb.CountryCode(e))
-						}
-					}
-				} else {
-					so.Regions = append(so.Regions, pb.CountryCode_ALL)
-				}
-				pos, ok = fields["shipping_option3_service1_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option3_service1_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option3_service1_estimated_price"]
-					if !ok {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, "shipping_option3_service1_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
-				}
-				pos, ok = fields["shipping_option3_service2_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option3_service2_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option3_service2_estimated_price"]
-					if !ok {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, "shipping_option1_service2_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
-				}
-				pos, ok = fields["shipping_option3_service3_name"]
-				if ok && record[pos] != "" {
-					service := new(pb.Listing_ShippingOption_Service)
-					service.Name = record[pos]
-					pos, ok = fields["shipping_option3_service3_estimated_delivery"]
-					if ok {
-						service.EstimatedDelivery = record[pos]
-					}
-					pos, ok = fields["shipping_option3_service3_estimated_price"]
-					if !ok {
-						errChan <- fmt.Errorf("Error in record %d: %s", i, "shipping_option3_service3_estimated_price is a mandatory field")
-						return
-					}
-					if NormalizeCurrencyCode(listing.Metadata.PricingCurrency) != "BTC" {
-						f, err := strconv.ParseFloat(record[pos], 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-						service.Price = uint64(f * 100)
-					} else {
-						service.Price, err = strconv.ParseUint(record[pos], 10, 64)
-						if err != nil {
-							errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-							return
-						}
-					}
-					so.Services = append(so.Services, service)
+				hashes, err := n.SetProductImages(b64, filename)
+				if err != nil {
+					errs[x] = fmt.Errorf("image %d invalid", x)
+					return
 				}
-				listing.ShippingOptions = append(listing.ShippingOptions, so)
-			}
-			// Set moderators
-			if len(listing.Moderators) == 0 {
-				sd, err := n.Datastore.Settings().Get()
-				if err == nil && sd.StoreModerators != nil {
-					listing.Moderators = *sd.StoreModerators
+				images[x] = &pb.Listing_Item_Image{
+					Filename: filename,
+					Tiny:     hashes.Tiny,
+					Small:    hashes.Small,
+					Medium:   hashes.Medium,
+					Large:    hashes.Large,
+					Original: hashes.Original,
 				}
-			}
-
-			// Set inventory
-			err = n.SetListingInventory(listing)
+			}(x, img)
+		}
+		wg.Wait()
+		for _, err := range errs {
 			if err != nil {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-				return
+				return err
 			}
+		}
+		listing.Item.Images = images
+	}
 
-			// Sign listing
-			signedListing, err := n.SignListing(listing)
-			if err != nil {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-				return
-			}
+	lock.Lock()
+	defer lock.Unlock()
+	return n.writeListing(listing)
+}
 
-			// Save to disk
-			listingPath := path.Join(n.RepoPath, "root", "listings", signedListing.Listing.Slug+".json")
-			f, err := os.Create(listingPath)
-			if err != nil {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-				return
-			}
-			defer f.Close()
-			m := jsonpb.Marshaler{
-				EnumsAsInts:  false,
-				EmitDefaults: false,
-				Indent:       "    ",
-				OrigName:     false,
-			}
-			out, err := m.MarshalToString(signedListing)
-			if err != nil {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-				return
-			}
+// newImportListing returns an empty listing with the defaults shared by
+// every import format
+func newImportListing(currency string) (*pb.Listing, error) {
+	t, err := time.Parse(time.RFC3339, "2037-12-31T05:00:00.000Z")
+	if err != nil {
+		return nil, err
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Listing{
+		Metadata: &pb.Listing_Metadata{
+			Expiry:          ts,
+			PricingCurrency: strings.ToUpper(currency),
+		},
+		Item:            &pb.Listing_Item{Skus: []*pb.Listing_Item_Sku{}},
+		ShippingOptions: []*pb.Listing_ShippingOption{},
+	}, nil
+}
 
-			if _, err := f.WriteString(out); err != nil {
-				errChan <- fmt.Errorf("Error in record %d: %s", i, err.Error())
-				return
-			}
+// setImportShippingOptions adds copies of the shipping options to physical
+// goods
+func setImportShippingOptions(listing *pb.Listing, shippingOptions []*pb.Listing_ShippingOption) {
+	if listing.Metadata.ContractType != pb.Listing_Metadata_PHYSICAL_GOOD {
+		return
+	}
+	for _, so := range shippingOptions {
+		listing.ShippingOptions = append(listing.ShippingOptions, proto.Clone(so).(*pb.Listing_ShippingOption))
+	}
+}
 
-			// Add listing data
-			data, err := n.extractListingData(signedListing)
-			if err != nil {
-				errChan <- fmt.Errorf("Error extractinng listings: %s", err.Error())
-				return
-			}
+// importWholeUnitCurrencies are the fiat currencies without minor units
+var importWholeUnitCurrencies = map[string]bool{
+	"BIF": true, "CLP": true, "DJF": true, "GNF": true, "ISK": true, "JPY": true,
+	"KMF": true, "KRW": true, "PYG": true, "RWF": true, "UGX": true, "UYI": true,
+	"VND": true, "VUV": true, "XAF": true, "XOF": true, "XPF": true,
+}
 
-			indexLock.Lock()
-			ld = append(ld, data)
-			indexLock.Unlock()
-			wg.Done()
-		}()
+// parseImportPrice parses a decimal price into hundredths of the currency,
+// the unit of every fiat price, rounded to the minor units of the currency.
+// Bitcoin prices are expected in satoshi.
+func parseImportPrice(s string, currency string) (uint64, error) {
+	if NormalizeCurrencyCode(currency) == "BTC" {
+		return strconv.ParseUint(s, 10, 64)
 	}
-	wg.Wait()
-	select {
-	case err := <-errChan:
-		return err
-	default:
-	}
-	index, err := n.getListingIndex()
+	f, err := strconv.ParseFloat(s, 64)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	if f < 0 {
+		return 0, fmt.Errorf("invalid price %s", s)
+	}
+	if importWholeUnitCurrencies[NormalizeCurrencyCode(currency)] {
+		return uint64(math.Round(f)) * 100, nil
+	}
+	return uint64(math.Round(f * 100)), nil
+}
+
+// splitImportList splits a comma separated list, dropping empty entries
+func splitImportList(s string) []string {
+	var ret []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			ret = append(ret, e)
+		}
 	}
-	index = append(index, ld...)
+	return ret
+}
+
+func importOpenBazaarRows(rows []importRow) []importedListing {
+	var ret []importedListing
+	for _, row := range rows {
+		listing, images, err := openBazaarListingFromRow(row)
+		ret = append(ret, importedListing{rows: []int{row.number}, listing: listing, images: images, err: err})
+	}
+	return ret
+}
 
-	// Write it back to file
-	indexPath := path.Join(n.RepoPath, "root", "listings.json")
-	f, err := os.Create(indexPath)
+func openBazaarListingFromRow(row importRow) (*pb.Listing, []string, error) {
+	if !row.has("pricing_currency") {
+		return nil, nil, fmt.Errorf("%s is a mandatory field", "pricing_currency")
+	}
+	listing, err := newImportListing(row.get("pricing_currency"))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	defer f.Close()
+	currency := listing.Metadata.PricingCurrency
 
-	j, jerr := json.MarshalIndent(index, "", "    ")
-	if jerr != nil {
-		return jerr
+	if e, ok := pb.Listing_Metadata_ContractType_value[strings.ToUpper(row.get("contract_type"))]; ok {
+		listing.Metadata.ContractType = pb.Listing_Metadata_ContractType(e)
 	}
-	_, werr := f.Write(j)
-	if werr != nil {
-		return werr
+	if e, ok := pb.Listing_Metadata_Format_value[strings.ToUpper(row.get("format"))]; ok {
+		listing.Metadata.Format = pb.Listing_Metadata_Format(e)
 	}
-	return nil
+	if row.has("expiry") {
+		t, err := time.Parse(time.RFC3339, row.get("expiry"))
+		if err != nil {
+			return nil, nil, err
+		}
+		listing.Metadata.Expiry, err = ptypes.TimestampProto(t)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	listing.Metadata.Language = row.get("language")
+
+	if !row.has("title") {
+		return nil, nil, fmt.Errorf("%s is a mandatory field", "title")
+	}
+	listing.Item.Title = row.get("title")
+	listing.Item.Description = row.get("description")
+	listing.Item.ProcessingTime = row.get("processing_time")
+	if !row.has("price") {
+		return nil, nil, fmt.Errorf("%s is a mandatory field", "price")
+	}
+	listing.Item.Price, err = parseImportPrice(row.get("price"), currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	if row.has("nsfw") {
+		listing.Item.Nsfw, err = strconv.ParseBool(row.get("nsfw"))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if row.get("grams") != "" {
+		grams, err := strconv.ParseFloat(row.get("grams"), 32)
+		if err != nil {
+			return nil, nil, err
+		}
+		listing.Item.Grams = float32(grams)
+	}
+	listing.Item.Tags = splitImportList(row.get("tags"))
+	listing.Item.Categories = splitImportList(row.get("categories"))
+	listing.Item.Condition = row.get("condition")
+	if row.has("quantity") || row.has("sku_number") {
+		sku := &pb.Listing_Item_Sku{ProductID: row.get("sku_number")}
+		if row.has("quantity") {
+			sku.Quantity, err = strconv.ParseInt(row.get("quantity"), 10, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		listing.Item.Skus = append(listing.Item.Skus, sku)
+	}
+
+	for o := 1; o <= 3; o++ {
+		prefix := fmt.Sprintf("shipping_option%d_", o)
+		if row.get(prefix+"name") == "" {
+			continue
+		}
+		so := &pb.Listing_ShippingOption{
+			Name:     row.get(prefix + "name"),
+			Type:     pb.Listing_ShippingOption_FIXED_PRICE,
+			Regions:  []pb.CountryCode{},
+			Services: []*pb.Listing_ShippingOption_Service{},
+		}
+		if row.has(prefix + "countries") {
+			for _, c := range strings.Split(row.get(prefix+"countries"), ",") {
+				if e, ok := pb.CountryCode_value[strings.ToUpper(strings.TrimSpace(c))]; ok {
+					so.Regions = append(so.Regions, pb.CountryCode(e))
+				}
+			}
+		} else {
+			so.Regions = append(so.Regions, pb.CountryCode_ALL)
+		}
+		for s := 1; s <= 3; s++ {
+			servicePrefix := fmt.Sprintf("%sservice%d_", prefix, s)
+			if row.get(servicePrefix+"name") == "" {
+				continue
+			}
+			service := &pb.Listing_ShippingOption_Service{
+				Name:              row.get(servicePrefix + "name"),
+				EstimatedDelivery: row.get(servicePrefix + "estimated_delivery"),
+			}
+			if !row.has(servicePrefix + "estimated_price") {
+				return nil, nil, fmt.Errorf("%s is a mandatory field", servicePrefix+"estimated_price")
+			}
+			service.Price, err = parseImportPrice(row.get(servicePrefix+"estimated_price"), currency)
+			if err != nil {
+				return nil, nil, err
+			}
+			so.Services = append(so.Services, service)
+		}
+		listing.ShippingOptions = append(listing.ShippingOptions, so)
+	}
+
+	return listing, splitImportList(row.get("image_urls")), nil
 }
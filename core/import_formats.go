@@ -0,0 +1,469 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// importVariant is a single purchasable variant of a product in a foreign
+// export format
+type importVariant struct {
+	values    []string // The value of each option, in the order of the option names
+	productID string
+	price     uint64
+	quantity  int64
+	grams     float32
+}
+
+// setImportVariants maps the variants of a product onto the options and skus
+// of the listing. The cheapest variant sets the item price and the others are
+// priced through sku surcharges. Options which have the same value for every
+// variant are dropped as options must have at least two variants.
+func setImportVariants(listing *pb.Listing, optionNames []string, variants []importVariant) error {
+	if len(variants) == 0 {
+		return errors.New("product has no variants")
+	}
+	listing.Item.Price = variants[0].price
+	for _, v := range variants {
+		if v.price < listing.Item.Price {
+			listing.Item.Price = v.price
+		}
+		if listing.Item.Grams == 0 {
+			listing.Item.Grams = v.grams
+		}
+	}
+
+	var optionIndexes []int
+	for i, name := range optionNames {
+		if name == "" {
+			continue
+		}
+		option := &pb.Listing_Item_Option{Name: name}
+		seen := make(map[string]bool)
+		for _, v := range variants {
+			if i >= len(v.values) || v.values[i] == "" {
+				return fmt.Errorf("variant is missing a value for %s", name)
+			}
+			if !seen[v.values[i]] {
+				seen[v.values[i]] = true
+				option.Variants = append(option.Variants, &pb.Listing_Item_Option_Variant{Name: v.values[i]})
+			}
+		}
+		if len(option.Variants) < 2 {
+			continue
+		}
+		listing.Item.Options = append(listing.Item.Options, option)
+		optionIndexes = append(optionIndexes, i)
+	}
+	if len(listing.Item.Options) == 0 && len(variants) > 1 {
+		return errors.New("product variants do not differ in any option")
+	}
+
+	combos := make(map[string]bool)
+	for _, v := range variants {
+		sku := &pb.Listing_Item_Sku{
+			ProductID: v.productID,
+			Surcharge: int64(v.price) - int64(listing.Item.Price),
+			Quantity:  v.quantity,
+		}
+		for o, i := range optionIndexes {
+			for x, variant := range listing.Item.Options[o].Variants {
+				if variant.Name == v.values[i] {
+					sku.VariantCombo = append(sku.VariantCombo, uint32(x))
+				}
+			}
+		}
+		key := fmt.Sprint(sku.VariantCombo)
+		if combos[key] {
+			return errors.New("product has duplicate variants")
+		}
+		combos[key] = true
+		listing.Item.Skus = append(listing.Item.Skus, sku)
+	}
+	return nil
+}
+
+// parseImportQuantity parses an inventory count, an empty count means the
+// inventory isn't tracked
+func parseImportQuantity(s string) (int64, error) {
+	if s == "" {
+		return -1, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// parseImportGrams converts a weight in the given unit into grams
+func parseImportGrams(s string, unit string) (float32, error) {
+	if s == "" {
+		return 0, nil
+	}
+	w, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	switch strings.ToLower(unit) {
+	case "g", "":
+	case "kg":
+		w *= 1000
+	case "lb", "lbs":
+		w *= 453.59237
+	case "oz":
+		w *= 28.349523125
+	default:
+		return 0, fmt.Errorf("unknown weight unit %s", unit)
+	}
+	return float32(w), nil
+}
+
+// importCategory returns the leaf of a hierarchical category such as
+// "Clothing > Shirts"
+func importCategory(s string) string {
+	parts := strings.Split(s, ">")
+	return strings.TrimSpace(parts[len(parts)-1])
+}
+
+func importShopifyRows(rows []importRow, currency string, shippingOptions []*pb.Listing_ShippingOption) []importedListing {
+	// Rows of the same product share a handle
+	var (
+		handles  []string
+		products = make(map[string][]importRow)
+	)
+	for _, row := range rows {
+		handle := row.get("handle")
+		if _, ok := products[handle]; !ok {
+			handles = append(handles, handle)
+		}
+		products[handle] = append(products[handle], row)
+	}
+
+	var ret []importedListing
+	for _, handle := range handles {
+		imp := importedListing{}
+		for _, row := range products[handle] {
+			imp.rows = append(imp.rows, row.number)
+		}
+		imp.listing, imp.images, imp.err = shopifyListingFromRows(products[handle], currency, shippingOptions)
+		ret = append(ret, imp)
+	}
+	return ret
+}
+
+func shopifyListingFromRows(rows []importRow, currency string, shippingOptions []*pb.Listing_ShippingOption) (*pb.Listing, []string, error) {
+	product := rows[0]
+	if product.get("handle") == "" {
+		return nil, nil, errors.New("Handle is a mandatory field")
+	}
+	if product.get("title") == "" {
+		return nil, nil, errors.New("Title is a mandatory field")
+	}
+	if status := strings.ToLower(product.get("status")); status != "" && status != "active" {
+		return nil, nil, fmt.Errorf("product is %s", status)
+	}
+	listing, err := newImportListing(currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	listing.Item.Title = product.get("title")
+	listing.Item.Description = product.get("body (html)")
+	listing.Item.Tags = splitImportList(product.get("tags"))
+	if category := importCategory(product.get("product category")); category != "" {
+		listing.Item.Categories = append(listing.Item.Categories, category)
+	}
+	if t := product.get("type"); t != "" {
+		listing.Item.Categories = append(listing.Item.Categories, t)
+	}
+
+	optionNames := []string{product.get("option1 name"), product.get("option2 name"), product.get("option3 name")}
+	var (
+		variants        []importVariant
+		images          []string
+		seenImages      = make(map[string]bool)
+		requireShipping bool
+	)
+	addImage := func(src string) {
+		if src != "" && !seenImages[src] {
+			seenImages[src] = true
+			images = append(images, src)
+		}
+	}
+	for _, row := range rows {
+		addImage(row.get("image src"))
+		addImage(row.get("variant image"))
+		if row.get("variant price") == "" {
+			// Rows only listing additional images
+			continue
+		}
+		v := importVariant{
+			values:    []string{row.get("option1 value"), row.get("option2 value"), row.get("option3 value")},
+			productID: row.get("variant sku"),
+		}
+		v.price, err = parseImportPrice(row.get("variant price"), currency)
+		if err != nil {
+			return nil, nil, err
+		}
+		v.quantity, err = parseImportQuantity(row.get("variant inventory qty"))
+		if err != nil {
+			return nil, nil, err
+		}
+		if row.get("variant grams") != "" {
+			v.grams, err = parseImportGrams(row.get("variant grams"), "g")
+		} else {
+			v.grams, err = parseImportGrams(row.get("variant weight"), row.get("variant weight unit"))
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if shipping, err := strconv.ParseBool(strings.ToLower(row.get("variant requires shipping"))); err != nil || shipping {
+			requireShipping = true
+		}
+		variants = append(variants, v)
+	}
+	if err := setImportVariants(listing, optionNames, variants); err != nil {
+		return nil, nil, err
+	}
+	if !requireShipping {
+		listing.Metadata.ContractType = pb.Listing_Metadata_DIGITAL_GOOD
+	}
+	setImportShippingOptions(listing, shippingOptions)
+	return listing, images, nil
+}
+
+func importWooCommerceRows(rows []importRow, currency string, shippingOptions []*pb.Listing_ShippingOption) []importedListing {
+	// Variations reference their parent product by "id:<ID>" or by its SKU
+	var (
+		parents    []importRow
+		variations = make(map[string][]importRow)
+		parentKeys = make(map[int][]string)
+	)
+	for _, row := range rows {
+		if strings.ToLower(row.get("type")) == "variation" {
+			variations[row.get("parent")] = append(variations[row.get("parent")], row)
+			continue
+		}
+		parents = append(parents, row)
+		if id := row.get("id"); id != "" {
+			parentKeys[row.number] = append(parentKeys[row.number], "id:"+id)
+		}
+		if sku := row.get("sku"); sku != "" {
+			parentKeys[row.number] = append(parentKeys[row.number], sku)
+		}
+	}
+
+	var ret []importedListing
+	claimed := make(map[string]bool)
+	for _, parent := range parents {
+		imp := importedListing{rows: []int{parent.number}}
+		var children []importRow
+		for _, key := range parentKeys[parent.number] {
+			if !claimed[key] {
+				children = append(children, variations[key]...)
+				claimed[key] = true
+			}
+		}
+		for _, child := range children {
+			imp.rows = append(imp.rows, child.number)
+		}
+		imp.listing, imp.images, imp.err = wooCommerceListingFromRows(parent, children, currency, shippingOptions)
+		ret = append(ret, imp)
+	}
+	for _, row := range rows {
+		if strings.ToLower(row.get("type")) == "variation" && !claimed[row.get("parent")] {
+			ret = append(ret, importedListing{rows: []int{row.number}, err: fmt.Errorf("parent product %s not found", row.get("parent"))})
+		}
+	}
+	return ret
+}
+
+func wooCommerceListingFromRows(product importRow, variations []importRow, currency string, shippingOptions []*pb.Listing_ShippingOption) (*pb.Listing, []string, error) {
+	productType := strings.ToLower(product.get("type"))
+	if productType != "simple" && productType != "variable" {
+		return nil, nil, fmt.Errorf("unsupported product type %s", productType)
+	}
+	if published := product.get("published"); published != "" && published != "1" {
+		return nil, nil, errors.New("product is not published")
+	}
+	if product.get("name") == "" {
+		return nil, nil, errors.New("Name is a mandatory field")
+	}
+	listing, err := newImportListing(currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	listing.Item.Title = product.get("name")
+	listing.Item.Description = product.get("description")
+	if listing.Item.Description == "" {
+		listing.Item.Description = product.get("short description")
+	}
+	listing.Item.Tags = splitImportList(product.get("tags"))
+	for _, category := range splitImportList(product.get("categories")) {
+		listing.Item.Categories = append(listing.Item.Categories, importCategory(category))
+	}
+	if product.get("virtual") == "1" || product.get("downloadable") == "1" {
+		listing.Metadata.ContractType = pb.Listing_Metadata_DIGITAL_GOOD
+	}
+
+	// The weight column carries its unit, e.g. "Weight (kg)"
+	weightColumn, weightUnit := "", ""
+	for column := range product.fields {
+		if strings.HasPrefix(column, "weight (") && strings.HasSuffix(column, ")") {
+			weightColumn, weightUnit = column, strings.TrimSuffix(strings.TrimPrefix(column, "weight ("), ")")
+		}
+	}
+	variantFromRow := func(row importRow, fallback *importVariant) (importVariant, error) {
+		v := importVariant{productID: row.get("sku")}
+		var err error
+		price := row.get("sale price")
+		if price == "" {
+			price = row.get("regular price")
+		}
+		if price == "" && fallback != nil {
+			v.price = fallback.price
+		} else if v.price, err = parseImportPrice(price, currency); err != nil {
+			return v, err
+		}
+		if row.get("stock") == "" && row.get("in stock?") == "0" {
+			v.quantity = 0
+		} else if v.quantity, err = parseImportQuantity(row.get("stock")); err != nil {
+			return v, err
+		}
+		if weightColumn != "" && row.get(weightColumn) != "" {
+			v.grams, err = parseImportGrams(row.get(weightColumn), weightUnit)
+		} else if fallback != nil {
+			v.grams = fallback.grams
+		}
+		return v, err
+	}
+
+	if productType == "simple" {
+		v, err := variantFromRow(product, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := setImportVariants(listing, nil, []importVariant{v}); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		if len(variations) == 0 {
+			return nil, nil, errors.New("variable product has no variations")
+		}
+		// The price of a variable product is optional
+		parent, err := variantFromRow(product, &importVariant{})
+		if err != nil {
+			return nil, nil, err
+		}
+		var optionNames []string
+		for i := 1; product.has(fmt.Sprintf("attribute %d name", i)); i++ {
+			optionNames = append(optionNames, product.get(fmt.Sprintf("attribute %d name", i)))
+		}
+		var variants []importVariant
+		for _, row := range variations {
+			v, err := variantFromRow(row, &parent)
+			if err != nil {
+				return nil, nil, err
+			}
+			// Variations list their attributes in their own order
+			values := make(map[string]string)
+			for i := 1; row.has(fmt.Sprintf("attribute %d name", i)); i++ {
+				values[row.get(fmt.Sprintf("attribute %d name", i))] = row.get(fmt.Sprintf("attribute %d value(s)", i))
+			}
+			for _, name := range optionNames {
+				if name != "" && values[name] == "" {
+					return nil, nil, fmt.Errorf("variation for any %s is not supported", name)
+				}
+				v.values = append(v.values, values[name])
+			}
+			variants = append(variants, v)
+		}
+		if err := setImportVariants(listing, optionNames, variants); err != nil {
+			return nil, nil, err
+		}
+	}
+	setImportShippingOptions(listing, shippingOptions)
+	return listing, splitImportList(product.get("images")), nil
+}
+
+func importEtsyRows(rows []importRow, shippingOptions []*pb.Listing_ShippingOption) []importedListing {
+	var ret []importedListing
+	for _, row := range rows {
+		listing, images, err := etsyListingFromRow(row, shippingOptions)
+		ret = append(ret, importedListing{rows: []int{row.number}, listing: listing, images: images, err: err})
+	}
+	return ret
+}
+
+func etsyListingFromRow(row importRow, shippingOptions []*pb.Listing_ShippingOption) (*pb.Listing, []string, error) {
+	if row.get("title") == "" {
+		return nil, nil, errors.New("TITLE is a mandatory field")
+	}
+	if row.get("currency_code") == "" {
+		return nil, nil, errors.New("CURRENCY_CODE is a mandatory field")
+	}
+	listing, err := newImportListing(row.get("currency_code"))
+	if err != nil {
+		return nil, nil, err
+	}
+	listing.Item.Title = row.get("title")
+	listing.Item.Description = row.get("description")
+	listing.Item.Tags = splitImportList(row.get("tags"))
+
+	base := importVariant{productID: row.get("sku")}
+	base.price, err = parseImportPrice(row.get("price"), listing.Metadata.PricingCurrency)
+	if err != nil {
+		return nil, nil, err
+	}
+	base.quantity, err = parseImportQuantity(row.get("quantity"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Etsy only exports the possible values of each variation so every
+	// combination is offered at the listing price, sharing its quantity
+	var optionNames []string
+	variants := []importVariant{base}
+	for i := 1; row.has(fmt.Sprintf("variation %d name", i)); i++ {
+		name := row.get(fmt.Sprintf("variation %d name", i))
+		values := splitImportList(row.get(fmt.Sprintf("variation %d values", i)))
+		if name == "" || len(values) == 0 {
+			continue
+		}
+		optionNames = append(optionNames, name)
+		var combined []importVariant
+		for _, v := range variants {
+			for _, value := range values {
+				c := v
+				c.values = append(append([]string{}, v.values...), value)
+				combined = append(combined, c)
+			}
+		}
+		variants = combined
+	}
+	if len(variants) > 1 {
+		// The SKU can't be attributed to a single combination, and the
+		// quantity is split so the combinations don't offer more in total
+		for i := range variants {
+			variants[i].productID = ""
+			if base.quantity >= 0 {
+				variants[i].quantity = base.quantity / int64(len(variants))
+				if int64(i) < base.quantity%int64(len(variants)) {
+					variants[i].quantity++
+				}
+			}
+		}
+	}
+	if err := setImportVariants(listing, optionNames, variants); err != nil {
+		return nil, nil, err
+	}
+	setImportShippingOptions(listing, shippingOptions)
+
+	var images []string
+	for i := 1; row.has(fmt.Sprintf("image%d", i)); i++ {
+		if src := row.get(fmt.Sprintf("image%d", i)); src != "" {
+			images = append(images, src)
+		}
+	}
+	return listing, images, nil
+}
@@ -0,0 +1,186 @@
+package core
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func mustReadImportRows(t *testing.T, data string) (map[string]int, []importRow) {
+	reader := csv.NewReader(strings.NewReader(data))
+	records, err := reader.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := make(map[string]int)
+	for i, c := range records[0] {
+		fields[strings.ToLower(c)] = i
+	}
+	var rows []importRow
+	for i, record := range records[1:] {
+		rows = append(rows, importRow{number: i + 1, fields: fields, record: record})
+	}
+	return fields, rows
+}
+
+func TestDetectImportFormat(t *testing.T) {
+	tests := map[string]string{
+		"Handle,Title,Variant Price":                            ImportFormatShopify,
+		"ID,Type,SKU,Name,Regular price":                        ImportFormatWooCommerce,
+		"TITLE,DESCRIPTION,PRICE,CURRENCY_CODE,QUANTITY,IMAGE1": ImportFormatEtsy,
+		"contract_type,pricing_currency,title,price,image_urls": ImportFormatOpenBazaar,
+	}
+	for header, expected := range tests {
+		fields, _ := mustReadImportRows(t, header+"\n")
+		if format := detectImportFormat(fields); format != expected {
+			t.Errorf("expected %s for %q, got %s", expected, header, format)
+		}
+	}
+}
+
+func TestParseImportPrice(t *testing.T) {
+	if p, err := parseImportPrice("19.99", "USD"); err != nil || p != 1999 {
+		t.Errorf("expected 1999, got %d (%v)", p, err)
+	}
+	if p, err := parseImportPrice("1500", "BTC"); err != nil || p != 1500 {
+		t.Errorf("expected 1500, got %d (%v)", p, err)
+	}
+	if p, err := parseImportPrice("1500.4", "JPY"); err != nil || p != 150000 {
+		t.Errorf("expected 150000, got %d (%v)", p, err)
+	}
+	if _, err := parseImportPrice("-1", "USD"); err == nil {
+		t.Error("expected negative price to fail")
+	}
+}
+
+func TestImportShopifyRows(t *testing.T) {
+	_, rows := mustReadImportRows(t, `Handle,Title,Body (HTML),Type,Tags,Option1 Name,Option1 Value,Variant SKU,Variant Grams,Variant Inventory Qty,Variant Price,Variant Requires Shipping,Image Src,Status
+shirt,Shirt,<p>Soft</p>,Shirts,"cotton, summer",Size,Small,SH-S,200,5,10.00,true,https://example.com/1.jpg,active
+shirt,,,,,,Large,SH-L,250,2,12.50,true,https://example.com/2.jpg,
+shirt,,,,,,,,,,,,https://example.com/3.jpg,
+ebook,Ebook,,,,Title,Default Title,EB,0,,5.00,false,https://example.com/4.jpg,active
+draft,Draft,,,,Title,Default Title,DR,0,,5.00,true,https://example.com/5.jpg,draft
+`)
+	shipping := []*pb.Listing_ShippingOption{{Name: "Post", Regions: []pb.CountryCode{pb.CountryCode_ALL}}}
+	imported := importShopifyRows(rows, "USD", shipping)
+	if len(imported) != 3 {
+		t.Fatalf("expected 3 products, got %d", len(imported))
+	}
+
+	shirt := imported[0]
+	if shirt.err != nil {
+		t.Fatal(shirt.err)
+	}
+	if len(shirt.rows) != 3 || len(shirt.images) != 3 {
+		t.Errorf("expected 3 rows and images, got %v and %v", shirt.rows, shirt.images)
+	}
+	l := shirt.listing
+	if l.Item.Title != "Shirt" || l.Item.Price != 1000 || l.Item.Grams != 200 || len(l.Item.Tags) != 2 || l.Item.Categories[0] != "Shirts" {
+		t.Errorf("unexpected item: %+v", l.Item)
+	}
+	if len(l.Item.Options) != 1 || l.Item.Options[0].Name != "Size" || len(l.Item.Options[0].Variants) != 2 {
+		t.Errorf("unexpected options: %+v", l.Item.Options)
+	}
+	if len(l.Item.Skus) != 2 || l.Item.Skus[1].Surcharge != 250 || l.Item.Skus[1].Quantity != 2 || l.Item.Skus[1].ProductID != "SH-L" || l.Item.Skus[1].VariantCombo[0] != 1 {
+		t.Errorf("unexpected skus: %+v", l.Item.Skus)
+	}
+	if l.Metadata.ContractType != pb.Listing_Metadata_PHYSICAL_GOOD || len(l.ShippingOptions) != 1 || l.ShippingOptions[0] == shipping[0] {
+		t.Errorf("expected a copy of the shipping option on a physical good, got %v", l.ShippingOptions)
+	}
+
+	ebook := imported[1]
+	if ebook.err != nil {
+		t.Fatal(ebook.err)
+	}
+	if len(ebook.listing.Item.Options) != 0 || len(ebook.listing.Item.Skus) != 1 || ebook.listing.Item.Skus[0].Quantity != -1 {
+		t.Errorf("expected a single untracked sku, got %+v", ebook.listing.Item)
+	}
+	if ebook.listing.Metadata.ContractType != pb.Listing_Metadata_DIGITAL_GOOD || len(ebook.listing.ShippingOptions) != 0 {
+		t.Error("expected a digital good without shipping options")
+	}
+
+	if imported[2].err == nil || imported[2].rows[0] != 5 {
+		t.Error("expected draft product to be skipped")
+	}
+}
+
+func TestImportWooCommerceRows(t *testing.T) {
+	_, rows := mustReadImportRows(t, `ID,Type,SKU,Name,Published,Description,Stock,Weight (kg),Sale price,Regular price,Categories,Tags,Images,Parent,Attribute 1 name,Attribute 1 value(s),Attribute 2 name,Attribute 2 value(s)
+10,variable,HOOD,Hoodie,1,Warm,,0.5,,,"Clothing > Hoodies",winter,"https://example.com/h1.jpg, https://example.com/h2.jpg",,Color,"Red, Blue",Size,M
+11,variation,HOOD-R,Hoodie - Red,1,,3,,,40,,,,id:10,Color,Red,Size,M
+12,variation,HOOD-B,Hoodie - Blue,1,,,0.6,35,40,,,,HOOD,Color,Blue,Size,M
+20,simple,MUG,Mug,1,Ceramic,7,0.3,,9.99,Kitchen,,https://example.com/m.jpg,,,,,
+30,variation,GONE,Orphan,1,,,,,5,,,,id:99,Color,Red,,
+40,grouped,SET,Set,1,,,,,,,,,,,,,
+`)
+	imported := importWooCommerceRows(rows, "EUR", nil)
+	if len(imported) != 4 {
+		t.Fatalf("expected 4 records, got %d", len(imported))
+	}
+
+	hoodie := imported[0]
+	if hoodie.err != nil {
+		t.Fatal(hoodie.err)
+	}
+	l := hoodie.listing
+	if len(hoodie.rows) != 3 || len(hoodie.images) != 2 {
+		t.Errorf("expected 3 rows and 2 images, got %v and %v", hoodie.rows, hoodie.images)
+	}
+	if l.Item.Price != 3500 || l.Item.Grams != 500 || l.Item.Categories[0] != "Hoodies" || l.Metadata.PricingCurrency != "EUR" {
+		t.Errorf("unexpected item: %+v", l.Item)
+	}
+	if len(l.Item.Options) != 1 || l.Item.Options[0].Name != "Color" {
+		t.Errorf("expected only the color option, got %+v", l.Item.Options)
+	}
+	if len(l.Item.Skus) != 2 || l.Item.Skus[0].Surcharge != 500 || l.Item.Skus[0].Quantity != 3 || l.Item.Skus[1].Quantity != -1 {
+		t.Errorf("unexpected skus: %+v", l.Item.Skus)
+	}
+
+	mug := imported[1]
+	if mug.err != nil {
+		t.Fatal(mug.err)
+	}
+	if mug.listing.Item.Price != 999 || mug.listing.Item.Grams != 300 || mug.listing.Item.Skus[0].Quantity != 7 {
+		t.Errorf("unexpected mug: %+v", mug.listing.Item)
+	}
+
+	if imported[2].err == nil || imported[2].rows[0] != 6 {
+		t.Error("expected grouped product to be skipped")
+	}
+	if imported[3].err == nil || imported[3].rows[0] != 5 {
+		t.Error("expected orphan variation to be skipped")
+	}
+}
+
+func TestImportEtsyRows(t *testing.T) {
+	_, rows := mustReadImportRows(t, `TITLE,DESCRIPTION,PRICE,CURRENCY_CODE,QUANTITY,TAGS,IMAGE1,IMAGE2,VARIATION 1 TYPE,VARIATION 1 NAME,VARIATION 1 VALUES,VARIATION 2 TYPE,VARIATION 2 NAME,VARIATION 2 VALUES,SKU
+Necklace,Silver,25.00,GBP,5,"silver,jewelry",https://example.com/n1.jpg,https://example.com/n2.jpg,,Length,"40cm,45cm",,Finish,"Matte,Polished",NK
+Ring,,abc,GBP,1,,,,,,,,,,RG
+`)
+	imported := importEtsyRows(rows, nil)
+	if len(imported) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(imported))
+	}
+	necklace := imported[0]
+	if necklace.err != nil {
+		t.Fatal(necklace.err)
+	}
+	l := necklace.listing
+	if l.Item.Price != 2500 || l.Metadata.PricingCurrency != "GBP" || len(necklace.images) != 2 {
+		t.Errorf("unexpected listing: %+v", l)
+	}
+	if len(l.Item.Options) != 2 || len(l.Item.Skus) != 4 || l.Item.Skus[3].ProductID != "" {
+		t.Errorf("expected every combination as a sku, got %+v", l.Item.Skus)
+	}
+	for i, expected := range []int64{2, 1, 1, 1} {
+		if i < len(l.Item.Skus) && l.Item.Skus[i].Quantity != expected {
+			t.Errorf("expected the quantity to be split across the combinations, got %+v", l.Item.Skus)
+			break
+		}
+	}
+	if imported[1].err == nil {
+		t.Error("expected invalid price to be skipped")
+	}
+}
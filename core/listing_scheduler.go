@@ -0,0 +1,244 @@
+package core
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/op/go-logging"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const listingSchedulerInterval = time.Duration(1) * time.Minute
+
+// listingSchedulerNode is the part of the node which the scheduler publishes
+// and archives listings with
+type listingSchedulerNode interface {
+	listingExists(slug string) (bool, error)
+	writeListing(listing *pb.Listing) error
+	getListingIndex() ([]ListingData, error)
+	GetListingFromSlug(slug string) (*pb.SignedListing, error)
+	archiveListing(sl *pb.SignedListing, archivedAt time.Time) error
+	UpdateFollow() error
+	SeedNode() error
+}
+
+type listingScheduler struct {
+	// PerformTask dependencies
+	node      listingSchedulerNode
+	datastore repo.Datastore
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartListingScheduler - start the worker which publishes scheduled listings
+// and archives expired ones
+func (n *OpenBazaarNode) StartListingScheduler() {
+	n.ListingScheduler = &listingScheduler{
+		node:          n,
+		datastore:     n.Datastore,
+		intervalDelay: listingSchedulerInterval,
+		logger:        logging.MustGetLogger("listingScheduler"),
+	}
+	go n.ListingScheduler.Run()
+}
+
+func (scheduler *listingScheduler) Run() {
+	scheduler.watchdogTimer = time.NewTicker(scheduler.intervalDelay)
+	scheduler.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	scheduler.PerformTask()
+	for {
+		select {
+		case <-scheduler.watchdogTimer.C:
+			scheduler.PerformTask()
+		case <-scheduler.stopWorker:
+			scheduler.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (scheduler *listingScheduler) Stop() {
+	scheduler.stopWorker <- true
+	close(scheduler.stopWorker)
+}
+
+// PerformTask publishes every scheduled listing which is due, archives every
+// listing which has expired and reseeds the node if anything changed.
+// Scheduled listings which fail to publish are flagged with the error and
+// not retried.
+func (scheduler *listingScheduler) PerformTask() {
+	now := time.Now()
+	published := scheduler.publishDueListings(now)
+	archived := scheduler.archiveExpiredListings(now)
+	if published+archived == 0 {
+		return
+	}
+
+	scheduler.logger.Infof("published %d scheduled listings, archived %d expired listings", published, archived)
+	if err := scheduler.node.UpdateFollow(); err != nil {
+		scheduler.logger.Errorf("updating follow counts: %s", err.Error())
+	}
+	if err := scheduler.node.SeedNode(); err != nil {
+		scheduler.logger.Errorf("seeding node: %s", err.Error())
+	}
+}
+
+func (scheduler *listingScheduler) publishDueListings(now time.Time) int {
+	due, err := scheduler.datastore.ScheduledListings().GetDue(now)
+	if err != nil {
+		scheduler.logger.Errorf("loading scheduled listings: %s", err.Error())
+		return 0
+	}
+
+	var published int
+	for _, s := range due {
+		if err := scheduler.publish(s.Listing); err != nil {
+			scheduler.logger.Warningf("publishing scheduled listing (%s): %s", s.Slug, err.Error())
+			if err := scheduler.datastore.ScheduledListings().SetPublishError(s.Slug, err.Error()); err != nil {
+				scheduler.logger.Errorf("flagging scheduled listing (%s): %s", s.Slug, err.Error())
+			}
+			continue
+		}
+		if err := scheduler.datastore.ScheduledListings().Delete(s.Slug); err != nil {
+			scheduler.logger.Errorf("removing scheduled listing (%s): %s", s.Slug, err.Error())
+		}
+		published++
+	}
+	return published
+}
+
+func (scheduler *listingScheduler) publish(listing *pb.Listing) error {
+	exists, err := scheduler.node.listingExists(listing.Slug)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrListingAlreadyExists
+	}
+	return scheduler.node.writeListing(listing)
+}
+
+func (scheduler *listingScheduler) archiveExpiredListings(now time.Time) int {
+	index, err := scheduler.node.getListingIndex()
+	if err != nil {
+		scheduler.logger.Errorf("loading listing index: %s", err.Error())
+		return 0
+	}
+
+	var archived int
+	for _, ld := range index {
+		sl, err := scheduler.node.GetListingFromSlug(ld.Slug)
+		if err != nil {
+			scheduler.logger.Errorf("loading listing (%s): %s", ld.Slug, err.Error())
+			continue
+		}
+		if sl.Listing.Metadata == nil || sl.Listing.Metadata.Expiry == nil {
+			continue
+		}
+		if time.Unix(sl.Listing.Metadata.Expiry.Seconds, 0).After(now) {
+			continue
+		}
+		if err := scheduler.node.archiveListing(sl, now); err != nil {
+			scheduler.logger.Errorf("archiving listing (%s): %s", ld.Slug, err.Error())
+			continue
+		}
+		archived++
+	}
+	return archived
+}
+
+// ScheduleListing validates and saves a listing draft which will be published
+// once publishAt has passed. The listing is signed when it is published.
+func (n *OpenBazaarNode) ScheduleListing(listing *pb.Listing, publishAt time.Time) error {
+	if !publishAt.After(time.Now()) {
+		return ErrListingPublishTimeInPast
+	}
+	if listing.Item == nil {
+		return errors.New("No item in listing")
+	}
+	if listing.Metadata == nil || listing.Metadata.Expiry == nil {
+		return errors.New("Missing required field: Expiry")
+	}
+	if !time.Unix(listing.Metadata.Expiry.Seconds, 0).After(publishAt) {
+		return errors.New("Listing expiration must be after publishAt")
+	}
+
+	var err error
+	if listing.Slug == "" {
+		listing.Slug, err = n.GenerateSlug(listing.Item.Title)
+		if err != nil {
+			return err
+		}
+	}
+	if err := n.validateListingDraft(listing); err != nil {
+		return err
+	}
+	exists, err := n.listingExists(listing.Slug)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrListingAlreadyExists
+	}
+	_, err = n.Datastore.ScheduledListings().Get(listing.Slug)
+	if err == nil {
+		return ErrListingAlreadyScheduled
+	} else if err != sql.ErrNoRows {
+		return err
+	}
+
+	return n.Datastore.ScheduledListings().Put(listing, publishAt)
+}
+
+// archiveListing moves the listing, including its inventory, into the
+// archive and removes it from the index. Callers are responsible for calling
+// SeedNode.
+func (n *OpenBazaarNode) archiveListing(sl *pb.SignedListing, archivedAt time.Time) error {
+	inventory, err := n.Datastore.Inventory().Get(sl.Listing.Slug)
+	if err != nil {
+		return err
+	}
+	if err := n.Datastore.ArchivedListings().Put(sl, inventory, archivedAt); err != nil {
+		return err
+	}
+	return n.DeleteListing(sl.Listing.Slug)
+}
+
+// RestoreListing republishes an archived listing. A new expiry must be given
+// if the archived listing has already expired, a zero expiry keeps the old one.
+func (n *OpenBazaarNode) RestoreListing(slug string, expiry time.Time) error {
+	archived, err := n.Datastore.ArchivedListings().Get(slug)
+	if err == sql.ErrNoRows {
+		return ErrArchivedListingDoesNotExist
+	} else if err != nil {
+		return err
+	}
+
+	// Signing clears the quantities, which CreateListing sets the inventory from
+	listing := archived.Listing.Listing
+	for i, sku := range listing.Item.Skus {
+		if count, ok := archived.Inventory[i]; ok {
+			sku.Quantity = count
+		}
+	}
+	if !expiry.IsZero() {
+		listing.Metadata.Expiry, err = ptypes.TimestampProto(expiry)
+		if err != nil {
+			return err
+		}
+	}
+	if err := n.CreateListing(listing); err != nil {
+		return err
+	}
+	return n.Datastore.ArchivedListings().Delete(slug)
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/op/go-logging"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+)
+
+// testSchedulerNode keeps listings in memory and records what the scheduler
+// does with them
+type testSchedulerNode struct {
+	listings  map[string]*pb.SignedListing
+	writeErrs map[string]error
+	written   []string
+	archived  []string
+	seeds     int
+}
+
+func (n *testSchedulerNode) listingExists(slug string) (bool, error) {
+	_, ok := n.listings[slug]
+	return ok, nil
+}
+
+func (n *testSchedulerNode) writeListing(listing *pb.Listing) error {
+	n.written = append(n.written, listing.Slug)
+	if err := n.writeErrs[listing.Slug]; err != nil {
+		return err
+	}
+	n.listings[listing.Slug] = &pb.SignedListing{Listing: listing}
+	return nil
+}
+
+func (n *testSchedulerNode) getListingIndex() ([]ListingData, error) {
+	var index []ListingData
+	for slug := range n.listings {
+		index = append(index, ListingData{Slug: slug})
+	}
+	return index, nil
+}
+
+func (n *testSchedulerNode) GetListingFromSlug(slug string) (*pb.SignedListing, error) {
+	return n.listings[slug], nil
+}
+
+func (n *testSchedulerNode) archiveListing(sl *pb.SignedListing, archivedAt time.Time) error {
+	n.archived = append(n.archived, sl.Listing.Slug)
+	delete(n.listings, sl.Listing.Slug)
+	return nil
+}
+
+func (n *testSchedulerNode) UpdateFollow() error { return nil }
+
+func (n *testSchedulerNode) SeedNode() error {
+	n.seeds++
+	return nil
+}
+
+func TestListingSchedulerPerformTask(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	datastore := db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin)
+
+	now := time.Now()
+	expired := factory.NewListing("expired")
+	expired.Metadata.Expiry = &timestamp.Timestamp{Seconds: now.Add(-time.Minute).Unix()}
+	node := &testSchedulerNode{
+		listings: map[string]*pb.SignedListing{
+			"expired": {Listing: expired},
+			"current": {Listing: factory.NewListing("current")},
+			"taken":   {Listing: factory.NewListing("taken")},
+		},
+		writeErrs: map[string]error{"invalid": errors.New("Listing must have a title")},
+	}
+	for slug, publishAt := range map[string]time.Time{
+		"due":     now.Add(-time.Minute),
+		"invalid": now.Add(-time.Minute),
+		"taken":   now.Add(-time.Minute),
+		"later":   now.Add(time.Hour),
+	} {
+		if err := datastore.ScheduledListings().Put(factory.NewListing(slug), publishAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scheduler := &listingScheduler{
+		node:      node,
+		datastore: datastore,
+		logger:    logging.MustGetLogger("testListingScheduler"),
+	}
+	scheduler.PerformTask()
+
+	if len(node.written) != 2 || node.listings["due"] == nil {
+		t.Errorf("Expected the due and invalid listings to be written, got %v", node.written)
+	}
+	if len(node.archived) != 1 || node.archived[0] != "expired" {
+		t.Errorf("Expected the expired listing to be archived, got %v", node.archived)
+	}
+	if node.seeds != 1 {
+		t.Errorf("Expected the node to be seeded once, seeded %d times", node.seeds)
+	}
+	scheduled, err := datastore.ScheduledListings().GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	publishErrors := make(map[string]string)
+	for _, s := range scheduled {
+		publishErrors[s.Slug] = s.PublishError
+	}
+	expected := map[string]string{
+		"invalid": "Listing must have a title",
+		"taken":   ErrListingAlreadyExists.Error(),
+		"later":   "",
+	}
+	if len(publishErrors) != len(expected) {
+		t.Errorf("Expected scheduled listings %v, got %v", expected, publishErrors)
+	}
+	for slug, reason := range expected {
+		if r, ok := publishErrors[slug]; !ok || r != reason {
+			t.Errorf("Expected %s to be scheduled with error %q, got %q", slug, reason, r)
+		}
+	}
+
+	// Listings which failed to publish aren't retried
+	scheduler.PerformTask()
+	if len(node.written) != 2 {
+		t.Errorf("Expected failed listings not to be retried, wrote %v", node.written)
+	}
+	if node.seeds != 1 {
+		t.Errorf("Expected the node not to be reseeded, seeded %d times", node.seeds)
+	}
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// ListingLanguages returns the listing's primary language followed by the
+// languages of each of its translations
+func ListingLanguages(listing *pb.Listing) []string {
+	languages := []string{}
+	if listing.Metadata != nil && listing.Metadata.Language != "" {
+		languages = append(languages, listing.Metadata.Language)
+	}
+	for _, t := range listing.Translations {
+		languages = append(languages, t.Language)
+	}
+	return languages
+}
+
+// ListingTranslation returns the translation of the listing best matching the
+// preferred languages, given in order of preference, and its language. The
+// listing is never modified, as that would break its signature. A nil
+// translation is returned with the primary language when it is preferred
+// over the translations, and with an empty language when nothing matched.
+func ListingTranslation(listing *pb.Listing, preferred []string) (*pb.Listing_Translation, string) {
+	var primary string
+	if listing.Metadata != nil {
+		primary = listing.Metadata.Language
+	}
+	for _, lang := range preferred {
+		if primary != "" && languageMatches(lang, primary) {
+			return nil, primary
+		}
+		if t := translationForLanguage(listing.Translations, lang); t != nil {
+			return t, t.Language
+		}
+	}
+	return nil, ""
+}
+
+// translationForLanguage returns the translation whose language equals lang,
+// falling back to one sharing the same base language (e.g. "de" for "de-AT")
+func translationForLanguage(translations []*pb.Listing_Translation, lang string) *pb.Listing_Translation {
+	for _, t := range translations {
+		if normalizeLanguage(t.Language) == normalizeLanguage(lang) {
+			return t
+		}
+	}
+	for _, t := range translations {
+		if languageMatches(lang, t.Language) {
+			return t
+		}
+	}
+	return nil
+}
+
+func normalizeLanguage(lang string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(lang), "_", "-", -1))
+}
+
+func baseLanguage(lang string) string {
+	return strings.SplitN(normalizeLanguage(lang), "-", 2)[0]
+}
+
+func languageMatches(a, b string) bool {
+	return baseLanguage(a) != "" && baseLanguage(a) == baseLanguage(b)
+}
+
+func validateListingTranslations(listing *pb.Listing, errs *ValidationError) {
+	if len(listing.Translations) > MaxListItems {
+		errs.addf("translations", ErrCodeFieldTooMany, "Number of translations is greater than the max of %d", MaxListItems)
+	}
+	languages := make(map[string]bool)
+	if listing.Metadata.Language != "" {
+		languages[normalizeLanguage(listing.Metadata.Language)] = true
+	}
+	for i, t := range listing.Translations {
+		field := fmt.Sprintf("translations[%d]", i)
+		if t.Language == "" {
+			errs.add(field+".language", ErrCodeFieldRequired, "Translation language must not be empty")
+		}
+		if len(t.Language) > WordMaxCharacters {
+			errs.addf(field+".language", ErrCodeFieldTooLong, "Translation language is longer than the max of %d characters", WordMaxCharacters)
+		}
+		if t.Language != "" && languages[normalizeLanguage(t.Language)] {
+			errs.add(field+".language", ErrCodeFieldNotUnique, "Translation languages must be unique and differ from the listing language")
+		}
+		languages[normalizeLanguage(t.Language)] = true
+		if len(t.Title) > TitleMaxCharacters {
+			errs.addf(field+".title", ErrCodeFieldTooLong, "Translated title is longer than the max of %d characters", TitleMaxCharacters)
+		}
+		if len(t.Description) > DescriptionMaxCharacters {
+			errs.addf(field+".description", ErrCodeFieldTooLong, "Translated description is longer than the max of %d characters", DescriptionMaxCharacters)
+		}
+		if len(t.TermsAndConditions) > PolicyMaxCharacters {
+			errs.addf(field+".termsAndConditions", ErrCodeFieldTooLong, "Translated terms and conditions length must be less than the max of %d", PolicyMaxCharacters)
+		}
+		if len(t.RefundPolicy) > PolicyMaxCharacters {
+			errs.addf(field+".refundPolicy", ErrCodeFieldTooLong, "Translated refund policy length must be less than the max of %d", PolicyMaxCharacters)
+		}
+		for j, on := range t.OptionNames {
+			optionField := fmt.Sprintf("%s.optionNames[%d]", field, j)
+			if len(on.Name) > WordMaxCharacters {
+				errs.addf(optionField+".name", ErrCodeFieldTooLong, "Translated option title length must be less than the max of %d", WordMaxCharacters)
+			}
+			found := false
+			for _, opt := range listing.Item.Options {
+				if opt.Name == on.Option {
+					found = true
+					break
+				}
+			}
+			if !found {
+				errs.addf(optionField+".option", ErrCodeFieldInvalid, "Translated option %s does not exist in the listing", on.Option)
+			}
+		}
+	}
+}
@@ -27,8 +27,9 @@ import (
 )
 
 const (
-	// ListingVersion - current listing version
-	ListingVersion = 4
+	// ListingVersion - current listing version. Version 5 adds price tiers
+	// and the fields older vendors would drop from an order's listing.
+	ListingVersion = 5
 	// TitleMaxCharacters - max size for title
 	TitleMaxCharacters = 140
 	// ShortDescriptionLength - min length for description
@@ -89,6 +90,7 @@ type ListingData struct {
 	Slug               string    `json:"slug"`
 	Title              string    `json:"title"`
 	Categories         []string  `json:"categories"`
+	Tags               []string  `json:"tags"`
 	NSFW               bool      `json:"nsfw"`
 	ContractType       string    `json:"contractType"`
 	Description        string    `json:"description"`
@@ -97,6 +99,7 @@ type ListingData struct {
 	ShipsTo            []string  `json:"shipsTo"`
 	FreeShipping       []string  `json:"freeShipping"`
 	Language           string    `json:"language"`
+	Languages          []string  `json:"languages"`
 	AverageRating      float32   `json:"averageRating"`
 	RatingCount        uint32    `json:"ratingCount"`
 	ModeratorIDs       []string  `json:"moderators"`
@@ -138,17 +141,7 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.SignedListing, er
 
 	sl := new(pb.SignedListing)
 
-	// Temporary hack to work around test env shortcomings
-	if n.TestNetworkEnabled() || n.RegressionNetworkEnabled() {
-		if listing.Metadata.EscrowTimeoutHours == 0 {
-			listing.Metadata.EscrowTimeoutHours = 1
-		}
-	} else {
-		listing.Metadata.EscrowTimeoutHours = EscrowTimeout
-	}
-
-	// Set crypto currency
-	listing.Metadata.AcceptedCurrencies = []string{NormalizeCurrencyCode(n.Wallet.CurrencyCode())}
+	n.setListingDefaults(listing)
 
 	// Sanitize a few critical fields
 	if listing.Item == nil {
@@ -167,6 +160,11 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.SignedListing, er
 			serv.Name = sanitizer.Sanitize(serv.Name)
 		}
 	}
+	for _, t := range listing.Translations {
+		for _, on := range t.OptionNames {
+			on.Name = sanitizer.Sanitize(on.Name)
+		}
+	}
 
 	// Check the listing data is correct for continuing
 	testingEnabled := n.TestNetworkEnabled() || n.RegressionNetworkEnabled()
@@ -247,6 +245,41 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.SignedListing, er
 	return sl, nil
 }
 
+// setListingDefaults sets the fields of the listing which are set by the node
+// rather than the vendor
+func (n *OpenBazaarNode) setListingDefaults(listing *pb.Listing) {
+	// Temporary hack to work around test env shortcomings
+	if n.TestNetworkEnabled() || n.RegressionNetworkEnabled() {
+		if listing.Metadata.EscrowTimeoutHours == 0 {
+			listing.Metadata.EscrowTimeoutHours = 1
+		}
+	} else {
+		listing.Metadata.EscrowTimeoutHours = EscrowTimeout
+	}
+
+	// Set crypto currency
+	listing.Metadata.AcceptedCurrencies = []string{NormalizeCurrencyCode(n.Wallet.CurrencyCode())}
+}
+
+// validateListingDraft runs the checks made when the listing is written and
+// signed, without changing the listing
+func (n *OpenBazaarNode) validateListingDraft(listing *pb.Listing) error {
+	draft := proto.Clone(listing).(*pb.Listing)
+	if draft.Metadata.ContractType == pb.Listing_Metadata_CRYPTOCURRENCY {
+		var errs ValidationError
+		validateCryptocurrencyListing(draft, &errs)
+		if err := errs.err(); err != nil {
+			return err
+		}
+		setCryptocurrencyListingDefaults(draft)
+	}
+	if err := validateListingSkus(draft); err != nil {
+		return err
+	}
+	n.setListingDefaults(draft)
+	return validateListing(draft, n.TestNetworkEnabled() || n.RegressionNetworkEnabled())
+}
+
 /*SetListingInventory Sets the inventory for the listing in the database. Does some basic validation
   to make sure the inventory uses the correct variants. */
 func (n *OpenBazaarNode) SetListingInventory(listing *pb.Listing) error {
@@ -334,6 +367,24 @@ func (n *OpenBazaarNode) UpdateListing(listing *pb.Listing) error {
 }
 
 func (n *OpenBazaarNode) saveListing(listing *pb.Listing) error {
+	if err := n.writeListing(listing); err != nil {
+		return err
+	}
+	// Update followers/following
+	err := n.UpdateFollow()
+	if err != nil {
+		return err
+	}
+	if err = n.SeedNode(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// writeListing signs the listing, writes it to disk and adds it to the index
+// without republishing. Callers are responsible for calling SeedNode.
+func (n *OpenBazaarNode) writeListing(listing *pb.Listing) error {
 	if len(listing.Moderators) == 0 {
 		sd, err := n.Datastore.Settings().Get()
 		if err == nil && sd.StoreModerators != nil {
@@ -342,8 +393,9 @@ func (n *OpenBazaarNode) saveListing(listing *pb.Listing) error {
 	}
 
 	if listing.Metadata.ContractType == pb.Listing_Metadata_CRYPTOCURRENCY {
-		err := validateCryptocurrencyListing(listing)
-		if err != nil {
+		var errs ValidationError
+		validateCryptocurrencyListing(listing, &errs)
+		if err := errs.err(); err != nil {
 			return err
 		}
 
@@ -378,20 +430,7 @@ func (n *OpenBazaarNode) saveListing(listing *pb.Listing) error {
 	if _, err := f.WriteString(out); err != nil {
 		return err
 	}
-	err = n.updateListingIndex(signedListing)
-	if err != nil {
-		return err
-	}
-	// Update followers/following
-	err = n.UpdateFollow()
-	if err != nil {
-		return err
-	}
-	if err = n.SeedNode(); err != nil {
-		return err
-	}
-
-	return nil
+	return n.updateListingIndex(signedListing)
 }
 
 func (n *OpenBazaarNode) listingExists(slug string) (bool, error) {
@@ -480,6 +519,7 @@ func (n *OpenBazaarNode) extractListingData(listing *pb.SignedListing) (ListingD
 		Slug:         listing.Listing.Slug,
 		Title:        listing.Listing.Item.Title,
 		Categories:   listing.Listing.Item.Categories,
+		Tags:         listing.Listing.Item.Tags,
 		NSFW:         listing.Listing.Item.Nsfw,
 		CoinType:     listing.Listing.Metadata.CoinType,
 		ContractType: listing.Listing.Metadata.ContractType.String(),
@@ -493,6 +533,7 @@ func (n *OpenBazaarNode) extractListingData(listing *pb.SignedListing) (ListingD
 		ShipsTo:            shipsTo,
 		FreeShipping:       freeShipping,
 		Language:           listing.Listing.Metadata.Language,
+		Languages:          ListingLanguages(listing.Listing),
 		ModeratorIDs:       listing.Listing.Moderators,
 		AcceptedCurrencies: []string{n.Wallet.CurrencyCode()},
 	}
@@ -850,7 +891,8 @@ func (n *OpenBazaarNode) GetListingFromSlug(slug string) (*pb.SignedListing, err
 
 /* Performs a ton of checks to make sure the listing is formatted correctly. We should not allow
    invalid listings to be saved or purchased as it can lead to ambiguity when moderating a dispute
-   or possible attacks. This function needs to be maintained in conjunction with contracts.proto */
+   or possible attacks. This function needs to be maintained in conjunction with contracts.proto.
+   Every invalid field found is returned in a ValidationError. */
 func validateListing(listing *pb.Listing, testnet bool) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -864,126 +906,109 @@ func validateListing(listing *pb.Listing, testnet bool) (err error) {
 			}
 		}
 	}()
+	var errs ValidationError
 
 	// Slug
 	if listing.Slug == "" {
-		return errors.New("Slug must not be empty")
+		errs.add("slug", ErrCodeFieldRequired, "Slug must not be empty")
 	}
 	if len(listing.Slug) > SentenceMaxCharacters {
-		return fmt.Errorf("Slug is longer than the max of %d", SentenceMaxCharacters)
+		errs.addf("slug", ErrCodeFieldTooLong, "Slug is longer than the max of %d", SentenceMaxCharacters)
 	}
 	if strings.Contains(listing.Slug, " ") {
-		return errors.New("Slugs cannot contain spaces")
+		errs.add("slug", ErrCodeFieldInvalid, "Slugs cannot contain spaces")
 	}
 	if strings.Contains(listing.Slug, "/") {
-		return errors.New("Slugs cannot contain file separators")
+		errs.add("slug", ErrCodeFieldInvalid, "Slugs cannot contain file separators")
 	}
 
 	// Metadata
 	if listing.Metadata == nil {
-		return errors.New("Missing required field: Metadata")
+		errs.add("metadata", ErrCodeFieldRequired, "Missing required field: Metadata")
+	}
+	if listing.Item == nil {
+		errs.add("item", ErrCodeFieldRequired, "Missing required field: Item")
+	}
+	if listing.Metadata == nil || listing.Item == nil {
+		return errs.err()
 	}
 	if listing.Metadata.ContractType > pb.Listing_Metadata_CRYPTOCURRENCY {
-		return errors.New("Invalid contract type")
+		errs.add("metadata.contractType", ErrCodeFieldInvalid, "Invalid contract type")
 	}
 	if listing.Metadata.Format > pb.Listing_Metadata_MARKET_PRICE {
-		return errors.New("Invalid listing format")
+		errs.add("metadata.format", ErrCodeFieldInvalid, "Invalid listing format")
 	}
 	if listing.Metadata.Expiry == nil {
-		return errors.New("Missing required field: Expiry")
-	}
-	if time.Unix(listing.Metadata.Expiry.Seconds, 0).Before(time.Now()) {
-		return errors.New("Listing expiration must be in the future")
+		errs.add("metadata.expiry", ErrCodeFieldRequired, "Missing required field: Expiry")
+	} else if time.Unix(listing.Metadata.Expiry.Seconds, 0).Before(time.Now()) {
+		errs.add("metadata.expiry", ErrCodeFieldOutOfRange, "Listing expiration must be in the future")
 	}
 	if len(listing.Metadata.Language) > WordMaxCharacters {
-		return fmt.Errorf("Language is longer than the max of %d characters", WordMaxCharacters)
+		errs.addf("metadata.language", ErrCodeFieldTooLong, "Language is longer than the max of %d characters", WordMaxCharacters)
 	}
 
 	if !testnet && listing.Metadata.EscrowTimeoutHours != EscrowTimeout {
-		return fmt.Errorf("Escrow timeout must be %d hours", EscrowTimeout)
+		errs.addf("metadata.escrowTimeoutHours", ErrCodeFieldInvalid, "Escrow timeout must be %d hours", EscrowTimeout)
 	}
 	if len(listing.Metadata.AcceptedCurrencies) == 0 {
-		return errors.New("At least one accepted currency must be provided")
+		errs.add("metadata.acceptedCurrencies", ErrCodeFieldRequired, "At least one accepted currency must be provided")
 	}
 	if len(listing.Metadata.AcceptedCurrencies) > MaxListItems {
-		return fmt.Errorf("AcceptedCurrencies is longer than the max of %d currencies", MaxListItems)
+		errs.addf("metadata.acceptedCurrencies", ErrCodeFieldTooMany, "AcceptedCurrencies is longer than the max of %d currencies", MaxListItems)
 	}
-	for _, c := range listing.Metadata.AcceptedCurrencies {
+	for i, c := range listing.Metadata.AcceptedCurrencies {
 		if len(c) > WordMaxCharacters {
-			return fmt.Errorf("Accepted currency is longer than the max of %d characters", WordMaxCharacters)
+			errs.addf(fmt.Sprintf("metadata.acceptedCurrencies[%d]", i), ErrCodeFieldTooLong, "Accepted currency is longer than the max of %d characters", WordMaxCharacters)
 		}
 	}
 
 	// Item
 	if listing.Item.Title == "" {
-		return errors.New("Listing must have a title")
+		errs.add("item.title", ErrCodeFieldRequired, "Listing must have a title")
 	}
 	if listing.Metadata.ContractType != pb.Listing_Metadata_CRYPTOCURRENCY && listing.Item.Price == 0 {
-		return errors.New("Zero price listings are not allowed")
+		errs.add("item.price", ErrCodeFieldOutOfRange, "Zero price listings are not allowed")
 	}
 	if len(listing.Item.Title) > TitleMaxCharacters {
-		return fmt.Errorf("Title is longer than the max of %d characters", TitleMaxCharacters)
+		errs.addf("item.title", ErrCodeFieldTooLong, "Title is longer than the max of %d characters", TitleMaxCharacters)
 	}
 	if len(listing.Item.Description) > DescriptionMaxCharacters {
-		return fmt.Errorf("Description is longer than the max of %d characters", DescriptionMaxCharacters)
+		errs.addf("item.description", ErrCodeFieldTooLong, "Description is longer than the max of %d characters", DescriptionMaxCharacters)
 	}
 	if len(listing.Item.ProcessingTime) > SentenceMaxCharacters {
-		return fmt.Errorf("Processing time length must be less than the max of %d", SentenceMaxCharacters)
+		errs.addf("item.processingTime", ErrCodeFieldTooLong, "Processing time length must be less than the max of %d", SentenceMaxCharacters)
 	}
 	if len(listing.Item.Tags) > MaxTags {
-		return fmt.Errorf("Number of tags exceeds the max of %d", MaxTags)
+		errs.addf("item.tags", ErrCodeFieldTooMany, "Number of tags exceeds the max of %d", MaxTags)
 	}
-	for _, tag := range listing.Item.Tags {
+	for i, tag := range listing.Item.Tags {
+		field := fmt.Sprintf("item.tags[%d]", i)
 		if tag == "" {
-			return errors.New("Tags must not be empty")
+			errs.add(field, ErrCodeFieldRequired, "Tags must not be empty")
 		}
 		if len(tag) > WordMaxCharacters {
-			return fmt.Errorf("Tags must be less than max of %d", WordMaxCharacters)
+			errs.addf(field, ErrCodeFieldTooLong, "Tags must be less than max of %d", WordMaxCharacters)
 		}
 	}
 	if len(listing.Item.Images) == 0 {
-		return errors.New("Listing must contain at least one image")
+		errs.add("item.images", ErrCodeFieldRequired, "Listing must contain at least one image")
 	}
 	if len(listing.Item.Images) > MaxListItems {
-		return fmt.Errorf("Number of listing images is greater than the max of %d", MaxListItems)
+		errs.addf("item.images", ErrCodeFieldTooMany, "Number of listing images is greater than the max of %d", MaxListItems)
 	}
-	for _, img := range listing.Item.Images {
-		_, err := cid.Decode(img.Tiny)
-		if err != nil {
-			return errors.New("Tiny image hashes must be properly formatted CID")
-		}
-		_, err = cid.Decode(img.Small)
-		if err != nil {
-			return errors.New("Small image hashes must be properly formatted CID")
-		}
-		_, err = cid.Decode(img.Medium)
-		if err != nil {
-			return errors.New("Medium image hashes must be properly formatted CID")
-		}
-		_, err = cid.Decode(img.Large)
-		if err != nil {
-			return errors.New("Large image hashes must be properly formatted CID")
-		}
-		_, err = cid.Decode(img.Original)
-		if err != nil {
-			return errors.New("Original image hashes must be properly formatted CID")
-		}
-		if img.Filename == "" {
-			return errors.New("Image file names must not be nil")
-		}
-		if len(img.Filename) > FilenameMaxCharacters {
-			return fmt.Errorf("Image filename length must be less than the max of %d", FilenameMaxCharacters)
-		}
+	for i, img := range listing.Item.Images {
+		validateListingImage(fmt.Sprintf("item.images[%d]", i), img, &errs)
 	}
 	if len(listing.Item.Categories) > MaxCategories {
-		return fmt.Errorf("Number of categories must be less than max of %d", MaxCategories)
+		errs.addf("item.categories", ErrCodeFieldTooMany, "Number of categories must be less than max of %d", MaxCategories)
 	}
-	for _, category := range listing.Item.Categories {
+	for i, category := range listing.Item.Categories {
+		field := fmt.Sprintf("item.categories[%d]", i)
 		if category == "" {
-			return errors.New("Categories must not be nil")
+			errs.add(field, ErrCodeFieldRequired, "Categories must not be nil")
 		}
 		if len(category) > WordMaxCharacters {
-			return fmt.Errorf("Category length must be less than the max of %d", WordMaxCharacters)
+			errs.addf(field, ErrCodeFieldTooLong, "Category length must be less than the max of %d", WordMaxCharacters)
 		}
 	}
 
@@ -991,61 +1016,38 @@ func validateListing(listing *pb.Listing, testnet bool) (err error) {
 	variantSizeMap := make(map[int]int)
 	optionMap := make(map[string]struct{})
 	for i, option := range listing.Item.Options {
+		field := fmt.Sprintf("item.options[%d]", i)
 		if _, ok := optionMap[option.Name]; ok {
-			return errors.New("Option names must be unique")
+			errs.add(field+".name", ErrCodeFieldNotUnique, "Option names must be unique")
 		}
 		if option.Name == "" {
-			return errors.New("Options titles must not be empty")
+			errs.add(field+".name", ErrCodeFieldRequired, "Options titles must not be empty")
 		}
 		if len(option.Variants) < 2 {
-			return errors.New("Options must have more than one variants")
+			errs.add(field+".variants", ErrCodeFieldRequired, "Options must have more than one variants")
 		}
 		if len(option.Name) > WordMaxCharacters {
-			return fmt.Errorf("Option title length must be less than the max of %d", WordMaxCharacters)
+			errs.addf(field+".name", ErrCodeFieldTooLong, "Option title length must be less than the max of %d", WordMaxCharacters)
 		}
 		if len(option.Description) > SentenceMaxCharacters {
-			return fmt.Errorf("Option description length must be less than the max of %d", SentenceMaxCharacters)
+			errs.addf(field+".description", ErrCodeFieldTooLong, "Option description length must be less than the max of %d", SentenceMaxCharacters)
 		}
 		if len(option.Variants) > MaxListItems {
-			return fmt.Errorf("Number of variants is greater than the max of %d", MaxListItems)
+			errs.addf(field+".variants", ErrCodeFieldTooMany, "Number of variants is greater than the max of %d", MaxListItems)
 		}
 		varMap := make(map[string]struct{})
-		for _, variant := range option.Variants {
+		for j, variant := range option.Variants {
+			variantField := fmt.Sprintf("%s.variants[%d]", field, j)
 			if _, ok := varMap[variant.Name]; ok {
-				return errors.New("Variant names must be unique")
+				errs.add(variantField+".name", ErrCodeFieldNotUnique, "Variant names must be unique")
 			}
 			if len(variant.Name) > WordMaxCharacters {
-				return fmt.Errorf("Variant name length must be less than the max of %d", WordMaxCharacters)
+				errs.addf(variantField+".name", ErrCodeFieldTooLong, "Variant name length must be less than the max of %d", WordMaxCharacters)
 			}
 			if variant.Image != nil && (variant.Image.Filename != "" ||
 				variant.Image.Large != "" || variant.Image.Medium != "" || variant.Image.Small != "" ||
 				variant.Image.Tiny != "" || variant.Image.Original != "") {
-				_, err := cid.Decode(variant.Image.Tiny)
-				if err != nil {
-					return errors.New("Tiny image hashes must be properly formatted CID")
-				}
-				_, err = cid.Decode(variant.Image.Small)
-				if err != nil {
-					return errors.New("Small image hashes must be properly formatted CID")
-				}
-				_, err = cid.Decode(variant.Image.Medium)
-				if err != nil {
-					return errors.New("Medium image hashes must be properly formatted CID")
-				}
-				_, err = cid.Decode(variant.Image.Large)
-				if err != nil {
-					return errors.New("Large image hashes must be properly formatted CID")
-				}
-				_, err = cid.Decode(variant.Image.Original)
-				if err != nil {
-					return errors.New("Original image hashes must be properly formatted CID")
-				}
-				if variant.Image.Filename == "" {
-					return errors.New("Image file names must not be nil")
-				}
-				if len(variant.Image.Filename) > FilenameMaxCharacters {
-					return fmt.Errorf("Image filename length must be less than the max of %d", FilenameMaxCharacters)
-				}
+				validateListingImage(variantField+".image", variant.Image, &errs)
 			}
 			varMap[variant.Name] = struct{}{}
 		}
@@ -1055,233 +1057,261 @@ func validateListing(listing *pb.Listing, testnet bool) (err error) {
 	}
 
 	if len(listing.Item.Skus) > maxCombos {
-		return errors.New("More skus than variant combinations")
+		errs.add("item.skus", ErrCodeFieldTooMany, "More skus than variant combinations")
 	}
 	comboMap := make(map[string]bool)
-	for _, sku := range listing.Item.Skus {
+	for i, sku := range listing.Item.Skus {
+		field := fmt.Sprintf("item.skus[%d]", i)
 		if maxCombos > 1 && len(sku.VariantCombo) == 0 {
-			return errors.New("Skus must specify a variant combo when options are used")
+			errs.add(field+".variantCombo", ErrCodeFieldRequired, "Skus must specify a variant combo when options are used")
 		}
 		if len(sku.ProductID) > WordMaxCharacters {
-			return fmt.Errorf("Product ID length must be less than the max of %d", WordMaxCharacters)
+			errs.addf(field+".productID", ErrCodeFieldTooLong, "Product ID length must be less than the max of %d", WordMaxCharacters)
 		}
 		formatted, err := json.Marshal(sku.VariantCombo)
 		if err != nil {
 			return err
 		}
-		_, ok := comboMap[string(formatted)]
-		if !ok {
-			comboMap[string(formatted)] = true
-		} else {
-			return errors.New("Duplicate sku")
+		if comboMap[string(formatted)] {
+			errs.add(field+".variantCombo", ErrCodeFieldNotUnique, "Duplicate sku")
 		}
+		comboMap[string(formatted)] = true
 		if len(sku.VariantCombo) != len(listing.Item.Options) {
-			return errors.New("Incorrect number of variants in sku combination")
+			errs.add(field+".variantCombo", ErrCodeFieldInvalid, "Incorrect number of variants in sku combination")
 		}
-		for i, combo := range sku.VariantCombo {
-			if int(combo) > variantSizeMap[i] {
-				return errors.New("Invalid sku variant combination")
+		for j, combo := range sku.VariantCombo {
+			if int(combo) > variantSizeMap[j] {
+				errs.add(field+".variantCombo", ErrCodeFieldInvalid, "Invalid sku variant combination")
+				break
 			}
 		}
-
 	}
 
 	// Taxes
 	if len(listing.Taxes) > MaxListItems {
-		return fmt.Errorf("Number of taxes is greater than the max of %d", MaxListItems)
+		errs.addf("taxes", ErrCodeFieldTooMany, "Number of taxes is greater than the max of %d", MaxListItems)
 	}
-	for _, tax := range listing.Taxes {
+	for i, tax := range listing.Taxes {
+		field := fmt.Sprintf("taxes[%d]", i)
 		if tax.TaxType == "" {
-			return errors.New("Tax type must be specified")
+			errs.add(field+".taxType", ErrCodeFieldRequired, "Tax type must be specified")
 		}
 		if len(tax.TaxType) > WordMaxCharacters {
-			return fmt.Errorf("Tax type length must be less than the max of %d", WordMaxCharacters)
+			errs.addf(field+".taxType", ErrCodeFieldTooLong, "Tax type length must be less than the max of %d", WordMaxCharacters)
 		}
 		if len(tax.TaxRegions) == 0 {
-			return errors.New("Tax must specify at least one region")
+			errs.add(field+".taxRegions", ErrCodeFieldRequired, "Tax must specify at least one region")
 		}
 		if len(tax.TaxRegions) > MaxCountryCodes {
-			return fmt.Errorf("Number of tax regions is greater than the max of %d", MaxCountryCodes)
+			errs.addf(field+".taxRegions", ErrCodeFieldTooMany, "Number of tax regions is greater than the max of %d", MaxCountryCodes)
 		}
 		if tax.Percentage == 0 || tax.Percentage > 100 {
-			return errors.New("Tax percentage must be between 0 and 100")
+			errs.add(field+".percentage", ErrCodeFieldOutOfRange, "Tax percentage must be between 0 and 100")
 		}
 	}
 
 	// Coupons
 	if len(listing.Coupons) > MaxListItems {
-		return fmt.Errorf("Number of coupons is greater than the max of %d", MaxListItems)
+		errs.addf("coupons", ErrCodeFieldTooMany, "Number of coupons is greater than the max of %d", MaxListItems)
 	}
-	for _, coupon := range listing.Coupons {
+	for i, coupon := range listing.Coupons {
+		field := fmt.Sprintf("coupons[%d]", i)
 		if len(coupon.Title) > CouponTitleMaxCharacters {
-			return fmt.Errorf("Coupon title length must be less than the max of %d", SentenceMaxCharacters)
+			errs.addf(field+".title", ErrCodeFieldTooLong, "Coupon title length must be less than the max of %d", SentenceMaxCharacters)
 		}
 		if len(coupon.GetDiscountCode()) > CodeMaxCharacters {
-			return fmt.Errorf("Coupon code length must be less than the max of %d", CodeMaxCharacters)
+			errs.addf(field+".discountCode", ErrCodeFieldTooLong, "Coupon code length must be less than the max of %d", CodeMaxCharacters)
 		}
 		if coupon.GetPercentDiscount() > 100 {
-			return errors.New("Percent discount cannot be over 100 percent")
+			errs.add(field+".percentDiscount", ErrCodeFieldOutOfRange, "Percent discount cannot be over 100 percent")
 		}
 		if coupon.GetPriceDiscount() > listing.Item.Price {
-			return errors.New("Price discount cannot be greater than the item price")
+			errs.add(field+".priceDiscount", ErrCodeFieldOutOfRange, "Price discount cannot be greater than the item price")
 		}
 		if coupon.GetPercentDiscount() == 0 && coupon.GetPriceDiscount() == 0 {
-			return errors.New("Coupons must have at least one positive discount value")
+			errs.add(field, ErrCodeFieldRequired, "Coupons must have at least one positive discount value")
 		}
 	}
 
 	// Moderators
 	if len(listing.Moderators) > MaxListItems {
-		return fmt.Errorf("Number of moderators is greater than the max of %d", MaxListItems)
+		errs.addf("moderators", ErrCodeFieldTooMany, "Number of moderators is greater than the max of %d", MaxListItems)
 	}
-	for _, moderator := range listing.Moderators {
+	for i, moderator := range listing.Moderators {
 		_, err := mh.FromB58String(moderator)
 		if err != nil {
-			return errors.New("Moderator IDs must be multihashes")
+			errs.add(fmt.Sprintf("moderators[%d]", i), ErrCodeFieldInvalid, "Moderator IDs must be multihashes")
 		}
 	}
 
 	// TermsAndConditions
 	if len(listing.TermsAndConditions) > PolicyMaxCharacters {
-		return fmt.Errorf("Terms and conditions length must be less than the max of %d", PolicyMaxCharacters)
+		errs.addf("termsAndConditions", ErrCodeFieldTooLong, "Terms and conditions length must be less than the max of %d", PolicyMaxCharacters)
 	}
 
 	// RefundPolicy
 	if len(listing.RefundPolicy) > PolicyMaxCharacters {
-		return fmt.Errorf("Refun policy length must be less than the max of %d", PolicyMaxCharacters)
+		errs.addf("refundPolicy", ErrCodeFieldTooLong, "Refun policy length must be less than the max of %d", PolicyMaxCharacters)
 	}
 
 	// Type-specific validations
 	if listing.Metadata.ContractType == pb.Listing_Metadata_PHYSICAL_GOOD {
-		err := validatePhysicalListing(listing)
-		if err != nil {
-			return err
-		}
+		validatePhysicalListing(listing, &errs)
 	} else if listing.Metadata.ContractType == pb.Listing_Metadata_CRYPTOCURRENCY {
-		err := validateCryptocurrencyListing(listing)
-		if err != nil {
-			return err
-		}
+		validateCryptocurrencyListing(listing, &errs)
 	}
 
 	// Format-specific validations
 	if listing.Metadata.Format == pb.Listing_Metadata_MARKET_PRICE {
-		err := validateMarketPriceListing(listing)
-		if err != nil {
-			return err
+		if err := validateMarketPriceListing(listing); err != nil {
+			errs.addError("metadata", err)
 		}
 	}
 
-	return nil
+	// Price tiers
+	validateListingPriceTiers(listing, &errs)
+
+	// Translations
+	validateListingTranslations(listing, &errs)
+
+	return errs.err()
 }
 
-func validatePhysicalListing(listing *pb.Listing) error {
+// validateListingImage records the invalid fields of an image of the
+// listing, whose path is field
+func validateListingImage(field string, img *pb.Listing_Item_Image, errs *ValidationError) {
+	if _, err := cid.Decode(img.Tiny); err != nil {
+		errs.add(field+".tiny", ErrCodeFieldInvalid, "Tiny image hashes must be properly formatted CID")
+	}
+	if _, err := cid.Decode(img.Small); err != nil {
+		errs.add(field+".small", ErrCodeFieldInvalid, "Small image hashes must be properly formatted CID")
+	}
+	if _, err := cid.Decode(img.Medium); err != nil {
+		errs.add(field+".medium", ErrCodeFieldInvalid, "Medium image hashes must be properly formatted CID")
+	}
+	if _, err := cid.Decode(img.Large); err != nil {
+		errs.add(field+".large", ErrCodeFieldInvalid, "Large image hashes must be properly formatted CID")
+	}
+	if _, err := cid.Decode(img.Original); err != nil {
+		errs.add(field+".original", ErrCodeFieldInvalid, "Original image hashes must be properly formatted CID")
+	}
+	if img.Filename == "" {
+		errs.add(field+".filename", ErrCodeFieldRequired, "Image file names must not be nil")
+	}
+	if len(img.Filename) > FilenameMaxCharacters {
+		errs.addf(field+".filename", ErrCodeFieldTooLong, "Image filename length must be less than the max of %d", FilenameMaxCharacters)
+	}
+}
+
+func validatePhysicalListing(listing *pb.Listing, errs *ValidationError) {
 	if listing.Metadata.PricingCurrency == "" {
-		return errors.New("Listing pricing currency code must not be empty")
+		errs.add("metadata.pricingCurrency", ErrCodeFieldRequired, "Listing pricing currency code must not be empty")
 	}
 	if len(listing.Metadata.PricingCurrency) > WordMaxCharacters {
-		return fmt.Errorf("PricingCurrency is longer than the max of %d characters", WordMaxCharacters)
+		errs.addf("metadata.pricingCurrency", ErrCodeFieldTooLong, "PricingCurrency is longer than the max of %d characters", WordMaxCharacters)
 	}
 	if len(listing.Item.Condition) > SentenceMaxCharacters {
-		return fmt.Errorf("Condition length must be less than the max of %d", SentenceMaxCharacters)
+		errs.addf("item.condition", ErrCodeFieldTooLong, "Condition length must be less than the max of %d", SentenceMaxCharacters)
 	}
 	if len(listing.Item.Options) > MaxListItems {
-		return fmt.Errorf("Number of options is greater than the max of %d", MaxListItems)
+		errs.addf("item.options", ErrCodeFieldTooMany, "Number of options is greater than the max of %d", MaxListItems)
 	}
 
 	// ShippingOptions
 	if len(listing.ShippingOptions) == 0 {
-		return errors.New("Must be at least one shipping option for a physical good")
+		errs.add("shippingOptions", ErrCodeFieldRequired, "Must be at least one shipping option for a physical good")
 	}
 	if len(listing.ShippingOptions) > MaxListItems {
-		return fmt.Errorf("Number of shipping options is greater than the max of %d", MaxListItems)
+		errs.addf("shippingOptions", ErrCodeFieldTooMany, "Number of shipping options is greater than the max of %d", MaxListItems)
 	}
 	var shippingTitles []string
-	for _, shippingOption := range listing.ShippingOptions {
+	for i, shippingOption := range listing.ShippingOptions {
+		field := fmt.Sprintf("shippingOptions[%d]", i)
 		if shippingOption.Name == "" {
-			return errors.New("Shipping option title name must not be empty")
+			errs.add(field+".name", ErrCodeFieldRequired, "Shipping option title name must not be empty")
 		}
 		if len(shippingOption.Name) > WordMaxCharacters {
-			return fmt.Errorf("Shipping option service length must be less than the max of %d", WordMaxCharacters)
+			errs.addf(field+".name", ErrCodeFieldTooLong, "Shipping option service length must be less than the max of %d", WordMaxCharacters)
 		}
 		for _, t := range shippingTitles {
 			if t == shippingOption.Name {
-				return errors.New("Shipping option titles must be unique")
+				errs.add(field+".name", ErrCodeFieldNotUnique, "Shipping option titles must be unique")
+				break
 			}
 		}
 		shippingTitles = append(shippingTitles, shippingOption.Name)
 		if shippingOption.Type > pb.Listing_ShippingOption_FIXED_PRICE {
-			return errors.New("Unknown shipping option type")
+			errs.add(field+".type", ErrCodeFieldInvalid, "Unknown shipping option type")
 		}
 		if len(shippingOption.Regions) == 0 {
-			return errors.New("Shipping options must specify at least one region")
+			errs.add(field+".regions", ErrCodeFieldRequired, "Shipping options must specify at least one region")
 		}
-		for _, region := range shippingOption.Regions {
+		for j, region := range shippingOption.Regions {
 			if int(region) == 0 {
-				return errors.New("Shipping region cannot be NA")
+				errs.add(fmt.Sprintf("%s.regions[%d]", field, j), ErrCodeFieldInvalid, "Shipping region cannot be NA")
 			} else if int(region) > 246 && int(region) != 500 {
-				return errors.New("Invalid shipping region")
+				errs.add(fmt.Sprintf("%s.regions[%d]", field, j), ErrCodeFieldInvalid, "Invalid shipping region")
 			}
-
 		}
 		if len(shippingOption.Regions) > MaxCountryCodes {
-			return fmt.Errorf("Number of shipping regions is greater than the max of %d", MaxCountryCodes)
+			errs.addf(field+".regions", ErrCodeFieldTooMany, "Number of shipping regions is greater than the max of %d", MaxCountryCodes)
 		}
 		if len(shippingOption.Services) == 0 && shippingOption.Type != pb.Listing_ShippingOption_LOCAL_PICKUP {
-			return errors.New("At least one service must be specified for a shipping option when not local pickup")
+			errs.add(field+".services", ErrCodeFieldRequired, "At least one service must be specified for a shipping option when not local pickup")
 		}
 		if len(shippingOption.Services) > MaxListItems {
-			return fmt.Errorf("Number of shipping services is greater than the max of %d", MaxListItems)
+			errs.addf(field+".services", ErrCodeFieldTooMany, "Number of shipping services is greater than the max of %d", MaxListItems)
 		}
 		var serviceTitles []string
-		for _, option := range shippingOption.Services {
+		for j, option := range shippingOption.Services {
+			serviceField := fmt.Sprintf("%s.services[%d]", field, j)
 			if option.Name == "" {
-				return errors.New("Shipping option service name must not be empty")
+				errs.add(serviceField+".name", ErrCodeFieldRequired, "Shipping option service name must not be empty")
 			}
 			if len(option.Name) > WordMaxCharacters {
-				return fmt.Errorf("Shipping option service length must be less than the max of %d", WordMaxCharacters)
+				errs.addf(serviceField+".name", ErrCodeFieldTooLong, "Shipping option service length must be less than the max of %d", WordMaxCharacters)
 			}
 			for _, t := range serviceTitles {
 				if t == option.Name {
-					return errors.New("Shipping option services names must be unique")
+					errs.add(serviceField+".name", ErrCodeFieldNotUnique, "Shipping option services names must be unique")
+					break
 				}
 			}
 			serviceTitles = append(serviceTitles, option.Name)
 			if option.EstimatedDelivery == "" {
-				return errors.New("Shipping option estimated delivery must not be empty")
+				errs.add(serviceField+".estimatedDelivery", ErrCodeFieldRequired, "Shipping option estimated delivery must not be empty")
 			}
 			if len(option.EstimatedDelivery) > SentenceMaxCharacters {
-				return fmt.Errorf("Shipping option estimated delivery length must be less than the max of %d", SentenceMaxCharacters)
+				errs.addf(serviceField+".estimatedDelivery", ErrCodeFieldTooLong, "Shipping option estimated delivery length must be less than the max of %d", SentenceMaxCharacters)
 			}
 		}
 	}
-
-	return nil
 }
 
-func validateCryptocurrencyListing(listing *pb.Listing) error {
+func validateCryptocurrencyListing(listing *pb.Listing, errs *ValidationError) {
+	if len(listing.Coupons) > 0 {
+		errs.addError("coupons", ErrCryptocurrencyListingIllegalField("coupons"))
+	}
+	if len(listing.Item.Options) > 0 {
+		errs.addError("item.options", ErrCryptocurrencyListingIllegalField("item.options"))
+	}
+	if len(listing.ShippingOptions) > 0 {
+		errs.addError("shippingOptions", ErrCryptocurrencyListingIllegalField("shippingOptions"))
+	}
+	if len(listing.Item.Condition) > 0 {
+		errs.addError("item.condition", ErrCryptocurrencyListingIllegalField("item.condition"))
+	}
+	if len(listing.Metadata.PricingCurrency) > 0 {
+		errs.addError("metadata.pricingCurrency", ErrCryptocurrencyListingIllegalField("metadata.pricingCurrency"))
+	}
 	switch {
-	case len(listing.Coupons) > 0:
-		return ErrCryptocurrencyListingIllegalField("coupons")
-	case len(listing.Item.Options) > 0:
-		return ErrCryptocurrencyListingIllegalField("item.options")
-	case len(listing.ShippingOptions) > 0:
-		return ErrCryptocurrencyListingIllegalField("shippingOptions")
-	case len(listing.Item.Condition) > 0:
-		return ErrCryptocurrencyListingIllegalField("item.condition")
-	case len(listing.Metadata.PricingCurrency) > 0:
-		return ErrCryptocurrencyListingIllegalField("metadata.pricingCurrency")
 	case strings.ToLower(listing.Metadata.CoinType) == "bch":
-		return ErrCryptocurrencyListingIllegalField("Currency `BCH` not tracked")
+		errs.add("metadata.coinType", ErrCodeFieldInvalid, illegalFieldString("cryptocurrency listing", "Currency `BCH` not tracked"))
 	case listing.Metadata.CoinType == "":
-		return ErrCryptocurrencyListingCoinTypeRequired
+		errs.addError("metadata.coinType", ErrCryptocurrencyListingCoinTypeRequired)
+	case listing.Metadata.CoinDivisibility != coinDivisibilityForType(listing.Metadata.CoinType):
+		errs.addError("metadata.coinDivisibility", ErrListingCoinDivisibilityIncorrect)
 	}
-
-	if listing.Metadata.CoinDivisibility != coinDivisibilityForType(listing.Metadata.CoinType) {
-		return ErrListingCoinDivisibilityIncorrect
-	}
-
-	return nil
 }
 
 func validateMarketPriceListing(listing *pb.Listing) error {
@@ -1304,6 +1334,36 @@ func validateMarketPriceListing(listing *pb.Listing) error {
 	return nil
 }
 
+func validateListingPriceTiers(listing *pb.Listing, errs *ValidationError) {
+	if !hasPriceTiers(listing) {
+		return
+	}
+	if listing.Metadata.Format == pb.Listing_Metadata_MARKET_PRICE {
+		errs.addError("item.priceTiers", ErrMarketPriceListingIllegalField("priceTiers"))
+		return
+	}
+	validatePriceTiers("item.priceTiers", listing.Item.PriceTiers, errs)
+	for i, sku := range listing.Item.Skus {
+		validatePriceTiers(fmt.Sprintf("item.skus[%d].priceTiers", i), sku.PriceTiers, errs)
+	}
+}
+
+func validatePriceTiers(field string, tiers []*pb.Listing_Item_PriceTier, errs *ValidationError) {
+	if len(tiers) > MaxListItems {
+		errs.addf(field, ErrCodeFieldTooMany, "Number of price tiers is greater than the max of %d", MaxListItems)
+	}
+	var lastQuantity uint64 = 1
+	for i, tier := range tiers {
+		if tier.MinQuantity <= lastQuantity {
+			errs.add(fmt.Sprintf("%s[%d].minQuantity", field, i), ErrCodeFieldOutOfRange, "Price tier minimum quantities must be greater than one and in ascending order")
+		}
+		if tier.Price == 0 {
+			errs.add(fmt.Sprintf("%s[%d].price", field, i), ErrCodeFieldOutOfRange, "Zero price tiers are not allowed")
+		}
+		lastQuantity = tier.MinQuantity
+	}
+}
+
 func validateListingSkus(listing *pb.Listing) error {
 	if listing.Metadata.ContractType == pb.Listing_Metadata_CRYPTOCURRENCY {
 		for _, sku := range listing.Item.Skus {
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/proto"
 )
 
 func TestFactoryCryptoListingCoinDivisibilityMatchesConst(t *testing.T) {
@@ -12,3 +14,44 @@ func TestFactoryCryptoListingCoinDivisibilityMatchesConst(t *testing.T) {
 		t.Fatal("DefaultCoinDivisibility constant has changed. Please update factory value.")
 	}
 }
+
+func TestListingTranslation(t *testing.T) {
+	newListing := func() *pb.Listing {
+		listing := factory.NewListing("localized")
+		listing.Metadata.Language = "en"
+		listing.Translations = []*pb.Listing_Translation{
+			{
+				Language:    "de",
+				Title:       "Ron Swanson T-Shirt",
+				OptionNames: []*pb.Listing_Translation_OptionName{{Option: "Size", Name: "Größe"}},
+			},
+			{Language: "pt-BR", Title: "Camiseta Ron Swanson"},
+		}
+		return listing
+	}
+
+	listing := newListing()
+	if langs := core.ListingLanguages(listing); len(langs) != 3 || langs[0] != "en" || langs[1] != "de" || langs[2] != "pt-BR" {
+		t.Errorf("unexpected listing languages: %v", langs)
+	}
+
+	translation, lang := core.ListingTranslation(listing, []string{"fr", "de-AT"})
+	if lang != "de" || translation != listing.Translations[0] {
+		t.Errorf("expected de translation to be selected, got %q", lang)
+	}
+	if !proto.Equal(listing, newListing()) {
+		t.Error("expected the listing to be left untouched so its signature still verifies")
+	}
+
+	if translation, lang := core.ListingTranslation(listing, []string{"pt"}); lang != "pt-BR" || translation.Title != "Camiseta Ron Swanson" {
+		t.Errorf("expected base language match for pt, got %q", lang)
+	}
+
+	if translation, lang := core.ListingTranslation(listing, []string{"en-US", "de"}); lang != "en" || translation != nil {
+		t.Errorf("expected primary language to be preferred, got %q", lang)
+	}
+
+	if translation, lang := core.ListingTranslation(listing, []string{"ja"}); lang != "" || translation != nil {
+		t.Errorf("expected no translation, got %q", lang)
+	}
+}
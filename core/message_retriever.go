@@ -11,9 +11,11 @@ func (n *OpenBazaarNode) StartMessageRetriever() {
 		Db:        n.Datastore,
 		IPFSNode:  n.IpfsNode,
 		BanManger: n.BanManager,
+		Sessions:  n.Sessions,
+		Dedup:     n.Deduplicator,
 		Service:   n.Service,
 		PrefixLen: 14,
-		PushNodes: n.PushNodes,
+		PushNodes: n.PushNodeManager.All(),
 		Dialer:    n.TorDialer,
 		SendAck:   n.SendOfflineAck,
 		SendError: n.SendError,
@@ -35,6 +35,9 @@ func (n *OpenBazaarNode) sendMessage(peerID string, k *libp2p.PubKey, message pb
 	if err != nil {
 		return err
 	}
+	if err := n.checkPeerSupports(p, message.MessageType); err != nil {
+		return err
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	err = n.Service.SendMessage(ctx, p, &message)
@@ -102,12 +105,16 @@ func (n *OpenBazaarNode) SendOfflineMessage(p peer.ID, k *libp2p.PubKey, m *pb.M
 		}
 
 		// Push provider to our push nodes for redundancy
-		for _, p := range n.PushNodes {
+		for _, p := range n.PushNodeManager.Active() {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
+			start := time.Now()
 			err := ipfs.PutPointerToPeer(n.IpfsNode, ctx, p, pointer)
 			if err != nil {
 				log.Error(err)
+				n.PushNodeManager.RecordFailure(p, err)
+			} else {
+				n.PushNodeManager.RecordSuccess(p, time.Since(start))
 			}
 		}
 
@@ -140,11 +147,7 @@ func (n *OpenBazaarNode) GetPeerStatus(peerID string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	m := pb.Message{MessageType: pb.Message_PING}
-	_, err = n.Service.SendRequest(ctx, p, &m)
-	if err != nil {
+	if _, err := n.ExchangeCapabilities(p); err != nil {
 		return "offline", nil
 	}
 	return "online", nil
@@ -195,6 +198,9 @@ func (n *OpenBazaarNode) Follow(peerID string) error {
 	if err != nil {
 		return err
 	}
+	if n.StoreAnnouncer != nil {
+		n.StoreAnnouncer.Subscribe(peerID)
+	}
 	err = n.UpdateFollow()
 	if err != nil {
 		return err
@@ -247,6 +253,9 @@ func (n *OpenBazaarNode) Unfollow(peerID string) error {
 	if err != nil {
 		return err
 	}
+	if n.StoreAnnouncer != nil {
+		n.StoreAnnouncer.Unsubscribe(peerID)
+	}
 	err = n.UpdateFollow()
 	if err != nil {
 		return err
@@ -261,6 +270,10 @@ func (n *OpenBazaarNode) SendOrder(peerID string, contract *pb.RicardianContract
 		return resp, err
 	}
 
+	if err := n.checkPeerSupportsListings(p, contract.VendorListings); err != nil {
+		return resp, err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	any, err := ptypes.MarshalAny(contract)
@@ -465,6 +478,9 @@ func (n *OpenBazaarNode) SendChat(peerID string, chatMessage *pb.Chat) error {
 	if err != nil {
 		return err
 	}
+	if err := n.checkPeerSupports(p, m.MessageType); err != nil {
+		return err
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	err = n.Service.SendMessage(ctx, p, &m)
@@ -616,18 +632,25 @@ func (n *OpenBazaarNode) SendStore(peerID string, ids []cid.Cid) error {
 	if err != nil {
 		return err
 	}
+	start := time.Now()
 	pmes, err := n.Service.SendRequest(context.Background(), p, &m)
 	if err != nil {
+		n.PushNodeManager.RecordFailure(p, err)
 		return err
 	}
 	defer n.Service.DisconnectFromPeer(p)
 	if pmes.Payload == nil {
-		return errors.New("Peer responded with nil payload")
+		err = errors.New("Peer responded with nil payload")
+		n.PushNodeManager.RecordFailure(p, err)
+		return err
 	}
 	if pmes.MessageType == pb.Message_ERROR {
 		log.Errorf("Error response from %s: %s", peerID, string(pmes.Payload.Value))
-		return errors.New("Peer responded with error message")
+		err = errors.New("Peer responded with error message")
+		n.PushNodeManager.RecordFailure(p, err)
+		return err
 	}
+	n.PushNodeManager.RecordSuccess(p, time.Since(start))
 
 	resp := new(pb.CidList)
 	err = ptypes.UnmarshalAny(pmes.Payload, resp)
@@ -79,6 +79,20 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderID string, paymentAd
 	if err != nil {
 		return "", "", 0, false, err
 	}
+	// Refuse the order before it's sent, as a vendor which is too old for
+	// the listings would otherwise be sent an offline order
+	vendorID, err := peer.IDB58Decode(contract.VendorListings[0].VendorID.PeerID)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	if n.PeerCapabilities.Get(vendorID) == nil {
+		if _, err := n.ExchangeCapabilities(vendorID); err != nil {
+			log.Debugf("Vendor %s is offline, not checking its capabilities: %s", vendorID.Pretty(), err)
+		}
+	}
+	if err := n.checkPeerSupportsListings(vendorID, contract.VendorListings); err != nil {
+		return "", "", 0, false, err
+	}
 
 	// Add payment data and send to vendor
 	if data.Moderator != "" { // Moderated payment
@@ -122,6 +136,10 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderID string, paymentAd
 		if (fpb * EscrowReleaseSize) > (payment.Amount / 4) {
 			return "", "", 0, false, errors.New("transaction fee too high for moderated payment")
 		}
+		contract.BuyerOrder.Payment = payment
+		if err := n.ValidateOrderPriceTiers(contract); err != nil {
+			return "", "", 0, false, err
+		}
 
 		/* Generate a payment address using the first child key derived from the buyers's,
 		   vendors's and moderator's masterPubKey and a random chaincode. */
@@ -251,6 +269,9 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderID string, paymentAd
 
 	payment.Amount = total
 	contract.BuyerOrder.Payment = payment
+	if err := n.ValidateOrderPriceTiers(contract); err != nil {
+		return "", "", 0, false, err
+	}
 	contract, err = n.SignOrder(contract)
 	if err != nil {
 		return "", "", 0, false, err
@@ -391,7 +412,7 @@ func extractErrorMessage(m *pb.Message) error {
 			return errors.New(errMsg.ErrorMessage)
 		}
 
-		return fmt.Errorf("vendor rejected order, reason: %s", errMsg.ErrorMessage)
+		return newOrderRejectedError(errMsg.ErrorMessage)
 	}
 	// For backwards compatibility check for a string payload
 	return errors.New(string(m.Payload.Value))
@@ -783,16 +804,24 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 		if err != nil {
 			return 0, err
 		}
-		itemTotal += satoshis
 		selectedSku, err := GetSelectedSku(l, item.Options)
 		if err != nil {
 			return 0, err
 		}
+		// Replace the unit price if the quantity qualifies for a price tier
+		tier, skuTier := GetPriceTier(l, selectedSku, itemQuantity)
+		if tier != nil {
+			satoshis, err = n.getPriceInSatoshi(l.Metadata.PricingCurrency, tier.Price)
+			if err != nil {
+				return 0, err
+			}
+		}
+		itemTotal += satoshis
 		var skuExists bool
 		for i, sku := range l.Item.Skus {
 			if selectedSku == i {
 				skuExists = true
-				if sku.Surcharge != 0 {
+				if sku.Surcharge != 0 && !skuTier {
 					surcharge := uint64(sku.Surcharge)
 					if sku.Surcharge < 0 {
 						surcharge = uint64(-sku.Surcharge)
@@ -1058,32 +1087,32 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract, checkInve
 
 	// Check order contains all required fields
 	if contract.BuyerOrder == nil {
-		return errors.New("contract doesn't contain an order")
+		return ErrOrderMissingOrder
 	}
 	if contract.BuyerOrder.Payment == nil {
-		return errors.New("order doesn't contain a payment")
+		return ErrOrderMissingPayment
 	}
 	if contract.BuyerOrder.BuyerID == nil {
-		return errors.New("order doesn't contain a buyer ID")
+		return ErrOrderMissingBuyerID
 	}
 	if len(contract.BuyerOrder.Items) == 0 {
-		return errors.New("order hasn't selected any items")
+		return ErrOrderMissingItems
 	}
 	if len(contract.BuyerOrder.RatingKeys) != len(contract.BuyerOrder.Items) {
-		return errors.New("number of rating keys do not match number of items")
+		return ErrOrderRatingKeysMismatch
 	}
 	for _, ratingKey := range contract.BuyerOrder.RatingKeys {
 		if len(ratingKey) != 33 {
-			return errors.New("invalid rating key in order")
+			return ErrOrderInvalidRatingKey
 		}
 	}
 	if contract.BuyerOrder.Timestamp == nil {
-		return errors.New("order is missing a timestamp")
+		return ErrOrderMissingTimestamp
 	}
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		_, err := mh.FromB58String(contract.BuyerOrder.Payment.Moderator)
 		if err != nil {
-			return errors.New("invalid moderator")
+			return ErrOrderInvalidModerator
 		}
 		var availableMods []string
 		for _, listing := range contract.VendorListings {
@@ -1097,7 +1126,7 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract, checkInve
 			}
 		}
 		if !validMod {
-			return errors.New("invalid moderator")
+			return ErrOrderInvalidModerator
 		}
 	}
 
@@ -1131,7 +1160,7 @@ collectListings:
 		}
 	}
 	if len(itemHashes) > 0 {
-		return errors.New("item hashes in the order do not match the included listings")
+		return ErrOrderListingHashMismatch
 	}
 
 	// Validate no duplicate coupons
@@ -1139,7 +1168,7 @@ collectListings:
 		couponMap := make(map[string]bool)
 		for _, c := range item.CouponCodes {
 			if couponMap[c] {
-				return errors.New("duplicate coupon code in order")
+				return ErrOrderDuplicateCoupon
 			}
 			couponMap[c] = true
 		}
@@ -1176,7 +1205,7 @@ collectListings:
 						}
 					}
 					if !validVariant {
-						return errors.New("selected variant not in listing")
+						return ErrOrderInvalidVariant
 					}
 				}
 			check:
@@ -1189,7 +1218,7 @@ collectListings:
 			}
 		}
 		if len(listingOptions) > 0 {
-			return errors.New("not all options were selected")
+			return ErrOrderMissingOptions
 		}
 		// Create inventory paths to check later
 		inv.Count = int64(GetOrderQuantity(listingMap[item.ListingHash], item))
@@ -1212,7 +1241,7 @@ collectListings:
 					}
 				}
 				if option == nil {
-					return errors.New("shipping option not found in listing")
+					return ErrOrderInvalidShipping
 				}
 
 				// Check that this option ships to buyer
@@ -1224,7 +1253,7 @@ collectListings:
 					}
 				}
 				if !shipsToMe {
-					return errors.New("listing does ship to selected country")
+					return ErrOrderShippingRegion
 				}
 
 				// Check service exists
@@ -1236,7 +1265,7 @@ collectListings:
 						}
 					}
 					if service == nil {
-						return errors.New("shipping service not found in listing")
+						return ErrOrderInvalidService
 					}
 				}
 				break
@@ -1249,7 +1278,7 @@ collectListings:
 		for _, inv := range inventoryList {
 			amt, err := n.Datastore.Inventory().GetSpecific(inv.Slug, inv.Variant)
 			if err != nil {
-				return errors.New("vendor has no inventory for the selected variant")
+				return ErrOrderNoInventory
 			}
 			if amt >= 0 && amt < inv.Count {
 				return NewErrOutOfInventory(amt)
@@ -1267,13 +1296,13 @@ collectListings:
 	}
 	if containsPhysicalGood {
 		if contract.BuyerOrder.Shipping == nil {
-			return errors.New("order is missing shipping object")
+			return ErrOrderMissingShipping
 		}
 		if contract.BuyerOrder.Shipping.Address == "" {
-			return errors.New("shipping address is empty")
+			return ErrOrderMissingAddress
 		}
 		if contract.BuyerOrder.Shipping.ShipTo == "" {
-			return errors.New("ship to name is empty")
+			return ErrOrderMissingShipTo
 		}
 	}
 
@@ -1283,6 +1312,11 @@ collectListings:
 		return err
 	}
 
+	// Validate the ordered quantities meet the price tiers they're paid at
+	if err := n.ValidateOrderPriceTiers(contract); err != nil {
+		return err
+	}
+
 	// Validate the each item in the order is for sale
 	if !n.hasKnownListings(contract) {
 		return ErrPurchaseUnknownListing
@@ -1520,6 +1554,135 @@ func SameSku(selectedVariants []int, sku *pb.Listing_Item_Sku) bool {
 	return true
 }
 
+// GetPriceTier - return the price tier which applies to the ordered quantity of
+// the selected sku, or nil if the regular price applies. Tiers on the sku take
+// precedence over the item tiers, in which case the returned bool is true and
+// the tier price already includes the sku surcharge.
+func GetPriceTier(listing *pb.Listing, selectedSku int, quantity uint64) (*pb.Listing_Item_PriceTier, bool) {
+	if listing.Metadata.Format == pb.Listing_Metadata_MARKET_PRICE {
+		return nil, false
+	}
+	if selectedSku < len(listing.Item.Skus) && len(listing.Item.Skus[selectedSku].PriceTiers) > 0 {
+		tier := priceTierForQuantity(listing.Item.Skus[selectedSku].PriceTiers, quantity)
+		return tier, tier != nil
+	}
+	return priceTierForQuantity(listing.Item.PriceTiers, quantity), false
+}
+
+func priceTierForQuantity(tiers []*pb.Listing_Item_PriceTier, quantity uint64) *pb.Listing_Item_PriceTier {
+	var selected *pb.Listing_Item_PriceTier
+	for _, tier := range tiers {
+		if quantity >= tier.MinQuantity && (selected == nil || tier.MinQuantity > selected.MinQuantity) {
+			selected = tier
+		}
+	}
+	return selected
+}
+
+// ValidateOrderPriceTiers - validate the price tiers of the ordered listings
+// and that no item is paid at a tier its quantity doesn't meet. An item is
+// paid at the tier whose order total is closest to the payment amount, so a
+// change of the exchange rates doesn't reject the order.
+func (n *OpenBazaarNode) ValidateOrderPriceTiers(contract *pb.RicardianContract) error {
+	var total uint64
+	for _, item := range contract.BuyerOrder.Items {
+		listing, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil {
+			return err
+		}
+		if !hasPriceTiers(listing) {
+			continue
+		}
+		var errs ValidationError
+		validateListingPriceTiers(listing, &errs)
+		if errs.err() != nil {
+			return ErrPurchasePriceTierNotApplied
+		}
+
+		payment := contract.BuyerOrder.Payment
+		if payment == nil || payment.Amount == 0 {
+			continue
+		}
+		if total == 0 {
+			total, err = n.CalculateOrderTotal(contract)
+			if err != nil {
+				// The handlers of the payment methods report totals which
+				// can't be calculated
+				return nil
+			}
+		}
+		deltas, err := n.unmetPriceTierDeltas(listing, item)
+		if err != nil {
+			return err
+		}
+		distance := absDifference(int64(payment.Amount), int64(total))
+		for _, delta := range deltas {
+			if absDifference(int64(payment.Amount), int64(total)+delta) < distance {
+				return ErrPurchasePriceTierNotApplied
+			}
+		}
+	}
+	return nil
+}
+
+// unmetPriceTierDeltas returns how much the order total changes when the
+// item is priced at each tier its quantity doesn't meet
+func (n *OpenBazaarNode) unmetPriceTierDeltas(listing *pb.Listing, item *pb.Order_Item) ([]int64, error) {
+	selectedSku, err := GetSelectedSku(listing, item.Options)
+	if err != nil {
+		return nil, err
+	}
+	quantity := GetOrderQuantity(listing, item)
+
+	// Sku tiers include the surcharge, item tiers replace the base price
+	tiers := listing.Item.PriceTiers
+	applied := int64(listing.Item.Price)
+	if selectedSku < len(listing.Item.Skus) && len(listing.Item.Skus[selectedSku].PriceTiers) > 0 {
+		tiers = listing.Item.Skus[selectedSku].PriceTiers
+		applied += listing.Item.Skus[selectedSku].Surcharge
+	}
+	if tier, _ := GetPriceTier(listing, selectedSku, quantity); tier != nil {
+		applied = int64(tier.Price)
+	}
+
+	var deltas []int64
+	for _, tier := range tiers {
+		if tier.MinQuantity <= quantity {
+			continue
+		}
+		difference := int64(tier.Price) - applied
+		satoshis, err := n.getPriceInSatoshi(listing.Metadata.PricingCurrency, uint64(absDifference(difference, 0)))
+		if err != nil {
+			return nil, err
+		}
+		delta := int64(satoshis * quantity)
+		if difference < 0 {
+			delta = -delta
+		}
+		deltas = append(deltas, delta)
+	}
+	return deltas, nil
+}
+
+func absDifference(a, b int64) int64 {
+	if a < b {
+		return b - a
+	}
+	return a - b
+}
+
+func hasPriceTiers(listing *pb.Listing) bool {
+	if len(listing.Item.PriceTiers) > 0 {
+		return true
+	}
+	for _, sku := range listing.Item.Skus {
+		if len(sku.PriceTiers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // GetOrderQuantity - return the specified item quantity
 func GetOrderQuantity(l *pb.Listing, item *pb.Order_Item) uint64 {
 	if l.Metadata.Version < 3 {
@@ -351,4 +351,129 @@ func TestOpenBazaarNode_CalculateOrderTotal(t *testing.T) {
 	if total != 1115000 {
 		t.Error("Calculated wrong order total")
 	}
+
+	// Test price tiers
+	listing3 := &pb.Listing{
+		Metadata: &pb.Listing_Metadata{
+			ContractType:       pb.Listing_Metadata_PHYSICAL_GOOD,
+			Format:             pb.Listing_Metadata_FIXED_PRICE,
+			AcceptedCurrencies: []string{"BTC"},
+			PricingCurrency:    "BTC",
+			Version:            3,
+		},
+		Item: &pb.Listing_Item{
+			Price: 100000,
+			PriceTiers: []*pb.Listing_Item_PriceTier{
+				{MinQuantity: 10, Price: 90000},
+				{MinQuantity: 100, Price: 75000},
+			},
+			Options: []*pb.Listing_Item_Option{
+				{
+					Name: "color",
+					Variants: []*pb.Listing_Item_Option_Variant{
+						{Name: "red"},
+						{Name: "blue"},
+					},
+				},
+			},
+			Skus: []*pb.Listing_Item_Sku{
+				{
+					Surcharge:    10000,
+					VariantCombo: []uint32{0},
+				},
+				{
+					Surcharge:    10000,
+					VariantCombo: []uint32{1},
+					PriceTiers: []*pb.Listing_Item_PriceTier{
+						{MinQuantity: 5, Price: 80000},
+					},
+				},
+			},
+		},
+		ShippingOptions: []*pb.Listing_ShippingOption{
+			{
+				Name:    "Pickup",
+				Regions: []pb.CountryCode{pb.CountryCode_ALL},
+				Type:    pb.Listing_ShippingOption_LOCAL_PICKUP,
+			},
+		},
+	}
+	ser, err = proto.Marshal(listing3)
+	if err != nil {
+		t.Error(err)
+	}
+	listingID, err = core.EncodeCID(ser)
+	if err != nil {
+		t.Error(err)
+	}
+	contract3 := &pb.RicardianContract{
+		VendorListings: []*pb.Listing{listing3},
+		BuyerOrder: &pb.Order{
+			Items: []*pb.Order_Item{
+				{
+					ListingHash:    listingID.String(),
+					ShippingOption: &pb.Order_Item_ShippingOption{Name: "Pickup"},
+				},
+			},
+			Shipping: &pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES},
+		},
+	}
+
+	tests := []struct {
+		color    string
+		quantity uint64
+		expected uint64
+	}{
+		// Regular price plus surcharge below the first tier
+		{"red", 9, 9 * 110000},
+		// Item tiers replace the base price, surcharge still applies
+		{"red", 10, 10 * 100000},
+		{"red", 100, 100 * 85000},
+		// Sku tiers replace the unit price including surcharge
+		{"blue", 4, 4 * 110000},
+		{"blue", 5, 5 * 80000},
+		{"blue", 100, 100 * 80000},
+	}
+	for _, tt := range tests {
+		contract3.BuyerOrder.Items[0].Quantity64 = tt.quantity
+		contract3.BuyerOrder.Items[0].Options = []*pb.Order_Item_Option{{Name: "color", Value: tt.color}}
+		total, err = node.CalculateOrderTotal(contract3)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if total != tt.expected {
+			t.Errorf("Calculated wrong order total for %d %s: expected %d, got %d", tt.quantity, tt.color, tt.expected, total)
+		}
+	}
+
+	// Orders paid at a tier their quantity doesn't meet are rejected
+	contract3.BuyerOrder.Payment = &pb.Order_Payment{}
+	tierTests := []struct {
+		color    string
+		quantity uint64
+		amount   uint64
+		valid    bool
+	}{
+		{"red", 5, 5 * 110000, true},
+		{"red", 10, 10 * 100000, true},
+		{"blue", 5, 5 * 80000, true},
+		// Close to the tiered total after the exchange rate changed
+		{"red", 10, 10*100000 + 1000, true},
+		{"red", 5, 5 * 100000, false},
+		{"red", 50, 50 * 85000, false},
+		{"blue", 4, 4 * 80000, false},
+	}
+	for _, tt := range tierTests {
+		contract3.BuyerOrder.Items[0].Quantity64 = tt.quantity
+		contract3.BuyerOrder.Items[0].Options = []*pb.Order_Item_Option{{Name: "color", Value: tt.color}}
+		contract3.BuyerOrder.Payment.Amount = tt.amount
+		err = node.ValidateOrderPriceTiers(contract3)
+		if tt.valid && err != nil {
+			t.Errorf("Rejected %d %s paid %d: %s", tt.quantity, tt.color, tt.amount, err)
+		}
+		if !tt.valid && err != core.ErrPurchasePriceTierNotApplied {
+			t.Errorf("Expected %d %s paid %d to be rejected, got %v", tt.quantity, tt.color, tt.amount, err)
+		}
+	}
 }
@@ -6,6 +6,6 @@ import (
 
 // StartPointerRepublisher - setup republisher for IPNS
 func (n *OpenBazaarNode) StartPointerRepublisher() {
-	n.PointerRepublisher = net.NewPointerRepublisher(n.IpfsNode, n.Datastore, n.PushNodes, n.IsModerator)
+	n.PointerRepublisher = net.NewPointerRepublisher(n.IpfsNode, n.Datastore, n.MessageStorage, n.PushNodeManager.Active, n.IsModerator)
 	go n.PointerRepublisher.Run()
 }
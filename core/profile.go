@@ -103,6 +103,9 @@ func (n *OpenBazaarNode) UpdateProfile(profile *pb.Profile) error {
 	if _, err := f.WriteString(out); err != nil {
 		return err
 	}
+	if err := n.UpdatePreKeyBundle(); err != nil {
+		log.Errorf("updating prekey bundle: %s", err.Error())
+	}
 	return nil
 }
 
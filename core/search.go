@@ -0,0 +1,211 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/op/go-logging"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	searchIndexerInterval = time.Duration(1) * time.Hour
+	searchIndexerTimeout  = time.Duration(30) * time.Second
+	searchIndexerWorkers  = 8
+	// searchPeerExpiry is how long the listings of a store which isn't
+	// followed stay indexed after it was last browsed
+	searchPeerExpiry = time.Duration(30*24) * time.Hour
+)
+
+type searchIndexer struct {
+	// PerformTask dependencies
+	node *OpenBazaarNode
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartSearchIndexer - start the worker which keeps the local search index
+// in sync with the listings of followed and previously browsed stores
+func (n *OpenBazaarNode) StartSearchIndexer() {
+	n.SearchIndexer = &searchIndexer{
+		node:          n,
+		intervalDelay: searchIndexerInterval,
+		logger:        logging.MustGetLogger("searchIndexer"),
+	}
+	go n.SearchIndexer.Run()
+}
+
+func (indexer *searchIndexer) Run() {
+	indexer.watchdogTimer = time.NewTicker(indexer.intervalDelay)
+	indexer.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	indexer.PerformTask()
+	for {
+		select {
+		case <-indexer.watchdogTimer.C:
+			indexer.PerformTask()
+		case <-indexer.stopWorker:
+			indexer.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (indexer *searchIndexer) Stop() {
+	indexer.stopWorker <- true
+	close(indexer.stopWorker)
+}
+
+// PerformTask removes the stores which expired from the index, then resolves
+// the IPNS root of every followed and indexed store and reindexes the stores
+// whose root has changed since they were last indexed
+func (indexer *searchIndexer) PerformTask() {
+	following, err := indexer.node.Datastore.Following().Get("", -1)
+	if err != nil {
+		indexer.logger.Errorf("loading following: %s", err.Error())
+		return
+	}
+	if err := indexer.node.Datastore.Search().DeletePeersBefore(time.Now().Add(-searchPeerExpiry)); err != nil {
+		indexer.logger.Errorf("removing expired peers: %s", err.Error())
+	}
+	peers, err := indexer.node.Datastore.Search().GetPeers()
+	if err != nil {
+		indexer.logger.Errorf("loading indexed peers: %s", err.Error())
+		return
+	}
+	for _, peerID := range following {
+		if _, ok := peers[peerID]; !ok {
+			peers[peerID] = ""
+		}
+	}
+	delete(peers, indexer.node.IPFSIdentityString())
+
+	var (
+		updated int
+		lock    sync.Mutex
+		wg      sync.WaitGroup
+		buf     = make(chan struct{}, searchIndexerWorkers)
+	)
+	for peerID, indexedRoot := range peers {
+		wg.Add(1)
+		buf <- struct{}{}
+		go func(peerID, indexedRoot string) {
+			defer func() {
+				<-buf
+				wg.Done()
+			}()
+			if indexer.reindexPeer(peerID, indexedRoot) {
+				lock.Lock()
+				updated++
+				lock.Unlock()
+			}
+		}(peerID, indexedRoot)
+	}
+	wg.Wait()
+	if updated > 0 {
+		indexer.logger.Infof("reindexed listings of %d stores", updated)
+	}
+}
+
+// reindexPeer reindexes the listings of the peer if its root has changed and
+// returns whether it did
+func (indexer *searchIndexer) reindexPeer(peerID, indexedRoot string) bool {
+	root, err := indexer.node.IPNSResolve(peerID, searchIndexerTimeout, false)
+	if err != nil {
+		indexer.logger.Debugf("resolving %s: %s", peerID, err.Error())
+		return false
+	}
+	if root == indexedRoot {
+		return false
+	}
+	listingsJSON, err := ipfs.Cat(indexer.node.IpfsNode, path.Join(root, "listings.json"), searchIndexerTimeout)
+	if err != nil {
+		indexer.logger.Debugf("fetching listings of %s: %s", peerID, err.Error())
+		return false
+	}
+	if err := indexer.node.IndexPeerListings(peerID, root, listingsJSON); err != nil {
+		indexer.logger.Errorf("indexing listings of %s: %s", peerID, err.Error())
+		return false
+	}
+	return true
+}
+
+// IndexPeerListings replaces the indexed listings of a peer with the contents
+// of its listings.json index. The root is the IPFS hash the index was loaded
+// from, or empty if unknown.
+func (n *OpenBazaarNode) IndexPeerListings(peerID, root string, listingsJSON []byte) error {
+	var index []ListingData
+	if err := json.Unmarshal(listingsJSON, &index); err != nil {
+		return err
+	}
+	now := time.Now()
+	listings := make([]repo.SearchListing, 0, len(index))
+	for _, ld := range index {
+		listings = append(listings, repo.SearchListing{
+			PeerID:          peerID,
+			Slug:            ld.Slug,
+			Hash:            ld.Hash,
+			Title:           ld.Title,
+			Description:     ld.Description,
+			Tags:            ld.Tags,
+			Categories:      ld.Categories,
+			ShipsTo:         ld.ShipsTo,
+			PricingCurrency: ld.Price.CurrencyCode,
+			Price:           ld.Price.Amount,
+			Thumbnail:       ld.Thumbnail.Small,
+			IndexedAt:       now,
+		})
+	}
+	return n.Datastore.Search().ReplacePeer(peerID, root, listings)
+}
+
+// IndexListing adds a listing fetched from another store to the search index
+func (n *OpenBazaarNode) IndexListing(sl *pb.SignedListing, hash string) error {
+	listing := sl.Listing
+	if listing == nil || listing.VendorID == nil || listing.Item == nil || listing.Metadata == nil {
+		return errors.New("listing is missing required fields")
+	}
+	if listing.VendorID.PeerID == n.IPFSIdentityString() {
+		return nil
+	}
+
+	var shipsTo []string
+	for _, so := range listing.ShippingOptions {
+		for _, region := range so.Regions {
+			shipsTo = append(shipsTo, region.String())
+		}
+	}
+	var thumbnail string
+	if len(listing.Item.Images) > 0 {
+		thumbnail = listing.Item.Images[0].Small
+	}
+	description := listing.Item.Description
+	if len(description) > ShortDescriptionLength {
+		description = description[:ShortDescriptionLength]
+	}
+	return n.Datastore.Search().Put(repo.SearchListing{
+		PeerID:          listing.VendorID.PeerID,
+		Slug:            listing.Slug,
+		Hash:            hash,
+		Title:           listing.Item.Title,
+		Description:     description,
+		Tags:            listing.Item.Tags,
+		Categories:      listing.Item.Categories,
+		ShipsTo:         shipsTo,
+		PricingCurrency: listing.Metadata.PricingCurrency,
+		Price:           listing.Item.Price,
+		Thumbnail:       thumbnail,
+		IndexedAt:       time.Now(),
+	})
+}
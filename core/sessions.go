@@ -0,0 +1,103 @@
+package core
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	ipnspath "github.com/ipfs/go-ipfs/path"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/net"
+)
+
+const (
+	preKeyBundleFetchTimeout = time.Second * 30
+
+	// preKeyRotationCheckInterval is how often the age of the signed prekey
+	// is checked
+	preKeyRotationCheckInterval = time.Hour
+)
+
+// UpdatePreKeyBundle writes our prekey bundle next to the profile so it is
+// published with the next seed
+func (n *OpenBazaarNode) UpdatePreKeyBundle() error {
+	bundle, err := n.Sessions.PreKeyBundle()
+	if err == net.ErrSessionsUnsupported {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(bundle, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(n.RepoPath, "root", "prekeys.json"), out, os.ModePerm)
+}
+
+// StartPreKeyRotator rotates the signed prekey once it is older than
+// net.SignedPreKeyRotation and publishes the new bundle, so it rotates on
+// nodes which run for weeks without their profile changing
+func (n *OpenBazaarNode) StartPreKeyRotator() {
+	ticker := time.NewTicker(preKeyRotationCheckInterval)
+	go func() {
+		for range ticker.C {
+			n.rotatePreKey()
+		}
+	}()
+}
+
+func (n *OpenBazaarNode) rotatePreKey() {
+	if !n.Sessions.PreKeyRotationDue() {
+		return
+	}
+	if err := n.UpdatePreKeyBundle(); err != nil {
+		log.Errorf("rotating signed prekey: %s", err.Error())
+		return
+	}
+	if err := n.SeedNode(); err != nil {
+		log.Errorf("publishing rotated prekey bundle: %s", err.Error())
+	}
+}
+
+// FetchPreKeyBundle fetches and verifies the prekey bundle of a peer
+func (n *OpenBazaarNode) FetchPreKeyBundle(peerID peer.ID) (*net.PreKeyBundle, error) {
+	b, err := n.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerID.Pretty(), "prekeys.json")), preKeyBundleFetchTimeout, true)
+	if err != nil {
+		return nil, err
+	}
+	bundle := new(net.PreKeyBundle)
+	if err := json.Unmarshal(b, bundle); err != nil {
+		return nil, err
+	}
+	if err := bundle.Verify(peerID); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// establishSession starts a session with the peer from its published prekey
+// bundle unless there already is one. It returns false if the peer doesn't
+// support sessions.
+func (n *OpenBazaarNode) establishSession(peerID peer.ID) bool {
+	if n.Sessions.HasSession(peerID) {
+		return true
+	}
+
+	if !n.Sessions.ShouldFetchPreKeyBundle(peerID) {
+		return false
+	}
+
+	bundle, err := n.FetchPreKeyBundle(peerID)
+	if err == nil {
+		err = n.Sessions.InitSession(peerID, bundle)
+	}
+	if err != nil {
+		log.Debugf("No session with %s, falling back to identity key encryption: %s", peerID.Pretty(), err.Error())
+		n.Sessions.PreKeyBundleMissed(peerID)
+		return false
+	}
+	return true
+}
@@ -12,6 +12,7 @@ import (
 	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
 	multihash "gx/ipfs/QmZyZDi491cCNTLfAhwcaDii2Kg4pwKRkhqQzURGDvY6ua/go-multihash"
 
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/ipfs/go-ipfs/core"
 
 	cid "gx/ipfs/QmcZfnkapfECQGcLZaf9B79NRg7cRa9EnZh4LSbkCzwNvY/go-cid"
@@ -122,6 +123,12 @@ func addPointer(node *core.IpfsNode, ctx context.Context, k *cid.Cid, pi ps.Peer
 func putPointer(ctx context.Context, dht *routing.IpfsDHT, p peer.ID, pi ps.PeerInfo, skey string) error {
 	pmes := dhtpb.NewMessage(dhtpb.Message_ADD_PROVIDER, skey, 0)
 	pmes.ProviderPeers = dhtpb.RawPeerInfosToPBPeers([]ps.PeerInfo{pi})
+	// Older nodes only decode https addresses in the legacy layout
+	for _, pbp := range pmes.ProviderPeers {
+		for i, addr := range pi.Addrs {
+			pbp.Addrs[i] = sto.PointerAddrBytes(addr)
+		}
+	}
 
 	err := dht.SendMessage(ctx, p, pmes)
 	if err != nil {
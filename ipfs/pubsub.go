@@ -19,6 +19,7 @@ const (
 	GlobalIPNSTopic    = "IPNS"
 	GlobalBlockTopic   = "BLOCK"
 	GlobalCIDTopic     = "CID"
+	StoreTopicPrefix   = "/store/"
 )
 
 type Pubsub struct {
@@ -145,6 +146,7 @@ func (r *PubsubSubscriber) Cancel(name string) bool {
 }
 
 func (r *PubsubSubscriber) handleSubscription(sub *floodsub.Subscription, topic string, resp chan<- []byte, cancel func()) {
+	defer close(resp)
 	defer sub.Cancel()
 	defer cancel()
 
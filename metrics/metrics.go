@@ -0,0 +1,112 @@
+// Package metrics holds the Prometheus metrics of the node internals. They
+// are registered with the default registry and served at /metrics by the API.
+package metrics
+
+import (
+	"time"
+
+	prometheus "gx/ipfs/QmX3QZ5jHEPidwUrymXV1iSCSUhdGxj15sm2gP4jKMef7B/client_golang/prometheus"
+)
+
+const namespace = "openbazaar"
+
+// Results of handling a message from a peer or retrieved offline, and of
+// sending a message
+const (
+	ResultHandled     = "handled"
+	ResultError       = "error"
+	ResultBanned      = "banned"
+	ResultRateLimited = "rate_limited"
+	ResultUnsupported = "unsupported"
+	ResultDuplicate   = "duplicate"
+	ResultQueued      = "queued"
+	ResultSent        = "sent"
+)
+
+// Results of retrieving an offline message
+const (
+	RetrievalDownloaded     = "downloaded"
+	RetrievalDownloadFailed = "download_failed"
+	RetrievalUndecryptable  = "undecryptable"
+)
+
+var (
+	// MessagesReceived counts the messages received from peers by type and
+	// result
+	MessagesReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "service",
+		Name:      "messages_received_total",
+		Help:      "Messages received from peers by type and result",
+	}, []string{"type", "result"})
+
+	// MessagesSent counts the messages sent to peers by type and whether
+	// sending failed
+	MessagesSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "service",
+		Name:      "messages_sent_total",
+		Help:      "Messages sent to peers by type and result",
+	}, []string{"type", "result"})
+
+	// HandlerDuration observes how long the handlers of each message type
+	// take, for messages received directly and offline
+	HandlerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "service",
+		Name:      "handler_duration_seconds",
+		Help:      "Time taken to handle messages by type",
+	}, []string{"type"})
+
+	// OfflineMessages counts the offline messages retrieved by the
+	// MessageRetriever by result
+	OfflineMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "retriever",
+		Name:      "messages_total",
+		Help:      "Offline messages retrieved by result",
+	}, []string{"result"})
+
+	// PublishDuration observes how long publishing the root directory to
+	// IPNS takes
+	PublishDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "publish",
+		Name:      "duration_seconds",
+		Help:      "Time taken to publish to IPNS",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+	})
+
+	// PublishFailures counts the failed publishes
+	PublishFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "publish",
+		Name:      "failures_total",
+		Help:      "Failed publishes to IPNS",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		MessagesReceived,
+		MessagesSent,
+		HandlerDuration,
+		OfflineMessages,
+		PublishDuration,
+		PublishFailures,
+	)
+}
+
+// ObserveHandler records the time taken by the handler of the message type
+// since start
+func ObserveHandler(messageType string, start time.Time) {
+	HandlerDuration.WithLabelValues(messageType).Observe(time.Since(start).Seconds())
+}
+
+// SendResult returns the result label of sending a message
+func SendResult(err error) string {
+	if err != nil {
+		return ResultError
+	}
+	return ResultSent
+}
@@ -242,12 +242,20 @@ func NewNode(config NodeConfig) (*Node, error) {
 		}
 	}
 	bm := obnet.NewBanManager(blockedNodes)
+	bans, err := sqliteDB.Bans().GetAll()
+	if err != nil {
+		return nil, err
+	}
+	bm.SetBans(bans)
 
 	// Create namesys resolvers
 	resolvers := []obns.Resolver{
 		bstk.NewBlockStackClient(resolverConfig.Id, nil),
 		obns.NewDNSResolver(),
 	}
+	if resolverConfig.Eth != "" {
+		resolvers = append(resolvers, obns.NewENSResolver(resolverConfig.Eth, nil))
+	}
 	ns, err := obns.NewNameSystem(resolvers)
 	if err != nil {
 		return nil, err
@@ -262,17 +270,29 @@ func NewNode(config NodeConfig) (*Node, error) {
 		}
 		pushNodes = append(pushNodes, p)
 	}
+	var fallbackPushNodes []peer.ID
+	for _, pnd := range dataSharing.FallbackPushTo {
+		p, err := peer.IDB58Decode(pnd)
+		if err != nil {
+			return nil, err
+		}
+		fallbackPushNodes = append(fallbackPushNodes, p)
+	}
 
 	// OpenBazaar node setup
 	core.Node = &core.OpenBazaarNode{
-		RepoPath:      config.RepoPath,
-		Datastore:     sqliteDB,
-		Wallet:        wallet,
-		NameSystem:    ns,
-		ExchangeRates: exchangeRates,
-		UserAgent:     core.USERAGENT,
-		PushNodes:     pushNodes,
-		BanManager:    bm,
+		RepoPath:         config.RepoPath,
+		Datastore:        sqliteDB,
+		Wallet:           wallet,
+		NameSystem:       ns,
+		ExchangeRates:    exchangeRates,
+		UserAgent:        core.USERAGENT,
+		PushNodes:        pushNodes,
+		PushNodeManager:  obnet.NewPushNodeManager(pushNodes, fallbackPushNodes),
+		BanManager:       bm,
+		AbuseManager:     obnet.NewAbuseManager(),
+		PeerCapabilities: obnet.NewCapabilityCache(),
+		Deduplicator:     obnet.NewMessageDeduplicator(sqliteDB.ReceivedMessages()),
 	}
 
 	if len(cfg.Addresses.Gateway) <= 0 {
@@ -310,6 +330,7 @@ func (n *Node) Start() error {
 	}
 
 	n.OpenBazaarNode.IpfsNode = nd
+	n.OpenBazaarNode.Sessions = obnet.NewSessionManager(nd.PrivateKey, n.OpenBazaarNode.Datastore.Sessions())
 
 	// Get current directory root hash
 	_, ipnskey := namesys.IpnsKeysForID(nd.Identity)
@@ -334,7 +355,7 @@ func (n *Node) Start() error {
 	}
 
 	// Offline messaging storage
-	n.OpenBazaarNode.MessageStorage = selfhosted.NewSelfHostedStorage(n.OpenBazaarNode.RepoPath, n.OpenBazaarNode.IpfsNode, n.OpenBazaarNode.PushNodes, n.OpenBazaarNode.SendStore)
+	n.OpenBazaarNode.MessageStorage = selfhosted.NewSelfHostedStorage(n.OpenBazaarNode.RepoPath, n.OpenBazaarNode.IpfsNode, n.OpenBazaarNode.PushNodeManager.Active, n.OpenBazaarNode.SendStore)
 
 	// Build pubsub
 	publisher := ipfs.NewPubsubPublisher(context.Background(), nd.PeerHost, nd.Routing, nd.Repo.Datastore(), nd.Floodsub)
@@ -364,18 +385,23 @@ func (n *Node) Start() error {
 			Db:        n.OpenBazaarNode.Datastore,
 			IPFSNode:  n.OpenBazaarNode.IpfsNode,
 			BanManger: n.OpenBazaarNode.BanManager,
+			Sessions:  n.OpenBazaarNode.Sessions,
+			Dedup:     n.OpenBazaarNode.Deduplicator,
 			Service:   core.Node.Service,
 			PrefixLen: 14,
-			PushNodes: core.Node.PushNodes,
+			PushNodes: core.Node.PushNodeManager.All(),
 			Dialer:    nil,
 			SendAck:   n.OpenBazaarNode.SendOfflineAck,
 			SendError: n.OpenBazaarNode.SendError,
 		})
 		go MR.Run()
 		n.OpenBazaarNode.MessageRetriever = MR
-		PR := rep.NewPointerRepublisher(n.OpenBazaarNode.IpfsNode, n.OpenBazaarNode.Datastore, n.OpenBazaarNode.PushNodes, n.OpenBazaarNode.IsModerator)
+		PR := rep.NewPointerRepublisher(n.OpenBazaarNode.IpfsNode, n.OpenBazaarNode.Datastore, n.OpenBazaarNode.MessageStorage, n.OpenBazaarNode.PushNodeManager.Active, n.OpenBazaarNode.IsModerator)
 		go PR.Run()
 		n.OpenBazaarNode.PointerRepublisher = PR
+		n.OpenBazaarNode.StartListingScheduler()
+		n.OpenBazaarNode.StartSearchIndexer()
+		n.OpenBazaarNode.StartStoreAnnouncer()
 		MR.Wait()
 		if n.OpenBazaarNode.Wallet != nil {
 			TL := lis.NewTransactionListener(n.OpenBazaarNode.Datastore, n.OpenBazaarNode.Broadcast, n.OpenBazaarNode.Wallet)
@@ -388,6 +414,8 @@ func (n *Node) Start() error {
 		}
 
 		core.PublishLock.Unlock()
+		core.Node.UpdatePreKeyBundle()
+		core.Node.StartPreKeyRotator()
 		core.Node.UpdateFollow()
 		if !core.InitalPublishComplete {
 			core.Node.SeedNode()
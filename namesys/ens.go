@@ -0,0 +1,225 @@
+package namesys
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	keccak "gx/ipfs/QmQPWTeQJnJE7MYu6dJTiNTQRNuqBr41dis6UgY6Uekmgd/keccakpg"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+	cid "gx/ipfs/QmcZfnkapfECQGcLZaf9B79NRg7cRa9EnZh4LSbkCzwNvY/go-cid"
+
+	"golang.org/x/net/proxy"
+)
+
+const (
+	// ENSRegistryAddress is the address of the ENS registry on Ethereum mainnet
+	ENSRegistryAddress = "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"
+
+	// ENSTextRecordKey is the key of the text record holding the peer ID
+	ENSTextRecordKey = "openbazaar"
+
+	// ipns-ns multicodec which prefixes contenthash records of IPNS names
+	ipnsNamespaceCodec = 0xe5
+)
+
+var (
+	resolverMethodID    = ensMethodID("resolver(bytes32)")
+	textMethodID        = ensMethodID("text(bytes32,string)")
+	contenthashMethodID = ensMethodID("contenthash(bytes32)")
+)
+
+// ENSResolver implements a Resolver on .eth domains using the text or
+// contenthash record of the name
+type ENSResolver struct {
+	endpoint   string
+	registry   string
+	httpClient *http.Client
+}
+
+// NewENSResolver constructs a name resolver querying the ENS registry
+// through an Ethereum JSON-RPC endpoint
+func NewENSResolver(endpoint string, dialer proxy.Dialer) *ENSResolver {
+	dial := net.Dial
+	if dialer != nil {
+		dial = dialer.Dial
+	}
+	return &ENSResolver{
+		endpoint:   endpoint,
+		registry:   ENSRegistryAddress,
+		httpClient: &http.Client{Transport: &http.Transport{Dial: dial}, Timeout: time.Minute},
+	}
+}
+
+// Domains implements Resolver.
+func (r *ENSResolver) Domains() []string {
+	return []string{"eth"}
+}
+
+// Resolve implements Resolver. The text record takes precedence over the
+// contenthash record.
+func (r *ENSResolver) Resolve(ctx context.Context, name string) (peer.ID, error) {
+	node := ensNamehash(name)
+	ret, err := r.call(ctx, r.registry, resolverMethodID, node)
+	if err != nil {
+		return "", err
+	}
+	if len(ret) != 32 {
+		return "", fmt.Errorf("invalid resolver address for %s", name)
+	}
+	resolver := ret[12:]
+	if bytes.Equal(resolver, make([]byte, 20)) {
+		return "", ErrResolveFailed
+	}
+	resolverAddr := "0x" + hex.EncodeToString(resolver)
+
+	// Resolvers may not implement both records so call errors are ignored
+	ret, err = r.call(ctx, resolverAddr, textMethodID, node, abiUint(64), abiString(ENSTextRecordKey))
+	if err == nil {
+		if txt, err := abiDecodeBytes(ret); err == nil && len(txt) > 0 {
+			if pid, err := peer.IDB58Decode(strings.TrimSpace(string(txt))); err == nil {
+				return pid, nil
+			}
+		}
+	}
+	ret, err = r.call(ctx, resolverAddr, contenthashMethodID, node)
+	if err == nil {
+		if hash, err := abiDecodeBytes(ret); err == nil && len(hash) > 0 {
+			return parseContenthash(hash)
+		}
+	}
+	return "", ErrResolveFailed
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type jsonRPCResponse struct {
+	Result string `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// call makes an eth_call of the contract with the ABI encoded call data
+func (r *ENSResolver) call(ctx context.Context, to string, data ...[]byte) ([]byte, error) {
+	body, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "eth_call",
+		Params: []interface{}{
+			map[string]string{"to": to, "data": "0x" + hex.EncodeToString(bytes.Join(data, nil))},
+			"latest",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ethereum endpoint returned %s", resp.Status)
+	}
+	var rpcResp jsonRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return nil, err
+	}
+	if rpcResp.Error != nil {
+		return nil, fmt.Errorf("eth_call failed: %s", rpcResp.Error.Message)
+	}
+	return hex.DecodeString(strings.TrimPrefix(rpcResp.Result, "0x"))
+}
+
+// parseContenthash returns the peer ID of an EIP-1577 contenthash holding an
+// IPNS name
+func parseContenthash(hash []byte) (peer.ID, error) {
+	codec, n := binary.Uvarint(hash)
+	if n <= 0 || codec != ipnsNamespaceCodec {
+		return "", ErrResolveFailed
+	}
+	c, err := cid.Cast(hash[n:])
+	if err != nil {
+		return "", err
+	}
+	return peer.IDFromBytes(c.Hash())
+}
+
+// ensNamehash implements the recursive name hash of EIP-137
+func ensNamehash(name string) []byte {
+	node := make([]byte, 32)
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = keccak256(node, keccak256([]byte(labels[i])))
+	}
+	return node
+}
+
+func ensMethodID(signature string) []byte {
+	return keccak256([]byte(signature))[:4]
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := keccak.New256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+func abiUint(i uint64) []byte {
+	b := make([]byte, 32)
+	binary.BigEndian.PutUint64(b[24:], i)
+	return b
+}
+
+// abiString encodes a dynamic string argument as its length and right
+// padded contents
+func abiString(s string) []byte {
+	padded := make([]byte, (len(s)+31)/32*32)
+	copy(padded, s)
+	return append(abiUint(uint64(len(s))), padded...)
+}
+
+// abiDecodeBytes decodes a single dynamic bytes or string return value
+func abiDecodeBytes(ret []byte) ([]byte, error) {
+	errInvalid := errors.New("invalid ABI encoded bytes")
+	if len(ret) < 64 {
+		return nil, errInvalid
+	}
+	offset := new(big.Int).SetBytes(ret[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(ret)-32) {
+		return nil, errInvalid
+	}
+	start := offset.Uint64()
+	length := new(big.Int).SetBytes(ret[start : start+32])
+	if !length.IsUint64() || length.Uint64() > uint64(len(ret))-start-32 {
+		return nil, errInvalid
+	}
+	return ret[start+32 : start+32+length.Uint64()], nil
+}
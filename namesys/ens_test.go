@@ -0,0 +1,142 @@
+package namesys
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+	cid "gx/ipfs/QmcZfnkapfECQGcLZaf9B79NRg7cRa9EnZh4LSbkCzwNvY/go-cid"
+)
+
+const (
+	testPeerID          = "QmNgBZN7z1CfMLbwyEwnGoixjbSaBcP9fS5ecMzZwCq3Ku"
+	testResolverAddress = "0x4976fb03c32e5b8cfe2b6ccb31c09ba78ebaba41"
+)
+
+// ethStandIn is a JSON-RPC endpoint answering eth_call for the ENS registry
+// and a single public resolver
+type ethStandIn struct {
+	resolvers    map[string]bool
+	text         map[string]string
+	contenthash  map[string][]byte
+	requestCount int
+}
+
+func newEthStandIn() *ethStandIn {
+	return &ethStandIn{
+		resolvers:   make(map[string]bool),
+		text:        make(map[string]string),
+		contenthash: make(map[string][]byte),
+	}
+}
+
+func abiBytes(b []byte) []byte {
+	padded := make([]byte, (len(b)+31)/32*32)
+	copy(padded, b)
+	return append(append(abiUint(32), abiUint(uint64(len(b)))...), padded...)
+}
+
+func (e *ethStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.requestCount++
+	var req struct {
+		Params []json.RawMessage `json:"params"`
+	}
+	var call struct {
+		To   string `json:"to"`
+		Data string `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Params) != 2 {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	json.Unmarshal(req.Params[0], &call)
+	data, _ := hex.DecodeString(strings.TrimPrefix(call.Data, "0x"))
+	method, node := hex.EncodeToString(data[:4]), hex.EncodeToString(data[4:36])
+
+	var ret []byte
+	switch {
+	case strings.EqualFold(call.To, ENSRegistryAddress) && method == hex.EncodeToString(resolverMethodID):
+		ret = make([]byte, 32)
+		if e.resolvers[node] {
+			addr, _ := hex.DecodeString(strings.TrimPrefix(testResolverAddress, "0x"))
+			copy(ret[12:], addr)
+		}
+	case call.To == testResolverAddress && method == hex.EncodeToString(textMethodID):
+		// The string offset is relative to the arguments
+		args := data[4:]
+		offset := binary.BigEndian.Uint64(args[56:64])
+		length := binary.BigEndian.Uint64(args[offset+24 : offset+32])
+		if key := args[offset+32 : offset+32+length]; string(key) != ENSTextRecordKey {
+			http.Error(w, "bad text key", http.StatusBadRequest)
+			return
+		}
+		ret = abiBytes([]byte(e.text[node]))
+	case call.To == testResolverAddress && method == hex.EncodeToString(contenthashMethodID):
+		ret = abiBytes(e.contenthash[node])
+	default:
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0", "id": 1, "error": map[string]interface{}{"code": -32000, "message": "execution reverted"},
+		})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": "0x" + hex.EncodeToString(ret)})
+}
+
+func TestENSNamehash(t *testing.T) {
+	for name, expected := range map[string]string{
+		"":        "0000000000000000000000000000000000000000000000000000000000000000",
+		"eth":     "93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae",
+		"foo.eth": "de9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f",
+	} {
+		if h := hex.EncodeToString(ensNamehash(name)); h != expected {
+			t.Errorf("expected namehash of %q to be %s, got %s", name, expected, h)
+		}
+	}
+}
+
+func TestENSResolver(t *testing.T) {
+	eth := newEthStandIn()
+	server := httptest.NewServer(eth)
+	defer server.Close()
+	r := NewENSResolver(server.URL, nil)
+
+	pid, err := peer.IDB58Decode(testPeerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	textNode := hex.EncodeToString(ensNamehash("text.eth"))
+	eth.resolvers[textNode] = true
+	eth.text[textNode] = testPeerID
+
+	// contenthash of /ipns/<peer ID> as set by the ENS manager
+	hashNode := hex.EncodeToString(ensNamehash("hash.eth"))
+	eth.resolvers[hashNode] = true
+	codec := make([]byte, binary.MaxVarintLen64)
+	codec = codec[:binary.PutUvarint(codec, ipnsNamespaceCodec)]
+	eth.contenthash[hashNode] = append(codec, cid.NewCidV1(0x72, []byte(pid)).Bytes()...)
+
+	eth.resolvers[hex.EncodeToString(ensNamehash("empty.eth"))] = true
+
+	for name, expected := range map[string]struct {
+		pid peer.ID
+		err error
+	}{
+		"text.eth":     {pid, nil},
+		"hash.eth":     {pid, nil},
+		"empty.eth":    {"", ErrResolveFailed},
+		"unknown.eth":  {"", ErrResolveFailed},
+		"TEXT.eth":     {pid, nil},
+		"sub.text.eth": {"", ErrResolveFailed},
+	} {
+		got, err := r.Resolve(context.Background(), name)
+		if got != expected.pid || err != expected.err {
+			t.Errorf("expected %s to resolve to %s (%v), got %s (%v)", name, expected.pid.Pretty(), expected.err, got.Pretty(), err)
+		}
+	}
+}
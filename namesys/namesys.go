@@ -5,6 +5,7 @@ import (
 	"errors"
 	"gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -14,15 +15,24 @@ var ErrResolveFailed = errors.New("Could not resolve name.")
 // ErrNoResolver signals no resolver exists for the specified domain.
 var ErrNoResolver = errors.New("No resover for domain.")
 
-const cacheTTL = time.Minute
+const (
+	// Names which resolved are cached for cacheTTL, names which have no
+	// record for negativeCacheTTL. Other errors are not cached.
+	cacheTTL         = 10 * time.Minute
+	negativeCacheTTL = time.Minute
+)
 
 type NameSystem struct {
 	resolvers map[string]Resolver
+
+	cacheLock sync.Mutex
 	cache     map[string]cachedName
+	now       func() time.Time
 }
 
 type cachedName struct {
 	id         peer.ID
+	err        error
 	expiration time.Time
 }
 
@@ -30,6 +40,7 @@ func NewNameSystem(resolvers []Resolver) (*NameSystem, error) {
 	n := &NameSystem{
 		resolvers: make(map[string]Resolver),
 		cache:     make(map[string]cachedName),
+		now:       time.Now,
 	}
 	for _, r := range resolvers {
 		for _, domain := range r.Domains() {
@@ -48,31 +59,38 @@ func (n *NameSystem) Resolve(ctx context.Context, name string) (pid peer.ID, err
 	split := strings.Split(name, ".")
 	ext := split[len(split)-1]
 
+	n.cacheLock.Lock()
 	cn, ok := n.cache[name]
-	if ok {
-		if cn.expiration.Before(time.Now()) {
-			return cn.id, nil
-		}
+	n.cacheLock.Unlock()
+	if ok && n.now().Before(cn.expiration) {
+		return cn.id, cn.err
 	}
 
 	r, ok := n.resolvers[ext]
-	if ok {
-		pid, err = r.Resolve(ctx, name)
-		if err != nil {
-			return pid, err
-		}
-		n.cache[name] = cachedName{pid, time.Now().Add(cacheTTL)}
-		return pid, nil
+	if !ok {
+		r, ok = n.resolvers["dns"]
 	}
+	if !ok {
+		return pid, ErrNoResolver
+	}
+	pid, err = r.Resolve(ctx, name)
+	switch err {
+	case nil:
+		n.cacheName(name, cachedName{id: pid, expiration: n.now().Add(cacheTTL)})
+	case ErrResolveFailed:
+		n.cacheName(name, cachedName{err: err, expiration: n.now().Add(negativeCacheTTL)})
+	}
+	return pid, err
+}
 
-	dnsResolver, ok := n.resolvers["dns"]
-	if ok {
-		pid, err = dnsResolver.Resolve(ctx, name)
-		if err != nil {
-			return pid, err
+func (n *NameSystem) cacheName(name string, cn cachedName) {
+	n.cacheLock.Lock()
+	defer n.cacheLock.Unlock()
+	now := n.now()
+	for k, c := range n.cache {
+		if !now.Before(c.expiration) {
+			delete(n.cache, k)
 		}
-		n.cache[name] = cachedName{pid, time.Now().Add(cacheTTL)}
-		return pid, nil
 	}
-	return pid, ErrNoResolver
+	n.cache[name] = cn
 }
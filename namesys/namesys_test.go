@@ -0,0 +1,51 @@
+package namesys
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNameSystemCache(t *testing.T) {
+	eth := newEthStandIn()
+	server := httptest.NewServer(eth)
+	defer server.Close()
+	node := hex.EncodeToString(ensNamehash("store.eth"))
+	eth.resolvers[node] = true
+	eth.text[node] = testPeerID
+
+	ns, err := NewNameSystem([]Resolver{NewENSResolver(server.URL, nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	ns.now = func() time.Time { return now }
+
+	resolve := func(name string, expectedErr error, expectedRequests int) {
+		_, err := ns.Resolve(context.Background(), name)
+		if err != expectedErr {
+			t.Errorf("expected %s to return %v, got %v", name, expectedErr, err)
+		}
+		if eth.requestCount != expectedRequests {
+			t.Errorf("expected %d requests after resolving %s, got %d", expectedRequests, name, eth.requestCount)
+		}
+	}
+
+	// Resolved names are served from the cache until they expire
+	resolve("store.eth", nil, 2)
+	resolve("store.eth", nil, 2)
+	now = now.Add(cacheTTL)
+	resolve("store.eth", nil, 4)
+
+	// So are names without a record, for a shorter time
+	resolve("unknown.eth", ErrResolveFailed, 5)
+	resolve("unknown.eth", ErrResolveFailed, 5)
+	now = now.Add(negativeCacheTTL)
+	resolve("unknown.eth", ErrResolveFailed, 6)
+
+	if _, err := ns.Resolve(context.Background(), "store.id"); err != ErrNoResolver {
+		t.Errorf("expected no resolver for .id, got %v", err)
+	}
+}
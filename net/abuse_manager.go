@@ -0,0 +1,224 @@
+package net
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+const (
+	// PenaltyRateLimited is added to a peer's abuse score for every message
+	// dropped because it exceeded the rate limit
+	PenaltyRateLimited = 1.0
+	// PenaltyHandlerError is added to a peer's abuse score for every message
+	// its handler rejected, such as invalid signatures or malformed contracts
+	PenaltyHandlerError = 5.0
+
+	// AbuseScoreThreshold is the score at which a peer is temporarily banned
+	AbuseScoreThreshold = 100.0
+	// AbuseScoreHalfLife is the time it takes for a score to halve
+	AbuseScoreHalfLife = time.Minute * 10
+	// AbuseBanDuration is how long a peer stays banned once it reaches the
+	// threshold
+	AbuseBanDuration = time.Hour
+
+	// maxTrackedPeers triggers pruning of idle peers
+	maxTrackedPeers = 1000
+)
+
+// RateLimit - a token bucket refilled at Rate tokens per second holding at
+// most Burst tokens
+type RateLimit struct {
+	Rate  float64
+	Burst float64
+}
+
+// DefaultRateLimits - limits for message types which are expensive to handle
+var DefaultRateLimits = map[pb.Message_MessageType]RateLimit{
+	pb.Message_CHAT:     {Rate: 2, Burst: 20},
+	pb.Message_FOLLOW:   {Rate: 0.2, Burst: 5},
+	pb.Message_UNFOLLOW: {Rate: 0.2, Burst: 5},
+	pb.Message_ORDER:    {Rate: 0.1, Burst: 5},
+}
+
+// DefaultRateLimit - limit for every message type without its own limit
+var DefaultRateLimit = RateLimit{Rate: 5, Burst: 50}
+
+// PeerAbuse - the abuse state of a single peer
+type PeerAbuse struct {
+	PeerID      string     `json:"peerID"`
+	Score       float64    `json:"score"`
+	Banned      bool       `json:"banned"`
+	BannedUntil *time.Time `json:"bannedUntil,omitempty"`
+}
+
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+type peerAbuse struct {
+	buckets     map[pb.Message_MessageType]*tokenBucket
+	score       float64
+	scoredAt    time.Time
+	bannedUntil time.Time
+}
+
+// AbuseManager rate limits incoming messages per peer and message type and
+// temporarily bans peers whose abuse score reaches the threshold. Scores
+// decay exponentially so occasional errors are forgiven.
+type AbuseManager struct {
+	limits       map[pb.Message_MessageType]RateLimit
+	defaultLimit RateLimit
+	threshold    float64
+	halfLife     time.Duration
+	banDuration  time.Duration
+	peers        map[peer.ID]*peerAbuse
+	now          func() time.Time
+	*sync.Mutex
+}
+
+func NewAbuseManager() *AbuseManager {
+	return &AbuseManager{
+		limits:       DefaultRateLimits,
+		defaultLimit: DefaultRateLimit,
+		threshold:    AbuseScoreThreshold,
+		halfLife:     AbuseScoreHalfLife,
+		banDuration:  AbuseBanDuration,
+		peers:        make(map[peer.ID]*peerAbuse),
+		now:          time.Now,
+		Mutex:        new(sync.Mutex),
+	}
+}
+
+// Allow takes a token from the peer's bucket for the message type. Messages
+// are refused while the bucket is empty or the peer is temporarily banned.
+func (am *AbuseManager) Allow(peerID peer.ID, messageType pb.Message_MessageType) bool {
+	am.Lock()
+	defer am.Unlock()
+
+	now := am.now()
+	pa := am.peer(peerID, now)
+	if now.Before(pa.bannedUntil) {
+		return false
+	}
+
+	limit, ok := am.limits[messageType]
+	if !ok {
+		limit = am.defaultLimit
+	}
+	bucket, ok := pa.buckets[messageType]
+	if !ok {
+		bucket = &tokenBucket{tokens: limit.Burst, updatedAt: now}
+		pa.buckets[messageType] = bucket
+	}
+	bucket.tokens = math.Min(limit.Burst, bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*limit.Rate)
+	bucket.updatedAt = now
+	if bucket.tokens < 1 {
+		am.penalize(pa, PenaltyRateLimited, now)
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// Penalize raises the peer's abuse score, banning it temporarily once the
+// score reaches the threshold
+func (am *AbuseManager) Penalize(peerID peer.ID, amount float64) {
+	am.Lock()
+	defer am.Unlock()
+	now := am.now()
+	am.penalize(am.peer(peerID, now), amount, now)
+}
+
+// IsBanned returns whether the peer is temporarily banned
+func (am *AbuseManager) IsBanned(peerID peer.ID) bool {
+	am.Lock()
+	defer am.Unlock()
+	pa, ok := am.peers[peerID]
+	return ok && am.now().Before(pa.bannedUntil)
+}
+
+// Scores returns the current abuse state of every peer with a score or ban,
+// highest score first
+func (am *AbuseManager) Scores() []PeerAbuse {
+	am.Lock()
+	defer am.Unlock()
+
+	now := am.now()
+	am.prune(now)
+	ret := []PeerAbuse{}
+	for pid, pa := range am.peers {
+		am.decay(pa, now)
+		entry := PeerAbuse{PeerID: pid.Pretty(), Score: pa.score}
+		if now.Before(pa.bannedUntil) {
+			bannedUntil := pa.bannedUntil
+			entry.Banned = true
+			entry.BannedUntil = &bannedUntil
+		}
+		if entry.Score < 0.01 && !entry.Banned {
+			continue
+		}
+		ret = append(ret, entry)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Score > ret[j].Score })
+	return ret
+}
+
+func (am *AbuseManager) peer(peerID peer.ID, now time.Time) *peerAbuse {
+	pa, ok := am.peers[peerID]
+	if !ok {
+		if len(am.peers) >= maxTrackedPeers {
+			am.prune(now)
+		}
+		pa = &peerAbuse{buckets: make(map[pb.Message_MessageType]*tokenBucket), scoredAt: now}
+		am.peers[peerID] = pa
+	}
+	return pa
+}
+
+func (am *AbuseManager) penalize(pa *peerAbuse, amount float64, now time.Time) {
+	am.decay(pa, now)
+	pa.score += amount
+	if pa.score >= am.threshold && !now.Before(pa.bannedUntil) {
+		pa.bannedUntil = now.Add(am.banDuration)
+	}
+}
+
+func (am *AbuseManager) decay(pa *peerAbuse, now time.Time) {
+	elapsed := now.Sub(pa.scoredAt)
+	if elapsed > 0 {
+		pa.score *= math.Pow(0.5, float64(elapsed)/float64(am.halfLife))
+	}
+	pa.scoredAt = now
+}
+
+// prune forgets peers which are neither banned nor scored and whose buckets
+// have refilled
+func (am *AbuseManager) prune(now time.Time) {
+	for pid, pa := range am.peers {
+		am.decay(pa, now)
+		if pa.score >= 0.01 || now.Before(pa.bannedUntil) {
+			continue
+		}
+		idle := true
+		for messageType, bucket := range pa.buckets {
+			limit, ok := am.limits[messageType]
+			if !ok {
+				limit = am.defaultLimit
+			}
+			if bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*limit.Rate < limit.Burst {
+				idle = false
+				break
+			}
+		}
+		if idle {
+			delete(am.peers, pid)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package net
+
+import (
+	"testing"
+	"time"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func newTestAbuseManager(t *testing.T) (*AbuseManager, peer.ID, *time.Time) {
+	pid, err := peer.IDB58Decode("QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	am := NewAbuseManager()
+	am.now = func() time.Time { return now }
+	return am, pid, &now
+}
+
+func TestAbuseManagerRateLimit(t *testing.T) {
+	am, pid, now := newTestAbuseManager(t)
+	limit := DefaultRateLimits[pb.Message_FOLLOW]
+	for i := 0; i < int(limit.Burst); i++ {
+		if !am.Allow(pid, pb.Message_FOLLOW) {
+			t.Fatalf("expected message %d to be allowed", i)
+		}
+	}
+	if am.Allow(pid, pb.Message_FOLLOW) {
+		t.Error("expected message over the burst to be rate limited")
+	}
+	if !am.Allow(pid, pb.Message_CHAT) {
+		t.Error("expected other message types to have their own bucket")
+	}
+	scores := am.Scores()
+	if len(scores) != 1 || scores[0].Score != PenaltyRateLimited || scores[0].Banned {
+		t.Errorf("expected a rate limit penalty, got %+v", scores)
+	}
+
+	*now = now.Add(time.Duration(float64(time.Second) / limit.Rate))
+	if !am.Allow(pid, pb.Message_FOLLOW) {
+		t.Error("expected the bucket to refill")
+	}
+}
+
+func TestAbuseManagerBanAndDecay(t *testing.T) {
+	am, pid, now := newTestAbuseManager(t)
+	for i := 0; i < int(AbuseScoreThreshold/PenaltyHandlerError); i++ {
+		am.Penalize(pid, PenaltyHandlerError)
+	}
+	if !am.IsBanned(pid) || am.Allow(pid, pb.Message_PING) {
+		t.Fatal("expected peer to be temporarily banned")
+	}
+	scores := am.Scores()
+	if len(scores) != 1 || !scores[0].Banned || !scores[0].BannedUntil.Equal(now.Add(AbuseBanDuration)) {
+		t.Errorf("unexpected scores %+v", scores)
+	}
+
+	*now = now.Add(AbuseBanDuration)
+	if am.IsBanned(pid) || !am.Allow(pid, pb.Message_PING) {
+		t.Error("expected ban to expire")
+	}
+	if score := am.Scores()[0].Score; score > AbuseScoreThreshold/32+0.01 {
+		t.Errorf("expected score to decay by five half lives, got %f", score)
+	}
+
+	*now = now.Add(AbuseScoreHalfLife * 20)
+	if scores := am.Scores(); len(scores) != 0 {
+		t.Errorf("expected idle peer to be pruned, got %+v", scores)
+	}
+}
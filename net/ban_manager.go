@@ -3,10 +3,25 @@ package net
 import (
 	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
 	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 )
 
+// messageScopes maps the message types which can be banned individually to
+// their ban scope. Messages about existing orders are deliberately left out so
+// a ban on new orders doesn't block disputes or fulfillment.
+var messageScopes = map[pb.Message_MessageType]repo.BanScope{
+	pb.Message_CHAT:     repo.BanScopeChat,
+	pb.Message_FOLLOW:   repo.BanScopeFollow,
+	pb.Message_UNFOLLOW: repo.BanScopeFollow,
+	pb.Message_ORDER:    repo.BanScopeOrder,
+}
+
 type BanManager struct {
 	blockedIds map[string]bool
+	bans       map[string]map[repo.BanScope]repo.Ban
 	*sync.RWMutex
 }
 
@@ -15,7 +30,11 @@ func NewBanManager(blockedIds []peer.ID) *BanManager {
 	for _, pid := range blockedIds {
 		blockedMap[pid.Pretty()] = true
 	}
-	return &BanManager{blockedMap, new(sync.RWMutex)}
+	return &BanManager{
+		blockedIds: blockedMap,
+		bans:       make(map[string]map[repo.BanScope]repo.Ban),
+		RWMutex:    new(sync.RWMutex),
+	}
 }
 
 func (bm *BanManager) AddBlockedId(peerId peer.ID) {
@@ -57,8 +76,68 @@ func (bm *BanManager) GetBlockedIds() []peer.ID {
 	return ret
 }
 
+// SetBans replaces the scoped bans with the given bans
+func (bm *BanManager) SetBans(bans []repo.Ban) {
+	bm.Lock()
+	defer bm.Unlock()
+
+	bm.bans = make(map[string]map[repo.BanScope]repo.Ban)
+	for _, ban := range bans {
+		bm.addBan(ban)
+	}
+}
+
+// AddBan adds a scoped ban, replacing any ban of the peer with the same scope
+func (bm *BanManager) AddBan(ban repo.Ban) {
+	bm.Lock()
+	defer bm.Unlock()
+	bm.addBan(ban)
+}
+
+// RemoveBan removes the ban of the peer with the given scope, or every scoped
+// ban of the peer if the scope is empty
+func (bm *BanManager) RemoveBan(peerId peer.ID, scope repo.BanScope) {
+	bm.Lock()
+	defer bm.Unlock()
+	if scope == "" {
+		delete(bm.bans, peerId.Pretty())
+		return
+	}
+	delete(bm.bans[peerId.Pretty()], scope)
+}
+
+// IsBanned returns whether every message from the peer should be dropped
 func (bm *BanManager) IsBanned(peerId peer.ID) bool {
 	bm.RLock()
 	defer bm.RUnlock()
-	return bm.blockedIds[peerId.Pretty()]
+	return bm.blockedIds[peerId.Pretty()] || bm.hasActiveBan(peerId.Pretty(), repo.BanScopeAll)
+}
+
+// IsBannedFor returns whether a message of the given type from the peer
+// should be dropped
+func (bm *BanManager) IsBannedFor(peerId peer.ID, messageType pb.Message_MessageType) bool {
+	if bm.IsBanned(peerId) {
+		return true
+	}
+	scope, ok := messageScopes[messageType]
+	if !ok {
+		return false
+	}
+	bm.RLock()
+	defer bm.RUnlock()
+	return bm.hasActiveBan(peerId.Pretty(), scope)
+}
+
+func (bm *BanManager) addBan(ban repo.Ban) {
+	scopes, ok := bm.bans[ban.PeerID]
+	if !ok {
+		scopes = make(map[repo.BanScope]repo.Ban)
+		bm.bans[ban.PeerID] = scopes
+	}
+	scopes[ban.Scope] = ban
+}
+
+func (bm *BanManager) hasActiveBan(peerId string, scope repo.BanScope) bool {
+	ban, ok := bm.bans[peerId][scope]
+	return ok && ban.IsActive(time.Now())
 }
@@ -0,0 +1,58 @@
+package net
+
+import (
+	"testing"
+	"time"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestBanManagerScopes(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bm := NewBanManager([]peer.ID{})
+	bm.AddBan(repo.Ban{PeerID: pid.Pretty(), Scope: repo.BanScopeChat, CreatedAt: time.Now()})
+
+	if bm.IsBanned(pid) {
+		t.Error("expected a chat ban not to block every message")
+	}
+	if !bm.IsBannedFor(pid, pb.Message_CHAT) {
+		t.Error("expected chat to be blocked")
+	}
+	if bm.IsBannedFor(pid, pb.Message_DISPUTE_OPEN) || bm.IsBannedFor(pid, pb.Message_ORDER) {
+		t.Error("expected orders and disputes to be allowed")
+	}
+
+	bm.RemoveBan(pid, repo.BanScopeChat)
+	if bm.IsBannedFor(pid, pb.Message_CHAT) {
+		t.Error("expected chat ban to be removed")
+	}
+
+	bm.AddBan(repo.Ban{PeerID: pid.Pretty(), Scope: repo.BanScopeAll, CreatedAt: time.Now()})
+	if !bm.IsBanned(pid) || !bm.IsBannedFor(pid, pb.Message_DISPUTE_OPEN) {
+		t.Error("expected every message to be blocked")
+	}
+}
+
+func TestBanManagerExpiry(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired := time.Now().Add(-time.Minute)
+	bm := NewBanManager([]peer.ID{})
+	bm.SetBans([]repo.Ban{{PeerID: pid.Pretty(), Scope: repo.BanScopeAll, CreatedAt: expired.Add(-time.Hour), Expires: &expired}})
+	if bm.IsBanned(pid) {
+		t.Error("expected expired ban to be ignored")
+	}
+
+	bm.AddBlockedId(pid)
+	if !bm.IsBanned(pid) {
+		t.Error("expected blocked node to be banned")
+	}
+}
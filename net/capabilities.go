@@ -0,0 +1,181 @@
+package net
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+const (
+	// ErrorCodeOrderProcessing - the order could not be processed
+	ErrorCodeOrderProcessing = 0
+	// ErrorCodeUnsupportedMessageType - the peer has no handler for the message type
+	ErrorCodeUnsupportedMessageType = 1
+	// ErrorCodeDuplicateMessage - the request was already processed and its
+	// response is no longer known
+	ErrorCodeDuplicateMessage = 2
+
+	// CapabilityCacheDuration is how long the capabilities of a peer are
+	// trusted before they are exchanged again
+	CapabilityCacheDuration = time.Hour * 24
+)
+
+// LegacyMessageTypes are the message types understood by peers which predate
+// capability negotiation
+var LegacyMessageTypes = []pb.Message_MessageType{
+	pb.Message_PING,
+	pb.Message_CHAT,
+	pb.Message_FOLLOW,
+	pb.Message_UNFOLLOW,
+	pb.Message_ORDER,
+	pb.Message_ORDER_REJECT,
+	pb.Message_ORDER_CANCEL,
+	pb.Message_ORDER_CONFIRMATION,
+	pb.Message_ORDER_FULFILLMENT,
+	pb.Message_ORDER_COMPLETION,
+	pb.Message_DISPUTE_OPEN,
+	pb.Message_DISPUTE_UPDATE,
+	pb.Message_DISPUTE_CLOSE,
+	pb.Message_REFUND,
+	pb.Message_OFFLINE_ACK,
+	pb.Message_OFFLINE_RELAY,
+	pb.Message_MODERATOR_ADD,
+	pb.Message_MODERATOR_REMOVE,
+	pb.Message_STORE,
+	pb.Message_BLOCK,
+	pb.Message_VENDOR_FINALIZED_PAYMENT,
+	pb.Message_ERROR,
+}
+
+// LegacyListingVersions are the listing versions understood by peers which
+// predate capability negotiation
+var LegacyListingVersions = []uint32{1, 2, 3, 4}
+
+// LegacyCapabilities returns the capabilities assumed for a peer which
+// doesn't advertise any
+func LegacyCapabilities(p peer.ID) *pb.Capabilities {
+	return &pb.Capabilities{
+		PeerID:          p.Pretty(),
+		MessageTypes:    LegacyMessageTypes,
+		ListingVersions: LegacyListingVersions,
+	}
+}
+
+// NewUnsupportedMessageTypeError builds the ERROR message sent back to a peer
+// which sent a message type this node has no handler for
+func NewUnsupportedMessageTypeError(messageType pb.Message_MessageType) (*pb.Message, error) {
+	e := &pb.Error{
+		Code:         ErrorCodeUnsupportedMessageType,
+		ErrorMessage: fmt.Sprintf("unsupported message type %s", messageType.String()),
+		MessageType:  messageType,
+	}
+	a, err := ptypes.MarshalAny(e)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Message{MessageType: pb.Message_ERROR, Payload: a}, nil
+}
+
+// NewDuplicateMessageError builds the ERROR message sent back to a peer which
+// repeated a request that was already processed, when the response to it
+// isn't known anymore
+func NewDuplicateMessageError(messageType pb.Message_MessageType) (*pb.Message, error) {
+	e := &pb.Error{
+		Code:         ErrorCodeDuplicateMessage,
+		ErrorMessage: fmt.Sprintf("duplicate %s message was already processed", messageType.String()),
+		MessageType:  messageType,
+	}
+	a, err := ptypes.MarshalAny(e)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Message{MessageType: pb.Message_ERROR, Payload: a}, nil
+}
+
+type cachedCapabilities struct {
+	capabilities *pb.Capabilities
+	cachedAt     time.Time
+}
+
+// CapabilityCache remembers the message types and contract versions other
+// peers advertised, so senders can avoid messages a peer can't handle
+type CapabilityCache struct {
+	peers map[peer.ID]cachedCapabilities
+	*sync.RWMutex
+}
+
+func NewCapabilityCache() *CapabilityCache {
+	return &CapabilityCache{make(map[peer.ID]cachedCapabilities), new(sync.RWMutex)}
+}
+
+// Put caches the capabilities of a peer
+func (cc *CapabilityCache) Put(p peer.ID, capabilities *pb.Capabilities) {
+	cc.Lock()
+	defer cc.Unlock()
+	cc.peers[p] = cachedCapabilities{capabilities, time.Now()}
+}
+
+// Get returns the cached capabilities of a peer, or nil if they are unknown
+// or have expired
+func (cc *CapabilityCache) Get(p peer.ID) *pb.Capabilities {
+	cc.RLock()
+	defer cc.RUnlock()
+	cached, ok := cc.peers[p]
+	if !ok || time.Since(cached.cachedAt) > CapabilityCacheDuration {
+		return nil
+	}
+	return cached.capabilities
+}
+
+// RemoveMessageType records that a peer refused a message type
+func (cc *CapabilityCache) RemoveMessageType(p peer.ID, messageType pb.Message_MessageType) {
+	cc.Lock()
+	defer cc.Unlock()
+	cached, ok := cc.peers[p]
+	if !ok {
+		cached = cachedCapabilities{LegacyCapabilities(p), time.Now()}
+	}
+	capabilities := *cached.capabilities
+	capabilities.MessageTypes = nil
+	for _, t := range cached.capabilities.MessageTypes {
+		if t != messageType {
+			capabilities.MessageTypes = append(capabilities.MessageTypes, t)
+		}
+	}
+	cc.peers[p] = cachedCapabilities{&capabilities, cached.cachedAt}
+}
+
+// SupportsMessageType returns whether the peer handles the message type.
+// Peers with unknown capabilities are assumed to handle the legacy types.
+func (cc *CapabilityCache) SupportsMessageType(p peer.ID, messageType pb.Message_MessageType) bool {
+	capabilities := cc.Get(p)
+	if capabilities == nil {
+		capabilities = LegacyCapabilities(p)
+	}
+	for _, t := range capabilities.MessageTypes {
+		if t == messageType {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsListingVersion returns whether the peer understands listings of
+// the given version
+func (cc *CapabilityCache) SupportsListingVersion(p peer.ID, version uint32) bool {
+	capabilities := cc.Get(p)
+	if capabilities == nil {
+		capabilities = LegacyCapabilities(p)
+	}
+	for _, v := range capabilities.ListingVersions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
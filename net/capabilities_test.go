@@ -0,0 +1,75 @@
+package net
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestCapabilityCache(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc := NewCapabilityCache()
+
+	// Unknown peers are assumed to speak the legacy protocol
+	if cc.Get(pid) != nil {
+		t.Error("expected no cached capabilities")
+	}
+	if !cc.SupportsMessageType(pid, pb.Message_CHAT) || !cc.SupportsListingVersion(pid, 4) {
+		t.Error("expected legacy capabilities for an unknown peer")
+	}
+	if cc.SupportsListingVersion(pid, 5) {
+		t.Error("expected unknown listing version to be unsupported")
+	}
+
+	cc.Put(pid, &pb.Capabilities{
+		PeerID:          pid.Pretty(),
+		MessageTypes:    []pb.Message_MessageType{pb.Message_PING, pb.Message_ORDER},
+		ListingVersions: []uint32{5},
+	})
+	if cc.SupportsMessageType(pid, pb.Message_CHAT) {
+		t.Error("expected chat to be unsupported")
+	}
+	if !cc.SupportsMessageType(pid, pb.Message_ORDER) || !cc.SupportsListingVersion(pid, 5) {
+		t.Error("expected advertised capabilities to be supported")
+	}
+
+	cc.RemoveMessageType(pid, pb.Message_ORDER)
+	if cc.SupportsMessageType(pid, pb.Message_ORDER) {
+		t.Error("expected refused message type to be removed")
+	}
+	if !cc.SupportsMessageType(pid, pb.Message_PING) {
+		t.Error("expected other message types to be kept")
+	}
+
+	// Expired entries fall back to the legacy capabilities
+	cached := cc.peers[pid]
+	cached.cachedAt = time.Now().Add(-CapabilityCacheDuration - time.Minute)
+	cc.peers[pid] = cached
+	if cc.Get(pid) != nil || !cc.SupportsMessageType(pid, pb.Message_ORDER) {
+		t.Error("expected expired capabilities to be ignored")
+	}
+}
+
+func TestNewUnsupportedMessageTypeError(t *testing.T) {
+	m, err := NewUnsupportedMessageTypeError(pb.Message_STORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.MessageType != pb.Message_ERROR {
+		t.Errorf("expected ERROR message, got %s", m.MessageType)
+	}
+	e := new(pb.Error)
+	if err := ptypes.UnmarshalAny(m.Payload, e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Code != ErrorCodeUnsupportedMessageType || e.MessageType != pb.Message_STORE {
+		t.Errorf("unexpected error payload %v", e)
+	}
+}
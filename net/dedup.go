@@ -0,0 +1,290 @@
+package net
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// DedupWindow is how long the hashes of processed messages are kept.
+	// Offline messages older than this may be processed again.
+	DedupWindow = time.Hour * 24 * 45
+
+	// dedupPruneInterval is how often expired hashes are deleted
+	dedupPruneInterval = time.Hour
+
+	// dedupResponseWindow is how long the responses to requests are kept to
+	// answer copies of the requests
+	dedupResponseWindow = time.Minute * 10
+)
+
+// dedupExemptMessageTypes are requests which peers legitimately repeat
+// with identical content
+var dedupExemptMessageTypes = map[pb.Message_MessageType]bool{
+	pb.Message_PING:          true,
+	pb.Message_STORE:         true,
+	pb.Message_BLOCK:         true,
+	pb.Message_OFFLINE_RELAY: true,
+}
+
+// DuplicateStats - the number of duplicate messages dropped since startup
+type DuplicateStats struct {
+	Total         uint64            `json:"total"`
+	ByMessageType map[string]uint64 `json:"byMessageType"`
+}
+
+// MessageDeduplicator drops messages which were already processed. Messages
+// are identified by a hash of the sender and content, so a message which
+// arrives both directly and through the offline message path is only
+// processed once, and replayed messages are ignored. Messages timestamped
+// outside the window are dropped, as their hash may have been forgotten.
+type MessageDeduplicator struct {
+	store     repo.ReceivedMessageStore
+	window    time.Duration
+	dropped   map[pb.Message_MessageType]uint64
+	claimed   map[string]bool
+	responses map[string]cachedResponse
+	prunedAt  time.Time
+	now       func() time.Time
+	*sync.Mutex
+}
+
+type cachedResponse struct {
+	message  *pb.Message
+	cachedAt time.Time
+}
+
+func NewMessageDeduplicator(store repo.ReceivedMessageStore) *MessageDeduplicator {
+	return &MessageDeduplicator{
+		store:     store,
+		window:    DedupWindow,
+		dropped:   make(map[pb.Message_MessageType]uint64),
+		claimed:   make(map[string]bool),
+		responses: make(map[string]cachedResponse),
+		now:       time.Now,
+		Mutex:     new(sync.Mutex),
+	}
+}
+
+// MessageHash returns the content hash identifying a message from the peer
+func MessageHash(p peer.ID, pmes *pb.Message) string {
+	h := sha256.New()
+	h.Write([]byte(p))
+	typ := make([]byte, 4)
+	binary.BigEndian.PutUint32(typ, uint32(pmes.MessageType))
+	h.Write(typ)
+	if pmes.Payload != nil {
+		h.Write([]byte(pmes.Payload.TypeUrl))
+		h.Write(pmes.Payload.Value)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Claim reserves the message for processing and returns false, counting it,
+// if it was already processed, is being processed, or is timestamped outside
+// the window. Claimed messages must be committed once they are processed, or
+// released if they fail, so a message is only recorded as processed after
+// its handler succeeds. Messages are processed if the store can't be read.
+func (md *MessageDeduplicator) Claim(p peer.ID, pmes *pb.Message) (bool, error) {
+	if dedupExemptMessageTypes[pmes.MessageType] {
+		return true, nil
+	}
+	now := md.now()
+	if timestamp, ok := messageTimestamp(pmes); ok && (now.Sub(timestamp) > md.window || timestamp.Sub(now) > md.window) {
+		md.drop(pmes.MessageType)
+		return false, nil
+	}
+	hash := MessageHash(p, pmes)
+	md.Lock()
+	if md.claimed[hash] {
+		md.dropped[pmes.MessageType]++
+		md.Unlock()
+		return false, nil
+	}
+	md.claimed[hash] = true
+	md.Unlock()
+
+	processed, err := md.store.Has(hash)
+	if err != nil {
+		return true, err
+	}
+	if processed {
+		md.Lock()
+		delete(md.claimed, hash)
+		md.dropped[pmes.MessageType]++
+		md.Unlock()
+		return false, nil
+	}
+	return true, nil
+}
+
+// Commit records that a claimed message was processed
+func (md *MessageDeduplicator) Commit(p peer.ID, pmes *pb.Message) error {
+	if dedupExemptMessageTypes[pmes.MessageType] {
+		return nil
+	}
+	now := md.now()
+	hash := MessageHash(p, pmes)
+	_, err := md.store.Put(hash, p.Pretty(), pmes.MessageType, now)
+
+	md.Lock()
+	delete(md.claimed, hash)
+	prune := now.Sub(md.prunedAt) > dedupPruneInterval
+	if prune {
+		md.prunedAt = now
+	}
+	md.Unlock()
+	if err != nil {
+		return err
+	}
+	if prune {
+		return md.store.DeleteBefore(now.Add(-md.window))
+	}
+	return nil
+}
+
+// Release forgets a claimed message which failed to be processed, so it can
+// be processed again
+func (md *MessageDeduplicator) Release(p peer.ID, pmes *pb.Message) {
+	if dedupExemptMessageTypes[pmes.MessageType] {
+		return
+	}
+	md.Lock()
+	delete(md.claimed, MessageHash(p, pmes))
+	md.Unlock()
+}
+
+func (md *MessageDeduplicator) drop(messageType pb.Message_MessageType) {
+	md.Lock()
+	md.dropped[messageType]++
+	md.Unlock()
+}
+
+// messageTimestamp returns the time the sender created the message at, for
+// the message types which carry one
+func messageTimestamp(pmes *pb.Message) (time.Time, bool) {
+	if pmes.Payload == nil {
+		return time.Time{}, false
+	}
+	var ts *timestamp.Timestamp
+	switch pmes.MessageType {
+	case pb.Message_CHAT:
+		chat := new(pb.Chat)
+		if ptypes.UnmarshalAny(pmes.Payload, chat) == nil {
+			ts = chat.Timestamp
+		}
+	case pb.Message_FOLLOW, pb.Message_UNFOLLOW, pb.Message_MODERATOR_ADD, pb.Message_MODERATOR_REMOVE:
+		sd := new(pb.SignedData)
+		command := new(pb.SignedData_Command)
+		if ptypes.UnmarshalAny(pmes.Payload, sd) == nil && proto.Unmarshal(sd.SerializedData, command) == nil {
+			ts = command.Timestamp
+		}
+	case pb.Message_ORDER_REJECT:
+		reject := new(pb.OrderReject)
+		if ptypes.UnmarshalAny(pmes.Payload, reject) == nil {
+			ts = reject.Timestamp
+		}
+	case pb.Message_ORDER, pb.Message_ORDER_CONFIRMATION, pb.Message_ORDER_FULFILLMENT, pb.Message_ORDER_COMPLETION,
+		pb.Message_DISPUTE_OPEN, pb.Message_DISPUTE_CLOSE, pb.Message_REFUND:
+		rc := new(pb.RicardianContract)
+		if ptypes.UnmarshalAny(pmes.Payload, rc) == nil {
+			ts = contractTimestamp(pmes.MessageType, rc)
+		}
+	}
+	if ts == nil {
+		return time.Time{}, false
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// contractTimestamp returns the timestamp of the part of the contract which
+// the message type adds
+func contractTimestamp(messageType pb.Message_MessageType, rc *pb.RicardianContract) *timestamp.Timestamp {
+	switch messageType {
+	case pb.Message_ORDER:
+		if rc.BuyerOrder != nil {
+			return rc.BuyerOrder.Timestamp
+		}
+	case pb.Message_ORDER_CONFIRMATION:
+		if rc.VendorOrderConfirmation != nil {
+			return rc.VendorOrderConfirmation.Timestamp
+		}
+	case pb.Message_ORDER_FULFILLMENT:
+		if n := len(rc.VendorOrderFulfillment); n > 0 && rc.VendorOrderFulfillment[n-1] != nil {
+			return rc.VendorOrderFulfillment[n-1].Timestamp
+		}
+	case pb.Message_ORDER_COMPLETION:
+		if rc.BuyerOrderCompletion != nil {
+			return rc.BuyerOrderCompletion.Timestamp
+		}
+	case pb.Message_DISPUTE_OPEN:
+		if rc.Dispute != nil {
+			return rc.Dispute.Timestamp
+		}
+	case pb.Message_DISPUTE_CLOSE:
+		if rc.DisputeResolution != nil {
+			return rc.DisputeResolution.Timestamp
+		}
+	case pb.Message_REFUND:
+		if rc.Refund != nil {
+			return rc.Refund.Timestamp
+		}
+	}
+	return nil
+}
+
+// PutResponse keeps the response to a processed request, to be sent again to
+// copies of the request
+func (md *MessageDeduplicator) PutResponse(p peer.ID, pmes *pb.Message, rpmes *pb.Message) {
+	if dedupExemptMessageTypes[pmes.MessageType] {
+		return
+	}
+	md.Lock()
+	defer md.Unlock()
+	now := md.now()
+	for hash, cached := range md.responses {
+		if now.Sub(cached.cachedAt) > dedupResponseWindow {
+			delete(md.responses, hash)
+		}
+	}
+	md.responses[MessageHash(p, pmes)] = cachedResponse{proto.Clone(rpmes).(*pb.Message), now}
+}
+
+// Response returns a copy of the response to a processed request, or nil if
+// it isn't known
+func (md *MessageDeduplicator) Response(p peer.ID, pmes *pb.Message) *pb.Message {
+	md.Lock()
+	defer md.Unlock()
+	cached, ok := md.responses[MessageHash(p, pmes)]
+	if !ok || md.now().Sub(cached.cachedAt) > dedupResponseWindow {
+		return nil
+	}
+	return proto.Clone(cached.message).(*pb.Message)
+}
+
+// Stats returns the number of duplicates dropped per message type
+func (md *MessageDeduplicator) Stats() DuplicateStats {
+	md.Lock()
+	defer md.Unlock()
+	stats := DuplicateStats{ByMessageType: make(map[string]uint64)}
+	for messageType, n := range md.dropped {
+		stats.Total += n
+		stats.ByMessageType[messageType.String()] = n
+	}
+	return stats
+}
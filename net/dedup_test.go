@@ -0,0 +1,184 @@
+package net
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type memoryReceivedMessageStore struct {
+	repo.Queryable
+	hashes map[string]time.Time
+}
+
+func (s *memoryReceivedMessageStore) Put(hash string, peerID string, messageType pb.Message_MessageType, timestamp time.Time) (bool, error) {
+	if _, ok := s.hashes[hash]; ok {
+		return false, nil
+	}
+	s.hashes[hash] = timestamp
+	return true, nil
+}
+func (s *memoryReceivedMessageStore) Has(hash string) (bool, error) {
+	_, ok := s.hashes[hash]
+	return ok, nil
+}
+func (s *memoryReceivedMessageStore) Delete(hash string) error {
+	delete(s.hashes, hash)
+	return nil
+}
+func (s *memoryReceivedMessageStore) DeleteBefore(t time.Time) error {
+	for hash, timestamp := range s.hashes {
+		if timestamp.Before(t) {
+			delete(s.hashes, hash)
+		}
+	}
+	return nil
+}
+
+func TestMessageDeduplicator(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := peer.IDB58Decode("QmeRQYAEaXSoaFp1VeTKUuNJ6qU6ZoT1p9aZT2fNzHdoFt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &memoryReceivedMessageStore{hashes: make(map[string]time.Time)}
+	md := NewMessageDeduplicator(store)
+	now := time.Now()
+	md.now = func() time.Time { return now }
+
+	refund := &pb.Message{MessageType: pb.Message_REFUND, Payload: &any.Any{TypeUrl: "type", Value: []byte("refund")}}
+	if claimed, err := md.Claim(pid, refund); err != nil || !claimed {
+		t.Fatalf("expected new message to be claimed, got %t (%v)", claimed, err)
+	}
+
+	// A copy arriving through another path carries a request ID
+	replay := *refund
+	replay.RequestId = 7
+	if claimed, _ := md.Claim(pid, &replay); claimed {
+		t.Error("expected a copy of a message being processed to be a duplicate")
+	}
+	if claimed, _ := md.Claim(other, refund); !claimed {
+		t.Error("expected the same content from another peer not to be a duplicate")
+	}
+
+	// Claims are only recorded once they're committed, so a message being
+	// processed when the node stops is processed again
+	if len(store.hashes) != 0 {
+		t.Errorf("expected no hashes to be recorded before commit, got %d", len(store.hashes))
+	}
+	if claimed, _ := NewMessageDeduplicator(store).Claim(pid, refund); !claimed {
+		t.Error("expected an uncommitted message to be claimed after a restart")
+	}
+	if err := md.Commit(pid, refund); err != nil {
+		t.Fatal(err)
+	}
+	if claimed, _ := md.Claim(pid, &replay); claimed {
+		t.Error("expected replayed message to be a duplicate")
+	}
+
+	ping := &pb.Message{MessageType: pb.Message_PING}
+	for i := 0; i < 2; i++ {
+		if claimed, _ := md.Claim(pid, ping); !claimed {
+			t.Error("expected pings to be exempt")
+		}
+	}
+
+	stats := md.Stats()
+	if stats.Total != 2 || stats.ByMessageType[pb.Message_REFUND.String()] != 2 {
+		t.Errorf("unexpected stats %v", stats)
+	}
+
+	// Released messages can be processed again
+	chat := &pb.Message{MessageType: pb.Message_CHAT, Payload: &any.Any{TypeUrl: "type", Value: []byte("chat")}}
+	md.Claim(pid, chat)
+	md.Release(pid, chat)
+	if claimed, _ := md.Claim(pid, chat); !claimed {
+		t.Error("expected released message to be claimed again")
+	}
+
+	// Hashes are forgotten after the window
+	now = now.Add(DedupWindow + time.Hour*2)
+	md.Commit(pid, chat)
+	if claimed, _ := md.Claim(pid, refund); !claimed {
+		t.Error("expected expired hash to be pruned")
+	}
+}
+
+func TestMessageDeduplicatorTimestampWindow(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := NewMessageDeduplicator(&memoryReceivedMessageStore{hashes: make(map[string]time.Time)})
+	now := time.Now()
+	md.now = func() time.Time { return now }
+
+	chatAt := func(t time.Time) *pb.Message {
+		ts, _ := ptypes.TimestampProto(t)
+		payload, _ := ptypes.MarshalAny(&pb.Chat{Message: "hello", Timestamp: ts})
+		return &pb.Message{MessageType: pb.Message_CHAT, Payload: payload}
+	}
+	if claimed, _ := md.Claim(pid, chatAt(now.Add(-DedupWindow+time.Hour))); !claimed {
+		t.Error("expected a message timestamped within the window to be claimed")
+	}
+	if claimed, _ := md.Claim(pid, chatAt(now.Add(-DedupWindow-time.Hour))); claimed {
+		t.Error("expected a message timestamped before the window to be dropped")
+	}
+	if claimed, _ := md.Claim(pid, chatAt(now.Add(DedupWindow+time.Hour))); claimed {
+		t.Error("expected a message timestamped after the window to be dropped")
+	}
+
+	refundAt := func(t time.Time) *pb.Message {
+		ts, _ := ptypes.TimestampProto(t)
+		payload, _ := ptypes.MarshalAny(&pb.RicardianContract{Refund: &pb.Refund{OrderID: "order", Timestamp: ts}})
+		return &pb.Message{MessageType: pb.Message_REFUND, Payload: payload}
+	}
+	if claimed, _ := md.Claim(pid, refundAt(now.Add(-DedupWindow-time.Hour))); claimed {
+		t.Error("expected a refund timestamped before the window to be dropped")
+	}
+	if stats := md.Stats(); stats.Total != 3 {
+		t.Errorf("expected the dropped messages to be counted, got %v", stats)
+	}
+}
+
+func TestMessageDeduplicatorResponses(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := NewMessageDeduplicator(&memoryReceivedMessageStore{hashes: make(map[string]time.Time)})
+	now := time.Now()
+	md.now = func() time.Time { return now }
+
+	order := &pb.Message{MessageType: pb.Message_ORDER, Payload: &any.Any{TypeUrl: "type", Value: []byte("order")}, RequestId: 1}
+	if md.Response(pid, order) != nil {
+		t.Error("expected no response before the order is processed")
+	}
+	md.PutResponse(pid, order, &pb.Message{MessageType: pb.Message_ORDER_CONFIRMATION, RequestId: 1, IsResponse: true})
+
+	retry := *order
+	retry.RequestId = 2
+	resp := md.Response(pid, &retry)
+	if resp == nil || resp.MessageType != pb.Message_ORDER_CONFIRMATION {
+		t.Fatalf("expected the response to the original request, got %v", resp)
+	}
+	// Responses are copies, so setting the request ID doesn't change the cache
+	resp.RequestId = 2
+	if md.Response(pid, order).RequestId != 1 {
+		t.Error("expected the cached response not to change")
+	}
+
+	now = now.Add(dedupResponseWindow + time.Minute)
+	if md.Response(pid, order) != nil {
+		t.Error("expected the response to expire")
+	}
+}
@@ -0,0 +1,161 @@
+package onion
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
+	"gx/ipfs/QmaPHkZLbQQbvcyavn8q1GFHg6o6yeceyHFSJ3Pjf3p3TQ/go-crypto/sha3"
+)
+
+const (
+	// P_ONION3 is the multicodec of v3 onion service addresses
+	P_ONION3 = 0x01BD
+
+	v2AddressLength = 16
+	v3AddressLength = 56
+	v3Version       = 0x03
+)
+
+var onionEncoding = base32.StdEncoding
+
+// The multiaddr library we build against predates v3 onions, so the protocol
+// is registered here. Importing this package is enough to parse /onion3.
+func init() {
+	if err := ma.AddProtocol(ma.Protocol{
+		Code:       P_ONION3,
+		Size:       296,
+		Name:       "onion3",
+		VCode:      ma.CodeToVarint(P_ONION3),
+		Transcoder: ma.NewTranscoderFromFunctions(onion3StB, onion3BtS),
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func onion3StB(s string) ([]byte, error) {
+	addr := strings.Split(s, ":")
+	if len(addr) != 2 {
+		return nil, fmt.Errorf("failed to parse onion3 addr: %s does not contain a port number", s)
+	}
+	if !IsV3Address(addr[0]) {
+		return nil, fmt.Errorf("failed to parse onion3 addr: %s not a Tor v3 onion address", s)
+	}
+	host, _ := onionEncoding.DecodeString(strings.ToUpper(addr[0]))
+	port, err := parsePort(addr[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse onion3 addr: %s", err)
+	}
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, port)
+	return append(host, b...), nil
+}
+
+func onion3BtS(b []byte) (string, error) {
+	if len(b) != 37 {
+		return "", fmt.Errorf("invalid onion3 addr length: %d", len(b))
+	}
+	addr := strings.ToLower(onionEncoding.EncodeToString(b[0:35]))
+	port := binary.BigEndian.Uint16(b[35:37])
+	return addr + ":" + strconv.Itoa(int(port)), nil
+}
+
+func parsePort(s string) (uint16, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if i >= 65536 || i < 1 {
+		return 0, fmt.Errorf("port %d out of range", i)
+	}
+	return uint16(i), nil
+}
+
+// V3Address returns the onion service ID of an ed25519 public key
+func V3Address(pubkey []byte) string {
+	b := make([]byte, 0, 35)
+	b = append(b, pubkey...)
+	b = append(b, v3Checksum(pubkey)...)
+	b = append(b, v3Version)
+	return strings.ToLower(onionEncoding.EncodeToString(b))
+}
+
+func v3Checksum(pubkey []byte) []byte {
+	h := sha3.New256()
+	h.Write([]byte(".onion checksum"))
+	h.Write(pubkey)
+	h.Write([]byte{v3Version})
+	return h.Sum(nil)[:2]
+}
+
+// IsV3Address reports whether the onion service ID is a well formed v3 address
+func IsV3Address(id string) bool {
+	if len(id) != v3AddressLength {
+		return false
+	}
+	b, err := onionEncoding.DecodeString(strings.ToUpper(id))
+	if err != nil || len(b) != 35 || b[34] != v3Version {
+		return false
+	}
+	checksum := v3Checksum(b[:32])
+	return b[32] == checksum[0] && b[33] == checksum[1]
+}
+
+// IsV2Address reports whether the onion service ID is a well formed v2 address
+func IsV2Address(id string) bool {
+	if len(id) != v2AddressLength {
+		return false
+	}
+	_, err := onionEncoding.DecodeString(strings.ToUpper(id))
+	return err == nil
+}
+
+// Multiaddr returns the multiaddr string of an onion service
+func Multiaddr(id string, port int) string {
+	if IsV3Address(id) {
+		return fmt.Sprintf("/onion3/%s:%d", id, port)
+	}
+	return fmt.Sprintf("/onion/%s:%d", id, port)
+}
+
+// IsValidOnionMultiAddr is used to validate that a multiaddr is representing
+// a v2 or v3 Tor onion service
+func IsValidOnionMultiAddr(a ma.Multiaddr) bool {
+	_, _, err := splitOnionMultiaddr(a)
+	return err == nil
+}
+
+// splitOnionMultiaddr returns the onion service ID and virtual port
+func splitOnionMultiaddr(a ma.Multiaddr) (string, uint16, error) {
+	protocols := a.Protocols()
+	if len(protocols) != 1 {
+		return "", 0, fmt.Errorf("not an onion address: %s", a)
+	}
+	var (
+		addr string
+		err  error
+	)
+	switch protocols[0].Code {
+	case ma.P_ONION:
+		addr, err = a.ValueForProtocol(ma.P_ONION)
+	case P_ONION3:
+		addr, err = a.ValueForProtocol(P_ONION3)
+	default:
+		return "", 0, fmt.Errorf("not an onion address: %s", a)
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	split := strings.Split(addr, ":")
+	if len(split) != 2 || !(IsV2Address(split[0]) || IsV3Address(split[0])) {
+		return "", 0, fmt.Errorf("failed to parse onion address %s", addr)
+	}
+	port, err := parsePort(split[1])
+	if err != nil {
+		return "", 0, err
+	}
+	return split[0], port, nil
+}
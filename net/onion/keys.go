@@ -0,0 +1,114 @@
+package onion
+
+import (
+	"crypto"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gx/ipfs/QmQ51pHe6u7CWodkUGDLqaCEMchkbMt7VEZnECF5mp6tVb/ed25519"
+
+	"github.com/yawning/bulb"
+	"github.com/yawning/bulb/utils/pkcs1"
+)
+
+const (
+	// KeyFileExtension is the extension of onion service keys in the repo.
+	// The file name is the onion service ID.
+	KeyFileExtension = ".onion_key"
+
+	v2KeyPEMType = "RSA PRIVATE KEY"
+	v3KeyPEMType = "ED25519-V3 PRIVATE KEY"
+	v3KeyType    = "ED25519-V3"
+)
+
+// CreateV3Key generates a new ed25519 v3 onion service key, saves it to
+// the directory and returns its onion service ID
+func CreateV3Key(dir string, rand io.Reader) (string, error) {
+	pub, priv, err := ed25519.GenerateKey(rand)
+	if err != nil {
+		return "", err
+	}
+	id := V3Address(pub[:])
+
+	f, err := os.Create(path.Join(dir, id+KeyFileExtension))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	block := pem.Block{Type: v3KeyPEMType, Bytes: expandV3Key(priv[:32])}
+	if err := pem.Encode(f, &block); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// expandV3Key returns the expanded secret key Tor expects for ADD_ONION
+func expandV3Key(seed []byte) []byte {
+	h := sha512.Sum512(seed)
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+	return h[:]
+}
+
+// KeyIDs returns the onion service IDs of the keys saved in the directory
+func KeyIDs(dir string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, file := range files {
+		if filepath.Ext(file.Name()) == KeyFileExtension {
+			ids = append(ids, strings.TrimSuffix(file.Name(), KeyFileExtension))
+		}
+	}
+	return ids, nil
+}
+
+// LoadKeys reads the v2 and v3 onion service keys saved in the directory
+func LoadKeys(dir string) (map[string]crypto.PrivateKey, error) {
+	ids, err := KeyIDs(dir)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PrivateKey)
+	for _, id := range ids {
+		b, err := ioutil.ReadFile(path.Join(dir, id+KeyFileExtension))
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode(b)
+		if block == nil {
+			return nil, fmt.Errorf("invalid onion key for %s", id)
+		}
+		switch block.Type {
+		case v2KeyPEMType:
+			priv, _, err := pkcs1.DecodePrivateKeyDER(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			keys[id] = priv
+		case v3KeyPEMType:
+			if len(block.Bytes) != 64 {
+				return nil, fmt.Errorf("invalid onion key for %s", id)
+			}
+			keys[id] = &bulb.OnionPrivateKey{
+				KeyType: v3KeyType,
+				Key:     base64.StdEncoding.EncodeToString(block.Bytes),
+			}
+		default:
+			return nil, fmt.Errorf("unknown onion key type %q for %s", block.Type, id)
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,223 @@
+package onion
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net"
+	"strconv"
+
+	manet "gx/ipfs/QmRK2LxanhK2gZq6k6R7vk5ZoYZk8ULSSTB7FzDsMUX6CB/go-multiaddr-net"
+	mafmt "gx/ipfs/QmTy17Jm1foTnvUS9JXRhLbRQ3XuC64jPTjUfpB4mHz2QM/mafmt"
+	tpt "gx/ipfs/QmVxtCwKFMmwcjhQXsGj6m4JAW7nGb9hRoErH9jpgqcLxA/go-libp2p-transport"
+	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
+
+	"github.com/yawning/bulb"
+	"golang.org/x/net/proxy"
+)
+
+// OnionTransport implements go-libp2p-transport's Transport interface for
+// v2 and v3 onion services
+type OnionTransport struct {
+	controlConn *bulb.Conn
+	auth        *proxy.Auth
+	keys        map[string]crypto.PrivateKey
+	onlyOnion   bool
+}
+
+// NewOnionTransport creates a OnionTransport
+//
+// controlNet and controlAddr contain the connecting information
+// for the tor control port; either TCP or UNIX domain socket.
+//
+// controlPass contains the optional tor control password
+//
+// auth contains the socks proxy username and password
+// keysDir is the key material for the Tor onion service.
+//
+// if onlyOnion is true the dialer will only be used to dial out on onion addresses
+func NewOnionTransport(controlNet, controlAddr, controlPass string, auth *proxy.Auth, keysDir string, onlyOnion bool) (*OnionTransport, error) {
+	keys, err := LoadKeys(keysDir)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := bulb.Dial(controlNet, controlAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.Authenticate(controlPass); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("Authentication failed: %v", err)
+	}
+	return &OnionTransport{
+		controlConn: conn,
+		auth:        auth,
+		keys:        keys,
+		onlyOnion:   onlyOnion,
+	}, nil
+}
+
+// TorDialer returns a proxy dialer gathered from the control interface.
+// This isn't needed for the IPFS transport but it provides
+// easy access to Tor for other functions.
+func (t *OnionTransport) TorDialer() (proxy.Dialer, error) {
+	return t.controlConn.Dialer(t.auth)
+}
+
+// Dialer creates and returns a go-libp2p-transport Dialer
+func (t *OnionTransport) Dialer(laddr ma.Multiaddr, opts ...tpt.DialOpt) (tpt.Dialer, error) {
+	return &OnionDialer{
+		auth:      t.auth,
+		laddr:     laddr,
+		transport: t,
+	}, nil
+}
+
+// Listen publishes the onion service of the address and returns a
+// go-libp2p-transport Listener
+func (t *OnionTransport) Listen(laddr ma.Multiaddr) (tpt.Listener, error) {
+	id, port, err := splitOnionMultiaddr(laddr)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := t.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("missing onion service key material for %s", id)
+	}
+	listener, err := t.controlConn.Listener(port, key)
+	if err != nil {
+		return nil, err
+	}
+	return &OnionListener{
+		listener:  listener,
+		laddr:     laddr,
+		transport: t,
+	}, nil
+}
+
+// Matches returns true if the address is a valid onion multiaddr
+func (t *OnionTransport) Matches(a ma.Multiaddr) bool {
+	return IsValidOnionMultiAddr(a)
+}
+
+// Close closes the connection to the Tor control port
+func (t *OnionTransport) Close() error {
+	return t.controlConn.Close()
+}
+
+// OnionDialer implements go-libp2p-transport's Dialer interface
+type OnionDialer struct {
+	auth      *proxy.Auth
+	laddr     ma.Multiaddr
+	transport *OnionTransport
+}
+
+// Dial connects to the specified multiaddr through Tor and returns a
+// go-libp2p-transport Conn
+func (d *OnionDialer) Dial(raddr ma.Multiaddr) (tpt.Conn, error) {
+	dialer, err := d.transport.TorDialer()
+	if err != nil {
+		return nil, err
+	}
+	var network, addr string
+	if id, port, err := splitOnionMultiaddr(raddr); err == nil {
+		network, addr = "tcp4", id+".onion:"+strconv.Itoa(int(port))
+	} else {
+		netaddr, err := manet.ToNetAddr(raddr)
+		if err != nil {
+			return nil, err
+		}
+		network, addr = netaddr.Network(), netaddr.String()
+	}
+	conn, err := dialer.Dial(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &OnionConn{
+		Conn:      conn,
+		transport: d.transport,
+		laddr:     d.laddr,
+		raddr:     raddr,
+	}, nil
+}
+
+// DialContext connects to the specified multiaddr. Tor dials can't be
+// canceled so the context is ignored.
+func (d *OnionDialer) DialContext(ctx context.Context, raddr ma.Multiaddr) (tpt.Conn, error) {
+	return d.Dial(raddr)
+}
+
+// If onlyOnion is set, Matches returns true only for onion addrs.
+// Otherwise TCP addrs can use this dialer in addition to onion.
+func (d *OnionDialer) Matches(a ma.Multiaddr) bool {
+	if d.transport.onlyOnion {
+		return IsValidOnionMultiAddr(a)
+	}
+	return IsValidOnionMultiAddr(a) || mafmt.TCP.Matches(a)
+}
+
+// OnionListener implements go-libp2p-transport's Listener interface
+type OnionListener struct {
+	listener  net.Listener
+	laddr     ma.Multiaddr
+	transport tpt.Transport
+}
+
+// Accept blocks until a connection is received returning
+// go-libp2p-transport's Conn interface or an error if
+// something went wrong
+func (l *OnionListener) Accept() (tpt.Conn, error) {
+	conn, err := l.listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	raddr, err := manet.FromNetAddr(conn.RemoteAddr())
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &OnionConn{
+		Conn:      conn,
+		transport: l.transport,
+		laddr:     l.laddr,
+		raddr:     raddr,
+	}, nil
+}
+
+// Close shuts down the listener and removes the onion service
+func (l *OnionListener) Close() error {
+	return l.listener.Close()
+}
+
+// Addr returns the address of the onion service
+func (l *OnionListener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// Multiaddr returns the local multiaddr we are listening on
+func (l *OnionListener) Multiaddr() ma.Multiaddr {
+	return l.laddr
+}
+
+// OnionConn implement's go-libp2p-transport's Conn interface
+type OnionConn struct {
+	net.Conn
+	transport tpt.Transport
+	laddr     ma.Multiaddr
+	raddr     ma.Multiaddr
+}
+
+// Transport returns the OnionTransport associated with this OnionConn
+func (c *OnionConn) Transport() tpt.Transport {
+	return c.transport
+}
+
+// LocalMultiaddr returns the local multiaddr for this connection
+func (c *OnionConn) LocalMultiaddr() ma.Multiaddr {
+	return c.laddr
+}
+
+// RemoteMultiaddr returns the remote multiaddr for this connection
+func (c *OnionConn) RemoteMultiaddr() ma.Multiaddr {
+	return c.raddr
+}
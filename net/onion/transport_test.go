@@ -0,0 +1,182 @@
+package onion
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
+)
+
+func TestV3Address(t *testing.T) {
+	const id = "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad"
+	if !IsV3Address(id) {
+		t.Errorf("expected %s to be a v3 address", id)
+	}
+	if IsV3Address(strings.Replace(id, "duck", "dack", 1)) {
+		t.Error("expected an address with a bad checksum to be rejected")
+	}
+	if IsV3Address("facebookcorewwwi") || !IsV2Address("facebookcorewwwi") {
+		t.Error("expected a v2 address to be detected")
+	}
+
+	m, err := ma.NewMultiaddr(Multiaddr(id, 4003))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.String() != "/onion3/"+id+":4003" {
+		t.Errorf("expected the multiaddr to round trip, got %s", m.String())
+	}
+	if !IsValidOnionMultiAddr(m) {
+		t.Error("expected a valid onion multiaddr")
+	}
+	if _, err := ma.NewMultiaddr("/onion3/" + id); err == nil {
+		t.Error("expected an onion3 multiaddr without a port to be rejected")
+	}
+}
+
+// fakeTor is a stand-in for the Tor control port which answers just enough
+// of the protocol to publish onion services
+type fakeTor struct {
+	listener net.Listener
+	onions   chan string
+}
+
+func newFakeTor(t *testing.T) *fakeTor {
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tor := &fakeTor{listener: l, onions: make(chan string, 1)}
+	go tor.serve()
+	return tor
+}
+
+func (tor *fakeTor) serve() {
+	conn, err := tor.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "PROTOCOLINFO":
+			fmt.Fprint(conn, "250-PROTOCOLINFO 1\r\n250-AUTH METHODS=NULL\r\n250-VERSION Tor=\"0.4.8.9\"\r\n250 OK\r\n")
+		case "AUTHENTICATE", "DEL_ONION":
+			fmt.Fprint(conn, "250 OK\r\n")
+		case "ADD_ONION":
+			tor.onions <- strings.TrimSpace(line)
+			fmt.Fprintf(conn, "250-ServiceID=%s\r\n250 OK\r\n", serviceID(fields[1]))
+		default:
+			fmt.Fprint(conn, "510 Unrecognized command\r\n")
+		}
+	}
+}
+
+// serviceID returns a fixed onion service ID of the key's version
+func serviceID(key string) string {
+	if strings.HasPrefix(key, "ED25519-V3:") {
+		return "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad"
+	}
+	return "facebookcorewwwi"
+}
+
+func TestOnionTransportListenV3(t *testing.T) {
+	dir, err := ioutil.TempDir("", "onion")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	id, err := CreateV3Key(dir, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsV3Address(id) {
+		t.Fatalf("expected a v3 onion address, got %s", id)
+	}
+
+	tor := newFakeTor(t)
+	defer tor.listener.Close()
+	transport, err := NewOnionTransport("tcp4", tor.listener.Addr().String(), "", nil, dir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+
+	laddr, err := ma.NewMultiaddr(Multiaddr(id, 4003))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !transport.Matches(laddr) {
+		t.Fatal("expected the transport to match a v3 address")
+	}
+	listener, err := transport.Listen(laddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	// Tor is told to publish the service with our ed25519 key and forward
+	// the virtual port to the local listener
+	cmd := <-tor.onions
+	fields := strings.Fields(cmd)
+	if len(fields) != 3 || !strings.HasPrefix(fields[1], "ED25519-V3:") || !strings.HasPrefix(fields[2], "Port=4003,") {
+		t.Fatalf("unexpected ADD_ONION command: %s", cmd)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(fields[1], "ED25519-V3:"))
+	if err != nil || len(key) != 64 {
+		t.Fatalf("expected a 64 byte expanded key, got %d bytes (%v)", len(key), err)
+	}
+
+	// Connections forwarded by Tor are accepted
+	go func() {
+		conn, err := net.Dial("tcp4", "127.0.0.1:"+strings.TrimPrefix(fields[2], "Port=4003,"))
+		if err == nil {
+			conn.Close()
+		}
+	}()
+	conn, err := listener.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+	if !conn.LocalMultiaddr().Equal(laddr) {
+		t.Errorf("expected local address %s, got %s", laddr, conn.LocalMultiaddr())
+	}
+}
+
+func TestOnionTransportMissingKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "onion")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tor := newFakeTor(t)
+	defer tor.listener.Close()
+	transport, err := NewOnionTransport("tcp4", tor.listener.Addr().String(), "", nil, dir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+
+	laddr, err := ma.NewMultiaddr("/onion3/duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad:4003")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := transport.Listen(laddr); err == nil {
+		t.Error("expected listening without a key to fail")
+	}
+}
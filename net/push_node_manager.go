@@ -0,0 +1,194 @@
+package net
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+)
+
+const (
+	// PushNodeFailureThreshold is the number of consecutive failures after
+	// which a push node is considered unhealthy
+	PushNodeFailureThreshold = 3
+	// PushNodeRetryInterval is how long an unhealthy push node is skipped
+	// before it is tried again
+	PushNodeRetryInterval = time.Minute * 10
+)
+
+// PushNodeStatus - the health of a single push node
+type PushNodeStatus struct {
+	PeerID              string     `json:"peerID"`
+	Fallback            bool       `json:"fallback"`
+	Healthy             bool       `json:"healthy"`
+	Active              bool       `json:"active"`
+	LastSuccess         *time.Time `json:"lastSuccess,omitempty"`
+	LastFailure         *time.Time `json:"lastFailure,omitempty"`
+	LastError           string     `json:"lastError,omitempty"`
+	LatencyMillis       int64      `json:"latencyMillis"`
+	Failures            int        `json:"failures"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+}
+
+type pushNode struct {
+	id                  peer.ID
+	fallback            bool
+	lastSuccess         time.Time
+	lastFailure         time.Time
+	lastError           string
+	latency             time.Duration
+	failures            int
+	consecutiveFailures int
+}
+
+// PushNodeManager tracks the health of the configured push nodes. Nodes which
+// keep failing are skipped in favor of the fallback nodes until their retry
+// interval passes.
+type PushNodeManager struct {
+	nodes []*pushNode
+	now   func() time.Time
+	*sync.RWMutex
+}
+
+func NewPushNodeManager(primary, fallback []peer.ID) *PushNodeManager {
+	pm := &PushNodeManager{now: time.Now, RWMutex: new(sync.RWMutex)}
+	seen := make(map[peer.ID]bool)
+	for _, p := range primary {
+		if !seen[p] {
+			seen[p] = true
+			pm.nodes = append(pm.nodes, &pushNode{id: p})
+		}
+	}
+	for _, p := range fallback {
+		if !seen[p] {
+			seen[p] = true
+			pm.nodes = append(pm.nodes, &pushNode{id: p, fallback: true})
+		}
+	}
+	return pm
+}
+
+// All returns every configured push node, primary nodes first
+func (pm *PushNodeManager) All() []peer.ID {
+	pm.RLock()
+	defer pm.RUnlock()
+	var ret []peer.ID
+	for _, n := range pm.nodes {
+		ret = append(ret, n.id)
+	}
+	return ret
+}
+
+// Active returns the push nodes data should be sent to. Healthy primary
+// nodes are used first, fastest first, and each unhealthy primary node is
+// replaced by a healthy fallback node. If no node is healthy every node is
+// returned so pushing is still attempted.
+func (pm *PushNodeManager) Active() []peer.ID {
+	pm.RLock()
+	defer pm.RUnlock()
+	return pm.active(pm.now())
+}
+
+func (pm *PushNodeManager) active(now time.Time) []peer.ID {
+	var primary, fallback []*pushNode
+	deficit := 0
+	for _, n := range pm.nodes {
+		switch {
+		case !n.fallback && pm.healthy(n, now):
+			primary = append(primary, n)
+		case !n.fallback:
+			deficit++
+		case pm.healthy(n, now):
+			fallback = append(fallback, n)
+		}
+	}
+	byLatency := func(nodes []*pushNode) {
+		sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].latency < nodes[j].latency })
+	}
+	byLatency(primary)
+	byLatency(fallback)
+	if deficit < len(fallback) {
+		fallback = fallback[:deficit]
+	}
+
+	var ret []peer.ID
+	for _, n := range append(primary, fallback...) {
+		ret = append(ret, n.id)
+	}
+	if len(ret) == 0 {
+		for _, n := range pm.nodes {
+			ret = append(ret, n.id)
+		}
+	}
+	return ret
+}
+
+func (pm *PushNodeManager) healthy(n *pushNode, now time.Time) bool {
+	return n.consecutiveFailures < PushNodeFailureThreshold || now.Sub(n.lastFailure) > PushNodeRetryInterval
+}
+
+// RecordSuccess records a successful push to the node. Peers which aren't
+// push nodes are ignored.
+func (pm *PushNodeManager) RecordSuccess(p peer.ID, latency time.Duration) {
+	pm.Lock()
+	defer pm.Unlock()
+	for _, n := range pm.nodes {
+		if n.id == p {
+			n.lastSuccess = pm.now()
+			n.latency = latency
+			n.consecutiveFailures = 0
+		}
+	}
+}
+
+// RecordFailure records a failed push to the node. Peers which aren't push
+// nodes are ignored.
+func (pm *PushNodeManager) RecordFailure(p peer.ID, err error) {
+	pm.Lock()
+	defer pm.Unlock()
+	for _, n := range pm.nodes {
+		if n.id == p {
+			n.lastFailure = pm.now()
+			n.failures++
+			n.consecutiveFailures++
+			if err != nil {
+				n.lastError = err.Error()
+			}
+		}
+	}
+}
+
+// Status returns the health of every push node
+func (pm *PushNodeManager) Status() []PushNodeStatus {
+	pm.RLock()
+	defer pm.RUnlock()
+	now := pm.now()
+	active := make(map[peer.ID]bool)
+	for _, p := range pm.active(now) {
+		active[p] = true
+	}
+	ret := []PushNodeStatus{}
+	for _, n := range pm.nodes {
+		status := PushNodeStatus{
+			PeerID:              n.id.Pretty(),
+			Fallback:            n.fallback,
+			Healthy:             pm.healthy(n, now),
+			Active:              active[n.id],
+			LastError:           n.lastError,
+			LatencyMillis:       int64(n.latency / time.Millisecond),
+			Failures:            n.failures,
+			ConsecutiveFailures: n.consecutiveFailures,
+		}
+		if !n.lastSuccess.IsZero() {
+			lastSuccess := n.lastSuccess
+			status.LastSuccess = &lastSuccess
+		}
+		if !n.lastFailure.IsZero() {
+			lastFailure := n.lastFailure
+			status.LastFailure = &lastFailure
+		}
+		ret = append(ret, status)
+	}
+	return ret
+}
@@ -0,0 +1,81 @@
+package net
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+)
+
+func TestPushNodeManagerFailover(t *testing.T) {
+	var ids []peer.ID
+	for _, s := range []string{
+		"QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ",
+		"QmeRQYAEaXSoaFp1VeTKUuNJ6qU6ZoT1p9aZT2fNzHdoFt",
+		"QmNp85zy9RLrQ5oQD4hPyS39ezrrXpcaa7R4Y9kxdWQLLQ",
+	} {
+		pid, err := peer.IDB58Decode(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, pid)
+	}
+	primaryA, primaryB, fallback := ids[0], ids[1], ids[2]
+	pm := NewPushNodeManager([]peer.ID{primaryA, primaryB}, []peer.ID{fallback})
+	now := time.Now()
+	pm.now = func() time.Time { return now }
+
+	if active := pm.Active(); len(active) != 2 || active[0] != primaryA || active[1] != primaryB {
+		t.Errorf("expected primary nodes to be active, got %v", active)
+	}
+
+	// Faster nodes are preferred
+	pm.RecordSuccess(primaryA, time.Second)
+	pm.RecordSuccess(primaryB, time.Millisecond)
+	if active := pm.Active(); active[0] != primaryB {
+		t.Errorf("expected fastest node first, got %v", active)
+	}
+
+	// A failing primary is replaced by the fallback
+	for i := 0; i < PushNodeFailureThreshold; i++ {
+		pm.RecordFailure(primaryA, errors.New("timeout"))
+	}
+	if active := pm.Active(); len(active) != 2 || active[0] != primaryB || active[1] != fallback {
+		t.Errorf("expected fallback to replace failing node, got %v", active)
+	}
+	status := pm.Status()
+	if status[0].Healthy || status[0].Active || status[0].LastError != "timeout" || status[0].Failures != PushNodeFailureThreshold {
+		t.Errorf("unexpected status for failing node %+v", status[0])
+	}
+	if !status[2].Fallback || !status[2].Active {
+		t.Errorf("unexpected status for fallback node %+v", status[2])
+	}
+
+	// Failing nodes are retried after the retry interval
+	now = now.Add(PushNodeRetryInterval + time.Second)
+	if active := pm.Active(); len(active) != 2 || active[1] != primaryA {
+		t.Errorf("expected failing node to be retried, got %v", active)
+	}
+	if !pm.Status()[0].Healthy {
+		t.Error("expected failing node to be reported healthy once it is retried")
+	}
+	pm.RecordSuccess(primaryA, time.Second)
+	if !pm.Status()[0].Healthy {
+		t.Error("expected node to recover after a success")
+	}
+}
+
+func TestPushNodeManagerAllFailing(t *testing.T) {
+	pid, err := peer.IDB58Decode("QmY8puEnVx66uEet64gAf4VZRo7oUyMCwG6KdB9KM92EGQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pm := NewPushNodeManager([]peer.ID{pid}, nil)
+	for i := 0; i < PushNodeFailureThreshold; i++ {
+		pm.RecordFailure(pid, nil)
+	}
+	if active := pm.Active(); len(active) != 1 {
+		t.Error("expected pushing to be attempted when every node is failing")
+	}
+}
@@ -5,9 +5,11 @@ import (
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/op/go-logging"
 	"golang.org/x/net/context"
+	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
 	"gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
 )
 
@@ -19,14 +21,16 @@ const kPointerExpiration = time.Hour * 24 * 30
 type PointerRepublisher struct {
 	ipfsNode    *core.IpfsNode
 	db          repo.Datastore
-	pushNodes   []peer.ID
+	storage     sto.OfflineMessagingStorage
+	pushNodes   func() []peer.ID
 	isModerator func() bool
 }
 
-func NewPointerRepublisher(node *core.IpfsNode, database repo.Datastore, pushNodes []peer.ID, isModerator func() bool) *PointerRepublisher {
+func NewPointerRepublisher(node *core.IpfsNode, database repo.Datastore, storage sto.OfflineMessagingStorage, pushNodes func() []peer.ID, isModerator func() bool) *PointerRepublisher {
 	return &PointerRepublisher{
 		ipfsNode:    node,
 		db:          database,
+		storage:     storage,
 		pushNodes:   pushNodes,
 		isModerator: isModerator,
 	}
@@ -53,11 +57,11 @@ func (r *PointerRepublisher) Republish() {
 	for _, p := range pointers {
 		switch p.Purpose {
 		case ipfs.MESSAGE:
-			if time.Now().Sub(p.Timestamp) > kPointerExpiration {
+			if time.Now().Sub(p.Timestamp) > kPointerExpiration || !r.refreshURL(&p) {
 				r.db.Pointers().Delete(p.Value.ID)
 			} else {
 				go ipfs.PublishPointer(r.ipfsNode, ctx, p)
-				for _, peer := range r.pushNodes {
+				for _, peer := range r.pushNodes() {
 					go ipfs.PutPointerToPeer(r.ipfsNode, context.Background(), peer, p)
 				}
 			}
@@ -72,3 +76,29 @@ func (r *PointerRepublisher) Republish() {
 		}
 	}
 }
+
+// refreshURL replaces a presigned URL which expires before the next
+// republish with a new one from the storage, so the pointer stays
+// retrievable for its whole lifetime. It returns false if the URL has
+// expired and couldn't be replaced.
+func (r *PointerRepublisher) refreshURL(p *ipfs.Pointer) bool {
+	if len(p.Value.Addrs) == 0 {
+		return true
+	}
+	expiry, ok := sto.HTTPSAddrExpiry(p.Value.Addrs[0])
+	if !ok || time.Until(expiry) > kRepointFrequency*2 {
+		return true
+	}
+	if refresher, ok := r.storage.(sto.OfflineMessageRefresher); ok {
+		addr, err := refresher.Refresh(p.Value.Addrs[0])
+		if err == nil {
+			err = r.db.Pointers().UpdateAddr(p.Value.ID, addr)
+		}
+		if err == nil {
+			p.Value.Addrs = []ma.Multiaddr{addr}
+			return true
+		}
+		log.Errorf("Error refreshing the URL of pointer %s: %s", p.Value.ID.Pretty(), err)
+	}
+	return time.Now().Before(expiry)
+}
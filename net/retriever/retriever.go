@@ -3,9 +3,11 @@ package net
 import (
 	"context"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
 	"github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/proxy"
 
@@ -14,6 +16,7 @@ import (
 	routing "gx/ipfs/QmRaVcGchmC1stHHK7YhcgEuTk5k1JiGS568pfYWMgT91H/go-libp2p-kad-dht"
 
 	"errors"
+	"fmt"
 	"github.com/op/go-logging"
 	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
 	ds "gx/ipfs/QmXRKBQA4wXP7xWbFiZsR1GP4HV6wMDQ1aWFxZZ4uBcPX9/go-datastore"
@@ -40,6 +43,8 @@ type MRConfig struct {
 	Db        repo.Datastore
 	IPFSNode  *core.IpfsNode
 	BanManger *net.BanManager
+	Sessions  *net.SessionManager
+	Dedup     *net.MessageDeduplicator
 	Service   net.NetworkService
 	PrefixLen int
 	PushNodes []peer.ID
@@ -52,6 +57,8 @@ type MessageRetriever struct {
 	db         repo.Datastore
 	node       *core.IpfsNode
 	bm         *net.BanManager
+	sessions   *net.SessionManager
+	dedup      *net.MessageDeduplicator
 	service    net.NetworkService
 	prefixLen  int
 	sendAck    func(peerId string, pointerID peer.ID) error
@@ -80,6 +87,8 @@ func NewMessageRetriever(cfg MRConfig) *MessageRetriever {
 		cfg.Db,
 		cfg.IPFSNode,
 		cfg.BanManger,
+		cfg.Sessions,
+		cfg.Dedup,
 		cfg.Service,
 		cfg.PrefixLen,
 		cfg.SendAck,
@@ -147,6 +156,9 @@ func (m *MessageRetriever) fetchPointers(useDHT bool) {
 	inFlight := make(map[string]bool)
 	// Iterate over the pointers, adding 1 to the waitgroup for each pointer found
 	for p := range peerOut {
+		if len(p.Addrs) > 0 {
+			p.Addrs[0] = sto.NormalizeAddr(p.Addrs[0])
+		}
 		if len(p.Addrs) > 0 && !m.db.OfflineMessages().Has(p.Addrs[0].String()) && !inFlight[p.Addrs[0].String()] {
 			inFlight[p.Addrs[0].String()] = true
 			log.Debugf("Found pointer with location %s", p.Addrs[0].String())
@@ -158,22 +170,14 @@ func (m *MessageRetriever) fetchPointers(useDHT bool) {
 			}
 
 			// HTTPS
-			if len(p.Addrs[0].Protocols()) == 2 && p.Addrs[0].Protocols()[0].Code == ma.P_IPFS && p.Addrs[0].Protocols()[1].Code == ma.P_HTTPS {
-				enc, err := p.Addrs[0].ValueForProtocol(ma.P_IPFS)
-				if err != nil {
-					continue
-				}
-				mh, err := multihash.FromB58String(enc)
-				if err != nil {
-					continue
-				}
-				d, err := multihash.Decode(mh)
+			if sto.IsHTTPSAddr(p.Addrs[0]) {
+				url, err := sto.DecodeHTTPSAddr(p.Addrs[0])
 				if err != nil {
 					continue
 				}
 				wg.Add(1)
 				downloaded++
-				go m.fetchHTTPS(p.ID, string(d.Digest), p.Addrs[0], wg)
+				go m.fetchHTTPS(p.ID, url, p.Addrs[0], wg)
 			}
 		}
 	}
@@ -239,9 +243,11 @@ func (m *MessageRetriever) fetchIPFS(pid peer.ID, n *core.IpfsNode, addr ma.Mult
 	case <-c:
 		if err != nil {
 			log.Errorf("Error retrieving offline message from %s, %s", addr.String(), err.Error())
+			metrics.OfflineMessages.WithLabelValues(metrics.RetrievalDownloadFailed).Inc()
 			return
 		}
 		log.Debugf("Successfully downloaded offline message from %s", addr.String())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalDownloaded).Inc()
 		m.db.OfflineMessages().Put(addr.String())
 		m.attemptDecrypt(ciphertext, pid, addr)
 	case <-m.DoneChan:
@@ -270,16 +276,24 @@ func (m *MessageRetriever) fetchHTTPS(pid peer.ID, url string, addr ma.Multiaddr
 			c <- struct{}{}
 			return
 		}
-		ciphertext, err = ioutil.ReadAll(resp.Body)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			err = fmt.Errorf("unexpected response status %s", resp.Status)
+		} else {
+			ciphertext, err = ioutil.ReadAll(resp.Body)
+		}
+		c <- struct{}{}
 	}()
 
 	select {
 	case <-c:
 		if err != nil {
 			log.Errorf("Error retrieving offline message from %s, %s", addr.String(), err.Error())
+			metrics.OfflineMessages.WithLabelValues(metrics.RetrievalDownloadFailed).Inc()
 			return
 		}
 		log.Debugf("Successfully downloaded offline message from %s", addr.String())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalDownloaded).Inc()
 		m.db.OfflineMessages().Put(addr.String())
 		m.attemptDecrypt(ciphertext, pid, addr)
 	case <-m.DoneChan:
@@ -295,14 +309,27 @@ func (m *MessageRetriever) attemptDecrypt(ciphertext []byte, pid peer.ID, addr m
 	plaintext, err := net.Decrypt(m.node.PrivateKey, ciphertext)
 	if err != nil {
 		log.Warning("Unable to decrypt offline message from %s: %s", addr.String(), err.Error())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalUndecryptable).Inc()
 		return
 	}
 
+	// Messages from peers with a session carry a second layer of encryption
+	var sender peer.ID
+	if net.IsSessionMessage(plaintext) {
+		sender, plaintext, err = m.sessions.Decrypt(plaintext)
+		if err != nil {
+			log.Warning("Unable to decrypt offline message from %s: %s", addr.String(), err.Error())
+			metrics.OfflineMessages.WithLabelValues(metrics.RetrievalUndecryptable).Inc()
+			return
+		}
+	}
+
 	// Unmarshal plaintext
 	env := pb.Envelope{}
 	err = proto.Unmarshal(plaintext, &env)
 	if err != nil {
 		log.Warning("Unable to decrypt offline message from %s: %s", addr.String(), err.Error())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalUndecryptable).Inc()
 		return
 	}
 
@@ -310,28 +337,38 @@ func (m *MessageRetriever) attemptDecrypt(ciphertext []byte, pid peer.ID, addr m
 	ser, err := proto.Marshal(env.Message)
 	if err != nil {
 		log.Warning("Unable to decrypt offline message from %s: %s", addr.String(), err.Error())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalUndecryptable).Inc()
 		return
 	}
 	pubkey, err := libp2p.UnmarshalPublicKey(env.Pubkey)
 	if err != nil {
 		log.Warning("Unable to decrypt offline message from %s: %s", addr.String(), err.Error())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalUndecryptable).Inc()
 		return
 	}
 
 	valid, err := pubkey.Verify(ser, env.Signature)
 	if err != nil || !valid {
 		log.Warning("Unable to decrypt offline message from %s: %s", addr.String(), err.Error())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalUndecryptable).Inc()
 		return
 	}
 
 	id, err := peer.IDFromPublicKey(pubkey)
 	if err != nil {
 		log.Warning("Unable to decrypt offline message from %s: %s", addr.String(), err.Error())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalUndecryptable).Inc()
+		return
+	}
+	if sender != "" && sender != id {
+		log.Warning("Dropped offline message from %s signed by a peer other than the session peer", addr.String())
+		metrics.OfflineMessages.WithLabelValues(metrics.RetrievalUndecryptable).Inc()
 		return
 	}
 
 	if m.bm.IsBanned(id) {
 		log.Warning("Received and dropped offline message from banned user: %s ", id.String())
+		metrics.OfflineMessages.WithLabelValues(metrics.ResultBanned).Inc()
 		return
 	}
 
@@ -366,17 +403,49 @@ func (m *MessageRetriever) handleMessage(env pb.Envelope, addr string, id *peer.
 		id = &i
 	}
 
+	if m.bm.IsBannedFor(*id, env.Message.MessageType) {
+		log.Warningf("Dropped offline %s message from banned user: %s", env.Message.MessageType, id.Pretty())
+		metrics.OfflineMessages.WithLabelValues(metrics.ResultBanned).Inc()
+		return nil
+	}
+
 	// Get handler for this message type
 	handler := m.service.HandlerForMsgType(env.Message.MessageType)
 	if handler == nil {
 		log.Errorf("Nil handler for message type %s", env.Message.MessageType)
+		metrics.OfflineMessages.WithLabelValues(metrics.ResultUnsupported).Inc()
+		if env.Message.MessageType != pb.Message_ERROR {
+			if resp, err := net.NewUnsupportedMessageTypeError(env.Message.MessageType); err == nil {
+				m.sendError(id.Pretty(), nil, *resp)
+			}
+		}
 		return errors.New("Nil handler for message")
 	}
 
+	// Drop messages which were already processed, directly or offline
+	claimed, err := m.dedup.Claim(*id, env.Message)
+	if err != nil {
+		log.Errorf("Error checking processed message %s: %s", addr, err.Error())
+	}
+	if !claimed {
+		log.Debugf("Dropped duplicate offline %s message from %s", env.Message.MessageType, id.Pretty())
+		metrics.OfflineMessages.WithLabelValues(metrics.ResultDuplicate).Inc()
+		return nil
+	}
+
 	// Dispatch handler
+	start := time.Now()
 	resp, err := handler(*id, env.Message, true)
-	if err != nil {
+	metrics.ObserveHandler(env.Message.MessageType.String(), start)
+	if err == nil {
+		metrics.OfflineMessages.WithLabelValues(metrics.ResultHandled).Inc()
+		if err := m.dedup.Commit(*id, env.Message); err != nil {
+			log.Errorf("Error recording processed message %s: %s", addr, err.Error())
+		}
+	} else {
+		m.dedup.Release(*id, env.Message)
 		if err == net.OutOfOrderMessage {
+			metrics.OfflineMessages.WithLabelValues(metrics.ResultQueued).Inc()
 			ser, err := proto.Marshal(&env)
 			if err == nil {
 				err := m.db.OfflineMessages().SetMessage(addr, ser)
@@ -387,10 +456,12 @@ func (m *MessageRetriever) handleMessage(env pb.Envelope, addr string, id *peer.
 				log.Errorf("Error serializing offline message %s for storage", addr)
 			}
 		} else if env.Message.MessageType == pb.Message_ORDER && resp != nil {
+			metrics.OfflineMessages.WithLabelValues(metrics.ResultError).Inc()
 			log.Errorf("Error processing ORDER message: %s, sending ERROR response", err.Error())
 			m.sendError(id.Pretty(), nil, *resp)
 			return err
 		} else {
+			metrics.OfflineMessages.WithLabelValues(metrics.ResultError).Inc()
 			log.Errorf("Error processing message %s. Type %s: %s", addr, env.Message.MessageType, err.Error())
 			return err
 		}
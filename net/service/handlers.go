@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
 	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
 	libp2p "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcutil"
 	"github.com/golang/protobuf/proto"
@@ -76,7 +78,14 @@ func (service *OpenBazaarService) HandlerForMsgType(t pb.Message_MessageType) fu
 
 func (service *OpenBazaarService) handlePing(peer peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 	log.Debugf("Received PING message from %s", peer.Pretty())
-	return pmes, nil
+	if pmes.Payload != nil {
+		service.node.CachePeerCapabilities(peer, pmes)
+	}
+	a, err := ptypes.MarshalAny(service.node.LocalCapabilities())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Message{MessageType: pb.Message_PING, Payload: a}, nil
 }
 
 func (service *OpenBazaarService) handleFollow(pid peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
@@ -185,6 +194,17 @@ func (service *OpenBazaarService) handleOfflineAck(p peer.ID, pmes *pb.Message,
 	if err != nil {
 		return nil, err
 	}
+	// The recipient has the message so remove it from storage, without
+	// holding up the stream while the storage responds
+	if cleaner, ok := service.node.MessageStorage.(sto.OfflineMessageCleaner); ok {
+		go func(addrs []ma.Multiaddr) {
+			for _, addr := range addrs {
+				if err := cleaner.Delete(addr); err != nil {
+					log.Errorf("Error deleting acknowledged offline message %s: %s", addr.String(), err)
+				}
+			}
+		}(pointer.Value.Addrs)
+	}
 	log.Debugf("received OFFLINE_ACK: %s", p.Pretty())
 	return nil, nil
 }
@@ -201,6 +221,13 @@ func (service *OpenBazaarService) handleOfflineRelay(p peer.ID, pmes *pb.Message
 	if err != nil {
 		return nil, err
 	}
+	var sender peer.ID
+	if net.IsSessionMessage(plaintext) {
+		sender, plaintext, err = service.node.Sessions.Decrypt(plaintext)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Unmarshal plaintext
 	env := pb.Envelope{}
@@ -227,6 +254,9 @@ func (service *OpenBazaarService) handleOfflineRelay(p peer.ID, pmes *pb.Message
 	if err != nil {
 		return nil, err
 	}
+	if sender != "" && sender != id {
+		return nil, errors.New("offline relay message signed by a peer other than the session peer")
+	}
 
 	// Get handler for this message type
 	handler := service.HandlerForMsgType(env.Message.MessageType)
@@ -235,12 +265,26 @@ func (service *OpenBazaarService) handleOfflineRelay(p peer.ID, pmes *pb.Message
 		return nil, nil
 	}
 
+	// Drop messages which were already processed, directly or offline
+	claimed, err := service.node.Deduplicator.Claim(id, env.Message)
+	if err != nil {
+		log.Errorf("Error checking processed %s message: %s", env.Message.MessageType.String(), err)
+	}
+	if !claimed {
+		log.Debugf("Dropped duplicate relayed %s message from %s", env.Message.MessageType.String(), id.Pretty())
+		return nil, nil
+	}
+
 	// Dispatch handler
 	_, err = handler(id, env.Message, true)
 	if err != nil {
 		log.Errorf("Handle message error: %s", err)
+		service.node.Deduplicator.Release(id, env.Message)
 		return nil, err
 	}
+	if err := service.node.Deduplicator.Commit(id, env.Message); err != nil {
+		log.Errorf("Error recording processed %s message: %s", env.Message.MessageType.String(), err)
+	}
 	log.Debugf("Received OFFLINE_RELAY message from %s", p.Pretty())
 	return nil, nil
 }
@@ -251,7 +295,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 	var orderId string
 	errorResponse := func(error string) *pb.Message {
 		e := &pb.Error{
-			Code:         0,
+			Code:         net.ErrorCodeOrderProcessing,
 			ErrorMessage: error,
 			OrderID:      orderId,
 		}
@@ -1460,6 +1504,13 @@ func (service *OpenBazaarService) handleError(peer peer.ID, pmes *pb.Message, op
 		return nil, err
 	}
 
+	// The peer doesn't handle a message type we sent
+	if errorMessage.Code == net.ErrorCodeUnsupportedMessageType {
+		log.Infof("Peer %s does not support %s messages", peer.Pretty(), errorMessage.MessageType.String())
+		service.node.PeerCapabilities.RemoveMessageType(peer, errorMessage.MessageType)
+		return nil, nil
+	}
+
 	// Load the order
 	contract, state, _, _, _, err := service.datastore.Purchases().GetByOrderId(errorMessage.OrderID)
 	if err != nil {
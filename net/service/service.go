@@ -15,6 +15,8 @@ import (
 	"io"
 
 	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/metrics"
+	"github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	ctxio "github.com/jbenet/go-context/io"
@@ -79,7 +81,7 @@ func (service *OpenBazaarService) handleNewMessage(s inet.Stream) {
 	mPeer := s.Conn().RemotePeer()
 
 	// Check if banned
-	if service.node.BanManager.IsBanned(mPeer) {
+	if service.node.BanManager.IsBanned(mPeer) || service.node.AbuseManager.IsBanned(mPeer) {
 		return
 	}
 
@@ -128,24 +130,100 @@ func (service *OpenBazaarService) handleNewMessage(s inet.Stream) {
 			return
 		}
 
+		// Drop message types the peer is banned from sending
+		if service.node.BanManager.IsBannedFor(mPeer, pmes.MessageType) {
+			log.Debugf("Dropped banned %s message from %s", pmes.MessageType.String(), mPeer.Pretty())
+			metrics.MessagesReceived.WithLabelValues(pmes.MessageType.String(), metrics.ResultBanned).Inc()
+			continue
+		}
+
+		// Drop messages over the rate limit and disconnect temporarily banned peers
+		if !service.node.AbuseManager.Allow(mPeer, pmes.MessageType) {
+			metrics.MessagesReceived.WithLabelValues(pmes.MessageType.String(), metrics.ResultRateLimited).Inc()
+			if service.node.AbuseManager.IsBanned(mPeer) {
+				s.Reset()
+				log.Infof("Temporarily banned peer %s for abuse", mPeer.Pretty())
+				return
+			}
+			log.Debugf("Rate limited %s message from %s", pmes.MessageType.String(), mPeer.Pretty())
+			continue
+		}
+
 		// Get handler for this msg type
 		handler := service.HandlerForMsgType(pmes.MessageType)
 		if handler == nil {
-			s.Reset()
-			log.Debug("Got back nil handler from handlerForMsgType")
-			return
+			log.Debugf("Got back nil handler from handlerForMsgType for %s", pmes.MessageType.String())
+			metrics.MessagesReceived.WithLabelValues(pmes.MessageType.String(), metrics.ResultUnsupported).Inc()
+			// Tell the peer so it stops sending this message type
+			rpmes, err := net.NewUnsupportedMessageTypeError(pmes.MessageType)
+			if err != nil || pmes.MessageType == pb.Message_ERROR {
+				s.Reset()
+				return
+			}
+			if pmes.RequestId != 0 {
+				rpmes.RequestId = pmes.RequestId
+				rpmes.IsResponse = true
+			}
+			if err := ms.SendMessage(service.ctx, rpmes); err != nil {
+				s.Reset()
+				log.Debugf("send response error: %s", err)
+				return
+			}
+			continue
+		}
+
+		// Drop messages which were already processed, directly or offline
+		claimed, err := service.node.Deduplicator.Claim(mPeer, pmes)
+		if err != nil {
+			log.Errorf("Error checking processed %s message: %s", pmes.MessageType.String(), err)
+		}
+		if !claimed {
+			log.Debugf("Dropped duplicate %s message from %s", pmes.MessageType.String(), mPeer.Pretty())
+			metrics.MessagesReceived.WithLabelValues(pmes.MessageType.String(), metrics.ResultDuplicate).Inc()
+			if pmes.RequestId == 0 {
+				continue
+			}
+			// Answer requests so their sender doesn't wait for a response
+			// which was sent to the original request
+			rpmes := service.node.Deduplicator.Response(mPeer, pmes)
+			if rpmes == nil {
+				if rpmes, err = net.NewDuplicateMessageError(pmes.MessageType); err != nil {
+					continue
+				}
+			}
+			rpmes.RequestId = pmes.RequestId
+			rpmes.IsResponse = true
+			if err := ms.SendMessage(service.ctx, rpmes); err != nil {
+				s.Reset()
+				log.Debugf("send response error: %s", err)
+				return
+			}
+			continue
 		}
 
 		// Dispatch handler
+		start := time.Now()
 		rpmes, err := handler(mPeer, pmes, nil)
+		metrics.ObserveHandler(pmes.MessageType.String(), start)
 		if err != nil {
 			log.Debugf("%s handle message error: %s", pmes.MessageType.String(), err)
+			metrics.MessagesReceived.WithLabelValues(pmes.MessageType.String(), metrics.ResultError).Inc()
+			service.node.AbuseManager.Penalize(mPeer, net.PenaltyHandlerError)
+			service.node.Deduplicator.Release(mPeer, pmes)
+		} else {
+			metrics.MessagesReceived.WithLabelValues(pmes.MessageType.String(), metrics.ResultHandled).Inc()
+			if err := service.node.Deduplicator.Commit(mPeer, pmes); err != nil {
+				log.Errorf("Error recording processed %s message: %s", pmes.MessageType.String(), err)
+			}
 		}
 
 		// If nil response, return it before serializing
 		if rpmes == nil {
 			continue
 		}
+		if err == nil && pmes.RequestId != 0 {
+			service.node.Deduplicator.PutResponse(mPeer, pmes, rpmes)
+		}
 
 		// give back request id
 		rpmes.RequestId = pmes.RequestId
@@ -164,10 +242,12 @@ func (service *OpenBazaarService) SendRequest(ctx context.Context, p peer.ID, pm
 	log.Debugf("Sending %s request to %s", pmes.MessageType.String(), p.Pretty())
 	ms, err := service.messageSenderForPeer(p)
 	if err != nil {
+		metrics.MessagesSent.WithLabelValues(pmes.MessageType.String(), metrics.ResultError).Inc()
 		return nil, err
 	}
 
 	rpmes, err := ms.SendRequest(ctx, pmes)
+	metrics.MessagesSent.WithLabelValues(pmes.MessageType.String(), metrics.SendResult(err)).Inc()
 	if err != nil {
 		log.Debugf("No response from %s", p.Pretty())
 		return nil, err
@@ -188,11 +268,11 @@ func (service *OpenBazaarService) SendMessage(ctx context.Context, p peer.ID, pm
 	}
 	ms, err := service.messageSenderForPeer(p)
 	if err != nil {
+		metrics.MessagesSent.WithLabelValues(pmes.MessageType.String(), metrics.ResultError).Inc()
 		return err
 	}
 
-	if err := ms.SendMessage(ctx, pmes); err != nil {
-		return err
-	}
-	return nil
+	err = ms.SendMessage(ctx, pmes)
+	metrics.MessagesSent.WithLabelValues(pmes.MessageType.String(), metrics.SendResult(err)).Inc()
+	return err
 }
@@ -0,0 +1,837 @@
+package net
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/nacl/box"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+	libp2p "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// SignedPreKeyRotation is how often a new signed prekey is published
+	SignedPreKeyRotation = time.Hour * 24 * 7
+
+	// SignedPreKeyLifetime is how long a replaced signed prekey is kept so
+	// messages sent to it while offline can still be decrypted
+	SignedPreKeyLifetime = time.Hour * 24 * 30
+
+	// PreKeyBundleRetryInterval is how long to wait before looking for the
+	// bundle of a peer which didn't publish one again
+	PreKeyBundleRetryInterval = time.Hour
+
+	// Maximum number of message keys kept for messages which have not arrived yet
+	maxSkippedMessageKeys = 1000
+
+	// Maximum number of replaced sessions kept per peer for messages sent
+	// with them which arrive late
+	maxPreviousSessions = 5
+
+	// Flag set on messages which carry the X3DH handshake
+	sessionFlagPreKey = 1
+)
+
+var (
+	// Prefix of session encrypted messages. The leading zero byte is never a
+	// valid protobuf tag so it can't be confused with a serialized envelope.
+	sessionMessagePrefix = []byte{0x00, 'O', 'B', 'D', 'R', 0x01}
+
+	// ErrNoSession - there is no session with the peer
+	ErrNoSession = errors.New("no session with peer")
+
+	// ErrSessionsUnsupported - sessions require ed25519 identity keys
+	ErrSessionsUnsupported = errors.New("sessions require an ed25519 identity key")
+
+	// ErrInvalidPreKeyBundle - the prekey bundle is not signed by the peer
+	ErrInvalidPreKeyBundle = errors.New("invalid prekey bundle")
+
+	// ErrMalformedSessionMessage - the session message could not be parsed
+	ErrMalformedSessionMessage = errors.New("malformed session message")
+
+	// ErrTooManySkippedMessages - the message is too far ahead of the chain
+	ErrTooManySkippedMessages = errors.New("too many skipped messages")
+
+	// ErrStaleHandshake - the message starts a session with an older signed
+	// prekey than the established session, as a replayed message would
+	ErrStaleHandshake = errors.New("handshake is older than the established session")
+)
+
+// PreKeyBundle - the public keys published alongside the profile which allow
+// other peers to start a session while we are offline
+type PreKeyBundle struct {
+	IdentityKey    []byte `json:"identityKey"`
+	SignedPreKeyID uint32 `json:"signedPreKeyID"`
+	SignedPreKey   []byte `json:"signedPreKey"`
+	Signature      []byte `json:"signature"`
+}
+
+// Verify checks the bundle belongs to the peer and the prekey is signed by
+// the peer's identity key
+func (b *PreKeyBundle) Verify(peerID peer.ID) error {
+	pubKey, err := libp2p.UnmarshalPublicKey(b.IdentityKey)
+	if err != nil {
+		return err
+	}
+	if !peerID.MatchesPublicKey(pubKey) {
+		return ErrInvalidPreKeyBundle
+	}
+	if _, ok := pubKey.(*libp2p.Ed25519PublicKey); !ok {
+		return ErrSessionsUnsupported
+	}
+	if len(b.SignedPreKey) != 32 {
+		return ErrInvalidPreKeyBundle
+	}
+	valid, err := pubKey.Verify(preKeySignaturePayload(b.SignedPreKeyID, b.SignedPreKey), b.Signature)
+	if err != nil || !valid {
+		return ErrInvalidPreKeyBundle
+	}
+	return nil
+}
+
+// SessionManager implements X3DH key agreement and the double ratchet for
+// offline messages. Session state is kept in the repo and updated with every
+// message, so old message keys are deleted as soon as they have been used.
+type SessionManager struct {
+	identity libp2p.PrivKey
+	store    repo.SessionStore
+	now      func() time.Time
+	*sync.Mutex
+
+	// Peers without a prekey bundle, so sending to peers running older
+	// versions doesn't wait on a lookup every time
+	bundleMissesLock sync.Mutex
+	bundleMisses     map[string]time.Time
+}
+
+func NewSessionManager(identity libp2p.PrivKey, store repo.SessionStore) *SessionManager {
+	return &SessionManager{
+		identity:     identity,
+		store:        store,
+		now:          time.Now,
+		Mutex:        new(sync.Mutex),
+		bundleMisses: make(map[string]time.Time),
+	}
+}
+
+// IsSessionMessage returns whether the plaintext of an offline message was
+// encrypted with a session rather than being a serialized envelope
+func IsSessionMessage(plaintext []byte) bool {
+	return bytes.HasPrefix(plaintext, sessionMessagePrefix)
+}
+
+// PreKeyBundle returns the bundle to publish, rotating the signed prekey when
+// it is older than SignedPreKeyRotation
+func (sm *SessionManager) PreKeyBundle() (*PreKeyBundle, error) {
+	if _, ok := sm.identity.(*libp2p.Ed25519PrivateKey); !ok {
+		return nil, ErrSessionsUnsupported
+	}
+	sm.Lock()
+	defer sm.Unlock()
+
+	now := sm.now()
+	preKey, err := sm.store.GetLatestPreKey()
+	if err != nil {
+		return nil, err
+	}
+	if preKeyRotationDue(preKey, now) {
+		var id uint32 = 1
+		if preKey != nil {
+			id = preKey.ID + 1
+		}
+		pub, priv, err := box.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := sm.identity.Sign(preKeySignaturePayload(id, pub[:]))
+		if err != nil {
+			return nil, err
+		}
+		preKey = &repo.PreKey{ID: id, PrivateKey: priv[:], PublicKey: pub[:], Signature: sig, CreatedAt: now}
+		if err := sm.store.PutPreKey(*preKey); err != nil {
+			return nil, err
+		}
+		if err := sm.store.DeletePreKeysBefore(now.Add(-SignedPreKeyLifetime)); err != nil {
+			return nil, err
+		}
+	}
+	identityKey, err := sm.identity.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return &PreKeyBundle{
+		IdentityKey:    identityKey,
+		SignedPreKeyID: preKey.ID,
+		SignedPreKey:   preKey.PublicKey,
+		Signature:      preKey.Signature,
+	}, nil
+}
+
+// PreKeyRotationDue returns whether the signed prekey is older than
+// SignedPreKeyRotation, so the next call to PreKeyBundle rotates it
+func (sm *SessionManager) PreKeyRotationDue() bool {
+	if _, ok := sm.identity.(*libp2p.Ed25519PrivateKey); !ok {
+		return false
+	}
+	sm.Lock()
+	defer sm.Unlock()
+	preKey, err := sm.store.GetLatestPreKey()
+	return err == nil && preKeyRotationDue(preKey, sm.now())
+}
+
+func preKeyRotationDue(preKey *repo.PreKey, now time.Time) bool {
+	return preKey == nil || now.Sub(preKey.CreatedAt) >= SignedPreKeyRotation
+}
+
+// PreKeyBundleMissed records that the peer has no prekey bundle
+func (sm *SessionManager) PreKeyBundleMissed(peerID peer.ID) {
+	sm.bundleMissesLock.Lock()
+	defer sm.bundleMissesLock.Unlock()
+	sm.bundleMisses[peerID.Pretty()] = sm.now()
+}
+
+// ShouldFetchPreKeyBundle returns false if the peer had no prekey bundle
+// within PreKeyBundleRetryInterval
+func (sm *SessionManager) ShouldFetchPreKeyBundle(peerID peer.ID) bool {
+	sm.bundleMissesLock.Lock()
+	defer sm.bundleMissesLock.Unlock()
+	missedAt, ok := sm.bundleMisses[peerID.Pretty()]
+	if !ok {
+		return true
+	}
+	if sm.now().Sub(missedAt) >= PreKeyBundleRetryInterval {
+		delete(sm.bundleMisses, peerID.Pretty())
+		return true
+	}
+	return false
+}
+
+// HasSession returns whether there is a session with the peer
+func (sm *SessionManager) HasSession(peerID peer.ID) bool {
+	sm.Lock()
+	defer sm.Unlock()
+	record, err := sm.getRecord(peerID)
+	return err == nil && record.Current != nil
+}
+
+// InitSession starts a session with the peer from its prekey bundle. The
+// handshake is sent along with every message until the peer replies.
+func (sm *SessionManager) InitSession(peerID peer.ID, bundle *PreKeyBundle) error {
+	if err := bundle.Verify(peerID); err != nil {
+		return err
+	}
+	ourIdentity, ok := sm.identity.(*libp2p.Ed25519PrivateKey)
+	if !ok {
+		return ErrSessionsUnsupported
+	}
+	theirPubKey, err := libp2p.UnmarshalPublicKey(bundle.IdentityKey)
+	if err != nil {
+		return err
+	}
+	theirIdentity, err := theirPubKey.(*libp2p.Ed25519PublicKey).ToCurve25519()
+	if err != nil {
+		return err
+	}
+	ourIdentityPub, err := sm.identity.GetPublic().(*libp2p.Ed25519PublicKey).ToCurve25519()
+	if err != nil {
+		return err
+	}
+	baseKeyPub, baseKeyPriv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	signedPreKey := toKey(bundle.SignedPreKey)
+
+	sk, err := x3dh(
+		dh(ourIdentity.ToCurve25519(), signedPreKey),
+		dh(baseKeyPriv, theirIdentity),
+		dh(baseKeyPriv, signedPreKey),
+	)
+	if err != nil {
+		return err
+	}
+	ratchetPub, ratchetPriv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	rootKey, sendChainKey, err := kdfRootKey(sk, dh(ratchetPriv, signedPreKey))
+	if err != nil {
+		return err
+	}
+	state := &sessionState{
+		RootKey:         rootKey,
+		SendChainKey:    sendChainKey,
+		SendRatchetPriv: ratchetPriv[:],
+		SendRatchetPub:  ratchetPub[:],
+		RecvRatchetPub:  signedPreKey[:],
+		AssociatedData:  append(ourIdentityPub[:], theirIdentity[:]...),
+		Skipped:         make(map[string][]byte),
+		PendingPreKey:   &pendingPreKey{BaseKey: baseKeyPub[:], SignedPreKeyID: bundle.SignedPreKeyID},
+	}
+
+	sm.Lock()
+	defer sm.Unlock()
+	record, err := sm.getRecord(peerID)
+	if err != nil {
+		return err
+	}
+	record.promote(state)
+	return sm.putRecord(peerID, record)
+}
+
+// Encrypt encrypts the plaintext with the session for the peer, advancing the
+// sending chain
+func (sm *SessionManager) Encrypt(peerID peer.ID, plaintext []byte) ([]byte, error) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	record, err := sm.getRecord(peerID)
+	if err != nil {
+		return nil, err
+	}
+	state := record.Current
+	if state == nil || state.SendChainKey == nil {
+		return nil, ErrNoSession
+	}
+	pubKey, err := sm.identity.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	h := sessionHeader{
+		SenderKey:     pubKey,
+		RatchetKey:    state.SendRatchetPub,
+		PrevSendCount: state.PrevSendCount,
+		Count:         state.SendCount,
+	}
+	if state.PendingPreKey != nil {
+		h.BaseKey = state.PendingPreKey.BaseKey
+		h.SignedPreKeyID = state.PendingPreKey.SignedPreKeyID
+	}
+	var messageKey []byte
+	state.SendChainKey, messageKey = kdfChainKey(state.SendChainKey)
+	state.SendCount++
+
+	header := h.marshal()
+	ad := append(append([]byte{}, state.AssociatedData...), header...)
+	ciphertext, err := sealMessage(messageKey, plaintext, ad)
+	if err != nil {
+		return nil, err
+	}
+	if err := sm.putRecord(peerID, record); err != nil {
+		return nil, err
+	}
+	return append(header, ciphertext...), nil
+}
+
+// Decrypt decrypts a session message, creating the session if the message
+// carries a handshake. It returns the sender, which the caller must match
+// against the signer of the decrypted envelope.
+func (sm *SessionManager) Decrypt(message []byte) (peer.ID, []byte, error) {
+	h, n, err := unmarshalSessionHeader(message)
+	if err != nil {
+		return "", nil, err
+	}
+	senderKey, err := libp2p.UnmarshalPublicKey(h.SenderKey)
+	if err != nil {
+		return "", nil, err
+	}
+	sender, err := peer.IDFromPublicKey(senderKey)
+	if err != nil {
+		return "", nil, err
+	}
+	header, ciphertext := message[:n], message[n:]
+
+	sm.Lock()
+	defer sm.Unlock()
+
+	record, err := sm.getRecord(sender)
+	if err != nil {
+		return "", nil, err
+	}
+	if h.BaseKey != nil && record.withBaseKey(h.BaseKey) == nil {
+		plaintext, err := sm.acceptSession(sender, senderKey, record, h, header, ciphertext)
+		if err != nil {
+			return "", nil, err
+		}
+		return sender, plaintext, nil
+	}
+	if record.Current == nil {
+		return "", nil, ErrNoSession
+	}
+
+	// Messages are usually for the current session, but may have been sent
+	// with a session it replaced and arrived late. A failed attempt must not
+	// change the state, so each one works on a copy.
+	candidates := append([]*sessionState{record.Current}, record.Previous...)
+	if h.BaseKey != nil {
+		candidates = []*sessionState{record.withBaseKey(h.BaseKey)}
+	}
+	var firstErr error
+	for _, state := range candidates {
+		attempt := state.clone()
+		plaintext, err := attempt.decrypt(h, header, ciphertext)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		*state = *attempt
+		if err := sm.putRecord(sender, record); err != nil {
+			return "", nil, err
+		}
+		return sender, plaintext, nil
+	}
+	return "", nil, firstErr
+}
+
+// acceptSession decrypts a message carrying a handshake we haven't seen and
+// keeps the session it starts. A handshake with an older signed prekey than
+// the established session is refused, so a replayed message can't replace
+// it. The replaced session is kept for messages which arrive late.
+func (sm *SessionManager) acceptSession(sender peer.ID, senderKey libp2p.PubKey, record *sessionRecord, h *sessionHeader, header, ciphertext []byte) ([]byte, error) {
+	if h.SignedPreKeyID < record.latestSignedPreKeyID() {
+		return nil, ErrStaleHandshake
+	}
+	state, err := sm.respondToSession(senderKey, h)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := state.decrypt(h, header, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	// If both sides started a session at the same time keep the one
+	// started by the peer with the lower ID, but still read the messages
+	// sent with the other one
+	if current := record.Current; current != nil && current.PendingPreKey != nil {
+		ourID, err := peer.IDFromPrivateKey(sm.identity)
+		if err != nil {
+			return nil, err
+		}
+		if sender.Pretty() > ourID.Pretty() {
+			record.keepPrevious(state)
+			return plaintext, sm.putRecord(sender, record)
+		}
+	}
+	record.promote(state)
+	return plaintext, sm.putRecord(sender, record)
+}
+
+// respondToSession derives the responder side of a session from the handshake
+// in the header
+func (sm *SessionManager) respondToSession(senderKey libp2p.PubKey, h *sessionHeader) (*sessionState, error) {
+	ourIdentity, ok := sm.identity.(*libp2p.Ed25519PrivateKey)
+	if !ok {
+		return nil, ErrSessionsUnsupported
+	}
+	edKey, ok := senderKey.(*libp2p.Ed25519PublicKey)
+	if !ok {
+		return nil, ErrSessionsUnsupported
+	}
+	theirIdentity, err := edKey.ToCurve25519()
+	if err != nil {
+		return nil, err
+	}
+	ourIdentityPub, err := sm.identity.GetPublic().(*libp2p.Ed25519PublicKey).ToCurve25519()
+	if err != nil {
+		return nil, err
+	}
+	preKey, err := sm.store.GetPreKey(h.SignedPreKeyID)
+	if err != nil {
+		return nil, err
+	}
+	if preKey == nil {
+		return nil, fmt.Errorf("unknown signed prekey %d", h.SignedPreKeyID)
+	}
+	signedPreKey := toKey(preKey.PrivateKey)
+	baseKey := toKey(h.BaseKey)
+
+	sk, err := x3dh(
+		dh(signedPreKey, theirIdentity),
+		dh(ourIdentity.ToCurve25519(), baseKey),
+		dh(signedPreKey, baseKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionState{
+		RootKey:         sk,
+		SendRatchetPriv: preKey.PrivateKey,
+		SendRatchetPub:  preKey.PublicKey,
+		AssociatedData:  append(theirIdentity[:], ourIdentityPub[:]...),
+		Skipped:         make(map[string][]byte),
+		BaseKey:         h.BaseKey,
+		SignedPreKeyID:  h.SignedPreKeyID,
+	}, nil
+}
+
+// getRecord returns the sessions with the peer. Records stored before
+// replaced sessions were kept hold a single session state.
+func (sm *SessionManager) getRecord(peerID peer.ID) (*sessionRecord, error) {
+	record := new(sessionRecord)
+	serialized, err := sm.store.Get(peerID.Pretty())
+	if err != nil || serialized == nil {
+		return record, err
+	}
+	if err := json.Unmarshal(serialized, record); err != nil {
+		return nil, err
+	}
+	if record.Current == nil {
+		state := new(sessionState)
+		if err := json.Unmarshal(serialized, state); err != nil {
+			return nil, err
+		}
+		if state.RootKey != nil {
+			record.Current = state
+		}
+	}
+	for _, state := range append([]*sessionState{record.Current}, record.Previous...) {
+		if state != nil && state.Skipped == nil {
+			state.Skipped = make(map[string][]byte)
+		}
+	}
+	return record, nil
+}
+
+func (sm *SessionManager) putRecord(peerID peer.ID, record *sessionRecord) error {
+	serialized, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return sm.store.Put(peerID.Pretty(), serialized)
+}
+
+// sessionRecord - the current session with a peer and the sessions it
+// replaced, newest first
+type sessionRecord struct {
+	Current  *sessionState   `json:"current,omitempty"`
+	Previous []*sessionState `json:"previous,omitempty"`
+}
+
+// promote makes the state the current session, keeping the one it replaces
+func (r *sessionRecord) promote(state *sessionState) {
+	if r.Current != nil {
+		r.keepPrevious(r.Current)
+	}
+	r.Current = state
+}
+
+// keepPrevious adds a replaced session, dropping the oldest ones
+func (r *sessionRecord) keepPrevious(state *sessionState) {
+	r.Previous = append([]*sessionState{state}, r.Previous...)
+	if len(r.Previous) > maxPreviousSessions {
+		r.Previous = r.Previous[:maxPreviousSessions]
+	}
+}
+
+// latestSignedPreKeyID returns the newest of our signed prekeys which the
+// peer started a kept session with
+func (r *sessionRecord) latestSignedPreKeyID() uint32 {
+	var latest uint32
+	for _, state := range append([]*sessionState{r.Current}, r.Previous...) {
+		if state != nil && state.BaseKey != nil && state.SignedPreKeyID > latest {
+			latest = state.SignedPreKeyID
+		}
+	}
+	return latest
+}
+
+// withBaseKey returns the session the peer started with the handshake base
+// key, if it is kept
+func (r *sessionRecord) withBaseKey(baseKey []byte) *sessionState {
+	for _, state := range append([]*sessionState{r.Current}, r.Previous...) {
+		if state != nil && state.BaseKey != nil && bytes.Equal(state.BaseKey, baseKey) {
+			return state
+		}
+	}
+	return nil
+}
+
+type pendingPreKey struct {
+	BaseKey        []byte `json:"baseKey"`
+	SignedPreKeyID uint32 `json:"signedPreKeyID"`
+}
+
+// sessionState - the double ratchet state of a session
+type sessionState struct {
+	RootKey         []byte            `json:"rootKey"`
+	SendChainKey    []byte            `json:"sendChainKey,omitempty"`
+	RecvChainKey    []byte            `json:"recvChainKey,omitempty"`
+	SendRatchetPriv []byte            `json:"sendRatchetPriv"`
+	SendRatchetPub  []byte            `json:"sendRatchetPub"`
+	RecvRatchetPub  []byte            `json:"recvRatchetPub,omitempty"`
+	SendCount       uint32            `json:"sendCount"`
+	RecvCount       uint32            `json:"recvCount"`
+	PrevSendCount   uint32            `json:"prevSendCount"`
+	Skipped         map[string][]byte `json:"skipped"`
+	AssociatedData  []byte            `json:"associatedData"`
+
+	// Set on the initiating side until the peer replies
+	PendingPreKey *pendingPreKey `json:"pendingPreKey,omitempty"`
+	// Set on the responding side to recognize repeated handshakes, and to
+	// refuse handshakes with older prekeys
+	BaseKey        []byte `json:"baseKey,omitempty"`
+	SignedPreKeyID uint32 `json:"signedPreKeyID,omitempty"`
+}
+
+func (s *sessionState) clone() *sessionState {
+	c := *s
+	c.Skipped = make(map[string][]byte, len(s.Skipped))
+	for k, v := range s.Skipped {
+		c.Skipped[k] = v
+	}
+	return &c
+}
+
+func (s *sessionState) decrypt(h *sessionHeader, header, ciphertext []byte) ([]byte, error) {
+	ad := append(append([]byte{}, s.AssociatedData...), header...)
+	skippedKey := skippedMessageKey(h.RatchetKey, h.Count)
+	if messageKey, ok := s.Skipped[skippedKey]; ok {
+		plaintext, err := openMessage(messageKey, ciphertext, ad)
+		if err != nil {
+			return nil, err
+		}
+		delete(s.Skipped, skippedKey)
+		return plaintext, nil
+	}
+
+	if !bytes.Equal(h.RatchetKey, s.RecvRatchetPub) || s.RecvChainKey == nil {
+		if err := s.skipMessageKeys(h.PrevSendCount); err != nil {
+			return nil, err
+		}
+		if err := s.ratchet(h.RatchetKey); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.skipMessageKeys(h.Count); err != nil {
+		return nil, err
+	}
+	var messageKey []byte
+	s.RecvChainKey, messageKey = kdfChainKey(s.RecvChainKey)
+	s.RecvCount++
+
+	plaintext, err := openMessage(messageKey, ciphertext, ad)
+	if err != nil {
+		return nil, err
+	}
+	// A reply means the peer has the session, so stop sending the handshake
+	s.PendingPreKey = nil
+	return plaintext, nil
+}
+
+func (s *sessionState) skipMessageKeys(until uint32) error {
+	if s.RecvChainKey == nil {
+		return nil
+	}
+	if until < s.RecvCount {
+		return nil
+	}
+	if until-s.RecvCount > maxSkippedMessageKeys || len(s.Skipped)+int(until-s.RecvCount) > maxSkippedMessageKeys {
+		return ErrTooManySkippedMessages
+	}
+	for s.RecvCount < until {
+		var messageKey []byte
+		s.RecvChainKey, messageKey = kdfChainKey(s.RecvChainKey)
+		s.Skipped[skippedMessageKey(s.RecvRatchetPub, s.RecvCount)] = messageKey
+		s.RecvCount++
+	}
+	return nil
+}
+
+func (s *sessionState) ratchet(ratchetKey []byte) error {
+	theirRatchet := toKey(ratchetKey)
+	s.PrevSendCount = s.SendCount
+	s.SendCount = 0
+	s.RecvCount = 0
+	s.RecvRatchetPub = ratchetKey
+
+	var err error
+	s.RootKey, s.RecvChainKey, err = kdfRootKey(s.RootKey, dh(toKey(s.SendRatchetPriv), theirRatchet))
+	if err != nil {
+		return err
+	}
+	pub, priv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	s.SendRatchetPub, s.SendRatchetPriv = pub[:], priv[:]
+	s.RootKey, s.SendChainKey, err = kdfRootKey(s.RootKey, dh(priv, theirRatchet))
+	return err
+}
+
+// sessionHeader - the unencrypted header of a session message. The whole
+// message is encrypted to the recipient's identity key so the header is only
+// visible to the recipient.
+type sessionHeader struct {
+	SenderKey      []byte
+	BaseKey        []byte
+	SignedPreKeyID uint32
+	RatchetKey     []byte
+	PrevSendCount  uint32
+	Count          uint32
+}
+
+func (h *sessionHeader) marshal() []byte {
+	buf := new(bytes.Buffer)
+	buf.Write(sessionMessagePrefix)
+	binary.Write(buf, binary.BigEndian, uint16(len(h.SenderKey)))
+	buf.Write(h.SenderKey)
+	if h.BaseKey != nil {
+		buf.WriteByte(sessionFlagPreKey)
+		buf.Write(h.BaseKey)
+		binary.Write(buf, binary.BigEndian, h.SignedPreKeyID)
+	} else {
+		buf.WriteByte(0)
+	}
+	buf.Write(h.RatchetKey)
+	binary.Write(buf, binary.BigEndian, h.PrevSendCount)
+	binary.Write(buf, binary.BigEndian, h.Count)
+	return buf.Bytes()
+}
+
+// unmarshalSessionHeader parses the header and returns its length
+func unmarshalSessionHeader(message []byte) (*sessionHeader, int, error) {
+	if !IsSessionMessage(message) {
+		return nil, 0, ErrMalformedSessionMessage
+	}
+	r := bytes.NewReader(message[len(sessionMessagePrefix):])
+	h := new(sessionHeader)
+
+	var keyLen uint16
+	if err := binary.Read(r, binary.BigEndian, &keyLen); err != nil {
+		return nil, 0, ErrMalformedSessionMessage
+	}
+	h.SenderKey = make([]byte, keyLen)
+	if _, err := io.ReadFull(r, h.SenderKey); err != nil {
+		return nil, 0, ErrMalformedSessionMessage
+	}
+	flags, err := r.ReadByte()
+	if err != nil {
+		return nil, 0, ErrMalformedSessionMessage
+	}
+	if flags&sessionFlagPreKey != 0 {
+		h.BaseKey = make([]byte, 32)
+		if _, err := io.ReadFull(r, h.BaseKey); err != nil {
+			return nil, 0, ErrMalformedSessionMessage
+		}
+		if err := binary.Read(r, binary.BigEndian, &h.SignedPreKeyID); err != nil {
+			return nil, 0, ErrMalformedSessionMessage
+		}
+	}
+	h.RatchetKey = make([]byte, 32)
+	if _, err := io.ReadFull(r, h.RatchetKey); err != nil {
+		return nil, 0, ErrMalformedSessionMessage
+	}
+	if err := binary.Read(r, binary.BigEndian, &h.PrevSendCount); err != nil {
+		return nil, 0, ErrMalformedSessionMessage
+	}
+	if err := binary.Read(r, binary.BigEndian, &h.Count); err != nil {
+		return nil, 0, ErrMalformedSessionMessage
+	}
+	return h, len(message) - r.Len(), nil
+}
+
+func preKeySignaturePayload(id uint32, pub []byte) []byte {
+	payload := []byte("OpenBazaar signed prekey")
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, id)
+	payload = append(payload, idBytes...)
+	return append(payload, pub...)
+}
+
+func skippedMessageKey(ratchetKey []byte, n uint32) string {
+	return fmt.Sprintf("%s:%d", hex.EncodeToString(ratchetKey), n)
+}
+
+func toKey(b []byte) *[32]byte {
+	var k [32]byte
+	copy(k[:], b)
+	return &k
+}
+
+func dh(priv, pub *[32]byte) []byte {
+	var shared [32]byte
+	curve25519.ScalarMult(&shared, priv, pub)
+	return shared[:]
+}
+
+// x3dh derives the shared secret of the handshake from its DH outputs
+func x3dh(dhs ...[]byte) ([]byte, error) {
+	ikm := bytes.Repeat([]byte{0xff}, 32)
+	for _, d := range dhs {
+		ikm = append(ikm, d...)
+	}
+	sk := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, make([]byte, 32), []byte("OpenBazaar X3DH")), sk); err != nil {
+		return nil, err
+	}
+	return sk, nil
+}
+
+func kdfRootKey(rootKey, dhOut []byte) ([]byte, []byte, error) {
+	out := make([]byte, 64)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, dhOut, rootKey, []byte("OpenBazaar Ratchet")), out); err != nil {
+		return nil, nil, err
+	}
+	return out[:32], out[32:], nil
+}
+
+func kdfChainKey(chainKey []byte) ([]byte, []byte) {
+	mac := hmac.New(sha256.New, chainKey)
+	mac.Write([]byte{0x02})
+	next := mac.Sum(nil)
+	mac = hmac.New(sha256.New, chainKey)
+	mac.Write([]byte{0x01})
+	return next, mac.Sum(nil)
+}
+
+func messageCipher(messageKey []byte) (cipher.AEAD, []byte, error) {
+	keys := make([]byte, 32+12)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, messageKey, nil, []byte("OpenBazaar Message Keys")), keys); err != nil {
+		return nil, nil, err
+	}
+	block, err := aes.NewCipher(keys[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, keys[32:], nil
+}
+
+func sealMessage(messageKey, plaintext, ad []byte) ([]byte, error) {
+	aead, nonce, err := messageCipher(messageKey)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, nonce, plaintext, ad), nil
+}
+
+func openMessage(messageKey, ciphertext, ad []byte) ([]byte, error) {
+	aead, nonce, err := messageCipher(messageKey)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, nonce, ciphertext, ad)
+}
@@ -0,0 +1,307 @@
+package net
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+	libp2p "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type memorySessionStore struct {
+	repo.Queryable
+	sessions map[string][]byte
+	preKeys  map[uint32]repo.PreKey
+}
+
+func newMemorySessionStore() *memorySessionStore {
+	return &memorySessionStore{sessions: make(map[string][]byte), preKeys: make(map[uint32]repo.PreKey)}
+}
+
+func (s *memorySessionStore) Get(peerID string) ([]byte, error) { return s.sessions[peerID], nil }
+func (s *memorySessionStore) Put(peerID string, state []byte) error {
+	s.sessions[peerID] = state
+	return nil
+}
+func (s *memorySessionStore) Delete(peerID string) error {
+	delete(s.sessions, peerID)
+	return nil
+}
+func (s *memorySessionStore) PutPreKey(preKey repo.PreKey) error {
+	s.preKeys[preKey.ID] = preKey
+	return nil
+}
+func (s *memorySessionStore) GetPreKey(id uint32) (*repo.PreKey, error) {
+	if preKey, ok := s.preKeys[id]; ok {
+		return &preKey, nil
+	}
+	return nil, nil
+}
+func (s *memorySessionStore) GetLatestPreKey() (*repo.PreKey, error) {
+	var latest *repo.PreKey
+	for _, preKey := range s.preKeys {
+		if latest == nil || preKey.ID > latest.ID {
+			p := preKey
+			latest = &p
+		}
+	}
+	return latest, nil
+}
+func (s *memorySessionStore) DeletePreKeysBefore(t time.Time) error {
+	for id, preKey := range s.preKeys {
+		if preKey.CreatedAt.Before(t) {
+			delete(s.preKeys, id)
+		}
+	}
+	return nil
+}
+
+func newTestSessionManager(t *testing.T) (*SessionManager, peer.ID) {
+	priv, _, err := libp2p.GenerateKeyPairWithReader(libp2p.Ed25519, 256, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewSessionManager(priv, newMemorySessionStore()), pid
+}
+
+func mustDecryptFrom(t *testing.T, sm *SessionManager, expectedSender peer.ID, message []byte, expected string) {
+	sender, plaintext, err := sm.Decrypt(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender != expectedSender || string(plaintext) != expected {
+		t.Errorf("expected %q from %s, got %q from %s", expected, expectedSender.Pretty(), plaintext, sender.Pretty())
+	}
+}
+
+func mustEncryptTo(t *testing.T, sm *SessionManager, recipient peer.ID, plaintext string) []byte {
+	message, err := sm.Encrypt(recipient, []byte(plaintext))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSessionMessage(message) {
+		t.Fatal("expected a session message")
+	}
+	return message
+}
+
+func TestSessionRoundTrip(t *testing.T) {
+	alice, aliceID := newTestSessionManager(t)
+	bob, bobID := newTestSessionManager(t)
+
+	bundle, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := alice.InitSession(bobID, bundle); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bob.Encrypt(aliceID, []byte("hi")); err != ErrNoSession {
+		t.Errorf("expected bob to have no session, got %v", err)
+	}
+
+	// Messages carrying the handshake may arrive out of order
+	m1 := mustEncryptTo(t, alice, bobID, "one")
+	m2 := mustEncryptTo(t, alice, bobID, "two")
+	mustDecryptFrom(t, bob, aliceID, m2, "two")
+	mustDecryptFrom(t, bob, aliceID, m1, "one")
+	if _, _, err := bob.Decrypt(m1); err == nil {
+		t.Error("expected a replayed message to fail")
+	}
+
+	// Replies ratchet forward
+	r1 := mustEncryptTo(t, bob, aliceID, "three")
+	mustDecryptFrom(t, alice, bobID, r1, "three")
+	m3 := mustEncryptTo(t, alice, bobID, "four")
+	if h, _, _ := unmarshalSessionHeader(m3); h.BaseKey != nil {
+		t.Error("expected handshake to stop after a reply")
+	}
+	mustDecryptFrom(t, bob, aliceID, m3, "four")
+}
+
+func TestSessionSimultaneousInit(t *testing.T) {
+	alice, aliceID := newTestSessionManager(t)
+	bob, bobID := newTestSessionManager(t)
+
+	aliceBundle, err := alice.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bobBundle, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := alice.InitSession(bobID, bobBundle); err != nil {
+		t.Fatal(err)
+	}
+	if err := bob.InitSession(aliceID, aliceBundle); err != nil {
+		t.Fatal(err)
+	}
+	fromAlice := mustEncryptTo(t, alice, bobID, "from alice")
+	fromBob := mustEncryptTo(t, bob, aliceID, "from bob")
+	mustDecryptFrom(t, bob, aliceID, fromAlice, "from alice")
+	mustDecryptFrom(t, alice, bobID, fromBob, "from bob")
+
+	// Both sides settle on the same session
+	mustDecryptFrom(t, bob, aliceID, mustEncryptTo(t, alice, bobID, "again"), "again")
+	mustDecryptFrom(t, alice, bobID, mustEncryptTo(t, bob, aliceID, "and back"), "and back")
+}
+
+func TestPreKeyBundleVerify(t *testing.T) {
+	bob, bobID := newTestSessionManager(t)
+	_, aliceID := newTestSessionManager(t)
+
+	bundle, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bundle.Verify(bobID); err != nil {
+		t.Error(err)
+	}
+	if err := bundle.Verify(aliceID); err != ErrInvalidPreKeyBundle {
+		t.Errorf("expected bundle of another peer to fail, got %v", err)
+	}
+	bundle.SignedPreKeyID++
+	if err := bundle.Verify(bobID); err != ErrInvalidPreKeyBundle {
+		t.Errorf("expected tampered bundle to fail, got %v", err)
+	}
+
+	// The signed prekey rotates
+	again, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.SignedPreKeyID != 1 {
+		t.Errorf("expected the same prekey before rotation, got %d", again.SignedPreKeyID)
+	}
+	if bob.PreKeyRotationDue() {
+		t.Error("expected no rotation to be due")
+	}
+	bob.now = func() time.Time { return time.Now().Add(SignedPreKeyRotation) }
+	if !bob.PreKeyRotationDue() {
+		t.Error("expected a rotation to be due")
+	}
+	rotated, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.SignedPreKeyID != 2 {
+		t.Errorf("expected a rotated prekey, got %d", rotated.SignedPreKeyID)
+	}
+}
+
+func TestSessionReplayAndOutOfOrder(t *testing.T) {
+	alice, aliceID := newTestSessionManager(t)
+	bob, bobID := newTestSessionManager(t)
+
+	bundle, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := alice.InitSession(bobID, bundle); err != nil {
+		t.Fatal(err)
+	}
+	handshake := mustEncryptTo(t, alice, bobID, "hello")
+	mustDecryptFrom(t, bob, aliceID, handshake, "hello")
+	mustDecryptFrom(t, alice, bobID, mustEncryptTo(t, bob, aliceID, "hi"), "hi")
+	late := mustEncryptTo(t, alice, bobID, "sent before alice lost her session")
+
+	// A replayed handshake doesn't replace the established session
+	if _, _, err := bob.Decrypt(handshake); err == nil {
+		t.Error("expected a replayed handshake to fail")
+	}
+	mustDecryptFrom(t, alice, bobID, mustEncryptTo(t, bob, aliceID, "still here"), "still here")
+
+	// Alice starts over, and a message of her old session arrives after the
+	// new handshake
+	if err := alice.InitSession(bobID, bundle); err != nil {
+		t.Fatal(err)
+	}
+	mustDecryptFrom(t, bob, aliceID, mustEncryptTo(t, alice, bobID, "new session"), "new session")
+	mustDecryptFrom(t, bob, aliceID, late, "sent before alice lost her session")
+	if _, _, err := bob.Decrypt(late); err == nil {
+		t.Error("expected a replayed message of the old session to fail")
+	}
+	mustDecryptFrom(t, alice, bobID, mustEncryptTo(t, bob, aliceID, "welcome back"), "welcome back")
+}
+
+func TestSessionRefusesStaleHandshake(t *testing.T) {
+	alice, aliceID := newTestSessionManager(t)
+	bob, bobID := newTestSessionManager(t)
+
+	oldBundle, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := alice.InitSession(bobID, oldBundle); err != nil {
+		t.Fatal(err)
+	}
+	stale := mustEncryptTo(t, alice, bobID, "never delivered")
+
+	bob.now = func() time.Time { return time.Now().Add(SignedPreKeyRotation) }
+	newBundle, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := alice.InitSession(bobID, newBundle); err != nil {
+		t.Fatal(err)
+	}
+	mustDecryptFrom(t, bob, aliceID, mustEncryptTo(t, alice, bobID, "current"), "current")
+
+	if _, _, err := bob.Decrypt(stale); err != ErrStaleHandshake {
+		t.Errorf("expected a handshake with an older prekey to be refused, got %v", err)
+	}
+	mustDecryptFrom(t, alice, bobID, mustEncryptTo(t, bob, aliceID, "reply"), "reply")
+}
+
+func TestSessionReadsLegacyState(t *testing.T) {
+	alice, aliceID := newTestSessionManager(t)
+	bob, bobID := newTestSessionManager(t)
+
+	bundle, err := bob.PreKeyBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := alice.InitSession(bobID, bundle); err != nil {
+		t.Fatal(err)
+	}
+	mustDecryptFrom(t, bob, aliceID, mustEncryptTo(t, alice, bobID, "hello"), "hello")
+
+	// Sessions used to be stored as a bare state
+	store := bob.store.(*memorySessionStore)
+	record, err := bob.getRecord(aliceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, err := json.Marshal(record.Current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.sessions[aliceID.Pretty()] = legacy
+	mustDecryptFrom(t, alice, bobID, mustEncryptTo(t, bob, aliceID, "hi"), "hi")
+}
+
+func TestPreKeyBundleMisses(t *testing.T) {
+	sm, _ := newTestSessionManager(t)
+	_, peerID := newTestSessionManager(t)
+
+	if !sm.ShouldFetchPreKeyBundle(peerID) {
+		t.Error("expected the bundle of a new peer to be fetched")
+	}
+	sm.PreKeyBundleMissed(peerID)
+	if sm.ShouldFetchPreKeyBundle(peerID) {
+		t.Error("expected the bundle not to be fetched right after a miss")
+	}
+	sm.now = func() time.Time { return time.Now().Add(PreKeyBundleRetryInterval) }
+	if !sm.ShouldFetchPreKeyBundle(peerID) {
+		t.Error("expected the bundle to be fetched again after the retry interval")
+	}
+}
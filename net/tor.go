@@ -2,15 +2,10 @@ package net
 
 import (
 	"crypto/rand"
-	"crypto/rsa"
-	"encoding/pem"
 	"errors"
+
+	"github.com/OpenBazaar/openbazaar-go/net/onion"
 	"github.com/yawning/bulb"
-	"github.com/yawning/bulb/utils/pkcs1"
-	"os"
-	"path"
-	"path/filepath"
-	"strings"
 )
 
 // Return the Tor control port if Tor is running or an error
@@ -28,55 +23,22 @@ func GetTorControlPort() (int, error) {
 	return 0, errors.New("Tor control unavailable")
 }
 
-// Generate a new RSA key and onion address and save it to the repo
+// Generate a new ed25519 key and v3 onion address and save it to the repo
 func CreateHiddenServiceKey(repoPath string) (onionAddr string, err error) {
-	priv, err := rsa.GenerateKey(rand.Reader, 1024)
-	if err != nil {
-		return "", err
-	}
-	id, err := pkcs1.OnionAddr(&priv.PublicKey)
-	if err != nil {
-		return "", err
-	}
-
-	f, err := os.Create(path.Join(repoPath, id+".onion_key"))
-	if err != nil {
-		return "", err
-	}
-	defer f.Close()
-
-	privKeyBytes, err := pkcs1.EncodePrivateKeyDER(priv)
-	if err != nil {
-		return "", err
-	}
-
-	block := pem.Block{Type: "RSA PRIVATE KEY", Bytes: privKeyBytes}
-	err = pem.Encode(f, &block)
-	if err != nil {
-		return "", err
-	}
-	return id, nil
+	return onion.CreateV3Key(repoPath, rand.Reader)
 }
 
-// Generate a new key pair if one does not already exist
+// Generate a new key pair if the repo does not already have a v3 key. Legacy
+// v2 keys are ignored as v2 onion services are no longer reachable.
 func MaybeCreateHiddenServiceKey(repoPath string) (onionAddr string, err error) {
-	d, err := os.Open(repoPath)
+	ids, err := onion.KeyIDs(repoPath)
 	if err != nil {
 		return "", err
 	}
-	defer d.Close()
-
-	files, err := d.Readdir(-1)
-	if err != nil {
-		return "", err
-	}
-
-	for _, file := range files {
-		if filepath.Ext(file.Name()) == ".onion_key" {
-			addr := strings.Split(file.Name(), ".onion_key")
-			return addr[0], nil
+	for _, id := range ids {
+		if onion.IsV3Address(id) {
+			return id, nil
 		}
 	}
-
 	return CreateHiddenServiceKey(repoPath)
 }
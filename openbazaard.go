@@ -70,6 +70,10 @@ func main() {
 		"set API credentials",
 		"The API password field in the config file takes a SHA256 hash of the password. This command will generate the hash for you and save it to the config file.",
 		&cmd.SetAPICreds{})
+	parser.AddCommand("apitoken",
+		"manage scoped API tokens",
+		"Creates, revokes and lists named API tokens. A token is sent as 'Authorization: Bearer <token>' and only allows the API requests of its scopes. Tokens with the wallet-spend scope, or the admin scope, can move funds.",
+		&cmd.APIToken{})
 	parser.AddCommand("start",
 		"start the OpenBazaar-Server",
 		"The start command starts the OpenBazaar-Server",
@@ -48,7 +48,7 @@ func (x Listing_Metadata_ContractType) String() string {
 	return proto.EnumName(Listing_Metadata_ContractType_name, int32(x))
 }
 func (Listing_Metadata_ContractType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 0, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 0, 0}
 }
 
 type Listing_Metadata_Format int32
@@ -71,7 +71,7 @@ func (x Listing_Metadata_Format) String() string {
 	return proto.EnumName(Listing_Metadata_Format_name, int32(x))
 }
 func (Listing_Metadata_Format) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 0, 1}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 0, 1}
 }
 
 type Listing_ShippingOption_ShippingType int32
@@ -94,7 +94,7 @@ func (x Listing_ShippingOption_ShippingType) String() string {
 	return proto.EnumName(Listing_ShippingOption_ShippingType_name, int32(x))
 }
 func (Listing_ShippingOption_ShippingType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 2, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 3, 0}
 }
 
 type Order_Payment_Method int32
@@ -120,7 +120,7 @@ func (x Order_Payment_Method) String() string {
 	return proto.EnumName(Order_Payment_Method_name, int32(x))
 }
 func (Order_Payment_Method) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{2, 2, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{2, 2, 0}
 }
 
 type Signature_Section int32
@@ -161,7 +161,7 @@ func (x Signature_Section) String() string {
 	return proto.EnumName(Signature_Section_name, int32(x))
 }
 func (Signature_Section) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{17, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{17, 0}
 }
 
 type RicardianContract struct {
@@ -185,7 +185,7 @@ func (m *RicardianContract) Reset()         { *m = RicardianContract{} }
 func (m *RicardianContract) String() string { return proto.CompactTextString(m) }
 func (*RicardianContract) ProtoMessage()    {}
 func (*RicardianContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{0}
 }
 func (m *RicardianContract) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RicardianContract.Unmarshal(m, b)
@@ -293,6 +293,7 @@ type Listing struct {
 	Moderators           []string                  `protobuf:"bytes,8,rep,name=moderators,proto3" json:"moderators,omitempty"`
 	TermsAndConditions   string                    `protobuf:"bytes,9,opt,name=termsAndConditions,proto3" json:"termsAndConditions,omitempty"`
 	RefundPolicy         string                    `protobuf:"bytes,10,opt,name=refundPolicy,proto3" json:"refundPolicy,omitempty"`
+	Translations         []*Listing_Translation    `protobuf:"bytes,11,rep,name=translations,proto3" json:"translations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -302,7 +303,7 @@ func (m *Listing) Reset()         { *m = Listing{} }
 func (m *Listing) String() string { return proto.CompactTextString(m) }
 func (*Listing) ProtoMessage()    {}
 func (*Listing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1}
 }
 func (m *Listing) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing.Unmarshal(m, b)
@@ -392,6 +393,13 @@ func (m *Listing) GetRefundPolicy() string {
 	return ""
 }
 
+func (m *Listing) GetTranslations() []*Listing_Translation {
+	if m != nil {
+		return m.Translations
+	}
+	return nil
+}
+
 type Listing_Metadata struct {
 	Version              uint32                        `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	ContractType         Listing_Metadata_ContractType `protobuf:"varint,2,opt,name=contractType,proto3,enum=Listing_Metadata_ContractType" json:"contractType,omitempty"`
@@ -413,7 +421,7 @@ func (m *Listing_Metadata) Reset()         { *m = Listing_Metadata{} }
 func (m *Listing_Metadata) String() string { return proto.CompactTextString(m) }
 func (*Listing_Metadata) ProtoMessage()    {}
 func (*Listing_Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 0}
 }
 func (m *Listing_Metadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_Metadata.Unmarshal(m, b)
@@ -511,28 +519,29 @@ func (m *Listing_Metadata) GetPriceModifier() float32 {
 }
 
 type Listing_Item struct {
-	Title                string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ProcessingTime       string                 `protobuf:"bytes,3,opt,name=processingTime,proto3" json:"processingTime,omitempty"`
-	Price                uint64                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Nsfw                 bool                   `protobuf:"varint,5,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
-	Tags                 []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	Images               []*Listing_Item_Image  `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
-	Categories           []string               `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
-	Grams                float32                `protobuf:"fixed32,9,opt,name=grams,proto3" json:"grams,omitempty"`
-	Condition            string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`
-	Options              []*Listing_Item_Option `protobuf:"bytes,11,rep,name=options,proto3" json:"options,omitempty"`
-	Skus                 []*Listing_Item_Sku    `protobuf:"bytes,12,rep,name=skus,proto3" json:"skus,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Title                string                    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ProcessingTime       string                    `protobuf:"bytes,3,opt,name=processingTime,proto3" json:"processingTime,omitempty"`
+	Price                uint64                    `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Nsfw                 bool                      `protobuf:"varint,5,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	Tags                 []string                  `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Images               []*Listing_Item_Image     `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	Categories           []string                  `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
+	Grams                float32                   `protobuf:"fixed32,9,opt,name=grams,proto3" json:"grams,omitempty"`
+	Condition            string                    `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`
+	Options              []*Listing_Item_Option    `protobuf:"bytes,11,rep,name=options,proto3" json:"options,omitempty"`
+	Skus                 []*Listing_Item_Sku       `protobuf:"bytes,12,rep,name=skus,proto3" json:"skus,omitempty"`
+	PriceTiers           []*Listing_Item_PriceTier `protobuf:"bytes,13,rep,name=priceTiers,proto3" json:"priceTiers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *Listing_Item) Reset()         { *m = Listing_Item{} }
 func (m *Listing_Item) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item) ProtoMessage()    {}
 func (*Listing_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 1}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 1}
 }
 func (m *Listing_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_Item.Unmarshal(m, b)
@@ -636,6 +645,13 @@ func (m *Listing_Item) GetSkus() []*Listing_Item_Sku {
 	return nil
 }
 
+func (m *Listing_Item) GetPriceTiers() []*Listing_Item_PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
 type Listing_Item_Option struct {
 	Name                 string                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description          string                         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -649,7 +665,7 @@ func (m *Listing_Item_Option) Reset()         { *m = Listing_Item_Option{} }
 func (m *Listing_Item_Option) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item_Option) ProtoMessage()    {}
 func (*Listing_Item_Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 1, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 1, 0}
 }
 func (m *Listing_Item_Option) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_Item_Option.Unmarshal(m, b)
@@ -702,7 +718,7 @@ func (m *Listing_Item_Option_Variant) Reset()         { *m = Listing_Item_Option
 func (m *Listing_Item_Option_Variant) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item_Option_Variant) ProtoMessage()    {}
 func (*Listing_Item_Option_Variant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 1, 0, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 1, 0, 0}
 }
 func (m *Listing_Item_Option_Variant) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_Item_Option_Variant.Unmarshal(m, b)
@@ -737,20 +753,21 @@ func (m *Listing_Item_Option_Variant) GetImage() *Listing_Item_Image {
 }
 
 type Listing_Item_Sku struct {
-	VariantCombo         []uint32 `protobuf:"varint,1,rep,packed,name=variantCombo,proto3" json:"variantCombo,omitempty"`
-	ProductID            string   `protobuf:"bytes,2,opt,name=productID,proto3" json:"productID,omitempty"`
-	Surcharge            int64    `protobuf:"varint,3,opt,name=surcharge,proto3" json:"surcharge,omitempty"`
-	Quantity             int64    `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	VariantCombo         []uint32                  `protobuf:"varint,1,rep,packed,name=variantCombo,proto3" json:"variantCombo,omitempty"`
+	ProductID            string                    `protobuf:"bytes,2,opt,name=productID,proto3" json:"productID,omitempty"`
+	Surcharge            int64                     `protobuf:"varint,3,opt,name=surcharge,proto3" json:"surcharge,omitempty"`
+	Quantity             int64                     `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PriceTiers           []*Listing_Item_PriceTier `protobuf:"bytes,5,rep,name=priceTiers,proto3" json:"priceTiers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *Listing_Item_Sku) Reset()         { *m = Listing_Item_Sku{} }
 func (m *Listing_Item_Sku) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item_Sku) ProtoMessage()    {}
 func (*Listing_Item_Sku) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 1, 1}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 1, 1}
 }
 func (m *Listing_Item_Sku) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_Item_Sku.Unmarshal(m, b)
@@ -798,6 +815,59 @@ func (m *Listing_Item_Sku) GetQuantity() int64 {
 	return 0
 }
 
+func (m *Listing_Item_Sku) GetPriceTiers() []*Listing_Item_PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
+type Listing_Item_PriceTier struct {
+	MinQuantity          uint64   `protobuf:"varint,1,opt,name=minQuantity,proto3" json:"minQuantity,omitempty"`
+	Price                uint64   `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Listing_Item_PriceTier) Reset()         { *m = Listing_Item_PriceTier{} }
+func (m *Listing_Item_PriceTier) String() string { return proto.CompactTextString(m) }
+func (*Listing_Item_PriceTier) ProtoMessage()    {}
+func (*Listing_Item_PriceTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 1, 2}
+}
+func (m *Listing_Item_PriceTier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Item_PriceTier.Unmarshal(m, b)
+}
+func (m *Listing_Item_PriceTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Item_PriceTier.Marshal(b, m, deterministic)
+}
+func (dst *Listing_Item_PriceTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Item_PriceTier.Merge(dst, src)
+}
+func (m *Listing_Item_PriceTier) XXX_Size() int {
+	return xxx_messageInfo_Listing_Item_PriceTier.Size(m)
+}
+func (m *Listing_Item_PriceTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Item_PriceTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Item_PriceTier proto.InternalMessageInfo
+
+func (m *Listing_Item_PriceTier) GetMinQuantity() uint64 {
+	if m != nil {
+		return m.MinQuantity
+	}
+	return 0
+}
+
+func (m *Listing_Item_PriceTier) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
 type Listing_Item_Image struct {
 	Filename             string   `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 	Original             string   `protobuf:"bytes,2,opt,name=original,proto3" json:"original,omitempty"`
@@ -814,7 +884,7 @@ func (m *Listing_Item_Image) Reset()         { *m = Listing_Item_Image{} }
 func (m *Listing_Item_Image) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item_Image) ProtoMessage()    {}
 func (*Listing_Item_Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 1, 2}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 1, 3}
 }
 func (m *Listing_Item_Image) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_Item_Image.Unmarshal(m, b)
@@ -876,6 +946,130 @@ func (m *Listing_Item_Image) GetTiny() string {
 	return ""
 }
 
+type Listing_Translation struct {
+	Language             string                            `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Title                string                            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string                            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	OptionNames          []*Listing_Translation_OptionName `protobuf:"bytes,4,rep,name=optionNames,proto3" json:"optionNames,omitempty"`
+	TermsAndConditions   string                            `protobuf:"bytes,5,opt,name=termsAndConditions,proto3" json:"termsAndConditions,omitempty"`
+	RefundPolicy         string                            `protobuf:"bytes,6,opt,name=refundPolicy,proto3" json:"refundPolicy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *Listing_Translation) Reset()         { *m = Listing_Translation{} }
+func (m *Listing_Translation) String() string { return proto.CompactTextString(m) }
+func (*Listing_Translation) ProtoMessage()    {}
+func (*Listing_Translation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 2}
+}
+func (m *Listing_Translation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Translation.Unmarshal(m, b)
+}
+func (m *Listing_Translation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Translation.Marshal(b, m, deterministic)
+}
+func (dst *Listing_Translation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Translation.Merge(dst, src)
+}
+func (m *Listing_Translation) XXX_Size() int {
+	return xxx_messageInfo_Listing_Translation.Size(m)
+}
+func (m *Listing_Translation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Translation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Translation proto.InternalMessageInfo
+
+func (m *Listing_Translation) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *Listing_Translation) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Listing_Translation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Listing_Translation) GetOptionNames() []*Listing_Translation_OptionName {
+	if m != nil {
+		return m.OptionNames
+	}
+	return nil
+}
+
+func (m *Listing_Translation) GetTermsAndConditions() string {
+	if m != nil {
+		return m.TermsAndConditions
+	}
+	return ""
+}
+
+func (m *Listing_Translation) GetRefundPolicy() string {
+	if m != nil {
+		return m.RefundPolicy
+	}
+	return ""
+}
+
+type Listing_Translation_OptionName struct {
+	Option               string   `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Listing_Translation_OptionName) Reset()         { *m = Listing_Translation_OptionName{} }
+func (m *Listing_Translation_OptionName) String() string { return proto.CompactTextString(m) }
+func (*Listing_Translation_OptionName) ProtoMessage()    {}
+func (*Listing_Translation_OptionName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 2, 0}
+}
+func (m *Listing_Translation_OptionName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Translation_OptionName.Unmarshal(m, b)
+}
+func (m *Listing_Translation_OptionName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Translation_OptionName.Marshal(b, m, deterministic)
+}
+func (dst *Listing_Translation_OptionName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Translation_OptionName.Merge(dst, src)
+}
+func (m *Listing_Translation_OptionName) XXX_Size() int {
+	return xxx_messageInfo_Listing_Translation_OptionName.Size(m)
+}
+func (m *Listing_Translation_OptionName) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Translation_OptionName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Translation_OptionName proto.InternalMessageInfo
+
+func (m *Listing_Translation_OptionName) GetOption() string {
+	if m != nil {
+		return m.Option
+	}
+	return ""
+}
+
+func (m *Listing_Translation_OptionName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type Listing_ShippingOption struct {
 	Name                 string                              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Type                 Listing_ShippingOption_ShippingType `protobuf:"varint,2,opt,name=type,proto3,enum=Listing_ShippingOption_ShippingType" json:"type,omitempty"`
@@ -890,7 +1084,7 @@ func (m *Listing_ShippingOption) Reset()         { *m = Listing_ShippingOption{}
 func (m *Listing_ShippingOption) String() string { return proto.CompactTextString(m) }
 func (*Listing_ShippingOption) ProtoMessage()    {}
 func (*Listing_ShippingOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 2}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 3}
 }
 func (m *Listing_ShippingOption) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_ShippingOption.Unmarshal(m, b)
@@ -952,7 +1146,7 @@ func (m *Listing_ShippingOption_Service) Reset()         { *m = Listing_Shipping
 func (m *Listing_ShippingOption_Service) String() string { return proto.CompactTextString(m) }
 func (*Listing_ShippingOption_Service) ProtoMessage()    {}
 func (*Listing_ShippingOption_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 2, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 3, 0}
 }
 func (m *Listing_ShippingOption_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_ShippingOption_Service.Unmarshal(m, b)
@@ -1014,7 +1208,7 @@ func (m *Listing_Tax) Reset()         { *m = Listing_Tax{} }
 func (m *Listing_Tax) String() string { return proto.CompactTextString(m) }
 func (*Listing_Tax) ProtoMessage()    {}
 func (*Listing_Tax) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 3}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 4}
 }
 func (m *Listing_Tax) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_Tax.Unmarshal(m, b)
@@ -1081,7 +1275,7 @@ func (m *Listing_Coupon) Reset()         { *m = Listing_Coupon{} }
 func (m *Listing_Coupon) String() string { return proto.CompactTextString(m) }
 func (*Listing_Coupon) ProtoMessage()    {}
 func (*Listing_Coupon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{1, 4}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{1, 5}
 }
 func (m *Listing_Coupon) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Listing_Coupon.Unmarshal(m, b)
@@ -1308,7 +1502,7 @@ func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{2}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{2}
 }
 func (m *Order) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Order.Unmarshal(m, b)
@@ -1415,7 +1609,7 @@ func (m *Order_Shipping) Reset()         { *m = Order_Shipping{} }
 func (m *Order_Shipping) String() string { return proto.CompactTextString(m) }
 func (*Order_Shipping) ProtoMessage()    {}
 func (*Order_Shipping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{2, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{2, 0}
 }
 func (m *Order_Shipping) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Order_Shipping.Unmarshal(m, b)
@@ -1502,7 +1696,7 @@ func (m *Order_Item) Reset()         { *m = Order_Item{} }
 func (m *Order_Item) String() string { return proto.CompactTextString(m) }
 func (*Order_Item) ProtoMessage()    {}
 func (*Order_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{2, 1}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{2, 1}
 }
 func (m *Order_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Order_Item.Unmarshal(m, b)
@@ -1590,7 +1784,7 @@ func (m *Order_Item_Option) Reset()         { *m = Order_Item_Option{} }
 func (m *Order_Item_Option) String() string { return proto.CompactTextString(m) }
 func (*Order_Item_Option) ProtoMessage()    {}
 func (*Order_Item_Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{2, 1, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{2, 1, 0}
 }
 func (m *Order_Item_Option) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Order_Item_Option.Unmarshal(m, b)
@@ -1636,7 +1830,7 @@ func (m *Order_Item_ShippingOption) Reset()         { *m = Order_Item_ShippingOp
 func (m *Order_Item_ShippingOption) String() string { return proto.CompactTextString(m) }
 func (*Order_Item_ShippingOption) ProtoMessage()    {}
 func (*Order_Item_ShippingOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{2, 1, 1}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{2, 1, 1}
 }
 func (m *Order_Item_ShippingOption) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Order_Item_ShippingOption.Unmarshal(m, b)
@@ -1688,7 +1882,7 @@ func (m *Order_Payment) Reset()         { *m = Order_Payment{} }
 func (m *Order_Payment) String() string { return proto.CompactTextString(m) }
 func (*Order_Payment) ProtoMessage()    {}
 func (*Order_Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{2, 2}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{2, 2}
 }
 func (m *Order_Payment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Order_Payment.Unmarshal(m, b)
@@ -1780,7 +1974,7 @@ func (m *OrderConfirmation) Reset()         { *m = OrderConfirmation{} }
 func (m *OrderConfirmation) String() string { return proto.CompactTextString(m) }
 func (*OrderConfirmation) ProtoMessage()    {}
 func (*OrderConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{3}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{3}
 }
 func (m *OrderConfirmation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderConfirmation.Unmarshal(m, b)
@@ -1848,7 +2042,7 @@ func (m *OrderReject) Reset()         { *m = OrderReject{} }
 func (m *OrderReject) String() string { return proto.CompactTextString(m) }
 func (*OrderReject) ProtoMessage()    {}
 func (*OrderReject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{4}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{4}
 }
 func (m *OrderReject) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderReject.Unmarshal(m, b)
@@ -1901,7 +2095,7 @@ func (m *RatingSignature) Reset()         { *m = RatingSignature{} }
 func (m *RatingSignature) String() string { return proto.CompactTextString(m) }
 func (*RatingSignature) ProtoMessage()    {}
 func (*RatingSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{5}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{5}
 }
 func (m *RatingSignature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RatingSignature.Unmarshal(m, b)
@@ -1950,7 +2144,7 @@ func (m *RatingSignature_TransactionMetadata) Reset()         { *m = RatingSigna
 func (m *RatingSignature_TransactionMetadata) String() string { return proto.CompactTextString(m) }
 func (*RatingSignature_TransactionMetadata) ProtoMessage()    {}
 func (*RatingSignature_TransactionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{5, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{5, 0}
 }
 func (m *RatingSignature_TransactionMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RatingSignature_TransactionMetadata.Unmarshal(m, b)
@@ -2019,10 +2213,12 @@ type RatingSignature_TransactionMetadata_Image struct {
 func (m *RatingSignature_TransactionMetadata_Image) Reset() {
 	*m = RatingSignature_TransactionMetadata_Image{}
 }
-func (m *RatingSignature_TransactionMetadata_Image) String() string { return proto.CompactTextString(m) }
-func (*RatingSignature_TransactionMetadata_Image) ProtoMessage()    {}
+func (m *RatingSignature_TransactionMetadata_Image) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RatingSignature_TransactionMetadata_Image) ProtoMessage() {}
 func (*RatingSignature_TransactionMetadata_Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{5, 0, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{5, 0, 0}
 }
 func (m *RatingSignature_TransactionMetadata_Image) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RatingSignature_TransactionMetadata_Image.Unmarshal(m, b)
@@ -2089,7 +2285,7 @@ func (m *BitcoinSignature) Reset()         { *m = BitcoinSignature{} }
 func (m *BitcoinSignature) String() string { return proto.CompactTextString(m) }
 func (*BitcoinSignature) ProtoMessage()    {}
 func (*BitcoinSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{6}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{6}
 }
 func (m *BitcoinSignature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BitcoinSignature.Unmarshal(m, b)
@@ -2146,7 +2342,7 @@ func (m *OrderFulfillment) Reset()         { *m = OrderFulfillment{} }
 func (m *OrderFulfillment) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment) ProtoMessage()    {}
 func (*OrderFulfillment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{7}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{7}
 }
 func (m *OrderFulfillment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderFulfillment.Unmarshal(m, b)
@@ -2241,7 +2437,7 @@ func (m *OrderFulfillment_PhysicalDelivery) Reset()         { *m = OrderFulfillm
 func (m *OrderFulfillment_PhysicalDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_PhysicalDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_PhysicalDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{7, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{7, 0}
 }
 func (m *OrderFulfillment_PhysicalDelivery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderFulfillment_PhysicalDelivery.Unmarshal(m, b)
@@ -2287,7 +2483,7 @@ func (m *OrderFulfillment_DigitalDelivery) Reset()         { *m = OrderFulfillme
 func (m *OrderFulfillment_DigitalDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_DigitalDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_DigitalDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{7, 1}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{7, 1}
 }
 func (m *OrderFulfillment_DigitalDelivery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderFulfillment_DigitalDelivery.Unmarshal(m, b)
@@ -2334,7 +2530,7 @@ func (m *OrderFulfillment_CryptocurrencyDelivery) Reset() {
 func (m *OrderFulfillment_CryptocurrencyDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_CryptocurrencyDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_CryptocurrencyDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{7, 2}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{7, 2}
 }
 func (m *OrderFulfillment_CryptocurrencyDelivery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderFulfillment_CryptocurrencyDelivery.Unmarshal(m, b)
@@ -2374,7 +2570,7 @@ func (m *OrderFulfillment_Payout) Reset()         { *m = OrderFulfillment_Payout
 func (m *OrderFulfillment_Payout) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_Payout) ProtoMessage()    {}
 func (*OrderFulfillment_Payout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{7, 3}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{7, 3}
 }
 func (m *OrderFulfillment_Payout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderFulfillment_Payout.Unmarshal(m, b)
@@ -2429,7 +2625,7 @@ func (m *OrderCompletion) Reset()         { *m = OrderCompletion{} }
 func (m *OrderCompletion) String() string { return proto.CompactTextString(m) }
 func (*OrderCompletion) ProtoMessage()    {}
 func (*OrderCompletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{8}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{8}
 }
 func (m *OrderCompletion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderCompletion.Unmarshal(m, b)
@@ -2489,7 +2685,7 @@ func (m *Rating) Reset()         { *m = Rating{} }
 func (m *Rating) String() string { return proto.CompactTextString(m) }
 func (*Rating) ProtoMessage()    {}
 func (*Rating) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{9}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{9}
 }
 func (m *Rating) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Rating.Unmarshal(m, b)
@@ -2547,7 +2743,7 @@ func (m *Rating_RatingData) Reset()         { *m = Rating_RatingData{} }
 func (m *Rating_RatingData) String() string { return proto.CompactTextString(m) }
 func (*Rating_RatingData) ProtoMessage()    {}
 func (*Rating_RatingData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{9, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{9, 0}
 }
 func (m *Rating_RatingData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Rating_RatingData.Unmarshal(m, b)
@@ -2680,7 +2876,7 @@ func (m *Dispute) Reset()         { *m = Dispute{} }
 func (m *Dispute) String() string { return proto.CompactTextString(m) }
 func (*Dispute) ProtoMessage()    {}
 func (*Dispute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{10}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{10}
 }
 func (m *Dispute) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Dispute.Unmarshal(m, b)
@@ -2751,7 +2947,7 @@ func (m *DisputeResolution) Reset()         { *m = DisputeResolution{} }
 func (m *DisputeResolution) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution) ProtoMessage()    {}
 func (*DisputeResolution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{11}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{11}
 }
 func (m *DisputeResolution) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisputeResolution.Unmarshal(m, b)
@@ -2828,7 +3024,7 @@ func (m *DisputeResolution_Payout) Reset()         { *m = DisputeResolution_Payo
 func (m *DisputeResolution_Payout) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout) ProtoMessage()    {}
 func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{11, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{11, 0}
 }
 func (m *DisputeResolution_Payout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisputeResolution_Payout.Unmarshal(m, b)
@@ -2898,7 +3094,7 @@ func (m *DisputeResolution_Payout_Output) Reset()         { *m = DisputeResoluti
 func (m *DisputeResolution_Payout_Output) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout_Output) ProtoMessage()    {}
 func (*DisputeResolution_Payout_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{11, 0, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{11, 0, 0}
 }
 func (m *DisputeResolution_Payout_Output) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisputeResolution_Payout_Output.Unmarshal(m, b)
@@ -3040,7 +3236,7 @@ func (m *DisputeAcceptance) Reset()         { *m = DisputeAcceptance{} }
 func (m *DisputeAcceptance) String() string { return proto.CompactTextString(m) }
 func (*DisputeAcceptance) ProtoMessage()    {}
 func (*DisputeAcceptance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{12}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{12}
 }
 func (m *DisputeAcceptance) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisputeAcceptance.Unmarshal(m, b)
@@ -3087,7 +3283,7 @@ func (m *Outpoint) Reset()         { *m = Outpoint{} }
 func (m *Outpoint) String() string { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()    {}
 func (*Outpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{13}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{13}
 }
 func (m *Outpoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Outpoint.Unmarshal(m, b)
@@ -3143,7 +3339,7 @@ func (m *Refund) Reset()         { *m = Refund{} }
 func (m *Refund) String() string { return proto.CompactTextString(m) }
 func (*Refund) ProtoMessage()    {}
 func (*Refund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{14}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{14}
 }
 func (m *Refund) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Refund.Unmarshal(m, b)
@@ -3210,7 +3406,7 @@ func (m *Refund_TransactionInfo) Reset()         { *m = Refund_TransactionInfo{}
 func (m *Refund_TransactionInfo) String() string { return proto.CompactTextString(m) }
 func (*Refund_TransactionInfo) ProtoMessage()    {}
 func (*Refund_TransactionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{14, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{14, 0}
 }
 func (m *Refund_TransactionInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Refund_TransactionInfo.Unmarshal(m, b)
@@ -3255,7 +3451,7 @@ func (m *VendorFinalizedPayment) Reset()         { *m = VendorFinalizedPayment{}
 func (m *VendorFinalizedPayment) String() string { return proto.CompactTextString(m) }
 func (*VendorFinalizedPayment) ProtoMessage()    {}
 func (*VendorFinalizedPayment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{15}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{15}
 }
 func (m *VendorFinalizedPayment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VendorFinalizedPayment.Unmarshal(m, b)
@@ -3296,7 +3492,7 @@ func (m *ID) Reset()         { *m = ID{} }
 func (m *ID) String() string { return proto.CompactTextString(m) }
 func (*ID) ProtoMessage()    {}
 func (*ID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{16}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{16}
 }
 func (m *ID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ID.Unmarshal(m, b)
@@ -3356,7 +3552,7 @@ func (m *ID_Pubkeys) Reset()         { *m = ID_Pubkeys{} }
 func (m *ID_Pubkeys) String() string { return proto.CompactTextString(m) }
 func (*ID_Pubkeys) ProtoMessage()    {}
 func (*ID_Pubkeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{16, 0}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{16, 0}
 }
 func (m *ID_Pubkeys) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ID_Pubkeys.Unmarshal(m, b)
@@ -3402,7 +3598,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{17}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{17}
 }
 func (m *Signature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Signature.Unmarshal(m, b)
@@ -3437,19 +3633,20 @@ func (m *Signature) GetSignatureBytes() []byte {
 }
 
 type SignedListing struct {
-	Listing              *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
-	Hash                 string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Listing              *Listing             `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	Hash                 string               `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Signature            []byte               `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Translation          *Listing_Translation `protobuf:"bytes,4,opt,name=translation,proto3" json:"translation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *SignedListing) Reset()         { *m = SignedListing{} }
 func (m *SignedListing) String() string { return proto.CompactTextString(m) }
 func (*SignedListing) ProtoMessage()    {}
 func (*SignedListing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_contracts_c1e9c5924aff92eb, []int{18}
+	return fileDescriptor_contracts_7f50661d59cf12ac, []int{18}
 }
 func (m *SignedListing) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignedListing.Unmarshal(m, b)
@@ -3490,6 +3687,13 @@ func (m *SignedListing) GetSignature() []byte {
 	return nil
 }
 
+func (m *SignedListing) GetTranslation() *Listing_Translation {
+	if m != nil {
+		return m.Translation
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RicardianContract)(nil), "RicardianContract")
 	proto.RegisterType((*Listing)(nil), "Listing")
@@ -3498,7 +3702,10 @@ func init() {
 	proto.RegisterType((*Listing_Item_Option)(nil), "Listing.Item.Option")
 	proto.RegisterType((*Listing_Item_Option_Variant)(nil), "Listing.Item.Option.Variant")
 	proto.RegisterType((*Listing_Item_Sku)(nil), "Listing.Item.Sku")
+	proto.RegisterType((*Listing_Item_PriceTier)(nil), "Listing.Item.PriceTier")
 	proto.RegisterType((*Listing_Item_Image)(nil), "Listing.Item.Image")
+	proto.RegisterType((*Listing_Translation)(nil), "Listing.Translation")
+	proto.RegisterType((*Listing_Translation_OptionName)(nil), "Listing.Translation.OptionName")
 	proto.RegisterType((*Listing_ShippingOption)(nil), "Listing.ShippingOption")
 	proto.RegisterType((*Listing_ShippingOption_Service)(nil), "Listing.ShippingOption.Service")
 	proto.RegisterType((*Listing_Tax)(nil), "Listing.Tax")
@@ -3543,214 +3750,223 @@ func init() {
 	proto.RegisterEnum("Signature_Section", Signature_Section_name, Signature_Section_value)
 }
 
-func init() { proto.RegisterFile("contracts.proto", fileDescriptor_contracts_c1e9c5924aff92eb) }
-
-var fileDescriptor_contracts_c1e9c5924aff92eb = []byte{
-	// 3296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xbd, 0x73, 0x1b, 0xd7,
-	0xb5, 0x17, 0xbe, 0x81, 0x03, 0x90, 0x00, 0xaf, 0x68, 0x19, 0x0f, 0xe3, 0x67, 0x51, 0x3b, 0xb2,
-	0x1e, 0x2d, 0xcb, 0x6b, 0x99, 0xef, 0xcd, 0x1b, 0x4d, 0x9c, 0xb1, 0x4d, 0x02, 0xa0, 0x09, 0x8b,
-	0x22, 0x99, 0x0b, 0xc8, 0x89, 0xd3, 0x28, 0xcb, 0xdd, 0x4b, 0xf0, 0x46, 0x8b, 0x5d, 0x78, 0xf7,
-	0x2e, 0x45, 0x24, 0x55, 0xba, 0x14, 0x99, 0x49, 0xe1, 0xc2, 0x45, 0xba, 0x54, 0xf9, 0x1f, 0xe2,
-	0x2a, 0x6d, 0x26, 0x4d, 0x0a, 0x8f, 0x53, 0xa5, 0xc9, 0x1f, 0x90, 0x26, 0x93, 0x22, 0x4d, 0xe6,
-	0x7e, 0xed, 0x17, 0x40, 0x59, 0x72, 0x26, 0x93, 0x8a, 0x7b, 0x7e, 0xe7, 0xdc, 0xbb, 0x77, 0xcf,
-	0x3d, 0xdf, 0x20, 0xb4, 0x6d, 0xdf, 0x63, 0x81, 0x65, 0xb3, 0xd0, 0x9c, 0x07, 0x3e, 0xf3, 0x7b,
-	0xc8, 0xf6, 0x23, 0x8f, 0x05, 0x0b, 0xdb, 0x77, 0x88, 0xc6, 0x6e, 0x4e, 0x7d, 0x7f, 0xea, 0x92,
-	0x77, 0x04, 0x75, 0x1a, 0x9d, 0xbd, 0xc3, 0xe8, 0x8c, 0x84, 0xcc, 0x9a, 0xcd, 0xa5, 0x80, 0xf1,
-	0xa7, 0x32, 0x6c, 0x60, 0x6a, 0x5b, 0x81, 0x43, 0x2d, 0xaf, 0xaf, 0x76, 0x44, 0xf7, 0x61, 0xfd,
-	0x82, 0x78, 0x8e, 0x1f, 0x1c, 0xd2, 0x90, 0x51, 0x6f, 0x1a, 0x76, 0x0b, 0x5b, 0xa5, 0xed, 0xe6,
-	0x4e, 0xdd, 0x54, 0x00, 0xce, 0xf1, 0xd1, 0x1d, 0x80, 0xd3, 0x68, 0x41, 0x82, 0xe3, 0xc0, 0x21,
-	0x41, 0xb7, 0xb8, 0x55, 0xd8, 0x6e, 0xee, 0x54, 0x4d, 0x41, 0xe1, 0x14, 0x07, 0x1d, 0xc2, 0xab,
-	0x72, 0xa5, 0x20, 0xfb, 0xbe, 0x77, 0x46, 0x83, 0x99, 0xc5, 0xa8, 0xef, 0x75, 0x4b, 0x62, 0x11,
-	0x32, 0x97, 0x38, 0xf8, 0xaa, 0x25, 0x68, 0x04, 0x37, 0x52, 0xac, 0xfd, 0xc8, 0x3d, 0xa3, 0xae,
-	0x3b, 0x23, 0x1e, 0xeb, 0x96, 0xc5, 0x79, 0x37, 0xcc, 0x3c, 0x03, 0x5f, 0xb1, 0x00, 0x0d, 0x60,
-	0x33, 0x39, 0x66, 0xdf, 0x9f, 0xcd, 0x5d, 0x22, 0x4e, 0x55, 0x11, 0xa7, 0xea, 0x98, 0x39, 0x1c,
-	0xaf, 0x94, 0x46, 0x06, 0xd4, 0x1c, 0x1a, 0xce, 0x23, 0x46, 0xba, 0x55, 0xb1, 0xb0, 0x6e, 0x0e,
-	0x24, 0x8d, 0x35, 0x03, 0x7d, 0x08, 0x1b, 0xea, 0x11, 0x93, 0xd0, 0x77, 0x23, 0xf1, 0x9a, 0x9a,
-	0xfa, 0xf8, 0x41, 0x9e, 0x83, 0x97, 0x85, 0x53, 0x3b, 0xec, 0xda, 0x36, 0x99, 0x33, 0xcb, 0xb3,
-	0x49, 0xb7, 0x9e, 0xdd, 0x21, 0xe1, 0xe0, 0x65, 0x61, 0x74, 0x13, 0xaa, 0x01, 0x39, 0x8b, 0x3c,
-	0xa7, 0xdb, 0x10, 0xcb, 0x6a, 0x26, 0x16, 0x24, 0x56, 0x30, 0xba, 0x0b, 0x10, 0xd2, 0xa9, 0x67,
-	0xb1, 0x28, 0x20, 0x61, 0x17, 0x84, 0x36, 0xc1, 0x1c, 0x6b, 0x08, 0xa7, 0xb8, 0xe8, 0x06, 0x54,
-	0x49, 0x10, 0xf8, 0x41, 0xd8, 0x6d, 0x6e, 0x95, 0xb6, 0x1b, 0x58, 0x51, 0xc6, 0x57, 0x9b, 0x50,
-	0x53, 0x06, 0x82, 0x10, 0x94, 0x43, 0x37, 0x9a, 0x76, 0x0b, 0x5b, 0x85, 0xed, 0x06, 0x16, 0xcf,
-	0xe8, 0x26, 0xd4, 0xe5, 0x65, 0x8c, 0x06, 0xca, 0x62, 0x4a, 0xe6, 0x68, 0x80, 0x63, 0x10, 0xbd,
-	0x0d, 0xf5, 0x19, 0x61, 0x96, 0x63, 0x31, 0x4b, 0x59, 0xc7, 0x86, 0x36, 0x40, 0xf3, 0x91, 0x62,
-	0xe0, 0x58, 0x04, 0xdd, 0x82, 0x32, 0x65, 0x64, 0xd6, 0x2d, 0x0b, 0xd1, 0xb5, 0x58, 0x74, 0xc4,
-	0xc8, 0x0c, 0x0b, 0x16, 0xda, 0x85, 0x76, 0x78, 0x4e, 0xe7, 0x73, 0xea, 0x4d, 0x8f, 0xe7, 0x5c,
-	0x97, 0x61, 0xb7, 0x22, 0xbe, 0xed, 0xd5, 0x58, 0x7a, 0x9c, 0xe1, 0xe3, 0xbc, 0x3c, 0x32, 0xa0,
-	0xc2, 0xac, 0x4b, 0x12, 0x76, 0xab, 0x62, 0x61, 0x2b, 0x5e, 0x38, 0xb1, 0x2e, 0xb1, 0x64, 0xa1,
-	0x37, 0xa1, 0x66, 0xfb, 0xd1, 0x9c, 0x6f, 0x5f, 0x13, 0x52, 0xed, 0x58, 0xaa, 0x2f, 0x70, 0xac,
-	0xf9, 0xe8, 0x75, 0x80, 0x99, 0xef, 0x90, 0xc0, 0x62, 0x5c, 0x81, 0x75, 0xa1, 0xc0, 0x14, 0x82,
-	0x4c, 0x40, 0x8c, 0x04, 0xb3, 0x70, 0xd7, 0x73, 0xfa, 0xbe, 0xe7, 0x50, 0x79, 0xe8, 0x86, 0x50,
-	0xe3, 0x0a, 0x0e, 0x32, 0xa0, 0x25, 0xaf, 0xf0, 0xc4, 0x77, 0xa9, 0xbd, 0xe8, 0x82, 0x90, 0xcc,
-	0x60, 0xbd, 0xbf, 0x95, 0xa1, 0xae, 0xf5, 0x87, 0xba, 0x50, 0xbb, 0x20, 0x41, 0xc8, 0x8d, 0x90,
-	0x5f, 0xce, 0x1a, 0xd6, 0x24, 0xda, 0x83, 0x96, 0x8e, 0x31, 0x93, 0xc5, 0x9c, 0x88, 0x3b, 0x5a,
-	0xdf, 0x79, 0x7d, 0xe9, 0x0a, 0xcc, 0x7e, 0x4a, 0x0a, 0x67, 0xd6, 0xa0, 0xfb, 0x50, 0x3d, 0xf3,
-	0xb9, 0xbb, 0x8a, 0x0b, 0x5c, 0xdf, 0xe9, 0x2e, 0xaf, 0xde, 0x17, 0x7c, 0xac, 0xe4, 0xd0, 0x0e,
-	0x54, 0xc9, 0xe5, 0x9c, 0x06, 0x0b, 0x75, 0x8f, 0x3d, 0x53, 0xc6, 0x30, 0x53, 0xc7, 0x30, 0x73,
-	0xa2, 0x63, 0x18, 0x56, 0x92, 0x5c, 0x49, 0x96, 0x30, 0x6e, 0xe2, 0xf4, 0xa3, 0x20, 0x20, 0x9e,
-	0x4d, 0x89, 0xbc, 0xd9, 0x06, 0x5e, 0xc1, 0x41, 0xdb, 0xd0, 0x9e, 0x07, 0xd4, 0xa6, 0xde, 0x54,
-	0x81, 0x0b, 0xe1, 0xae, 0x0d, 0x9c, 0x87, 0x51, 0x0f, 0xea, 0xae, 0xe5, 0x4d, 0x23, 0x6b, 0x4a,
-	0x84, 0x8f, 0x36, 0x70, 0x4c, 0xf3, 0xb7, 0x92, 0xd0, 0x0e, 0xfc, 0x67, 0xfc, 0x40, 0x7e, 0xc4,
-	0x0e, 0xfc, 0x48, 0x5c, 0x21, 0x57, 0xe2, 0x0a, 0x0e, 0xdf, 0xcb, 0xf6, 0xa9, 0x27, 0x74, 0x29,
-	0x2f, 0x30, 0xa6, 0xd1, 0x5d, 0xe8, 0xf0, 0xe7, 0x01, 0xbd, 0xa0, 0x21, 0x3d, 0xa5, 0x2e, 0x65,
-	0xf2, 0xea, 0xd6, 0xf0, 0x12, 0x8e, 0x6e, 0xc3, 0x1a, 0x3f, 0x26, 0x79, 0xe4, 0x3b, 0xf4, 0x8c,
-	0x92, 0xa0, 0xdb, 0xdc, 0x2a, 0x6c, 0x17, 0x71, 0x16, 0x34, 0x1c, 0x68, 0xa5, 0xef, 0x05, 0x6d,
-	0xc0, 0xda, 0xc9, 0xc1, 0xa7, 0xe3, 0x51, 0x7f, 0xf7, 0xf0, 0xc9, 0x47, 0xc7, 0xc7, 0x83, 0xce,
-	0x35, 0xd4, 0x81, 0xd6, 0x60, 0xf4, 0xd1, 0x68, 0xa2, 0x91, 0x02, 0x6a, 0x42, 0x6d, 0x3c, 0xc4,
-	0x9f, 0x8c, 0xfa, 0xc3, 0x4e, 0x11, 0xad, 0x03, 0xf4, 0xf1, 0xf1, 0xf7, 0x07, 0x4f, 0xf6, 0x1f,
-	0x1f, 0x0d, 0x3a, 0x25, 0x84, 0x60, 0xbd, 0x8f, 0x3f, 0x3d, 0x99, 0x1c, 0xf7, 0x1f, 0x63, 0x3c,
-	0x3c, 0xea, 0x7f, 0xda, 0x29, 0x1b, 0x6f, 0x41, 0x55, 0xde, 0x1f, 0x6a, 0x43, 0x73, 0x7f, 0xf4,
-	0x83, 0xe1, 0xe0, 0xc9, 0x09, 0xe6, 0xcb, 0xc5, 0xee, 0x8f, 0x76, 0xf1, 0xc3, 0xe1, 0x44, 0x21,
-	0xc5, 0xde, 0x9f, 0xab, 0x50, 0xe6, 0xce, 0x88, 0x36, 0xa1, 0xc2, 0x28, 0x73, 0x89, 0x0a, 0x07,
-	0x92, 0x40, 0x5b, 0xd0, 0x74, 0xb8, 0xda, 0xa8, 0xf0, 0x34, 0x61, 0x6e, 0x0d, 0x9c, 0x86, 0xd0,
-	0x1d, 0x58, 0x9f, 0x07, 0xbe, 0x4d, 0xc2, 0x90, 0x7a, 0x53, 0xae, 0x5b, 0x61, 0x55, 0x0d, 0x9c,
-	0x43, 0xf9, 0xfe, 0x42, 0x19, 0xc2, 0x84, 0xca, 0x58, 0x12, 0x3c, 0x06, 0x79, 0xe1, 0xd9, 0x33,
-	0x11, 0xd2, 0xeb, 0x58, 0x3c, 0x73, 0x8c, 0x59, 0x53, 0xe9, 0xcc, 0x0d, 0x2c, 0x9e, 0xd1, 0x5b,
-	0x50, 0xa5, 0x33, 0x6b, 0x4a, 0xb4, 0xf3, 0x5e, 0xcf, 0x44, 0x12, 0x73, 0xc4, 0x79, 0x58, 0x89,
-	0x70, 0xff, 0xb5, 0x2d, 0x46, 0xa6, 0x7e, 0xc0, 0x4d, 0x4e, 0xf9, 0x6f, 0x82, 0xf0, 0xa3, 0x4c,
-	0x03, 0x6b, 0x26, 0x5d, 0xb6, 0x88, 0x25, 0x81, 0x5e, 0x83, 0x86, 0xad, 0x7d, 0x56, 0xb9, 0x68,
-	0x02, 0x20, 0x13, 0x6a, 0xbe, 0x8a, 0x4e, 0x4d, 0x71, 0x82, 0xcd, 0xec, 0x09, 0x54, 0x68, 0xd2,
-	0x42, 0xe8, 0x0d, 0x28, 0x87, 0x4f, 0xa3, 0xb0, 0xdb, 0x52, 0x49, 0x2f, 0x23, 0x3c, 0x7e, 0x1a,
-	0x61, 0xc1, 0xee, 0xfd, 0xae, 0x00, 0x55, 0xb9, 0x54, 0xa8, 0xc2, 0x9a, 0x69, 0xfd, 0x8b, 0xe7,
-	0x17, 0x50, 0xff, 0x03, 0xa8, 0x5f, 0x58, 0x01, 0xb5, 0x3c, 0x16, 0x76, 0x4b, 0xe2, 0x5d, 0xaf,
-	0xad, 0x3a, 0x98, 0xf9, 0x89, 0x14, 0xc2, 0xb1, 0x74, 0xef, 0x00, 0x6a, 0x0a, 0x5c, 0xf9, 0xea,
-	0x37, 0xa1, 0x22, 0xd4, 0xa9, 0xd2, 0xc0, 0x4a, 0x85, 0x4b, 0x89, 0xde, 0xcf, 0x0a, 0x50, 0x1a,
-	0x3f, 0x8d, 0x78, 0x9c, 0x53, 0xbb, 0xf7, 0xfd, 0xd9, 0xa9, 0x2f, 0x0a, 0x94, 0x35, 0x9c, 0xc1,
-	0xb8, 0x96, 0xe7, 0x81, 0xef, 0x44, 0x36, 0x53, 0x19, 0xa6, 0x81, 0x13, 0x80, 0x73, 0xc3, 0x28,
-	0xb0, 0xcf, 0xad, 0x60, 0x2a, 0xed, 0xa8, 0x84, 0x13, 0x80, 0x3b, 0xeb, 0x67, 0x91, 0xe5, 0x31,
-	0xee, 0x88, 0x65, 0xc1, 0x8c, 0xe9, 0xde, 0x17, 0x05, 0xa8, 0x88, 0x43, 0x71, 0xa9, 0x33, 0xea,
-	0x92, 0xd4, 0x07, 0xc5, 0x34, 0xe7, 0xf9, 0x01, 0x9d, 0x52, 0xcf, 0x72, 0xd5, 0xcb, 0x63, 0x9a,
-	0x5b, 0x85, 0x1b, 0xbf, 0xb7, 0x81, 0x25, 0xc1, 0x13, 0xe9, 0x8c, 0x38, 0x34, 0x92, 0x29, 0xac,
-	0x81, 0x15, 0xc5, 0xa5, 0xc3, 0x99, 0xe5, 0xba, 0xc2, 0x72, 0x1b, 0x58, 0x12, 0xc2, 0x74, 0xa9,
-	0xa7, 0x23, 0x97, 0x78, 0xee, 0xfd, 0xa2, 0x04, 0xeb, 0xd9, 0x04, 0xb6, 0x52, 0xdf, 0x0f, 0xa0,
-	0xcc, 0x92, 0x88, 0x7e, 0xfb, 0x8a, 0xdc, 0x17, 0x93, 0x22, 0xae, 0x8b, 0x15, 0xe8, 0x0e, 0xd4,
-	0x02, 0x32, 0x15, 0xa6, 0xc9, 0x2d, 0x60, 0x7d, 0xa7, 0xc5, 0x33, 0x1a, 0x2f, 0x3b, 0xfb, 0xbe,
-	0x43, 0xb0, 0x66, 0xa2, 0xf7, 0xa0, 0x1e, 0x92, 0xe0, 0x82, 0xda, 0x44, 0x67, 0xd8, 0x9b, 0x57,
-	0xbe, 0x45, 0xca, 0xe1, 0x78, 0x41, 0xef, 0xf3, 0x02, 0xd4, 0x14, 0xba, 0xf2, 0xf8, 0xb1, 0x7b,
-	0x17, 0xd3, 0xee, 0x7d, 0x0f, 0x36, 0x48, 0xc8, 0xe8, 0xcc, 0x62, 0xc4, 0x19, 0x10, 0x97, 0x5e,
-	0x90, 0x60, 0xa1, 0xf4, 0xbb, 0xcc, 0x40, 0xf7, 0xe1, 0xba, 0xe5, 0x48, 0x7f, 0xb3, 0x5c, 0x6e,
-	0x66, 0x27, 0xa9, 0x80, 0xb1, 0x8a, 0x65, 0xbc, 0x0b, 0xad, 0xb4, 0x42, 0x78, 0x7c, 0x3b, 0x3c,
-	0xe6, 0xd1, 0xf4, 0x64, 0xd4, 0x7f, 0xf8, 0xf8, 0xa4, 0x73, 0x2d, 0x1f, 0x02, 0x0b, 0xbd, 0x5f,
-	0x16, 0xa0, 0x34, 0xb1, 0x2e, 0x79, 0x8e, 0x65, 0xd6, 0xa5, 0x08, 0xfc, 0xf2, 0x3b, 0x34, 0x89,
-	0xee, 0x01, 0x30, 0xeb, 0x12, 0x2b, 0x95, 0x16, 0x57, 0xa8, 0x34, 0xc5, 0xe7, 0x2e, 0xca, 0xac,
-	0x4b, 0x7d, 0x0a, 0xf1, 0x71, 0x75, 0x9c, 0x86, 0x78, 0x38, 0x9a, 0x93, 0xc0, 0x26, 0x1e, 0xe3,
-	0xee, 0x54, 0x16, 0x31, 0x27, 0x85, 0x88, 0x18, 0x20, 0x4b, 0x90, 0x2b, 0x82, 0xf0, 0x26, 0x94,
-	0xcf, 0xad, 0xf0, 0x5c, 0x5a, 0xec, 0xc1, 0x35, 0x2c, 0x28, 0x74, 0x1b, 0x5a, 0x0e, 0x0d, 0x45,
-	0x83, 0xc1, 0x0f, 0x25, 0xd5, 0x7a, 0x70, 0x0d, 0x67, 0x50, 0x74, 0x17, 0xda, 0xea, 0x55, 0x03,
-	0x05, 0x0b, 0x8b, 0x2d, 0x1e, 0x14, 0x70, 0x9e, 0x81, 0xee, 0xa8, 0x24, 0x16, 0x4b, 0x72, 0x33,
-	0x2e, 0x1f, 0x14, 0x70, 0x16, 0xde, 0xab, 0x42, 0x99, 0x37, 0x34, 0x7b, 0x00, 0x75, 0xfd, 0x2e,
-	0xe3, 0xf7, 0x00, 0x15, 0xd9, 0x4e, 0xdc, 0x86, 0x35, 0x59, 0xd9, 0xec, 0x3a, 0x4e, 0x40, 0xc2,
-	0x50, 0x7d, 0x4b, 0x16, 0xe4, 0x9e, 0x2e, 0x81, 0x7d, 0xa2, 0x6d, 0x26, 0x01, 0xd0, 0x5b, 0x50,
-	0x0f, 0xd3, 0x1a, 0xe5, 0xd5, 0x9a, 0xd8, 0x3d, 0x36, 0x54, 0x1c, 0x0b, 0xa0, 0xff, 0x86, 0x9a,
-	0x28, 0xfc, 0x47, 0x03, 0x55, 0x9e, 0x88, 0x92, 0x55, 0x63, 0xe8, 0x01, 0x34, 0xe2, 0x0e, 0x4b,
-	0xb5, 0x0e, 0xcf, 0xab, 0x5f, 0x12, 0x61, 0x74, 0x0b, 0x2a, 0xbc, 0x42, 0xd5, 0x65, 0x65, 0x53,
-	0x1d, 0x41, 0xd4, 0xae, 0x92, 0x83, 0xb6, 0xa1, 0x36, 0xb7, 0x16, 0xa2, 0xbd, 0x91, 0xed, 0xc2,
-	0xba, 0x12, 0x3a, 0x91, 0x28, 0xd6, 0x6c, 0x6e, 0x05, 0x81, 0xc5, 0x7d, 0xed, 0x21, 0x59, 0xc8,
-	0xa4, 0xd4, 0xc2, 0x29, 0x04, 0xed, 0xc0, 0xa6, 0xe5, 0x32, 0x12, 0x78, 0x16, 0x23, 0xbc, 0x48,
-	0xb0, 0x6c, 0x36, 0xf2, 0xce, 0x7c, 0x55, 0x95, 0xac, 0xe4, 0xa5, 0xeb, 0x44, 0xc8, 0xd4, 0x89,
-	0xbd, 0x3f, 0x16, 0xa0, 0x1e, 0x1b, 0xe0, 0x0d, 0xa8, 0x72, 0x65, 0x4d, 0x7c, 0x75, 0x15, 0x8a,
-	0xe2, 0xcb, 0x2d, 0x75, 0x47, 0x32, 0x18, 0x6a, 0x92, 0x7b, 0xb8, 0xcd, 0xa3, 0xac, 0x74, 0x55,
-	0xf1, 0x2c, 0x22, 0x1e, 0xb3, 0x18, 0x51, 0x81, 0x50, 0x12, 0xc2, 0xb8, 0xfd, 0x90, 0x59, 0xae,
-	0xb0, 0x41, 0x19, 0x0c, 0x53, 0x08, 0x0f, 0x4e, 0xaa, 0x07, 0x16, 0xd6, 0xb4, 0x14, 0x9c, 0x14,
-	0x93, 0xe7, 0x0e, 0xf5, 0xf2, 0x23, 0x9f, 0x89, 0x34, 0x2f, 0x6a, 0xe4, 0x34, 0xd6, 0xfb, 0x4d,
-	0x49, 0xd5, 0x2a, 0x5b, 0xd0, 0x74, 0x65, 0xe0, 0x3a, 0xe0, 0x7e, 0x21, 0xbf, 0x2a, 0x0d, 0x65,
-	0x52, 0x45, 0x51, 0xa8, 0x26, 0xa6, 0xf9, 0x91, 0xf5, 0xf3, 0xff, 0xff, 0x9f, 0xa8, 0x0d, 0xcb,
-	0x38, 0x85, 0xa0, 0x7b, 0x49, 0xaa, 0x97, 0x19, 0x15, 0xa5, 0x2e, 0x7e, 0x29, 0xd1, 0xef, 0xc1,
-	0x7a, 0xb6, 0x1d, 0x89, 0x6b, 0xe4, 0xd4, 0xa2, 0x5c, 0x03, 0x93, 0x5b, 0xc1, 0xd5, 0x3d, 0x23,
-	0x33, 0x5f, 0xa9, 0x4f, 0x3c, 0xf3, 0x6f, 0x94, 0xfd, 0x08, 0xd7, 0x93, 0x2e, 0x86, 0xd2, 0x90,
-	0xa8, 0xbc, 0xa4, 0x71, 0x69, 0x4f, 0xab, 0xa9, 0xca, 0x2b, 0x83, 0xf6, 0x76, 0x9e, 0x5b, 0x62,
-	0x6c, 0x42, 0xe5, 0xc2, 0x72, 0x23, 0xa2, 0x4c, 0x40, 0x12, 0xbd, 0xf7, 0x5f, 0x28, 0x67, 0x75,
-	0xa1, 0xa6, 0x12, 0x84, 0x36, 0x20, 0x45, 0xf6, 0xbe, 0x2c, 0x42, 0x4d, 0xb9, 0x00, 0x7a, 0x9b,
-	0xa7, 0x50, 0x76, 0xee, 0x3b, 0x62, 0xed, 0xfa, 0xce, 0x2b, 0x59, 0x17, 0xe1, 0x5d, 0xc7, 0xb9,
-	0xef, 0x60, 0x25, 0xc4, 0x23, 0x43, 0xdc, 0x6b, 0xe9, 0x0a, 0x21, 0x06, 0xb8, 0x2d, 0x5b, 0x33,
-	0x11, 0x9c, 0x4a, 0xe2, 0xe2, 0x14, 0x25, 0xaa, 0xb7, 0x73, 0x8b, 0x7a, 0x3c, 0x30, 0x29, 0x0b,
-	0x4d, 0x80, 0xb4, 0xa5, 0x57, 0xb2, 0x96, 0x2e, 0x7a, 0x33, 0x87, 0x90, 0xd9, 0x58, 0x94, 0x54,
-	0x2a, 0x73, 0x67, 0x30, 0x2e, 0x13, 0x1f, 0xe0, 0x21, 0x59, 0x08, 0x35, 0xb7, 0x70, 0x06, 0x13,
-	0x1e, 0xe3, 0x53, 0x4f, 0x98, 0x13, 0xf7, 0x18, 0x9f, 0x7a, 0xc6, 0x03, 0xa8, 0xca, 0x6f, 0x43,
-	0xd7, 0xa1, 0xbd, 0x3b, 0x18, 0xe0, 0xe1, 0x78, 0xfc, 0x04, 0x0f, 0xbf, 0xf7, 0x78, 0x38, 0x9e,
-	0x74, 0xae, 0x21, 0x80, 0xea, 0x60, 0x84, 0x87, 0xfd, 0x49, 0xa7, 0x80, 0xd6, 0xa0, 0xf1, 0xe8,
-	0x78, 0x30, 0xc4, 0xbb, 0x93, 0xe1, 0xa0, 0x53, 0x34, 0xfe, 0x5e, 0x80, 0x8d, 0xe5, 0xd1, 0x4a,
-	0x17, 0x6a, 0x3e, 0x07, 0x47, 0x03, 0x9d, 0xb2, 0x14, 0x99, 0x8d, 0x71, 0xc5, 0x97, 0x89, 0x71,
-	0xcb, 0x46, 0x54, 0x5a, 0x65, 0x44, 0xbc, 0x3d, 0x0b, 0xc8, 0x67, 0x11, 0x09, 0x19, 0x71, 0x76,
-	0xe5, 0x05, 0xc8, 0xbc, 0x9c, 0x87, 0xd1, 0x77, 0xa1, 0x23, 0xc3, 0xda, 0x38, 0x19, 0x56, 0xc8,
-	0x72, 0xa3, 0x63, 0xe2, 0x2c, 0x03, 0x2f, 0x49, 0x1a, 0x3f, 0x2f, 0x40, 0x53, 0x8e, 0xa8, 0xc8,
-	0x8f, 0x89, 0xcd, 0xfe, 0x2d, 0xdf, 0xcc, 0x6b, 0x73, 0x3a, 0xd5, 0xde, 0xbd, 0x61, 0xee, 0x51,
-	0xc6, 0xef, 0x2b, 0x39, 0x96, 0x60, 0x1b, 0x5f, 0x97, 0xa0, 0x9d, 0x3b, 0x30, 0xfa, 0x30, 0x35,
-	0xfe, 0x28, 0x88, 0x77, 0xde, 0xce, 0x7f, 0x94, 0x39, 0x09, 0x2c, 0x2f, 0xb4, 0x6c, 0x7e, 0x65,
-	0x2b, 0x26, 0x22, 0xbc, 0xc4, 0xd5, 0xa2, 0xe2, 0xd8, 0x2d, 0x9c, 0x00, 0xbd, 0xbf, 0x14, 0xe1,
-	0xfa, 0x8a, 0xf5, 0xa9, 0x88, 0x37, 0x4e, 0x46, 0x36, 0x69, 0x48, 0x24, 0x54, 0x9d, 0x4d, 0xf4,
-	0xbe, 0x31, 0xb0, 0x64, 0xc2, 0xa5, 0x15, 0x26, 0x6c, 0x40, 0x4b, 0x6d, 0x38, 0x11, 0x35, 0x88,
-	0xf4, 0xa2, 0x0c, 0x86, 0x0e, 0xa0, 0xc1, 0xce, 0xa3, 0xd9, 0xa9, 0x67, 0x51, 0x57, 0x25, 0xd3,
-	0xbb, 0x2f, 0xa2, 0x00, 0xd5, 0x30, 0x24, 0x8b, 0x7b, 0x3f, 0xd5, 0xf5, 0xba, 0xae, 0x99, 0x0b,
-	0x49, 0xcd, 0x9c, 0x54, 0xd7, 0xc5, 0x74, 0x75, 0x9d, 0xd4, 0xe2, 0xa5, 0x7c, 0x2d, 0x2e, 0x2b,
-	0xf7, 0x72, 0xba, 0x72, 0x4f, 0xd7, 0xfa, 0x95, 0x6c, 0xad, 0x6f, 0x9c, 0x40, 0x27, 0x7f, 0xe9,
-	0x3c, 0x2d, 0x50, 0x6f, 0x1e, 0xb1, 0x91, 0xe7, 0x90, 0x4b, 0x35, 0x77, 0x49, 0x21, 0xcf, 0xbf,
-	0x38, 0xe3, 0xab, 0x2a, 0x74, 0x96, 0x06, 0x98, 0xb1, 0xf1, 0x3a, 0x59, 0xe3, 0x75, 0xe2, 0xd9,
-	0x5b, 0x31, 0x35, 0x7b, 0xcb, 0x18, 0x74, 0xe9, 0x65, 0x0c, 0xfa, 0x08, 0x3a, 0xf3, 0xf3, 0x45,
-	0x48, 0x6d, 0xcb, 0x8d, 0xab, 0x6c, 0x39, 0x6d, 0x35, 0x96, 0xa6, 0xad, 0xe6, 0x49, 0x4e, 0x12,
-	0x2f, 0xad, 0x45, 0x0f, 0xa1, 0xed, 0xd0, 0x29, 0x65, 0xa9, 0xed, 0xa4, 0x07, 0xdf, 0x5a, 0xde,
-	0x6e, 0x90, 0x15, 0xc4, 0xf9, 0x95, 0xe8, 0x3e, 0x54, 0xe7, 0xd6, 0xc2, 0x8f, 0x98, 0x1a, 0xbf,
-	0x76, 0x57, 0x1c, 0x49, 0xf0, 0xb1, 0x92, 0x43, 0xdf, 0x81, 0x76, 0x2e, 0x2e, 0xa8, 0xe2, 0x6a,
-	0x39, 0x80, 0xe4, 0x05, 0x45, 0x9a, 0xf2, 0x19, 0xd1, 0x71, 0x98, 0x3f, 0xa3, 0x1f, 0xc1, 0x0d,
-	0x3b, 0x58, 0xcc, 0x99, 0x6f, 0xab, 0x11, 0x52, 0xfc, 0x55, 0x0d, 0xf1, 0x55, 0xdb, 0xcb, 0x27,
-	0xea, 0xaf, 0x94, 0xc7, 0x57, 0xec, 0xd3, 0x9b, 0x40, 0x27, 0xaf, 0x56, 0x91, 0x1c, 0x79, 0x0a,
-	0x25, 0x81, 0xbe, 0x7c, 0x45, 0xf2, 0x98, 0xcb, 0x02, 0xcb, 0x7e, 0x4a, 0xbd, 0xe9, 0x51, 0x34,
-	0x3b, 0x25, 0x3a, 0xcd, 0xe5, 0xd0, 0xde, 0x07, 0xd0, 0xce, 0x69, 0x17, 0x75, 0xa0, 0x14, 0x05,
-	0xae, 0xda, 0x90, 0x3f, 0x72, 0x33, 0x9f, 0x5b, 0x61, 0xf8, 0xcc, 0x0f, 0x1c, 0xdd, 0xd2, 0x6a,
-	0xba, 0xf7, 0x3e, 0xdc, 0x58, 0xfd, 0x21, 0xbc, 0x48, 0x67, 0x89, 0x97, 0xc6, 0xc1, 0x35, 0x0b,
-	0xf2, 0xc6, 0xbe, 0x2a, 0xef, 0x26, 0x8e, 0x99, 0x85, 0xe7, 0xc6, 0x4c, 0x31, 0x07, 0x13, 0x0b,
-	0x76, 0x33, 0x85, 0x65, 0x16, 0x44, 0x77, 0xa1, 0x23, 0x81, 0x7d, 0x42, 0x4e, 0x48, 0xb0, 0xb7,
-	0x60, 0x44, 0xa5, 0xf3, 0x25, 0xdc, 0xf8, 0x6d, 0x01, 0xda, 0xf9, 0x91, 0xfe, 0xd5, 0x7e, 0xf5,
-	0xed, 0x93, 0xc2, 0xbb, 0x00, 0xf2, 0xdd, 0xe3, 0xe7, 0xa6, 0x86, 0x94, 0x10, 0xba, 0x05, 0x35,
-	0x69, 0x7e, 0xa1, 0xf2, 0xb6, 0x9a, 0xb2, 0x4f, 0xac, 0x71, 0xe3, 0x0f, 0x65, 0xa8, 0x4a, 0x0c,
-	0xed, 0xe8, 0x06, 0x60, 0x90, 0x24, 0x0f, 0xa4, 0x16, 0xa8, 0x3f, 0x9c, 0x83, 0x53, 0x52, 0xdf,
-	0x90, 0x2c, 0xfe, 0x5a, 0x02, 0xc0, 0x19, 0xe1, 0x24, 0x03, 0x14, 0xf2, 0x19, 0xe0, 0x1b, 0x27,
-	0xfb, 0x26, 0x34, 0xe4, 0xf3, 0x98, 0xea, 0xa6, 0x6b, 0xd9, 0xdf, 0x12, 0x91, 0x6f, 0x6a, 0xbb,
-	0x5e, 0x83, 0x86, 0x78, 0x3c, 0xe2, 0x45, 0xa3, 0x8c, 0xbf, 0x09, 0xc0, 0xad, 0x56, 0x10, 0xfc,
-	0x5d, 0x55, 0x71, 0xd4, 0x98, 0xce, 0xe4, 0x2a, 0xce, 0xcf, 0x97, 0x5b, 0x5c, 0x26, 0x73, 0xcf,
-	0xf5, 0x97, 0xb9, 0x67, 0x6e, 0x3b, 0x17, 0x24, 0xe0, 0xc9, 0xa5, 0x21, 0x7b, 0x26, 0x45, 0x72,
-	0xce, 0x67, 0x91, 0x95, 0x1a, 0xf3, 0x6a, 0x32, 0x3f, 0x86, 0x6b, 0x0a, 0x6e, 0x66, 0x0c, 0x77,
-	0x1b, 0xd6, 0x1c, 0xe5, 0x5b, 0xe3, 0x39, 0x21, 0x4e, 0xb7, 0x25, 0x64, 0xb2, 0x20, 0x2f, 0xa2,
-	0xec, 0x28, 0x64, 0xfe, 0x8c, 0x04, 0x6a, 0x96, 0xd2, 0x5d, 0x13, 0x72, 0x79, 0x98, 0xa7, 0xba,
-	0x80, 0x5c, 0x50, 0xf2, 0xac, 0xbb, 0x2e, 0x53, 0x9d, 0xa4, 0x8c, 0xaf, 0x0b, 0x50, 0x53, 0xbf,
-	0x26, 0x65, 0x75, 0x50, 0x78, 0x19, 0x1d, 0x6c, 0x42, 0xc5, 0x76, 0x2d, 0x3a, 0xd3, 0xe9, 0x55,
-	0x10, 0xcb, 0xbe, 0x5b, 0x5a, 0xe5, 0xbb, 0xff, 0x03, 0x0d, 0x3f, 0x62, 0x73, 0x9f, 0x7a, 0x4c,
-	0x9b, 0x7d, 0xc3, 0x3c, 0x56, 0x08, 0x4e, 0x78, 0xc8, 0x04, 0x14, 0x92, 0x80, 0x5a, 0x2e, 0xfd,
-	0x09, 0x71, 0xf4, 0xd8, 0x5b, 0x58, 0x42, 0x0b, 0xaf, 0xe0, 0x18, 0xbf, 0xae, 0xc0, 0xc6, 0xd2,
-	0x4f, 0x6d, 0xff, 0xc2, 0x47, 0xa6, 0x82, 0x44, 0x31, 0x1b, 0x24, 0x78, 0xcf, 0x1a, 0xf8, 0x73,
-	0x3f, 0x24, 0xce, 0x9e, 0xee, 0x71, 0x53, 0x88, 0x68, 0xd5, 0x93, 0x9f, 0x01, 0x65, 0x51, 0x91,
-	0x42, 0xd0, 0xbb, 0x71, 0x46, 0x93, 0x15, 0xd0, 0x7f, 0x2d, 0xff, 0x44, 0x98, 0x4f, 0x69, 0xf7,
-	0xe1, 0x7a, 0x6c, 0xbf, 0xb1, 0x4f, 0xc9, 0xae, 0xae, 0x85, 0x57, 0xb1, 0x7a, 0x9f, 0x97, 0x5e,
-	0x36, 0xf6, 0xde, 0x82, 0xaa, 0x28, 0x57, 0xe4, 0xcc, 0x2a, 0x73, 0x2d, 0x8a, 0x81, 0xf6, 0xa0,
-	0x29, 0x7f, 0x23, 0x8d, 0xd8, 0x3c, 0x62, 0xca, 0xcb, 0xb7, 0xae, 0x3c, 0xbe, 0x29, 0xe5, 0x70,
-	0x7a, 0x11, 0x1a, 0x40, 0x4b, 0xfd, 0x5e, 0x2b, 0x37, 0x29, 0xbf, 0xe0, 0x26, 0x99, 0x55, 0xe8,
-	0x63, 0x68, 0xc7, 0x5f, 0xad, 0x36, 0xaa, 0xbc, 0xe0, 0x46, 0xf9, 0x85, 0x3d, 0x0a, 0x55, 0xb5,
-	0x6b, 0x17, 0xaa, 0xd2, 0x27, 0x65, 0x5e, 0x38, 0xb8, 0x86, 0x15, 0x8d, 0x7a, 0x49, 0x07, 0xa8,
-	0x07, 0x65, 0x71, 0x0f, 0x98, 0xf4, 0x94, 0xc5, 0x74, 0x4f, 0xb9, 0xb7, 0x01, 0x6d, 0xb9, 0xfa,
-	0x38, 0x50, 0xd6, 0x6f, 0xd0, 0xd8, 0x46, 0x53, 0xbf, 0xdc, 0x7e, 0x7b, 0x1b, 0xed, 0x41, 0xdd,
-	0x76, 0x95, 0x1d, 0xaa, 0xe4, 0xad, 0x69, 0xe3, 0x63, 0xa8, 0xeb, 0xfb, 0xe3, 0x55, 0xcd, 0x79,
-	0x32, 0xe9, 0x90, 0xf3, 0xbf, 0x4d, 0xa8, 0x50, 0x51, 0xaa, 0xca, 0xf9, 0x86, 0x24, 0x92, 0x76,
-	0x5e, 0xe6, 0x53, 0x49, 0x18, 0xbf, 0x2a, 0x42, 0x55, 0xfe, 0x9a, 0xfc, 0x1f, 0x6c, 0xa8, 0xd0,
-	0x10, 0x36, 0xe4, 0x88, 0x2f, 0xd5, 0x20, 0x28, 0xf3, 0x79, 0x55, 0xfd, 0xd8, 0x9d, 0xee, 0x1d,
-	0x46, 0xde, 0x99, 0x8f, 0x97, 0x57, 0xac, 0x9a, 0x96, 0xf4, 0xde, 0x83, 0x76, 0x6e, 0xa5, 0xe8,
-	0x2b, 0x2e, 0xa9, 0x13, 0xf7, 0x15, 0x97, 0xd4, 0xc9, 0x0e, 0x3b, 0x62, 0xed, 0xec, 0xc0, 0x8d,
-	0x4f, 0x84, 0x6d, 0xee, 0xf3, 0xe6, 0x80, 0x07, 0x25, 0x3d, 0xba, 0xb8, 0x52, 0x59, 0xc6, 0x97,
-	0x05, 0x28, 0x8e, 0x06, 0xdc, 0x74, 0xe6, 0x24, 0xc5, 0x57, 0x14, 0xc7, 0xcf, 0x2d, 0xcf, 0x71,
-	0xf5, 0x60, 0x44, 0x51, 0xe8, 0x0d, 0xa8, 0xcd, 0xa3, 0xd3, 0xa7, 0x64, 0x11, 0x2a, 0xe7, 0x6b,
-	0x9a, 0xa3, 0x81, 0x79, 0x22, 0x21, 0xac, 0x79, 0x3c, 0x02, 0x9d, 0xc6, 0x3a, 0x14, 0x2a, 0x6a,
-	0xe1, 0x14, 0xd2, 0xfb, 0x00, 0x6a, 0x6a, 0x0d, 0x37, 0x21, 0xea, 0x10, 0x39, 0xe9, 0x92, 0x49,
-	0x3f, 0xa6, 0xf9, 0xf1, 0xd5, 0x22, 0x55, 0x3c, 0x68, 0xd2, 0xf8, 0x47, 0x01, 0x1a, 0x49, 0xd1,
-	0x7c, 0x0f, 0x6a, 0x21, 0x91, 0xd7, 0x21, 0x47, 0x34, 0x28, 0xf9, 0xb7, 0x02, 0x73, 0x2c, 0x39,
-	0x58, 0x8b, 0xf0, 0xf2, 0x35, 0xae, 0x41, 0x78, 0x89, 0x16, 0xaa, 0xcd, 0x73, 0xa8, 0xf1, 0x85,
-	0xf8, 0xc9, 0x40, 0xae, 0x69, 0x42, 0xed, 0x70, 0x34, 0x9e, 0x8c, 0x8e, 0x3e, 0xea, 0x5c, 0x43,
-	0x0d, 0xa8, 0x1c, 0xe3, 0xc1, 0x10, 0x77, 0x0a, 0xe8, 0x06, 0x20, 0xf1, 0xf8, 0xa4, 0x7f, 0x7c,
-	0xb4, 0x3f, 0xc2, 0x8f, 0x76, 0x27, 0xa3, 0xe3, 0xa3, 0x4e, 0x11, 0xbd, 0x02, 0x1b, 0x12, 0xdf,
-	0x7f, 0x7c, 0xb8, 0x3f, 0x3a, 0x3c, 0x7c, 0x34, 0x3c, 0x9a, 0x74, 0x4a, 0x68, 0x13, 0x3a, 0x5a,
-	0xfc, 0xd1, 0xc9, 0xe1, 0x50, 0x08, 0x97, 0xf9, 0xe6, 0x83, 0xd1, 0xf8, 0xe4, 0xf1, 0x64, 0xd8,
-	0xa9, 0xf0, 0x1d, 0x15, 0xf1, 0x04, 0x0f, 0xc7, 0xc7, 0x87, 0x8f, 0x85, 0x50, 0x15, 0x01, 0x54,
-	0xf1, 0x50, 0xfc, 0x6a, 0x5a, 0x33, 0x08, 0xac, 0xf1, 0xef, 0x23, 0x8e, 0xfe, 0x57, 0x08, 0x03,
-	0x6a, 0xaa, 0xcd, 0x55, 0xfe, 0x9b, 0xfc, 0x57, 0x8d, 0x66, 0xc4, 0x3e, 0x58, 0x4c, 0xf9, 0x60,
-	0xa6, 0x3e, 0x2b, 0xe5, 0xea, 0xb3, 0xbd, 0xf2, 0x0f, 0x8b, 0xf3, 0xd3, 0xd3, 0xaa, 0xf0, 0x9d,
-	0xff, 0xfd, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0xeb, 0xf0, 0xe7, 0x1d, 0x24, 0x00, 0x00,
+func init() { proto.RegisterFile("contracts.proto", fileDescriptor_contracts_7f50661d59cf12ac) }
+
+var fileDescriptor_contracts_7f50661d59cf12ac = []byte{
+	// 3433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x3b, 0x73, 0x23, 0xc7,
+	0xb5, 0xde, 0xc1, 0x1b, 0x07, 0x20, 0x09, 0xf6, 0x52, 0xab, 0xb9, 0x28, 0x5d, 0x2d, 0x17, 0xb5,
+	0xda, 0x4b, 0xad, 0x56, 0xa3, 0x15, 0xef, 0x2d, 0xdd, 0x2d, 0xcb, 0x25, 0x89, 0x04, 0x40, 0x11,
+	0x5a, 0x2e, 0x09, 0x35, 0xb0, 0xb2, 0xe5, 0x64, 0x3d, 0x9c, 0x69, 0x82, 0xed, 0x05, 0x66, 0xa0,
+	0x99, 0x1e, 0x2e, 0x69, 0x47, 0xce, 0x1c, 0xb8, 0xca, 0x81, 0xaa, 0xac, 0xc0, 0xe5, 0xc4, 0x91,
+	0x63, 0x87, 0xb6, 0x22, 0xa7, 0x2e, 0x27, 0x0e, 0x5c, 0x72, 0xee, 0x1f, 0xe0, 0xc4, 0xe5, 0xc0,
+	0x0e, 0x5c, 0xfd, 0x9a, 0x17, 0xc0, 0x7d, 0xc8, 0xe5, 0x72, 0x36, 0xe7, 0x3b, 0xa7, 0x7b, 0xba,
+	0x4f, 0x9f, 0x67, 0xcf, 0xc0, 0x9a, 0xe3, 0x7b, 0x2c, 0xb0, 0x1d, 0x16, 0x5a, 0xf3, 0xc0, 0x67,
+	0x7e, 0x1b, 0x39, 0x7e, 0xe4, 0xb1, 0xe0, 0xc2, 0xf1, 0x5d, 0xa2, 0xb1, 0xeb, 0x13, 0xdf, 0x9f,
+	0x4c, 0xc9, 0x5b, 0x82, 0x3a, 0x8e, 0x4e, 0xde, 0x62, 0x74, 0x46, 0x42, 0x66, 0xcf, 0xe6, 0x52,
+	0xa0, 0xf3, 0xa7, 0x12, 0xac, 0x63, 0xea, 0xd8, 0x81, 0x4b, 0x6d, 0xaf, 0xab, 0x66, 0x44, 0x77,
+	0x61, 0xf5, 0x8c, 0x78, 0xae, 0x1f, 0x1c, 0xd0, 0x90, 0x51, 0x6f, 0x12, 0x9a, 0xc6, 0x66, 0x71,
+	0xab, 0xb1, 0x5d, 0xb3, 0x14, 0x80, 0x73, 0x7c, 0x74, 0x0b, 0xe0, 0x38, 0xba, 0x20, 0xc1, 0x51,
+	0xe0, 0x92, 0xc0, 0x2c, 0x6c, 0x1a, 0x5b, 0x8d, 0xed, 0x8a, 0x25, 0x28, 0x9c, 0xe2, 0xa0, 0x03,
+	0x78, 0x59, 0x8e, 0x14, 0x64, 0xd7, 0xf7, 0x4e, 0x68, 0x30, 0xb3, 0x19, 0xf5, 0x3d, 0xb3, 0x28,
+	0x06, 0x21, 0x6b, 0x81, 0x83, 0x2f, 0x1b, 0x82, 0x06, 0x70, 0x2d, 0xc5, 0xda, 0x8b, 0xa6, 0x27,
+	0x74, 0x3a, 0x9d, 0x11, 0x8f, 0x99, 0x25, 0xb1, 0xde, 0x75, 0x2b, 0xcf, 0xc0, 0x97, 0x0c, 0x40,
+	0x3d, 0xd8, 0x48, 0x96, 0xd9, 0xf5, 0x67, 0xf3, 0x29, 0x11, 0xab, 0x2a, 0x8b, 0x55, 0xb5, 0xac,
+	0x1c, 0x8e, 0x97, 0x4a, 0xa3, 0x0e, 0x54, 0x5d, 0x1a, 0xce, 0x23, 0x46, 0xcc, 0x8a, 0x18, 0x58,
+	0xb3, 0x7a, 0x92, 0xc6, 0x9a, 0x81, 0x3e, 0x80, 0x75, 0xf5, 0x88, 0x49, 0xe8, 0x4f, 0x23, 0xf1,
+	0x9a, 0xaa, 0xda, 0x7c, 0x2f, 0xcf, 0xc1, 0x8b, 0xc2, 0xa9, 0x19, 0x76, 0x1c, 0x87, 0xcc, 0x99,
+	0xed, 0x39, 0xc4, 0xac, 0x65, 0x67, 0x48, 0x38, 0x78, 0x51, 0x18, 0x5d, 0x87, 0x4a, 0x40, 0x4e,
+	0x22, 0xcf, 0x35, 0xeb, 0x62, 0x58, 0xd5, 0xc2, 0x82, 0xc4, 0x0a, 0x46, 0xb7, 0x01, 0x42, 0x3a,
+	0xf1, 0x6c, 0x16, 0x05, 0x24, 0x34, 0x41, 0x68, 0x13, 0xac, 0x91, 0x86, 0x70, 0x8a, 0x8b, 0xae,
+	0x41, 0x85, 0x04, 0x81, 0x1f, 0x84, 0x66, 0x63, 0xb3, 0xb8, 0x55, 0xc7, 0x8a, 0xea, 0xfc, 0xc3,
+	0x84, 0xaa, 0x32, 0x10, 0x84, 0xa0, 0x14, 0x4e, 0xa3, 0x89, 0x69, 0x6c, 0x1a, 0x5b, 0x75, 0x2c,
+	0x9e, 0xd1, 0x75, 0xa8, 0xc9, 0xc3, 0x18, 0xf4, 0x94, 0xc5, 0x14, 0xad, 0x41, 0x0f, 0xc7, 0x20,
+	0x7a, 0x13, 0x6a, 0x33, 0xc2, 0x6c, 0xd7, 0x66, 0xb6, 0xb2, 0x8e, 0x75, 0x6d, 0x80, 0xd6, 0x03,
+	0xc5, 0xc0, 0xb1, 0x08, 0xba, 0x01, 0x25, 0xca, 0xc8, 0xcc, 0x2c, 0x09, 0xd1, 0x95, 0x58, 0x74,
+	0xc0, 0xc8, 0x0c, 0x0b, 0x16, 0xda, 0x81, 0xb5, 0xf0, 0x94, 0xce, 0xe7, 0xd4, 0x9b, 0x1c, 0xcd,
+	0xb9, 0x2e, 0x43, 0xb3, 0x2c, 0xf6, 0xf6, 0x72, 0x2c, 0x3d, 0xca, 0xf0, 0x71, 0x5e, 0x1e, 0x75,
+	0xa0, 0xcc, 0xec, 0x73, 0x12, 0x9a, 0x15, 0x31, 0xb0, 0x19, 0x0f, 0x1c, 0xdb, 0xe7, 0x58, 0xb2,
+	0xd0, 0xeb, 0x50, 0x75, 0xfc, 0x68, 0xce, 0xa7, 0xaf, 0x0a, 0xa9, 0xb5, 0x58, 0xaa, 0x2b, 0x70,
+	0xac, 0xf9, 0xe8, 0x55, 0x80, 0x99, 0xef, 0x92, 0xc0, 0x66, 0x5c, 0x81, 0x35, 0xa1, 0xc0, 0x14,
+	0x82, 0x2c, 0x40, 0x8c, 0x04, 0xb3, 0x70, 0xc7, 0x73, 0xbb, 0xbe, 0xe7, 0x52, 0xb9, 0xe8, 0xba,
+	0x50, 0xe3, 0x12, 0x0e, 0xea, 0x40, 0x53, 0x1e, 0xe1, 0xd0, 0x9f, 0x52, 0xe7, 0xc2, 0x04, 0x21,
+	0x99, 0xc1, 0xd0, 0x3d, 0x68, 0xb2, 0xc0, 0xf6, 0xc2, 0xa9, 0x2d, 0x67, 0x6b, 0x88, 0x35, 0x6e,
+	0x24, 0x3b, 0x49, 0x98, 0x38, 0x23, 0xd9, 0xfe, 0x6b, 0x09, 0x6a, 0x5a, 0xf3, 0xc8, 0x84, 0xea,
+	0x19, 0x09, 0x42, 0x6e, 0xbe, 0xfc, 0x58, 0x57, 0xb0, 0x26, 0xd1, 0x2e, 0x34, 0x75, 0x74, 0x1a,
+	0x5f, 0xcc, 0x89, 0x38, 0xdd, 0xd5, 0xed, 0x57, 0x17, 0x0e, 0xcf, 0xea, 0xa6, 0xa4, 0x70, 0x66,
+	0x0c, 0xba, 0x0b, 0x95, 0x13, 0x9f, 0x3b, 0xba, 0x38, 0xfa, 0xd5, 0x6d, 0x73, 0x71, 0xf4, 0x9e,
+	0xe0, 0x63, 0x25, 0x87, 0xb6, 0xa1, 0x42, 0xce, 0xe7, 0x34, 0xb8, 0x50, 0x16, 0xd0, 0xb6, 0x64,
+	0xf4, 0xb3, 0x74, 0xf4, 0xb3, 0xc6, 0x3a, 0xfa, 0x61, 0x25, 0xc9, 0xd5, 0x6b, 0x0b, 0xb7, 0x20,
+	0x6e, 0x37, 0x0a, 0x02, 0xe2, 0x39, 0x94, 0x48, 0x9b, 0xa8, 0xe3, 0x25, 0x1c, 0xb4, 0x05, 0x6b,
+	0xf3, 0x80, 0x3a, 0xd4, 0x9b, 0x28, 0xf0, 0x42, 0x38, 0x7a, 0x1d, 0xe7, 0x61, 0xd4, 0x86, 0xda,
+	0xd4, 0xf6, 0x26, 0x91, 0x3d, 0x21, 0xc2, 0xbb, 0xeb, 0x38, 0xa6, 0xf9, 0x5b, 0x49, 0xe8, 0x04,
+	0xfe, 0x13, 0xbe, 0x20, 0x3f, 0x62, 0xfb, 0x7e, 0x24, 0x0e, 0x9f, 0x2b, 0x71, 0x09, 0x87, 0xcf,
+	0xe5, 0xf8, 0xd4, 0x13, 0xba, 0x94, 0x47, 0x1f, 0xd3, 0xe8, 0x36, 0xb4, 0xf8, 0x73, 0x8f, 0x9e,
+	0xd1, 0x90, 0x1e, 0xd3, 0x29, 0x65, 0xf2, 0xd0, 0x57, 0xf0, 0x02, 0x8e, 0x6e, 0xc2, 0x0a, 0x5f,
+	0x26, 0x79, 0xe0, 0xbb, 0xf4, 0x84, 0x92, 0xc0, 0x6c, 0x6c, 0x1a, 0x5b, 0x05, 0x9c, 0x05, 0x3b,
+	0x2e, 0x34, 0xd3, 0xe7, 0x82, 0xd6, 0x61, 0x65, 0xb8, 0xff, 0xe9, 0x68, 0xd0, 0xdd, 0x39, 0x78,
+	0xf4, 0xe1, 0xd1, 0x51, 0xaf, 0x75, 0x05, 0xb5, 0xa0, 0xd9, 0x1b, 0x7c, 0x38, 0x18, 0x6b, 0xc4,
+	0x40, 0x0d, 0xa8, 0x8e, 0xfa, 0xf8, 0x93, 0x41, 0xb7, 0xdf, 0x2a, 0xa0, 0x55, 0x80, 0x2e, 0x3e,
+	0xfa, 0x56, 0xef, 0xd1, 0xde, 0xc3, 0xc3, 0x5e, 0xab, 0x88, 0x10, 0xac, 0x76, 0xf1, 0xa7, 0xc3,
+	0xf1, 0x51, 0xf7, 0x21, 0xc6, 0xfd, 0xc3, 0xee, 0xa7, 0xad, 0x52, 0xe7, 0x0d, 0xa8, 0xc8, 0xf3,
+	0x43, 0x6b, 0xd0, 0xd8, 0x1b, 0x7c, 0xbb, 0xdf, 0x7b, 0x34, 0xc4, 0x7c, 0xb8, 0x98, 0xfd, 0xc1,
+	0x0e, 0xbe, 0xdf, 0x1f, 0x2b, 0xa4, 0xd0, 0xfe, 0x69, 0x0d, 0x4a, 0xdc, 0x8d, 0xd1, 0x06, 0x94,
+	0x19, 0x65, 0x53, 0xa2, 0x02, 0x89, 0x24, 0xd0, 0x26, 0x34, 0x5c, 0xae, 0x36, 0x2a, 0x7c, 0x54,
+	0x98, 0x5b, 0x1d, 0xa7, 0x21, 0x74, 0x0b, 0x56, 0xe7, 0x81, 0xef, 0x90, 0x30, 0xa4, 0xde, 0x84,
+	0xeb, 0x56, 0x58, 0x55, 0x1d, 0xe7, 0x50, 0x3e, 0xbf, 0x50, 0x86, 0x30, 0xa1, 0x12, 0x96, 0x04,
+	0x8f, 0x5e, 0x5e, 0x78, 0xf2, 0x44, 0x24, 0x83, 0x1a, 0x16, 0xcf, 0x1c, 0x63, 0xf6, 0x44, 0x86,
+	0x81, 0x3a, 0x16, 0xcf, 0xe8, 0x0d, 0xa8, 0xd0, 0x99, 0x3d, 0x21, 0xda, 0xed, 0xaf, 0x66, 0x62,
+	0x90, 0x35, 0xe0, 0x3c, 0xac, 0x44, 0xb8, 0xe7, 0x3b, 0x36, 0x23, 0x13, 0x3f, 0xa0, 0x24, 0xf6,
+	0xfc, 0x04, 0xe1, 0x4b, 0x99, 0x04, 0xf6, 0x4c, 0x3a, 0x7b, 0x01, 0x4b, 0x02, 0xbd, 0x02, 0x75,
+	0x47, 0x7b, 0xbb, 0x72, 0xee, 0x04, 0x40, 0x16, 0x54, 0xfd, 0xf9, 0x72, 0xa7, 0x16, 0x2b, 0x50,
+	0x41, 0x4d, 0x0b, 0xa1, 0xd7, 0xa0, 0x14, 0x3e, 0x8e, 0x42, 0xb3, 0xa9, 0xd2, 0x65, 0x46, 0x78,
+	0xf4, 0x38, 0xc2, 0x82, 0x8d, 0xfe, 0x1f, 0x40, 0x28, 0x62, 0x4c, 0x49, 0x10, 0x9a, 0x2b, 0xb9,
+	0x88, 0x29, 0x84, 0x87, 0x9a, 0x8f, 0x53, 0xa2, 0xed, 0xdf, 0x1a, 0x50, 0x91, 0xef, 0x14, 0x3a,
+	0xb4, 0x67, 0xfa, 0xe0, 0xc4, 0xf3, 0x73, 0x9c, 0xdb, 0x3d, 0xa8, 0x9d, 0xd9, 0x01, 0xb5, 0x3d,
+	0x16, 0x9a, 0x45, 0xf1, 0xde, 0x57, 0x96, 0xed, 0xc8, 0xfa, 0x44, 0x0a, 0xe1, 0x58, 0xba, 0xbd,
+	0x0f, 0x55, 0x05, 0x2e, 0x7d, 0xf5, 0xeb, 0x50, 0x16, 0xe7, 0xa0, 0x32, 0xcf, 0xd2, 0x93, 0x92,
+	0x12, 0xed, 0x5f, 0x1b, 0x50, 0x1c, 0x3d, 0x8e, 0x78, 0x68, 0x55, 0xb3, 0x77, 0xfd, 0xd9, 0xb1,
+	0x2f, 0x6a, 0xa2, 0x15, 0x9c, 0xc1, 0xf8, 0xf1, 0xcc, 0x03, 0xdf, 0x8d, 0x1c, 0xa6, 0x92, 0x5a,
+	0x1d, 0x27, 0x00, 0xe7, 0x86, 0x51, 0xe0, 0x9c, 0xda, 0xc1, 0x44, 0x1a, 0x60, 0x11, 0x27, 0x00,
+	0xf7, 0xf2, 0xcf, 0x22, 0xdb, 0x63, 0xdc, 0x83, 0x4b, 0x82, 0x19, 0xd3, 0xb9, 0x13, 0x28, 0x3f,
+	0xff, 0x09, 0x74, 0xa1, 0x1e, 0x33, 0xb8, 0xbe, 0x67, 0xd4, 0xfb, 0x58, 0xbf, 0xc4, 0x10, 0x36,
+	0x9e, 0x86, 0x12, 0xfb, 0x2f, 0xa4, 0xec, 0xbf, 0xfd, 0x85, 0x01, 0x65, 0xa1, 0x12, 0xbe, 0xc6,
+	0x13, 0x3a, 0x25, 0x29, 0x75, 0xc6, 0x34, 0xe7, 0xf9, 0x01, 0x9d, 0x50, 0xcf, 0x9e, 0xaa, 0xad,
+	0xc7, 0x34, 0x9f, 0x77, 0x1a, 0xef, 0xba, 0x8e, 0x25, 0xc1, 0x2b, 0x87, 0x19, 0x71, 0x69, 0x24,
+	0x73, 0x76, 0x1d, 0x2b, 0x8a, 0x4b, 0x87, 0x33, 0x7b, 0x3a, 0x15, 0x0e, 0x57, 0xc7, 0x92, 0x10,
+	0x1e, 0x47, 0x3d, 0x1d, 0x70, 0xc5, 0x73, 0xfb, 0x57, 0x05, 0x68, 0xa4, 0xd2, 0x55, 0x26, 0xea,
+	0x1a, 0xb9, 0xa8, 0x1b, 0xc7, 0x8e, 0xc2, 0x53, 0x62, 0x47, 0x71, 0xd1, 0x06, 0x77, 0xa0, 0x21,
+	0xfd, 0xe5, 0xd0, 0x9e, 0x91, 0x50, 0x95, 0x96, 0xd7, 0x97, 0x65, 0x4b, 0xeb, 0x28, 0x96, 0xc3,
+	0xe9, 0x31, 0x97, 0x64, 0xf1, 0xf2, 0x73, 0x67, 0xf1, 0xca, 0x62, 0x16, 0x6f, 0xdf, 0x03, 0x48,
+	0x5e, 0xc7, 0x55, 0x29, 0x5f, 0xa8, 0xb6, 0xad, 0xa8, 0xd8, 0xf6, 0x0b, 0x89, 0xed, 0xb7, 0x7f,
+	0x5c, 0x84, 0xd5, 0x6c, 0x99, 0xb3, 0xd4, 0x45, 0xee, 0x41, 0x89, 0x25, 0xd9, 0xfb, 0xe6, 0x25,
+	0x15, 0x52, 0x4c, 0x8a, 0x1c, 0x2e, 0x46, 0xa0, 0x5b, 0x50, 0x0d, 0xc8, 0x44, 0xec, 0x91, 0x3b,
+	0xed, 0xea, 0x76, 0xd3, 0xea, 0xca, 0xe6, 0xa4, 0xeb, 0xbb, 0x04, 0x6b, 0x26, 0x7a, 0x17, 0x6a,
+	0x21, 0x09, 0xce, 0xa8, 0x43, 0xb4, 0x4d, 0x5f, 0xbf, 0xf4, 0x2d, 0x52, 0x0e, 0xc7, 0x03, 0xda,
+	0x9f, 0x1b, 0x50, 0x55, 0xe8, 0xd2, 0xe5, 0x2f, 0x35, 0x65, 0x74, 0x07, 0xd6, 0x49, 0xc8, 0xe8,
+	0xcc, 0x66, 0xc4, 0xed, 0x91, 0x29, 0x3d, 0x23, 0xc1, 0x85, 0x3a, 0xf4, 0x45, 0x06, 0xba, 0x0b,
+	0x57, 0x6d, 0x57, 0x1e, 0x8a, 0x3d, 0xe5, 0x5e, 0x36, 0x4c, 0x25, 0x87, 0x65, 0xac, 0xce, 0xdb,
+	0xd0, 0x4c, 0x2b, 0x84, 0xe7, 0xb2, 0x83, 0x23, 0x9e, 0x39, 0x87, 0x83, 0xee, 0xfd, 0x87, 0xc3,
+	0xd6, 0x95, 0x7c, 0xba, 0x33, 0xda, 0x3f, 0x31, 0xa0, 0x38, 0xb6, 0xcf, 0x79, 0x3d, 0xc5, 0xec,
+	0x73, 0x3e, 0x4a, 0xed, 0x43, 0x93, 0xe8, 0x0e, 0x00, 0xb3, 0xcf, 0xb1, 0x52, 0x69, 0x61, 0x89,
+	0x4a, 0x53, 0x7c, 0x6e, 0xd1, 0xcc, 0x3e, 0xd7, 0xab, 0x10, 0x9b, 0xab, 0xe1, 0x34, 0xc4, 0x53,
+	0xcf, 0x9c, 0x04, 0x0e, 0xf1, 0x98, 0x3d, 0x91, 0xbb, 0x29, 0xe0, 0x14, 0x22, 0xc2, 0xb6, 0x2c,
+	0x54, 0x2f, 0x49, 0xb8, 0x1b, 0x50, 0x3a, 0xb5, 0xc3, 0x53, 0x69, 0x55, 0xfb, 0x57, 0xb0, 0xa0,
+	0xd0, 0x4d, 0x68, 0xba, 0x34, 0x14, 0x6d, 0x28, 0x5f, 0x94, 0x54, 0xeb, 0xfe, 0x15, 0x9c, 0x41,
+	0xd1, 0x6d, 0x58, 0x53, 0xaf, 0xea, 0x29, 0x58, 0x38, 0x42, 0x61, 0xdf, 0xc0, 0x79, 0x06, 0xba,
+	0xa5, 0x0a, 0x96, 0x58, 0x92, 0x3b, 0x42, 0x69, 0xdf, 0xc0, 0x59, 0x78, 0xb7, 0x02, 0x25, 0xde,
+	0xf6, 0xee, 0x02, 0xd4, 0xf4, 0xbb, 0x3a, 0xbf, 0x03, 0x28, 0xcb, 0xa6, 0xf3, 0x26, 0xac, 0x48,
+	0xcf, 0xd9, 0x71, 0xdd, 0x80, 0x84, 0xa1, 0xda, 0x4b, 0x16, 0xe4, 0xc1, 0x59, 0x02, 0x7b, 0x44,
+	0xdb, 0x4c, 0x02, 0xa0, 0x37, 0xa0, 0x16, 0xa6, 0x35, 0xca, 0x6b, 0x7a, 0x31, 0x7b, 0x6c, 0xa8,
+	0x38, 0x16, 0x40, 0xff, 0x0d, 0x55, 0xd1, 0x1e, 0x0e, 0x7a, 0x66, 0x29, 0x69, 0x6c, 0x34, 0x86,
+	0xee, 0x41, 0x3d, 0xee, 0xc3, 0xcd, 0xf2, 0x33, 0x6b, 0xd5, 0x44, 0x18, 0xdd, 0x80, 0x32, 0xef,
+	0x63, 0x74, 0xf3, 0xd1, 0x50, 0x4b, 0x10, 0x1d, 0x8e, 0xe4, 0xa0, 0x2d, 0xa8, 0xce, 0xed, 0x0b,
+	0xd1, 0x04, 0xcb, 0xa6, 0x72, 0x55, 0x09, 0x0d, 0x25, 0x8a, 0x35, 0x9b, 0x5b, 0x41, 0x60, 0x73,
+	0x5f, 0xbb, 0x4f, 0x2e, 0x64, 0x01, 0xd2, 0xc4, 0x29, 0x04, 0x6d, 0xc3, 0x86, 0x3d, 0x65, 0x24,
+	0xf0, 0x6c, 0x46, 0x78, 0x41, 0x68, 0x3b, 0x6c, 0xe0, 0x9d, 0xf8, 0xaa, 0x02, 0x5d, 0xca, 0x4b,
+	0xf7, 0x04, 0x90, 0xe9, 0x09, 0xda, 0x7f, 0x30, 0xa0, 0x16, 0x1b, 0xe0, 0x35, 0xa8, 0x70, 0x65,
+	0x8d, 0x7d, 0x1d, 0xad, 0x24, 0xc5, 0x87, 0xdb, 0xea, 0x8c, 0x64, 0xc0, 0xd2, 0x24, 0xf7, 0x70,
+	0x87, 0xe7, 0x2c, 0xe9, 0xaa, 0xe2, 0x59, 0xa4, 0x09, 0x66, 0x33, 0xa2, 0xb2, 0x87, 0x24, 0x84,
+	0x71, 0xfb, 0x21, 0xb3, 0xa7, 0xc2, 0x06, 0x65, 0x8c, 0x4d, 0x21, 0x3c, 0x38, 0xa9, 0x9b, 0x12,
+	0x61, 0x4d, 0x0b, 0xc1, 0x49, 0x31, 0x79, 0x0c, 0x56, 0x2f, 0x3f, 0xf4, 0x99, 0x28, 0xe9, 0x44,
+	0x0c, 0x4e, 0x63, 0xed, 0x5f, 0x16, 0x55, 0x5d, 0xba, 0x09, 0x8d, 0xa9, 0x0c, 0x5c, 0xfb, 0xdc,
+	0x2f, 0xe4, 0xae, 0xd2, 0x50, 0x26, 0xbb, 0x17, 0x84, 0x6a, 0x62, 0x9a, 0x2f, 0x59, 0x3f, 0xbf,
+	0xf3, 0x7f, 0xa2, 0x0f, 0x28, 0xe1, 0x14, 0x82, 0xee, 0x24, 0x65, 0x9d, 0x2c, 0x82, 0x50, 0xea,
+	0xe0, 0x17, 0x8a, 0xba, 0x5d, 0x58, 0xcd, 0x36, 0xad, 0x71, 0x3f, 0x94, 0x1a, 0x94, 0x6b, 0x73,
+	0x73, 0x23, 0xb8, 0xba, 0x67, 0x64, 0xe6, 0x2b, 0xf5, 0x89, 0x67, 0xbe, 0x47, 0xd9, 0xb5, 0x72,
+	0x3d, 0xe9, 0xc2, 0x37, 0x0d, 0x89, 0x2a, 0x5b, 0x1a, 0x97, 0xf6, 0xb4, 0xaa, 0xaa, 0xb2, 0x33,
+	0x68, 0x7b, 0xfb, 0xa9, 0x55, 0xe1, 0x06, 0x94, 0xcf, 0xec, 0x69, 0x14, 0xe7, 0x69, 0x41, 0xb4,
+	0xdf, 0x7b, 0xae, 0x9c, 0x65, 0x42, 0x55, 0x25, 0x08, 0x6d, 0x40, 0x8a, 0x6c, 0x7f, 0x59, 0x80,
+	0xaa, 0x72, 0x01, 0xf4, 0x26, 0xaf, 0x3b, 0xd8, 0xa9, 0xef, 0x8a, 0xb1, 0xab, 0xdb, 0x2f, 0x65,
+	0x5d, 0x84, 0x77, 0x98, 0xa7, 0xbe, 0x8b, 0x95, 0x10, 0x8f, 0x0c, 0x71, 0x47, 0xae, 0x8b, 0xba,
+	0x18, 0xe0, 0xb6, 0x6c, 0xcf, 0x44, 0x70, 0x2a, 0x8a, 0x83, 0x53, 0x14, 0x1f, 0xe5, 0x9c, 0xda,
+	0xd4, 0xe3, 0x81, 0x49, 0x59, 0x68, 0x02, 0xa4, 0x2d, 0xbd, 0x9c, 0xb5, 0x74, 0x91, 0xfb, 0x5d,
+	0x42, 0x66, 0x23, 0x51, 0x81, 0x24, 0xb9, 0x3f, 0xc1, 0xb8, 0x4c, 0xbc, 0x80, 0xfb, 0xe4, 0x42,
+	0xa8, 0xb9, 0x89, 0x33, 0x98, 0xf0, 0x18, 0x9f, 0x7a, 0x66, 0x4d, 0x79, 0x8c, 0x4f, 0xbd, 0xce,
+	0x3d, 0xa8, 0xc8, 0xbd, 0xa1, 0xab, 0xb0, 0xb6, 0xd3, 0xeb, 0xe1, 0xfe, 0x68, 0xf4, 0x08, 0xf7,
+	0x3f, 0x7e, 0xd8, 0x1f, 0x8d, 0x5b, 0x57, 0x10, 0x40, 0xa5, 0x37, 0xc0, 0xfd, 0xee, 0xb8, 0x65,
+	0xa0, 0x15, 0xa8, 0x3f, 0x38, 0xea, 0xf5, 0xf1, 0xce, 0xb8, 0xdf, 0x6b, 0x15, 0x3a, 0x7f, 0x33,
+	0x60, 0x7d, 0xf1, 0x02, 0xce, 0x84, 0xaa, 0xcf, 0xc1, 0x41, 0x4f, 0xa7, 0x2c, 0x45, 0x66, 0x63,
+	0x5c, 0xe1, 0x45, 0x62, 0xdc, 0xa2, 0x11, 0x15, 0x97, 0x19, 0x11, 0x6f, 0xc5, 0x03, 0xf2, 0x59,
+	0x44, 0x42, 0x46, 0xdc, 0x1d, 0x79, 0x00, 0x32, 0x2f, 0xe7, 0x61, 0xf4, 0x4d, 0x68, 0xc9, 0xb0,
+	0x36, 0x4a, 0xae, 0xb4, 0x64, 0xb9, 0xd1, 0xb2, 0x70, 0x96, 0x81, 0x17, 0x24, 0x3b, 0x3f, 0x32,
+	0xa0, 0x21, 0x76, 0x8e, 0xc9, 0xf7, 0x88, 0xc3, 0xfe, 0x2d, 0x7b, 0xe6, 0x7d, 0x18, 0x9d, 0x68,
+	0xef, 0x5e, 0xb7, 0x76, 0x29, 0xe3, 0xe7, 0x95, 0x2c, 0x4b, 0xb0, 0x3b, 0x5f, 0x15, 0x61, 0x2d,
+	0xb7, 0x60, 0xf4, 0x41, 0xea, 0x92, 0xcc, 0x10, 0xef, 0xbc, 0x99, 0xdf, 0x94, 0x2c, 0x51, 0x6d,
+	0x87, 0x1f, 0xd9, 0x92, 0x7b, 0x33, 0xde, 0x95, 0x68, 0x51, 0xb1, 0xec, 0x26, 0x4e, 0x80, 0xf6,
+	0x9f, 0x0b, 0x70, 0x75, 0xc9, 0xf8, 0x54, 0xc4, 0x1b, 0x25, 0x17, 0x7b, 0x69, 0x48, 0x24, 0x54,
+	0x9d, 0x4d, 0xf4, 0xbc, 0x31, 0xb0, 0x60, 0xc2, 0xc5, 0x25, 0x26, 0xdc, 0x81, 0xa6, 0x9a, 0x70,
+	0x2c, 0x6a, 0x10, 0xe9, 0x45, 0x19, 0x0c, 0xed, 0x43, 0x9d, 0x9d, 0x46, 0xb3, 0x63, 0xcf, 0xa6,
+	0x53, 0x95, 0x4c, 0x6f, 0x3f, 0x8f, 0x02, 0x54, 0x8f, 0x97, 0x0c, 0x6e, 0xff, 0x40, 0x37, 0x39,
+	0xba, 0xd1, 0x30, 0x92, 0x46, 0x23, 0x69, 0x49, 0x0a, 0xe9, 0x96, 0x24, 0x69, 0x60, 0x8a, 0xf9,
+	0x06, 0x46, 0xb6, 0x3b, 0xa5, 0x74, 0xbb, 0x93, 0x6e, 0x90, 0xca, 0xd9, 0x06, 0xa9, 0x33, 0x84,
+	0x56, 0xfe, 0xd0, 0x79, 0x5a, 0xa0, 0xde, 0x3c, 0x62, 0x03, 0xcf, 0x25, 0xe7, 0xea, 0x8e, 0x2d,
+	0x85, 0x3c, 0xfd, 0xe0, 0x3a, 0x7f, 0xac, 0x40, 0x6b, 0xe1, 0x9a, 0x3b, 0x36, 0x5e, 0x37, 0x6b,
+	0xbc, 0x6e, 0x7c, 0x43, 0x5b, 0x48, 0xdd, 0xd0, 0x66, 0x0c, 0xba, 0xf8, 0x22, 0x06, 0x7d, 0x08,
+	0xad, 0xf9, 0xe9, 0x45, 0x48, 0x1d, 0x7b, 0x1a, 0x57, 0xd9, 0xb2, 0x71, 0xea, 0x2c, 0xdc, 0xc9,
+	0x5b, 0xc3, 0x9c, 0x24, 0x5e, 0x18, 0x8b, 0xee, 0xc3, 0x9a, 0x4b, 0x27, 0x94, 0xa5, 0xa6, 0x93,
+	0x1e, 0x7c, 0x63, 0x71, 0xba, 0x5e, 0x56, 0x10, 0xe7, 0x47, 0xf2, 0xab, 0xc5, 0xb9, 0x7d, 0xe1,
+	0x47, 0x4c, 0x5d, 0xd2, 0x9b, 0x4b, 0x96, 0x24, 0xf8, 0x58, 0xc9, 0xa1, 0x6f, 0xc0, 0x5a, 0x2e,
+	0x2e, 0xa8, 0xe2, 0x6a, 0x31, 0x80, 0xe4, 0x05, 0x45, 0x9a, 0xf2, 0x19, 0xd1, 0x71, 0x98, 0x3f,
+	0xa3, 0xef, 0xc2, 0x35, 0x27, 0xb8, 0x98, 0x33, 0xdf, 0x51, 0xd7, 0x85, 0xf1, 0xae, 0xea, 0x62,
+	0x57, 0x5b, 0x8b, 0x2b, 0xea, 0x2e, 0x95, 0xc7, 0x97, 0xcc, 0xd3, 0x1e, 0x43, 0x2b, 0xaf, 0x56,
+	0x91, 0x1c, 0x79, 0x0a, 0x25, 0x81, 0x3e, 0x7c, 0x45, 0xf2, 0x98, 0xcb, 0xef, 0xfb, 0x1e, 0x53,
+	0x6f, 0x72, 0x18, 0xcd, 0x8e, 0x89, 0x4e, 0x73, 0x39, 0xb4, 0xfd, 0x3e, 0xac, 0xe5, 0xb4, 0x8b,
+	0x5a, 0x50, 0x8c, 0x82, 0xa9, 0x9a, 0x90, 0x3f, 0x72, 0x33, 0x9f, 0xdb, 0x61, 0xf8, 0xc4, 0x0f,
+	0x5c, 0x7d, 0x0f, 0xa0, 0xe9, 0xf6, 0x7b, 0x70, 0x6d, 0xf9, 0x46, 0x78, 0x91, 0xce, 0x12, 0x2f,
+	0x8d, 0x83, 0x6b, 0x16, 0x6c, 0xff, 0xd0, 0x80, 0x8a, 0x3c, 0x9b, 0x38, 0x66, 0x1a, 0x4f, 0x8d,
+	0x99, 0x7c, 0x5e, 0x79, 0x88, 0x3b, 0x99, 0xc2, 0x32, 0x0b, 0xf2, 0x5b, 0x54, 0x09, 0xec, 0x11,
+	0x32, 0x24, 0xc1, 0xee, 0x05, 0x23, 0x2a, 0x9d, 0x2f, 0xe0, 0x9d, 0xdf, 0x18, 0xb0, 0x96, 0xff,
+	0xf0, 0x73, 0xb9, 0x5f, 0x7d, 0xfd, 0xa4, 0xf0, 0x36, 0x80, 0x7c, 0xf7, 0xe8, 0xa9, 0xa9, 0x21,
+	0x25, 0x84, 0x6e, 0x40, 0x55, 0x9a, 0x9f, 0xbe, 0xa6, 0xa8, 0x2a, 0xfb, 0xc4, 0x1a, 0xef, 0xfc,
+	0xbe, 0x04, 0x15, 0x89, 0xa1, 0x6d, 0xdd, 0x00, 0xf4, 0x92, 0xe4, 0x81, 0xd4, 0x00, 0x0b, 0xc7,
+	0x1c, 0x9c, 0x92, 0x7a, 0x46, 0xb2, 0xf8, 0x4b, 0x11, 0x00, 0x67, 0x84, 0x93, 0x0c, 0x60, 0xe4,
+	0x33, 0xc0, 0x33, 0xbf, 0xff, 0x58, 0x50, 0x97, 0xcf, 0x23, 0xaa, 0x9b, 0xae, 0x45, 0x7f, 0x4b,
+	0x44, 0x9e, 0xd5, 0x76, 0xbd, 0x02, 0x75, 0xf1, 0xc8, 0xef, 0x4b, 0x54, 0xfc, 0x4d, 0x00, 0x6e,
+	0xb5, 0x82, 0xe0, 0xef, 0xaa, 0x88, 0xa5, 0xc6, 0x74, 0x26, 0x57, 0x71, 0x7e, 0xbe, 0xdc, 0xe2,
+	0x32, 0x99, 0x73, 0xae, 0xbd, 0xc8, 0x39, 0x73, 0xdb, 0x39, 0x23, 0x01, 0x4f, 0x2e, 0x75, 0xd9,
+	0x33, 0x29, 0x92, 0x73, 0x3e, 0x8b, 0xec, 0xd4, 0x95, 0xbe, 0x26, 0xf3, 0xb7, 0x56, 0x0d, 0xc1,
+	0x4d, 0x43, 0xdc, 0xee, 0x5d, 0xe5, 0x5b, 0xa3, 0x39, 0x21, 0xae, 0xd9, 0x14, 0x32, 0x59, 0x90,
+	0x17, 0x51, 0x4e, 0x14, 0x32, 0x7f, 0x46, 0x02, 0x75, 0x97, 0x62, 0xae, 0x08, 0xb9, 0x3c, 0xcc,
+	0x53, 0x5d, 0x40, 0xce, 0x28, 0x79, 0x62, 0xae, 0xca, 0x54, 0x27, 0xa9, 0xce, 0x57, 0x06, 0x54,
+	0xd5, 0x37, 0xc7, 0xac, 0x0e, 0x8c, 0x17, 0xd1, 0xc1, 0x06, 0x94, 0x9d, 0xa9, 0x4d, 0x67, 0x3a,
+	0xbd, 0x0a, 0x62, 0xd1, 0x77, 0x8b, 0xcb, 0x7c, 0xf7, 0x7f, 0xa0, 0xee, 0x47, 0x6c, 0xee, 0x53,
+	0x8f, 0x69, 0xb3, 0xaf, 0x5b, 0x47, 0x0a, 0xc1, 0x09, 0x8f, 0xdf, 0xc2, 0x85, 0x24, 0xa0, 0xf6,
+	0x94, 0x7e, 0x9f, 0xb8, 0xfa, 0x13, 0x87, 0xb0, 0x84, 0x26, 0x5e, 0xc2, 0xe9, 0xfc, 0xa2, 0x0c,
+	0xeb, 0x0b, 0x1f, 0x64, 0xff, 0x85, 0x4d, 0xa6, 0x82, 0x44, 0x21, 0x1b, 0x24, 0x78, 0xcf, 0x1a,
+	0xf8, 0x73, 0x3f, 0x24, 0xee, 0xae, 0xee, 0x71, 0x53, 0x08, 0xe7, 0x07, 0xf1, 0x0a, 0x54, 0x51,
+	0x91, 0x42, 0xd0, 0xdb, 0x71, 0x46, 0x93, 0x15, 0xd0, 0x7f, 0x2d, 0x7e, 0x48, 0xce, 0xa7, 0xb4,
+	0xbb, 0x70, 0x35, 0xb6, 0xdf, 0xd8, 0xa7, 0x64, 0x57, 0xd7, 0xc4, 0xcb, 0x58, 0xed, 0xcf, 0x8b,
+	0x2f, 0x1a, 0x7b, 0x6f, 0x40, 0x45, 0x94, 0x2b, 0xf2, 0xce, 0x2a, 0x73, 0x2c, 0x8a, 0x81, 0x76,
+	0xa1, 0x21, 0xbf, 0xa4, 0x47, 0x6c, 0x1e, 0x31, 0xe5, 0xe5, 0x9b, 0x97, 0x2e, 0xdf, 0x92, 0x72,
+	0x38, 0x3d, 0x08, 0xf5, 0xa0, 0xa9, 0xbe, 0xea, 0xcb, 0x49, 0x4a, 0xcf, 0x39, 0x49, 0x66, 0x14,
+	0xfa, 0x08, 0xd6, 0xe2, 0x5d, 0xab, 0x89, 0xca, 0xcf, 0x39, 0x51, 0x7e, 0x60, 0x9b, 0x42, 0x45,
+	0xcd, 0x6a, 0x42, 0x45, 0xfa, 0xa4, 0xcc, 0x0b, 0xfb, 0x57, 0xb0, 0xa2, 0x51, 0x3b, 0xe9, 0x00,
+	0xf5, 0x45, 0x99, 0x06, 0x52, 0x3d, 0x65, 0x21, 0xdd, 0x53, 0xee, 0xae, 0xc3, 0x9a, 0x1c, 0x7d,
+	0x14, 0x28, 0xeb, 0xef, 0xd0, 0xd8, 0x46, 0x53, 0xdf, 0xf7, 0xbf, 0xbe, 0x8d, 0xf2, 0x4f, 0x8d,
+	0x53, 0x65, 0x87, 0x2a, 0x79, 0x6b, 0xba, 0xf3, 0x11, 0xd4, 0xf4, 0xf9, 0xf1, 0xaa, 0xe6, 0x34,
+	0xb9, 0xe9, 0x10, 0xcf, 0xdc, 0x89, 0xa9, 0x28, 0x55, 0xe5, 0xfd, 0x86, 0x24, 0x92, 0x76, 0x5e,
+	0xe6, 0x53, 0x49, 0x74, 0x7e, 0x56, 0x80, 0x8a, 0xfc, 0xe7, 0xe0, 0x3f, 0xd8, 0x50, 0xa1, 0x3e,
+	0xac, 0xcb, 0x2b, 0xbe, 0x54, 0x83, 0xa0, 0xcc, 0xe7, 0x65, 0xf5, 0x4b, 0x44, 0xba, 0x77, 0xe0,
+	0x57, 0x5c, 0x78, 0x71, 0xc4, 0xb2, 0xdb, 0x92, 0xf6, 0xbb, 0xb0, 0x96, 0x1b, 0xc9, 0xc5, 0xd8,
+	0x39, 0x75, 0xe3, 0xbe, 0xe2, 0x9c, 0xba, 0xd9, 0xcb, 0x8e, 0x58, 0x3b, 0xdb, 0x70, 0xed, 0x13,
+	0x61, 0x9b, 0x7b, 0xd4, 0x93, 0x41, 0x49, 0x5f, 0x5d, 0x5c, 0xaa, 0xac, 0xce, 0x97, 0x06, 0x14,
+	0x06, 0x3d, 0x6e, 0x3a, 0x73, 0x92, 0xe2, 0x2b, 0x8a, 0xe3, 0xa7, 0xb6, 0xe7, 0xc6, 0x9f, 0x3f,
+	0x14, 0x85, 0x5e, 0x83, 0xea, 0x3c, 0x3a, 0x7e, 0xcc, 0xaf, 0x00, 0xa5, 0xf3, 0x35, 0xac, 0x41,
+	0xcf, 0x1a, 0x4a, 0x08, 0x6b, 0x1e, 0x8f, 0x40, 0xc7, 0xb1, 0x0e, 0x85, 0x8a, 0x9a, 0x38, 0x85,
+	0xb4, 0xdf, 0x87, 0xaa, 0x1a, 0xc3, 0x4d, 0x88, 0xba, 0x24, 0xf9, 0xc4, 0xd4, 0xc4, 0x31, 0xcd,
+	0x97, 0xaf, 0x06, 0xa9, 0xe2, 0x41, 0x93, 0x9d, 0xbf, 0x1b, 0x50, 0x4f, 0x8a, 0xe6, 0x3b, 0xfc,
+	0x1e, 0xc7, 0x89, 0xbf, 0x67, 0xac, 0x6e, 0xa3, 0xe4, 0xe7, 0x13, 0x6b, 0x24, 0x39, 0x58, 0x8b,
+	0xf0, 0xf2, 0x35, 0xae, 0x41, 0x78, 0x89, 0x16, 0xaa, 0xc9, 0x73, 0x68, 0xe7, 0x0b, 0xf1, 0xc9,
+	0x40, 0x8e, 0x69, 0x40, 0xf5, 0x60, 0x30, 0x1a, 0x0f, 0x0e, 0x3f, 0x6c, 0x5d, 0x41, 0x75, 0x28,
+	0x1f, 0xe1, 0x5e, 0x1f, 0xb7, 0x0c, 0x74, 0x0d, 0x90, 0x78, 0x7c, 0xd4, 0x3d, 0x3a, 0xdc, 0x1b,
+	0xe0, 0x07, 0x3b, 0xe3, 0xc1, 0xd1, 0x61, 0xab, 0x80, 0x5e, 0x82, 0x75, 0x89, 0xef, 0x3d, 0x3c,
+	0xd8, 0x1b, 0x1c, 0x1c, 0x3c, 0xe8, 0x1f, 0x8e, 0x5b, 0x45, 0xb4, 0x01, 0x2d, 0x2d, 0xfe, 0x60,
+	0x78, 0xd0, 0x17, 0xc2, 0x25, 0x3e, 0x79, 0x6f, 0x30, 0x1a, 0x3e, 0x1c, 0xf7, 0x5b, 0x65, 0x3e,
+	0xa3, 0x22, 0x1e, 0xe1, 0xfe, 0xe8, 0xe8, 0xe0, 0xa1, 0x10, 0xaa, 0xf0, 0xdb, 0x16, 0xdc, 0x17,
+	0x5f, 0xc8, 0xab, 0x9d, 0x9f, 0x1b, 0xb0, 0xc2, 0x37, 0x48, 0x5c, 0xfd, 0xc7, 0x4c, 0x07, 0xaa,
+	0xaa, 0xcf, 0x55, 0x0e, 0x9c, 0xfc, 0x7c, 0xa5, 0x19, 0xb1, 0x13, 0x16, 0x52, 0x4e, 0x98, 0x29,
+	0xd0, 0x8a, 0xb9, 0x02, 0x0d, 0xbd, 0x03, 0x8d, 0xd4, 0x0f, 0x1d, 0xca, 0xd4, 0x97, 0xff, 0xf9,
+	0x91, 0x16, 0xdc, 0x2d, 0x7d, 0xa7, 0x30, 0x3f, 0x3e, 0xae, 0x08, 0xa7, 0xfb, 0xdf, 0x7f, 0x0e,
+	0x00, 0xe5, 0xdd, 0xed, 0xe9, 0x7c, 0x26, 0x00, 0x00,
 }
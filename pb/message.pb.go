@@ -100,7 +100,7 @@ func (x Message_MessageType) String() string {
 	return proto.EnumName(Message_MessageType_name, int32(x))
 }
 func (Message_MessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{0, 0}
+	return fileDescriptor_message_c9692251fe001309, []int{0, 0}
 }
 
 type Chat_Flag int32
@@ -126,7 +126,42 @@ func (x Chat_Flag) String() string {
 	return proto.EnumName(Chat_Flag_name, int32(x))
 }
 func (Chat_Flag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{2, 0}
+	return fileDescriptor_message_c9692251fe001309, []int{2, 0}
+}
+
+type StoreAnnouncement_ChangeType int32
+
+const (
+	StoreAnnouncement_LISTING_ADDED   StoreAnnouncement_ChangeType = 0
+	StoreAnnouncement_LISTING_UPDATED StoreAnnouncement_ChangeType = 1
+	StoreAnnouncement_LISTING_REMOVED StoreAnnouncement_ChangeType = 2
+	StoreAnnouncement_PRICE_CHANGED   StoreAnnouncement_ChangeType = 3
+	StoreAnnouncement_POST_ADDED      StoreAnnouncement_ChangeType = 4
+	StoreAnnouncement_POST_REMOVED    StoreAnnouncement_ChangeType = 5
+)
+
+var StoreAnnouncement_ChangeType_name = map[int32]string{
+	0: "LISTING_ADDED",
+	1: "LISTING_UPDATED",
+	2: "LISTING_REMOVED",
+	3: "PRICE_CHANGED",
+	4: "POST_ADDED",
+	5: "POST_REMOVED",
+}
+var StoreAnnouncement_ChangeType_value = map[string]int32{
+	"LISTING_ADDED":   0,
+	"LISTING_UPDATED": 1,
+	"LISTING_REMOVED": 2,
+	"PRICE_CHANGED":   3,
+	"POST_ADDED":      4,
+	"POST_REMOVED":    5,
+}
+
+func (x StoreAnnouncement_ChangeType) String() string {
+	return proto.EnumName(StoreAnnouncement_ChangeType_name, int32(x))
+}
+func (StoreAnnouncement_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_message_c9692251fe001309, []int{8, 0}
 }
 
 type Message struct {
@@ -143,7 +178,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{0}
+	return fileDescriptor_message_c9692251fe001309, []int{0}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Message.Unmarshal(m, b)
@@ -204,7 +239,7 @@ func (m *Envelope) Reset()         { *m = Envelope{} }
 func (m *Envelope) String() string { return proto.CompactTextString(m) }
 func (*Envelope) ProtoMessage()    {}
 func (*Envelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{1}
+	return fileDescriptor_message_c9692251fe001309, []int{1}
 }
 func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Envelope.Unmarshal(m, b)
@@ -260,7 +295,7 @@ func (m *Chat) Reset()         { *m = Chat{} }
 func (m *Chat) String() string { return proto.CompactTextString(m) }
 func (*Chat) ProtoMessage()    {}
 func (*Chat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{2}
+	return fileDescriptor_message_c9692251fe001309, []int{2}
 }
 func (m *Chat) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chat.Unmarshal(m, b)
@@ -328,7 +363,7 @@ func (m *SignedData) Reset()         { *m = SignedData{} }
 func (m *SignedData) String() string { return proto.CompactTextString(m) }
 func (*SignedData) ProtoMessage()    {}
 func (*SignedData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{3}
+	return fileDescriptor_message_c9692251fe001309, []int{3}
 }
 func (m *SignedData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignedData.Unmarshal(m, b)
@@ -382,7 +417,7 @@ func (m *SignedData_Command) Reset()         { *m = SignedData_Command{} }
 func (m *SignedData_Command) String() string { return proto.CompactTextString(m) }
 func (*SignedData_Command) ProtoMessage()    {}
 func (*SignedData_Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{3, 0}
+	return fileDescriptor_message_c9692251fe001309, []int{3, 0}
 }
 func (m *SignedData_Command) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignedData_Command.Unmarshal(m, b)
@@ -434,7 +469,7 @@ func (m *CidList) Reset()         { *m = CidList{} }
 func (m *CidList) String() string { return proto.CompactTextString(m) }
 func (*CidList) ProtoMessage()    {}
 func (*CidList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{4}
+	return fileDescriptor_message_c9692251fe001309, []int{4}
 }
 func (m *CidList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CidList.Unmarshal(m, b)
@@ -473,7 +508,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{5}
+	return fileDescriptor_message_c9692251fe001309, []int{5}
 }
 func (m *Block) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Block.Unmarshal(m, b)
@@ -508,19 +543,20 @@ func (m *Block) GetCid() string {
 }
 
 type Error struct {
-	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
-	OrderID              string   `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Code                 uint32              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	ErrorMessage         string              `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	OrderID              string              `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	MessageType          Message_MessageType `protobuf:"varint,4,opt,name=messageType,proto3,enum=Message_MessageType" json:"messageType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1df6e446e7f943cb, []int{6}
+	return fileDescriptor_message_c9692251fe001309, []int{6}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Error.Unmarshal(m, b)
@@ -561,6 +597,191 @@ func (m *Error) GetOrderID() string {
 	return ""
 }
 
+func (m *Error) GetMessageType() Message_MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return Message_PING
+}
+
+type Capabilities struct {
+	PeerID               string                `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	MessageTypes         []Message_MessageType `protobuf:"varint,2,rep,packed,name=messageTypes,proto3,enum=Message_MessageType" json:"messageTypes,omitempty"`
+	ListingVersions      []uint32              `protobuf:"varint,3,rep,packed,name=listingVersions,proto3" json:"listingVersions,omitempty"`
+	UserAgent            string                `protobuf:"bytes,4,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Capabilities) Reset()         { *m = Capabilities{} }
+func (m *Capabilities) String() string { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()    {}
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_c9692251fe001309, []int{7}
+}
+func (m *Capabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Capabilities.Unmarshal(m, b)
+}
+func (m *Capabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Capabilities.Marshal(b, m, deterministic)
+}
+func (dst *Capabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Capabilities.Merge(dst, src)
+}
+func (m *Capabilities) XXX_Size() int {
+	return xxx_messageInfo_Capabilities.Size(m)
+}
+func (m *Capabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_Capabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Capabilities proto.InternalMessageInfo
+
+func (m *Capabilities) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *Capabilities) GetMessageTypes() []Message_MessageType {
+	if m != nil {
+		return m.MessageTypes
+	}
+	return nil
+}
+
+func (m *Capabilities) GetListingVersions() []uint32 {
+	if m != nil {
+		return m.ListingVersions
+	}
+	return nil
+}
+
+func (m *Capabilities) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+type StoreAnnouncement struct {
+	PeerID               string                      `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	RootHash             string                      `protobuf:"bytes,2,opt,name=rootHash,proto3" json:"rootHash,omitempty"`
+	Timestamp            *timestamp.Timestamp        `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Changes              []*StoreAnnouncement_Change `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *StoreAnnouncement) Reset()         { *m = StoreAnnouncement{} }
+func (m *StoreAnnouncement) String() string { return proto.CompactTextString(m) }
+func (*StoreAnnouncement) ProtoMessage()    {}
+func (*StoreAnnouncement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_c9692251fe001309, []int{8}
+}
+func (m *StoreAnnouncement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreAnnouncement.Unmarshal(m, b)
+}
+func (m *StoreAnnouncement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreAnnouncement.Marshal(b, m, deterministic)
+}
+func (dst *StoreAnnouncement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreAnnouncement.Merge(dst, src)
+}
+func (m *StoreAnnouncement) XXX_Size() int {
+	return xxx_messageInfo_StoreAnnouncement.Size(m)
+}
+func (m *StoreAnnouncement) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreAnnouncement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreAnnouncement proto.InternalMessageInfo
+
+func (m *StoreAnnouncement) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *StoreAnnouncement) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+func (m *StoreAnnouncement) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *StoreAnnouncement) GetChanges() []*StoreAnnouncement_Change {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type StoreAnnouncement_Change struct {
+	Type                 StoreAnnouncement_ChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=StoreAnnouncement_ChangeType" json:"type,omitempty"`
+	Slug                 string                       `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title                string                       `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *StoreAnnouncement_Change) Reset()         { *m = StoreAnnouncement_Change{} }
+func (m *StoreAnnouncement_Change) String() string { return proto.CompactTextString(m) }
+func (*StoreAnnouncement_Change) ProtoMessage()    {}
+func (*StoreAnnouncement_Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_c9692251fe001309, []int{8, 0}
+}
+func (m *StoreAnnouncement_Change) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreAnnouncement_Change.Unmarshal(m, b)
+}
+func (m *StoreAnnouncement_Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreAnnouncement_Change.Marshal(b, m, deterministic)
+}
+func (dst *StoreAnnouncement_Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreAnnouncement_Change.Merge(dst, src)
+}
+func (m *StoreAnnouncement_Change) XXX_Size() int {
+	return xxx_messageInfo_StoreAnnouncement_Change.Size(m)
+}
+func (m *StoreAnnouncement_Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreAnnouncement_Change.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreAnnouncement_Change proto.InternalMessageInfo
+
+func (m *StoreAnnouncement_Change) GetType() StoreAnnouncement_ChangeType {
+	if m != nil {
+		return m.Type
+	}
+	return StoreAnnouncement_LISTING_ADDED
+}
+
+func (m *StoreAnnouncement_Change) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *StoreAnnouncement_Change) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Message)(nil), "Message")
 	proto.RegisterType((*Envelope)(nil), "Envelope")
@@ -570,62 +791,81 @@ func init() {
 	proto.RegisterType((*CidList)(nil), "CidList")
 	proto.RegisterType((*Block)(nil), "Block")
 	proto.RegisterType((*Error)(nil), "Error")
+	proto.RegisterType((*Capabilities)(nil), "Capabilities")
+	proto.RegisterType((*StoreAnnouncement)(nil), "StoreAnnouncement")
+	proto.RegisterType((*StoreAnnouncement_Change)(nil), "StoreAnnouncement.Change")
 	proto.RegisterEnum("Message_MessageType", Message_MessageType_name, Message_MessageType_value)
 	proto.RegisterEnum("Chat_Flag", Chat_Flag_name, Chat_Flag_value)
-}
-
-func init() { proto.RegisterFile("message.proto", fileDescriptor_message_1df6e446e7f943cb) }
-
-var fileDescriptor_message_1df6e446e7f943cb = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x8f, 0x9b, 0x46,
-	0x14, 0x0e, 0x36, 0x5e, 0xec, 0x87, 0x77, 0x33, 0x3b, 0xdd, 0x46, 0x74, 0x95, 0xa6, 0x16, 0x87,
-	0xca, 0xbd, 0x10, 0x69, 0x23, 0x55, 0xbd, 0xb2, 0x30, 0xa4, 0x34, 0x18, 0xac, 0x31, 0x4e, 0xb5,
-	0xb9, 0x58, 0xd8, 0x4c, 0x5c, 0x1a, 0x1b, 0x28, 0xe0, 0x56, 0xee, 0xad, 0x87, 0xfe, 0xa2, 0xfe,
-	0x9e, 0xfe, 0x8b, 0x9e, 0xab, 0x6a, 0x86, 0xa1, 0xde, 0x4d, 0xa5, 0x95, 0x7a, 0xe2, 0xbd, 0xef,
-	0x7d, 0xbc, 0x79, 0xef, 0x9b, 0x0f, 0xe0, 0x7c, 0xcf, 0xea, 0x3a, 0xd9, 0x32, 0xab, 0xac, 0x8a,
-	0xa6, 0xb8, 0xfe, 0x6c, 0x5b, 0x14, 0xdb, 0x1d, 0x7b, 0x29, 0xb2, 0xf5, 0xe1, 0xfd, 0xcb, 0x24,
-	0x3f, 0xca, 0xd2, 0x17, 0x1f, 0x97, 0x9a, 0x6c, 0xcf, 0xea, 0x26, 0xd9, 0x97, 0x2d, 0xc1, 0xfc,
-	0x43, 0x05, 0x6d, 0xd6, 0x76, 0xc3, 0x5f, 0x83, 0x2e, 0x1b, 0xc7, 0xc7, 0x92, 0x19, 0xca, 0x44,
-	0x99, 0x5e, 0xdc, 0x5c, 0x59, 0xb2, 0xdc, 0x3d, 0x79, 0x8d, 0xde, 0x27, 0x62, 0x0b, 0xb4, 0x32,
-	0x39, 0xee, 0x8a, 0x24, 0x35, 0x7a, 0x13, 0x65, 0xaa, 0xdf, 0x5c, 0x59, 0xed, 0xb1, 0x56, 0x77,
-	0xac, 0x65, 0xe7, 0x47, 0xda, 0x91, 0xf0, 0x73, 0x18, 0x55, 0xec, 0xa7, 0x03, 0xab, 0x1b, 0x3f,
-	0x35, 0xfa, 0x13, 0x65, 0x3a, 0xa0, 0x27, 0x00, 0xbf, 0x00, 0xc8, 0x6a, 0xca, 0xea, 0xb2, 0xc8,
-	0x6b, 0x66, 0xa8, 0x13, 0x65, 0x3a, 0xa4, 0xf7, 0x10, 0xf3, 0xb7, 0x3e, 0xe8, 0xf7, 0x46, 0xc1,
-	0x43, 0x50, 0xe7, 0x7e, 0xf8, 0x1a, 0x3d, 0xe1, 0x91, 0xf3, 0xad, 0x1d, 0x23, 0x05, 0x03, 0x9c,
-	0x79, 0x51, 0x10, 0x44, 0xdf, 0xa3, 0x1e, 0x1e, 0xc3, 0x70, 0x19, 0xca, 0xac, 0x8f, 0x47, 0x30,
-	0x88, 0xa8, 0x4b, 0x28, 0x52, 0x31, 0x82, 0xb1, 0x08, 0x57, 0x94, 0x7c, 0x47, 0x9c, 0x18, 0x0d,
-	0x4e, 0x88, 0x63, 0x87, 0x0e, 0x09, 0xd0, 0x19, 0x7e, 0x06, 0x58, 0x22, 0x51, 0xe8, 0xf9, 0x74,
-	0x66, 0xc7, 0x7e, 0x14, 0x22, 0x0d, 0x7f, 0x0a, 0x97, 0x2d, 0xee, 0x2d, 0x03, 0xcf, 0x0f, 0x82,
-	0x19, 0x09, 0x63, 0x34, 0xc4, 0x57, 0x80, 0x3a, 0xfa, 0x6c, 0x1e, 0x10, 0x41, 0x1e, 0xf1, 0xb6,
-	0xae, 0xbf, 0x98, 0x2f, 0x63, 0xb2, 0x8a, 0xe6, 0x24, 0x44, 0x80, 0x31, 0x5c, 0x74, 0xc8, 0x72,
-	0xee, 0xda, 0x31, 0x41, 0x3a, 0xbe, 0x84, 0xf3, 0x0e, 0x73, 0x82, 0x68, 0x41, 0xd0, 0x98, 0xaf,
-	0x41, 0x89, 0xb7, 0x0c, 0x5d, 0x74, 0x8e, 0x9f, 0x82, 0x1e, 0x79, 0x5e, 0xe0, 0x87, 0x64, 0x65,
-	0x3b, 0x6f, 0xd0, 0x05, 0xe7, 0x77, 0x00, 0x25, 0x81, 0x7d, 0x87, 0x9e, 0x72, 0x68, 0x16, 0xb9,
-	0x84, 0xda, 0x71, 0x44, 0x57, 0xb6, 0xeb, 0x22, 0xc4, 0x27, 0x3a, 0x41, 0x94, 0xcc, 0xa2, 0xb7,
-	0x04, 0x5d, 0x72, 0x15, 0x16, 0x71, 0x44, 0x09, 0xc2, 0x3c, 0xbc, 0x0d, 0x22, 0xe7, 0x0d, 0xfa,
-	0x04, 0x3f, 0x07, 0xe3, 0x2d, 0x09, 0xdd, 0x88, 0xae, 0x3c, 0x3f, 0xb4, 0x03, 0xff, 0x1d, 0x71,
-	0x57, 0x73, 0xfb, 0x4e, 0xec, 0x76, 0x85, 0x01, 0x06, 0x84, 0xd2, 0x88, 0xa2, 0xbf, 0xfa, 0x66,
-	0x0a, 0x43, 0x92, 0xff, 0xcc, 0x76, 0x45, 0xc9, 0xb0, 0x09, 0x9a, 0x34, 0x83, 0x70, 0x8c, 0x7e,
-	0x33, 0xec, 0x9c, 0x42, 0xbb, 0x02, 0x7e, 0x06, 0x67, 0xe5, 0x61, 0xfd, 0x81, 0x1d, 0x85, 0x41,
-	0xc6, 0x54, 0x66, 0xdc, 0x09, 0x75, 0xb6, 0xcd, 0x93, 0xe6, 0x50, 0x31, 0xe1, 0x84, 0x31, 0x3d,
-	0x01, 0xe6, 0x9f, 0x0a, 0xa8, 0xce, 0x0f, 0x49, 0xc3, 0x69, 0xb2, 0x93, 0x9f, 0x8a, 0x43, 0x46,
-	0xf4, 0x04, 0x60, 0x03, 0xb4, 0xfa, 0xb0, 0xfe, 0x91, 0x6d, 0x1a, 0xd1, 0x7d, 0x44, 0xbb, 0x94,
-	0x57, 0xba, 0xd1, 0xfa, 0x6d, 0xa5, 0x1b, 0xe8, 0x1b, 0x18, 0xfd, 0xfb, 0x25, 0x08, 0x8f, 0xe9,
-	0x37, 0xd7, 0xff, 0x31, 0x6d, 0xdc, 0x31, 0xe8, 0x89, 0x8c, 0x5f, 0x80, 0xfa, 0x7e, 0x97, 0x6c,
-	0x8d, 0x81, 0xf8, 0x3a, 0xc0, 0xe2, 0x03, 0x5a, 0xde, 0x2e, 0xd9, 0x52, 0x81, 0x9b, 0x5f, 0x81,
-	0xca, 0x33, 0xac, 0x83, 0x36, 0x23, 0x8b, 0x85, 0xfd, 0x9a, 0xa0, 0x27, 0xfc, 0x22, 0xe3, 0x3b,
-	0xe1, 0x52, 0x85, 0xbb, 0x94, 0x12, 0xdb, 0x45, 0x3d, 0xf3, 0x6f, 0x05, 0x60, 0x91, 0x6d, 0x73,
-	0x96, 0xba, 0x49, 0x93, 0x60, 0x13, 0xc6, 0x35, 0xcb, 0x53, 0x56, 0xcd, 0x5b, 0xa9, 0x14, 0xa1,
-	0xc7, 0x03, 0x0c, 0x7f, 0x09, 0x17, 0x35, 0xab, 0xb2, 0x64, 0x97, 0xfd, 0xda, 0xbe, 0x25, 0x05,
-	0xfd, 0x08, 0x7d, 0x5c, 0xd8, 0xeb, 0xdf, 0x15, 0xd0, 0x9c, 0x62, 0xbf, 0x4f, 0xf2, 0x54, 0x5c,
-	0x0d, 0x63, 0x95, 0xef, 0x4a, 0x61, 0x65, 0x86, 0xa7, 0xa0, 0x36, 0xfc, 0x2f, 0xd0, 0x7b, 0xe4,
-	0x2f, 0x20, 0x18, 0x0f, 0xb5, 0xec, 0xff, 0x0f, 0x2d, 0xcd, 0xcf, 0x41, 0x73, 0xb2, 0x34, 0xc8,
-	0xea, 0x06, 0x63, 0x50, 0x37, 0x59, 0x5a, 0x1b, 0xca, 0xa4, 0x3f, 0x1d, 0x51, 0x11, 0x9b, 0xaf,
-	0x60, 0x70, 0xbb, 0x2b, 0x36, 0x1f, 0xf8, 0x3d, 0x56, 0xc9, 0x2f, 0x62, 0xdd, 0x56, 0x94, 0x2e,
-	0xc5, 0x08, 0xfa, 0x9b, 0x2c, 0x95, 0xf7, 0xce, 0x43, 0xf3, 0x0e, 0x06, 0xa4, 0xaa, 0x8a, 0x4a,
-	0x74, 0x2c, 0xd2, 0xd6, 0x94, 0xe7, 0x54, 0xc4, 0x5c, 0x62, 0xc6, 0x8b, 0x72, 0x09, 0xf9, 0xde,
-	0x03, 0x8c, 0x1f, 0x56, 0x54, 0xa9, 0x50, 0x44, 0x9a, 0x46, 0xa6, 0xb7, 0xea, 0xbb, 0x5e, 0xb9,
-	0x5e, 0x9f, 0x89, 0x9d, 0x5e, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x67, 0x2d, 0xb7, 0x8c, 0x85,
-	0x05, 0x00, 0x00,
+	proto.RegisterEnum("StoreAnnouncement_ChangeType", StoreAnnouncement_ChangeType_name, StoreAnnouncement_ChangeType_value)
+}
+
+func init() { proto.RegisterFile("message.proto", fileDescriptor_message_c9692251fe001309) }
+
+var fileDescriptor_message_c9692251fe001309 = []byte{
+	// 1025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0x0e, 0x45, 0xca, 0x92, 0x46, 0xb2, 0xbd, 0xde, 0xf8, 0x17, 0x28, 0x46, 0x92, 0x9f, 0xc0,
+	0x43, 0xa1, 0x5e, 0x14, 0xd4, 0x01, 0x8a, 0x5c, 0x69, 0x72, 0x65, 0xb3, 0xa1, 0x48, 0x61, 0x45,
+	0xbb, 0x70, 0x2e, 0x02, 0x25, 0x6e, 0x68, 0x36, 0x14, 0xa9, 0x72, 0xa9, 0x16, 0xee, 0xa1, 0x40,
+	0x0f, 0x7d, 0x80, 0x3e, 0x40, 0xef, 0xbd, 0xf7, 0x79, 0xfa, 0x16, 0x3d, 0x17, 0xc5, 0x2e, 0xc9,
+	0x48, 0x76, 0xe0, 0xa0, 0xed, 0x6d, 0xe6, 0x9b, 0x3f, 0x3b, 0xfb, 0xed, 0xc7, 0x21, 0xec, 0xaf,
+	0x18, 0xe7, 0x41, 0xc4, 0x46, 0xeb, 0x3c, 0x2b, 0xb2, 0x93, 0xa7, 0x51, 0x96, 0x45, 0x09, 0x7b,
+	0x29, 0xbd, 0xc5, 0xe6, 0xdd, 0xcb, 0x20, 0xbd, 0xad, 0x42, 0xff, 0xbf, 0x1f, 0x2a, 0xe2, 0x15,
+	0xe3, 0x45, 0xb0, 0x5a, 0x97, 0x09, 0xfa, 0xef, 0x1a, 0xb4, 0x26, 0x65, 0x37, 0xfc, 0x25, 0x74,
+	0xab, 0xc6, 0xfe, 0xed, 0x9a, 0xf5, 0x95, 0x81, 0x32, 0x3c, 0x38, 0x3d, 0x1e, 0x55, 0xe1, 0xd1,
+	0x64, 0x1b, 0xa3, 0xbb, 0x89, 0x78, 0x04, 0xad, 0x75, 0x70, 0x9b, 0x64, 0x41, 0xd8, 0x6f, 0x0c,
+	0x94, 0x61, 0xf7, 0xf4, 0x78, 0x54, 0x1e, 0x3b, 0xaa, 0x8f, 0x1d, 0x19, 0xe9, 0x2d, 0xad, 0x93,
+	0xf0, 0x33, 0xe8, 0xe4, 0xec, 0xdb, 0x0d, 0xe3, 0x85, 0x1d, 0xf6, 0xd5, 0x81, 0x32, 0x6c, 0xd2,
+	0x2d, 0x80, 0x5f, 0x00, 0xc4, 0x9c, 0x32, 0xbe, 0xce, 0x52, 0xce, 0xfa, 0xda, 0x40, 0x19, 0xb6,
+	0xe9, 0x0e, 0xa2, 0xff, 0xa4, 0x42, 0x77, 0x67, 0x14, 0xdc, 0x06, 0x6d, 0x6a, 0xbb, 0xe7, 0xe8,
+	0x91, 0xb0, 0xcc, 0x0b, 0xc3, 0x47, 0x0a, 0x06, 0xd8, 0x1b, 0x7b, 0x8e, 0xe3, 0x7d, 0x8d, 0x1a,
+	0xb8, 0x07, 0xed, 0x4b, 0xb7, 0xf2, 0x54, 0xdc, 0x81, 0xa6, 0x47, 0x2d, 0x42, 0x91, 0x86, 0x11,
+	0xf4, 0xa4, 0x39, 0xa7, 0xe4, 0x2b, 0x62, 0xfa, 0xa8, 0xb9, 0x45, 0x4c, 0xc3, 0x35, 0x89, 0x83,
+	0xf6, 0xf0, 0x13, 0xc0, 0x15, 0xe2, 0xb9, 0x63, 0x9b, 0x4e, 0x0c, 0xdf, 0xf6, 0x5c, 0xd4, 0xc2,
+	0xff, 0x83, 0xa3, 0x12, 0x1f, 0x5f, 0x3a, 0x63, 0xdb, 0x71, 0x26, 0xc4, 0xf5, 0x51, 0x1b, 0x1f,
+	0x03, 0xaa, 0xd3, 0x27, 0x53, 0x87, 0xc8, 0xe4, 0x8e, 0x68, 0x6b, 0xd9, 0xb3, 0xe9, 0xa5, 0x4f,
+	0xe6, 0xde, 0x94, 0xb8, 0x08, 0x30, 0x86, 0x83, 0x1a, 0xb9, 0x9c, 0x5a, 0x86, 0x4f, 0x50, 0x17,
+	0x1f, 0xc1, 0x7e, 0x8d, 0x99, 0x8e, 0x37, 0x23, 0xa8, 0x27, 0xae, 0x41, 0xc9, 0xf8, 0xd2, 0xb5,
+	0xd0, 0x3e, 0x3e, 0x84, 0xae, 0x37, 0x1e, 0x3b, 0xb6, 0x4b, 0xe6, 0x86, 0xf9, 0x06, 0x1d, 0x88,
+	0xfc, 0x1a, 0xa0, 0xc4, 0x31, 0xae, 0xd1, 0xa1, 0x80, 0x26, 0x9e, 0x45, 0xa8, 0xe1, 0x7b, 0x74,
+	0x6e, 0x58, 0x16, 0x42, 0x62, 0xa2, 0x2d, 0x44, 0xc9, 0xc4, 0xbb, 0x22, 0xe8, 0x48, 0xb0, 0x30,
+	0xf3, 0x3d, 0x4a, 0x10, 0x16, 0xe6, 0x99, 0xe3, 0x99, 0x6f, 0xd0, 0x63, 0xfc, 0x0c, 0xfa, 0x57,
+	0xc4, 0xb5, 0x3c, 0x3a, 0x1f, 0xdb, 0xae, 0xe1, 0xd8, 0x6f, 0x89, 0x35, 0x9f, 0x1a, 0xd7, 0xf2,
+	0x6e, 0xc7, 0x18, 0xa0, 0x49, 0x28, 0xf5, 0x28, 0xfa, 0x53, 0xd5, 0x43, 0x68, 0x93, 0xf4, 0x3b,
+	0x96, 0x64, 0x6b, 0x86, 0x75, 0x68, 0x55, 0x62, 0x90, 0x8a, 0xe9, 0x9e, 0xb6, 0x6b, 0xa5, 0xd0,
+	0x3a, 0x80, 0x9f, 0xc0, 0xde, 0x7a, 0xb3, 0x78, 0xcf, 0x6e, 0xa5, 0x40, 0x7a, 0xb4, 0xf2, 0x84,
+	0x12, 0x78, 0x1c, 0xa5, 0x41, 0xb1, 0xc9, 0x99, 0x54, 0x42, 0x8f, 0x6e, 0x01, 0xfd, 0x0f, 0x05,
+	0x34, 0xf3, 0x26, 0x28, 0x44, 0x5a, 0xd5, 0xc9, 0x0e, 0xe5, 0x21, 0x1d, 0xba, 0x05, 0x70, 0x1f,
+	0x5a, 0x7c, 0xb3, 0xf8, 0x86, 0x2d, 0x0b, 0xd9, 0xbd, 0x43, 0x6b, 0x57, 0x44, 0xea, 0xd1, 0xd4,
+	0x32, 0x52, 0x0f, 0xf4, 0x1a, 0x3a, 0x1f, 0xbe, 0x04, 0xa9, 0xb1, 0xee, 0xe9, 0xc9, 0x47, 0xa2,
+	0xf5, 0xeb, 0x0c, 0xba, 0x4d, 0xc6, 0x2f, 0x40, 0x7b, 0x97, 0x04, 0x51, 0xbf, 0x29, 0xbf, 0x0e,
+	0x18, 0x89, 0x01, 0x47, 0xe3, 0x24, 0x88, 0xa8, 0xc4, 0xf5, 0xcf, 0x41, 0x13, 0x1e, 0xee, 0x42,
+	0x6b, 0x42, 0x66, 0x33, 0xe3, 0x9c, 0xa0, 0x47, 0xe2, 0x21, 0xfd, 0x6b, 0xa9, 0x52, 0x45, 0xa8,
+	0x94, 0x12, 0xc3, 0x42, 0x0d, 0xfd, 0x2f, 0x05, 0x60, 0x16, 0x47, 0x29, 0x0b, 0xad, 0xa0, 0x08,
+	0xb0, 0x0e, 0x3d, 0xce, 0xd2, 0x90, 0xe5, 0xd3, 0x92, 0x2a, 0x45, 0xf2, 0x71, 0x07, 0xc3, 0x9f,
+	0xc1, 0x01, 0x67, 0x79, 0x1c, 0x24, 0xf1, 0x0f, 0x65, 0x55, 0x45, 0xe8, 0x3d, 0xf4, 0xd3, 0xc4,
+	0x9e, 0xfc, 0xac, 0x40, 0xcb, 0xcc, 0x56, 0xab, 0x20, 0x0d, 0xe5, 0xd3, 0x30, 0x96, 0xdb, 0x56,
+	0x45, 0x6c, 0xe5, 0xe1, 0x21, 0x68, 0x85, 0xd8, 0x02, 0x8d, 0x4f, 0x6c, 0x01, 0x99, 0x71, 0x97,
+	0x4b, 0xf5, 0x5f, 0x70, 0xa9, 0x3f, 0x87, 0x96, 0x19, 0x87, 0x4e, 0xcc, 0x0b, 0x8c, 0x41, 0x5b,
+	0xc6, 0x21, 0xef, 0x2b, 0x03, 0x75, 0xd8, 0xa1, 0xd2, 0xd6, 0x5f, 0x41, 0xf3, 0x2c, 0xc9, 0x96,
+	0xef, 0xc5, 0x3b, 0xe6, 0xc1, 0xf7, 0xf2, 0xba, 0x25, 0x29, 0xb5, 0x8b, 0x11, 0xa8, 0xcb, 0x38,
+	0xac, 0xde, 0x5d, 0x98, 0xfa, 0x2f, 0x0a, 0x34, 0x49, 0x9e, 0x67, 0xb9, 0x6c, 0x99, 0x85, 0xa5,
+	0x2a, 0xf7, 0xa9, 0xb4, 0x05, 0xc7, 0x4c, 0x04, 0xab, 0x5b, 0x54, 0x85, 0x77, 0x30, 0x71, 0x5a,
+	0x96, 0x87, 0x92, 0x92, 0x4a, 0x35, 0x95, 0x7b, 0x7f, 0x41, 0x6a, 0xff, 0x70, 0x41, 0xea, 0xbf,
+	0x29, 0xd0, 0x33, 0x83, 0x75, 0xb0, 0x88, 0x93, 0xb8, 0x88, 0x19, 0x7f, 0x90, 0xf4, 0xd7, 0xd0,
+	0xdb, 0xa9, 0xe3, 0xfd, 0xc6, 0x40, 0x7d, 0xf0, 0x84, 0x3b, 0x99, 0x78, 0x08, 0x87, 0x49, 0xcc,
+	0x8b, 0x38, 0x8d, 0xae, 0x58, 0xce, 0xe3, 0x2c, 0xe5, 0x7d, 0x75, 0xa0, 0x0e, 0xf7, 0xe9, 0x7d,
+	0x58, 0x48, 0x63, 0xc3, 0x59, 0x6e, 0x44, 0x2c, 0x2d, 0xe4, 0x15, 0x3a, 0x74, 0x0b, 0xe8, 0xbf,
+	0xaa, 0x70, 0x34, 0x2b, 0xb2, 0x9c, 0x19, 0x69, 0x9a, 0x6d, 0xd2, 0x25, 0x5b, 0xb1, 0xb4, 0x78,
+	0x70, 0xde, 0x13, 0x68, 0xe7, 0x59, 0x56, 0x5c, 0x04, 0xfc, 0xa6, 0xa2, 0xf2, 0x83, 0xff, 0xdf,
+	0x65, 0x81, 0x5f, 0x41, 0x6b, 0x79, 0x13, 0xa4, 0x11, 0xe3, 0x7d, 0x6d, 0xa0, 0x0e, 0xbb, 0xa7,
+	0x4f, 0x47, 0x1f, 0x8d, 0x24, 0xbe, 0xbb, 0x54, 0xac, 0x98, 0x2a, 0xf3, 0x84, 0xc1, 0x5e, 0x09,
+	0xe1, 0x2f, 0x2a, 0xe5, 0x96, 0xff, 0xaf, 0xe7, 0x0f, 0xd6, 0xee, 0x48, 0x18, 0x83, 0xc6, 0x93,
+	0x4d, 0x54, 0xdd, 0x41, 0xda, 0xf8, 0x18, 0x9a, 0x45, 0x5c, 0x24, 0xf5, 0xea, 0x28, 0x1d, 0xfd,
+	0x47, 0x80, 0x6d, 0xb5, 0x58, 0xb8, 0x8e, 0x3d, 0xf3, 0x6d, 0xf7, 0x5c, 0xac, 0x5b, 0x62, 0xa1,
+	0x47, 0xf8, 0x31, 0x1c, 0xd6, 0x50, 0xb9, 0xda, 0x2d, 0xa4, 0xec, 0x82, 0xe5, 0x0e, 0xb6, 0x50,
+	0x43, 0x14, 0x4f, 0xa9, 0x6d, 0x92, 0xb9, 0x79, 0x61, 0xb8, 0xe7, 0xc4, 0x42, 0x2a, 0x3e, 0x00,
+	0x98, 0x7a, 0x33, 0xbf, 0x6a, 0x26, 0x7f, 0x51, 0xd2, 0xaf, 0x8b, 0x9a, 0x67, 0xda, 0xdb, 0xc6,
+	0x7a, 0xb1, 0xd8, 0x93, 0x04, 0xbe, 0xfa, 0x7b, 0x00, 0x1a, 0xf8, 0x8c, 0x85, 0x09, 0x08, 0x00,
+	0x00,
 }
@@ -0,0 +1,3163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: service.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type SpendRequest_FeeLevel int32
+
+const (
+	SpendRequest_NORMAL   SpendRequest_FeeLevel = 0
+	SpendRequest_PRIORITY SpendRequest_FeeLevel = 1
+	SpendRequest_ECONOMIC SpendRequest_FeeLevel = 2
+)
+
+var SpendRequest_FeeLevel_name = map[int32]string{
+	0: "NORMAL",
+	1: "PRIORITY",
+	2: "ECONOMIC",
+}
+var SpendRequest_FeeLevel_value = map[string]int32{
+	"NORMAL":   0,
+	"PRIORITY": 1,
+	"ECONOMIC": 2,
+}
+
+func (x SpendRequest_FeeLevel) String() string {
+	return proto.EnumName(SpendRequest_FeeLevel_name, int32(x))
+}
+func (SpendRequest_FeeLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{23, 0}
+}
+
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{0}
+}
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (dst *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(dst, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+type ListingRequest struct {
+	Slug                 string   `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListingRequest) Reset()         { *m = ListingRequest{} }
+func (m *ListingRequest) String() string { return proto.CompactTextString(m) }
+func (*ListingRequest) ProtoMessage()    {}
+func (*ListingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{1}
+}
+func (m *ListingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingRequest.Unmarshal(m, b)
+}
+func (m *ListingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingRequest.Merge(dst, src)
+}
+func (m *ListingRequest) XXX_Size() int {
+	return xxx_messageInfo_ListingRequest.Size(m)
+}
+func (m *ListingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingRequest proto.InternalMessageInfo
+
+func (m *ListingRequest) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+type ListingIndex struct {
+	Listings             []*ListingIndexEntry `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListingIndex) Reset()         { *m = ListingIndex{} }
+func (m *ListingIndex) String() string { return proto.CompactTextString(m) }
+func (*ListingIndex) ProtoMessage()    {}
+func (*ListingIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{2}
+}
+func (m *ListingIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingIndex.Unmarshal(m, b)
+}
+func (m *ListingIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingIndex.Marshal(b, m, deterministic)
+}
+func (dst *ListingIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingIndex.Merge(dst, src)
+}
+func (m *ListingIndex) XXX_Size() int {
+	return xxx_messageInfo_ListingIndex.Size(m)
+}
+func (m *ListingIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingIndex proto.InternalMessageInfo
+
+func (m *ListingIndex) GetListings() []*ListingIndexEntry {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+type ListingIndexEntry struct {
+	Hash                 string                       `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Slug                 string                       `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title                string                       `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Categories           []string                     `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags                 []string                     `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Nsfw                 bool                         `protobuf:"varint,6,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	ContractType         string                       `protobuf:"bytes,7,opt,name=contractType,proto3" json:"contractType,omitempty"`
+	Description          string                       `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Thumbnail            *ListingIndexEntry_Thumbnail `protobuf:"bytes,9,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Price                *ListingIndexEntry_Price     `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	ShipsTo              []string                     `protobuf:"bytes,11,rep,name=shipsTo,proto3" json:"shipsTo,omitempty"`
+	FreeShipping         []string                     `protobuf:"bytes,12,rep,name=freeShipping,proto3" json:"freeShipping,omitempty"`
+	Language             string                       `protobuf:"bytes,13,opt,name=language,proto3" json:"language,omitempty"`
+	Languages            []string                     `protobuf:"bytes,14,rep,name=languages,proto3" json:"languages,omitempty"`
+	AverageRating        float32                      `protobuf:"fixed32,15,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	RatingCount          uint32                       `protobuf:"varint,16,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	Moderators           []string                     `protobuf:"bytes,17,rep,name=moderators,proto3" json:"moderators,omitempty"`
+	AcceptedCurrencies   []string                     `protobuf:"bytes,18,rep,name=acceptedCurrencies,proto3" json:"acceptedCurrencies,omitempty"`
+	CoinType             string                       `protobuf:"bytes,19,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ListingIndexEntry) Reset()         { *m = ListingIndexEntry{} }
+func (m *ListingIndexEntry) String() string { return proto.CompactTextString(m) }
+func (*ListingIndexEntry) ProtoMessage()    {}
+func (*ListingIndexEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{3}
+}
+func (m *ListingIndexEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingIndexEntry.Unmarshal(m, b)
+}
+func (m *ListingIndexEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingIndexEntry.Marshal(b, m, deterministic)
+}
+func (dst *ListingIndexEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingIndexEntry.Merge(dst, src)
+}
+func (m *ListingIndexEntry) XXX_Size() int {
+	return xxx_messageInfo_ListingIndexEntry.Size(m)
+}
+func (m *ListingIndexEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingIndexEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingIndexEntry proto.InternalMessageInfo
+
+func (m *ListingIndexEntry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry) GetCategories() []string {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetNsfw() bool {
+	if m != nil {
+		return m.Nsfw
+	}
+	return false
+}
+
+func (m *ListingIndexEntry) GetContractType() string {
+	if m != nil {
+		return m.ContractType
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry) GetThumbnail() *ListingIndexEntry_Thumbnail {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetPrice() *ListingIndexEntry_Price {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetShipsTo() []string {
+	if m != nil {
+		return m.ShipsTo
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetFreeShipping() []string {
+	if m != nil {
+		return m.FreeShipping
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry) GetLanguages() []string {
+	if m != nil {
+		return m.Languages
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetAverageRating() float32 {
+	if m != nil {
+		return m.AverageRating
+	}
+	return 0
+}
+
+func (m *ListingIndexEntry) GetRatingCount() uint32 {
+	if m != nil {
+		return m.RatingCount
+	}
+	return 0
+}
+
+func (m *ListingIndexEntry) GetModerators() []string {
+	if m != nil {
+		return m.Moderators
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetAcceptedCurrencies() []string {
+	if m != nil {
+		return m.AcceptedCurrencies
+	}
+	return nil
+}
+
+func (m *ListingIndexEntry) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+type ListingIndexEntry_Thumbnail struct {
+	Tiny                 string   `protobuf:"bytes,1,opt,name=tiny,proto3" json:"tiny,omitempty"`
+	Small                string   `protobuf:"bytes,2,opt,name=small,proto3" json:"small,omitempty"`
+	Medium               string   `protobuf:"bytes,3,opt,name=medium,proto3" json:"medium,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListingIndexEntry_Thumbnail) Reset()         { *m = ListingIndexEntry_Thumbnail{} }
+func (m *ListingIndexEntry_Thumbnail) String() string { return proto.CompactTextString(m) }
+func (*ListingIndexEntry_Thumbnail) ProtoMessage()    {}
+func (*ListingIndexEntry_Thumbnail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{3, 0}
+}
+func (m *ListingIndexEntry_Thumbnail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingIndexEntry_Thumbnail.Unmarshal(m, b)
+}
+func (m *ListingIndexEntry_Thumbnail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingIndexEntry_Thumbnail.Marshal(b, m, deterministic)
+}
+func (dst *ListingIndexEntry_Thumbnail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingIndexEntry_Thumbnail.Merge(dst, src)
+}
+func (m *ListingIndexEntry_Thumbnail) XXX_Size() int {
+	return xxx_messageInfo_ListingIndexEntry_Thumbnail.Size(m)
+}
+func (m *ListingIndexEntry_Thumbnail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingIndexEntry_Thumbnail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingIndexEntry_Thumbnail proto.InternalMessageInfo
+
+func (m *ListingIndexEntry_Thumbnail) GetTiny() string {
+	if m != nil {
+		return m.Tiny
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry_Thumbnail) GetSmall() string {
+	if m != nil {
+		return m.Small
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry_Thumbnail) GetMedium() string {
+	if m != nil {
+		return m.Medium
+	}
+	return ""
+}
+
+type ListingIndexEntry_Price struct {
+	CurrencyCode         string   `protobuf:"bytes,1,opt,name=currencyCode,proto3" json:"currencyCode,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Modifier             float32  `protobuf:"fixed32,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListingIndexEntry_Price) Reset()         { *m = ListingIndexEntry_Price{} }
+func (m *ListingIndexEntry_Price) String() string { return proto.CompactTextString(m) }
+func (*ListingIndexEntry_Price) ProtoMessage()    {}
+func (*ListingIndexEntry_Price) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{3, 1}
+}
+func (m *ListingIndexEntry_Price) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingIndexEntry_Price.Unmarshal(m, b)
+}
+func (m *ListingIndexEntry_Price) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingIndexEntry_Price.Marshal(b, m, deterministic)
+}
+func (dst *ListingIndexEntry_Price) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingIndexEntry_Price.Merge(dst, src)
+}
+func (m *ListingIndexEntry_Price) XXX_Size() int {
+	return xxx_messageInfo_ListingIndexEntry_Price.Size(m)
+}
+func (m *ListingIndexEntry_Price) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingIndexEntry_Price.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingIndexEntry_Price proto.InternalMessageInfo
+
+func (m *ListingIndexEntry_Price) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *ListingIndexEntry_Price) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ListingIndexEntry_Price) GetModifier() float32 {
+	if m != nil {
+		return m.Modifier
+	}
+	return 0
+}
+
+type PurchaseRequest struct {
+	ShipTo               string                  `protobuf:"bytes,1,opt,name=shipTo,proto3" json:"shipTo,omitempty"`
+	Address              string                  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	City                 string                  `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	State                string                  `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode           string                  `protobuf:"bytes,5,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	CountryCode          string                  `protobuf:"bytes,6,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	AddressNotes         string                  `protobuf:"bytes,7,opt,name=addressNotes,proto3" json:"addressNotes,omitempty"`
+	Moderator            string                  `protobuf:"bytes,8,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Items                []*PurchaseRequest_Item `protobuf:"bytes,9,rep,name=items,proto3" json:"items,omitempty"`
+	AlternateContactInfo string                  `protobuf:"bytes,10,opt,name=alternateContactInfo,proto3" json:"alternateContactInfo,omitempty"`
+	RefundAddress        string                  `protobuf:"bytes,11,opt,name=refundAddress,proto3" json:"refundAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PurchaseRequest) Reset()         { *m = PurchaseRequest{} }
+func (m *PurchaseRequest) String() string { return proto.CompactTextString(m) }
+func (*PurchaseRequest) ProtoMessage()    {}
+func (*PurchaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{4}
+}
+func (m *PurchaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchaseRequest.Unmarshal(m, b)
+}
+func (m *PurchaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchaseRequest.Marshal(b, m, deterministic)
+}
+func (dst *PurchaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseRequest.Merge(dst, src)
+}
+func (m *PurchaseRequest) XXX_Size() int {
+	return xxx_messageInfo_PurchaseRequest.Size(m)
+}
+func (m *PurchaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseRequest proto.InternalMessageInfo
+
+func (m *PurchaseRequest) GetShipTo() string {
+	if m != nil {
+		return m.ShipTo
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetAddressNotes() string {
+	if m != nil {
+		return m.AddressNotes
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetModerator() string {
+	if m != nil {
+		return m.Moderator
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetItems() []*PurchaseRequest_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *PurchaseRequest) GetAlternateContactInfo() string {
+	if m != nil {
+		return m.AlternateContactInfo
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+type PurchaseRequest_Item struct {
+	ListingHash          string                         `protobuf:"bytes,1,opt,name=listingHash,proto3" json:"listingHash,omitempty"`
+	Quantity             uint64                         `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Options              []*PurchaseRequest_Item_Option `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Shipping             *PurchaseRequest_Item_Shipping `protobuf:"bytes,4,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	Memo                 string                         `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Coupons              []string                       `protobuf:"bytes,6,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	PaymentAddress       string                         `protobuf:"bytes,7,opt,name=paymentAddress,proto3" json:"paymentAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *PurchaseRequest_Item) Reset()         { *m = PurchaseRequest_Item{} }
+func (m *PurchaseRequest_Item) String() string { return proto.CompactTextString(m) }
+func (*PurchaseRequest_Item) ProtoMessage()    {}
+func (*PurchaseRequest_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{4, 0}
+}
+func (m *PurchaseRequest_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchaseRequest_Item.Unmarshal(m, b)
+}
+func (m *PurchaseRequest_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchaseRequest_Item.Marshal(b, m, deterministic)
+}
+func (dst *PurchaseRequest_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseRequest_Item.Merge(dst, src)
+}
+func (m *PurchaseRequest_Item) XXX_Size() int {
+	return xxx_messageInfo_PurchaseRequest_Item.Size(m)
+}
+func (m *PurchaseRequest_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseRequest_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseRequest_Item proto.InternalMessageInfo
+
+func (m *PurchaseRequest_Item) GetListingHash() string {
+	if m != nil {
+		return m.ListingHash
+	}
+	return ""
+}
+
+func (m *PurchaseRequest_Item) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *PurchaseRequest_Item) GetOptions() []*PurchaseRequest_Item_Option {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *PurchaseRequest_Item) GetShipping() *PurchaseRequest_Item_Shipping {
+	if m != nil {
+		return m.Shipping
+	}
+	return nil
+}
+
+func (m *PurchaseRequest_Item) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *PurchaseRequest_Item) GetCoupons() []string {
+	if m != nil {
+		return m.Coupons
+	}
+	return nil
+}
+
+func (m *PurchaseRequest_Item) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+type PurchaseRequest_Item_Option struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseRequest_Item_Option) Reset()         { *m = PurchaseRequest_Item_Option{} }
+func (m *PurchaseRequest_Item_Option) String() string { return proto.CompactTextString(m) }
+func (*PurchaseRequest_Item_Option) ProtoMessage()    {}
+func (*PurchaseRequest_Item_Option) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{4, 0, 0}
+}
+func (m *PurchaseRequest_Item_Option) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchaseRequest_Item_Option.Unmarshal(m, b)
+}
+func (m *PurchaseRequest_Item_Option) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchaseRequest_Item_Option.Marshal(b, m, deterministic)
+}
+func (dst *PurchaseRequest_Item_Option) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseRequest_Item_Option.Merge(dst, src)
+}
+func (m *PurchaseRequest_Item_Option) XXX_Size() int {
+	return xxx_messageInfo_PurchaseRequest_Item_Option.Size(m)
+}
+func (m *PurchaseRequest_Item_Option) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseRequest_Item_Option.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseRequest_Item_Option proto.InternalMessageInfo
+
+func (m *PurchaseRequest_Item_Option) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PurchaseRequest_Item_Option) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type PurchaseRequest_Item_Shipping struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Service              string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseRequest_Item_Shipping) Reset()         { *m = PurchaseRequest_Item_Shipping{} }
+func (m *PurchaseRequest_Item_Shipping) String() string { return proto.CompactTextString(m) }
+func (*PurchaseRequest_Item_Shipping) ProtoMessage()    {}
+func (*PurchaseRequest_Item_Shipping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{4, 0, 1}
+}
+func (m *PurchaseRequest_Item_Shipping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchaseRequest_Item_Shipping.Unmarshal(m, b)
+}
+func (m *PurchaseRequest_Item_Shipping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchaseRequest_Item_Shipping.Marshal(b, m, deterministic)
+}
+func (dst *PurchaseRequest_Item_Shipping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseRequest_Item_Shipping.Merge(dst, src)
+}
+func (m *PurchaseRequest_Item_Shipping) XXX_Size() int {
+	return xxx_messageInfo_PurchaseRequest_Item_Shipping.Size(m)
+}
+func (m *PurchaseRequest_Item_Shipping) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseRequest_Item_Shipping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseRequest_Item_Shipping proto.InternalMessageInfo
+
+func (m *PurchaseRequest_Item_Shipping) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PurchaseRequest_Item_Shipping) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+type PurchaseResponse struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentAddress       string   `protobuf:"bytes,2,opt,name=paymentAddress,proto3" json:"paymentAddress,omitempty"`
+	Amount               uint64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	VendorOnline         bool     `protobuf:"varint,4,opt,name=vendorOnline,proto3" json:"vendorOnline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseResponse) Reset()         { *m = PurchaseResponse{} }
+func (m *PurchaseResponse) String() string { return proto.CompactTextString(m) }
+func (*PurchaseResponse) ProtoMessage()    {}
+func (*PurchaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{5}
+}
+func (m *PurchaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchaseResponse.Unmarshal(m, b)
+}
+func (m *PurchaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchaseResponse.Marshal(b, m, deterministic)
+}
+func (dst *PurchaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseResponse.Merge(dst, src)
+}
+func (m *PurchaseResponse) XXX_Size() int {
+	return xxx_messageInfo_PurchaseResponse.Size(m)
+}
+func (m *PurchaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseResponse proto.InternalMessageInfo
+
+func (m *PurchaseResponse) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *PurchaseResponse) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *PurchaseResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PurchaseResponse) GetVendorOnline() bool {
+	if m != nil {
+		return m.VendorOnline
+	}
+	return false
+}
+
+type OrderRequest struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderRequest) Reset()         { *m = OrderRequest{} }
+func (m *OrderRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderRequest) ProtoMessage()    {}
+func (*OrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{6}
+}
+func (m *OrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderRequest.Unmarshal(m, b)
+}
+func (m *OrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderRequest.Marshal(b, m, deterministic)
+}
+func (dst *OrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderRequest.Merge(dst, src)
+}
+func (m *OrderRequest) XXX_Size() int {
+	return xxx_messageInfo_OrderRequest.Size(m)
+}
+func (m *OrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderRequest proto.InternalMessageInfo
+
+func (m *OrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type OrderConfirmationRequest struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Reject               bool     `protobuf:"varint,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderConfirmationRequest) Reset()         { *m = OrderConfirmationRequest{} }
+func (m *OrderConfirmationRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderConfirmationRequest) ProtoMessage()    {}
+func (*OrderConfirmationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{7}
+}
+func (m *OrderConfirmationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderConfirmationRequest.Unmarshal(m, b)
+}
+func (m *OrderConfirmationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderConfirmationRequest.Marshal(b, m, deterministic)
+}
+func (dst *OrderConfirmationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderConfirmationRequest.Merge(dst, src)
+}
+func (m *OrderConfirmationRequest) XXX_Size() int {
+	return xxx_messageInfo_OrderConfirmationRequest.Size(m)
+}
+func (m *OrderConfirmationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderConfirmationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderConfirmationRequest proto.InternalMessageInfo
+
+func (m *OrderConfirmationRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderConfirmationRequest) GetReject() bool {
+	if m != nil {
+		return m.Reject
+	}
+	return false
+}
+
+type OrderQuery struct {
+	OrderStates          []OrderState `protobuf:"varint,1,rep,packed,name=orderStates,proto3,enum=OrderState" json:"orderStates,omitempty"`
+	SearchTerm           string       `protobuf:"bytes,2,opt,name=searchTerm,proto3" json:"searchTerm,omitempty"`
+	SortByAscending      bool         `protobuf:"varint,3,opt,name=sortByAscending,proto3" json:"sortByAscending,omitempty"`
+	SortByRead           bool         `protobuf:"varint,4,opt,name=sortByRead,proto3" json:"sortByRead,omitempty"`
+	Limit                int32        `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Exclude              []string     `protobuf:"bytes,6,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *OrderQuery) Reset()         { *m = OrderQuery{} }
+func (m *OrderQuery) String() string { return proto.CompactTextString(m) }
+func (*OrderQuery) ProtoMessage()    {}
+func (*OrderQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{8}
+}
+func (m *OrderQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderQuery.Unmarshal(m, b)
+}
+func (m *OrderQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderQuery.Marshal(b, m, deterministic)
+}
+func (dst *OrderQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderQuery.Merge(dst, src)
+}
+func (m *OrderQuery) XXX_Size() int {
+	return xxx_messageInfo_OrderQuery.Size(m)
+}
+func (m *OrderQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderQuery proto.InternalMessageInfo
+
+func (m *OrderQuery) GetOrderStates() []OrderState {
+	if m != nil {
+		return m.OrderStates
+	}
+	return nil
+}
+
+func (m *OrderQuery) GetSearchTerm() string {
+	if m != nil {
+		return m.SearchTerm
+	}
+	return ""
+}
+
+func (m *OrderQuery) GetSortByAscending() bool {
+	if m != nil {
+		return m.SortByAscending
+	}
+	return false
+}
+
+func (m *OrderQuery) GetSortByRead() bool {
+	if m != nil {
+		return m.SortByRead
+	}
+	return false
+}
+
+func (m *OrderQuery) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *OrderQuery) GetExclude() []string {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
+
+type OrderSummary struct {
+	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Slug                 string               `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Title                string               `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Thumbnail            string               `protobuf:"bytes,5,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Total                uint64               `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	PeerId               string               `protobuf:"bytes,7,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	Handle               string               `protobuf:"bytes,8,opt,name=handle,proto3" json:"handle,omitempty"`
+	ShippingName         string               `protobuf:"bytes,9,opt,name=shippingName,proto3" json:"shippingName,omitempty"`
+	ShippingAddress      string               `protobuf:"bytes,10,opt,name=shippingAddress,proto3" json:"shippingAddress,omitempty"`
+	CoinType             string               `protobuf:"bytes,11,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	PaymentCoin          string               `protobuf:"bytes,12,opt,name=paymentCoin,proto3" json:"paymentCoin,omitempty"`
+	State                OrderState           `protobuf:"varint,13,opt,name=state,proto3,enum=OrderState" json:"state,omitempty"`
+	Read                 bool                 `protobuf:"varint,14,opt,name=read,proto3" json:"read,omitempty"`
+	Moderated            bool                 `protobuf:"varint,15,opt,name=moderated,proto3" json:"moderated,omitempty"`
+	UnreadChatMessages   uint32               `protobuf:"varint,16,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OrderSummary) Reset()         { *m = OrderSummary{} }
+func (m *OrderSummary) String() string { return proto.CompactTextString(m) }
+func (*OrderSummary) ProtoMessage()    {}
+func (*OrderSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{9}
+}
+func (m *OrderSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderSummary.Unmarshal(m, b)
+}
+func (m *OrderSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderSummary.Marshal(b, m, deterministic)
+}
+func (dst *OrderSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderSummary.Merge(dst, src)
+}
+func (m *OrderSummary) XXX_Size() int {
+	return xxx_messageInfo_OrderSummary.Size(m)
+}
+func (m *OrderSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderSummary proto.InternalMessageInfo
+
+func (m *OrderSummary) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *OrderSummary) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetThumbnail() string {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *OrderSummary) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetShippingName() string {
+	if m != nil {
+		return m.ShippingName
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetShippingAddress() string {
+	if m != nil {
+		return m.ShippingAddress
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetPaymentCoin() string {
+	if m != nil {
+		return m.PaymentCoin
+	}
+	return ""
+}
+
+func (m *OrderSummary) GetState() OrderState {
+	if m != nil {
+		return m.State
+	}
+	return OrderState_PENDING
+}
+
+func (m *OrderSummary) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *OrderSummary) GetModerated() bool {
+	if m != nil {
+		return m.Moderated
+	}
+	return false
+}
+
+func (m *OrderSummary) GetUnreadChatMessages() uint32 {
+	if m != nil {
+		return m.UnreadChatMessages
+	}
+	return 0
+}
+
+type OrderList struct {
+	Orders               []*OrderSummary `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	QueryCount           uint32          `protobuf:"varint,2,opt,name=queryCount,proto3" json:"queryCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *OrderList) Reset()         { *m = OrderList{} }
+func (m *OrderList) String() string { return proto.CompactTextString(m) }
+func (*OrderList) ProtoMessage()    {}
+func (*OrderList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{10}
+}
+func (m *OrderList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderList.Unmarshal(m, b)
+}
+func (m *OrderList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderList.Marshal(b, m, deterministic)
+}
+func (dst *OrderList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderList.Merge(dst, src)
+}
+func (m *OrderList) XXX_Size() int {
+	return xxx_messageInfo_OrderList.Size(m)
+}
+func (m *OrderList) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderList proto.InternalMessageInfo
+
+func (m *OrderList) GetOrders() []*OrderSummary {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *OrderList) GetQueryCount() uint32 {
+	if m != nil {
+		return m.QueryCount
+	}
+	return 0
+}
+
+type CaseSummary struct {
+	CaseId               string               `protobuf:"bytes,1,opt,name=caseId,proto3" json:"caseId,omitempty"`
+	Slug                 string               `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Title                string               `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Thumbnail            string               `protobuf:"bytes,5,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Total                uint64               `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	BuyerId              string               `protobuf:"bytes,7,opt,name=buyerId,proto3" json:"buyerId,omitempty"`
+	BuyerHandle          string               `protobuf:"bytes,8,opt,name=buyerHandle,proto3" json:"buyerHandle,omitempty"`
+	VendorId             string               `protobuf:"bytes,9,opt,name=vendorId,proto3" json:"vendorId,omitempty"`
+	VendorHandle         string               `protobuf:"bytes,10,opt,name=vendorHandle,proto3" json:"vendorHandle,omitempty"`
+	CoinType             string               `protobuf:"bytes,11,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	PaymentCoin          string               `protobuf:"bytes,12,opt,name=paymentCoin,proto3" json:"paymentCoin,omitempty"`
+	BuyerOpened          bool                 `protobuf:"varint,13,opt,name=buyerOpened,proto3" json:"buyerOpened,omitempty"`
+	State                OrderState           `protobuf:"varint,14,opt,name=state,proto3,enum=OrderState" json:"state,omitempty"`
+	Read                 bool                 `protobuf:"varint,15,opt,name=read,proto3" json:"read,omitempty"`
+	UnreadChatMessages   uint32               `protobuf:"varint,16,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CaseSummary) Reset()         { *m = CaseSummary{} }
+func (m *CaseSummary) String() string { return proto.CompactTextString(m) }
+func (*CaseSummary) ProtoMessage()    {}
+func (*CaseSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{11}
+}
+func (m *CaseSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaseSummary.Unmarshal(m, b)
+}
+func (m *CaseSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaseSummary.Marshal(b, m, deterministic)
+}
+func (dst *CaseSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaseSummary.Merge(dst, src)
+}
+func (m *CaseSummary) XXX_Size() int {
+	return xxx_messageInfo_CaseSummary.Size(m)
+}
+func (m *CaseSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaseSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaseSummary proto.InternalMessageInfo
+
+func (m *CaseSummary) GetCaseId() string {
+	if m != nil {
+		return m.CaseId
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *CaseSummary) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetThumbnail() string {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *CaseSummary) GetBuyerId() string {
+	if m != nil {
+		return m.BuyerId
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetBuyerHandle() string {
+	if m != nil {
+		return m.BuyerHandle
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetVendorId() string {
+	if m != nil {
+		return m.VendorId
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetVendorHandle() string {
+	if m != nil {
+		return m.VendorHandle
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetPaymentCoin() string {
+	if m != nil {
+		return m.PaymentCoin
+	}
+	return ""
+}
+
+func (m *CaseSummary) GetBuyerOpened() bool {
+	if m != nil {
+		return m.BuyerOpened
+	}
+	return false
+}
+
+func (m *CaseSummary) GetState() OrderState {
+	if m != nil {
+		return m.State
+	}
+	return OrderState_PENDING
+}
+
+func (m *CaseSummary) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *CaseSummary) GetUnreadChatMessages() uint32 {
+	if m != nil {
+		return m.UnreadChatMessages
+	}
+	return 0
+}
+
+type CaseList struct {
+	Cases                []*CaseSummary `protobuf:"bytes,1,rep,name=cases,proto3" json:"cases,omitempty"`
+	QueryCount           uint32         `protobuf:"varint,2,opt,name=queryCount,proto3" json:"queryCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CaseList) Reset()         { *m = CaseList{} }
+func (m *CaseList) String() string { return proto.CompactTextString(m) }
+func (*CaseList) ProtoMessage()    {}
+func (*CaseList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{12}
+}
+func (m *CaseList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaseList.Unmarshal(m, b)
+}
+func (m *CaseList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaseList.Marshal(b, m, deterministic)
+}
+func (dst *CaseList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaseList.Merge(dst, src)
+}
+func (m *CaseList) XXX_Size() int {
+	return xxx_messageInfo_CaseList.Size(m)
+}
+func (m *CaseList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaseList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaseList proto.InternalMessageInfo
+
+func (m *CaseList) GetCases() []*CaseSummary {
+	if m != nil {
+		return m.Cases
+	}
+	return nil
+}
+
+func (m *CaseList) GetQueryCount() uint32 {
+	if m != nil {
+		return m.QueryCount
+	}
+	return 0
+}
+
+type ChatMessageRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatMessageRequest) Reset()         { *m = ChatMessageRequest{} }
+func (m *ChatMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageRequest) ProtoMessage()    {}
+func (*ChatMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{13}
+}
+func (m *ChatMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessageRequest.Unmarshal(m, b)
+}
+func (m *ChatMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessageRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChatMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageRequest.Merge(dst, src)
+}
+func (m *ChatMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_ChatMessageRequest.Size(m)
+}
+func (m *ChatMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageRequest proto.InternalMessageInfo
+
+func (m *ChatMessageRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ChatMessageRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ChatMessageRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ChatMessageResponse struct {
+	MessageId            string   `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatMessageResponse) Reset()         { *m = ChatMessageResponse{} }
+func (m *ChatMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageResponse) ProtoMessage()    {}
+func (*ChatMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{14}
+}
+func (m *ChatMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessageResponse.Unmarshal(m, b)
+}
+func (m *ChatMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessageResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChatMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageResponse.Merge(dst, src)
+}
+func (m *ChatMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_ChatMessageResponse.Size(m)
+}
+func (m *ChatMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageResponse proto.InternalMessageInfo
+
+func (m *ChatMessageResponse) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+type ChatMessagesRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	OffsetId             string   `protobuf:"bytes,3,opt,name=offsetId,proto3" json:"offsetId,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatMessagesRequest) Reset()         { *m = ChatMessagesRequest{} }
+func (m *ChatMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ChatMessagesRequest) ProtoMessage()    {}
+func (*ChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{15}
+}
+func (m *ChatMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessagesRequest.Unmarshal(m, b)
+}
+func (m *ChatMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessagesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChatMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessagesRequest.Merge(dst, src)
+}
+func (m *ChatMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_ChatMessagesRequest.Size(m)
+}
+func (m *ChatMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessagesRequest proto.InternalMessageInfo
+
+func (m *ChatMessagesRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetOffsetId() string {
+	if m != nil {
+		return m.OffsetId
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ChatMessage struct {
+	MessageId            string               `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	PeerId               string               `protobuf:"bytes,2,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	Subject              string               `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message              string               `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Read                 bool                 `protobuf:"varint,5,opt,name=read,proto3" json:"read,omitempty"`
+	Outgoing             bool                 `protobuf:"varint,6,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ChatMessage) Reset()         { *m = ChatMessage{} }
+func (m *ChatMessage) String() string { return proto.CompactTextString(m) }
+func (*ChatMessage) ProtoMessage()    {}
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{16}
+}
+func (m *ChatMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessage.Unmarshal(m, b)
+}
+func (m *ChatMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessage.Marshal(b, m, deterministic)
+}
+func (dst *ChatMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessage.Merge(dst, src)
+}
+func (m *ChatMessage) XXX_Size() int {
+	return xxx_messageInfo_ChatMessage.Size(m)
+}
+func (m *ChatMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessage proto.InternalMessageInfo
+
+func (m *ChatMessage) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *ChatMessage) GetOutgoing() bool {
+	if m != nil {
+		return m.Outgoing
+	}
+	return false
+}
+
+func (m *ChatMessage) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type ChatMessageList struct {
+	Messages             []*ChatMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChatMessageList) Reset()         { *m = ChatMessageList{} }
+func (m *ChatMessageList) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageList) ProtoMessage()    {}
+func (*ChatMessageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{17}
+}
+func (m *ChatMessageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessageList.Unmarshal(m, b)
+}
+func (m *ChatMessageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessageList.Marshal(b, m, deterministic)
+}
+func (dst *ChatMessageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageList.Merge(dst, src)
+}
+func (m *ChatMessageList) XXX_Size() int {
+	return xxx_messageInfo_ChatMessageList.Size(m)
+}
+func (m *ChatMessageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageList proto.InternalMessageInfo
+
+func (m *ChatMessageList) GetMessages() []*ChatMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type ChatConversation struct {
+	PeerId               string               `protobuf:"bytes,1,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	Unread               uint32               `protobuf:"varint,2,opt,name=unread,proto3" json:"unread,omitempty"`
+	LastMessage          string               `protobuf:"bytes,3,opt,name=lastMessage,proto3" json:"lastMessage,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Outgoing             bool                 `protobuf:"varint,5,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ChatConversation) Reset()         { *m = ChatConversation{} }
+func (m *ChatConversation) String() string { return proto.CompactTextString(m) }
+func (*ChatConversation) ProtoMessage()    {}
+func (*ChatConversation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{18}
+}
+func (m *ChatConversation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatConversation.Unmarshal(m, b)
+}
+func (m *ChatConversation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatConversation.Marshal(b, m, deterministic)
+}
+func (dst *ChatConversation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatConversation.Merge(dst, src)
+}
+func (m *ChatConversation) XXX_Size() int {
+	return xxx_messageInfo_ChatConversation.Size(m)
+}
+func (m *ChatConversation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatConversation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatConversation proto.InternalMessageInfo
+
+func (m *ChatConversation) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ChatConversation) GetUnread() uint32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+func (m *ChatConversation) GetLastMessage() string {
+	if m != nil {
+		return m.LastMessage
+	}
+	return ""
+}
+
+func (m *ChatConversation) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *ChatConversation) GetOutgoing() bool {
+	if m != nil {
+		return m.Outgoing
+	}
+	return false
+}
+
+type ChatConversationList struct {
+	Conversations        []*ChatConversation `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ChatConversationList) Reset()         { *m = ChatConversationList{} }
+func (m *ChatConversationList) String() string { return proto.CompactTextString(m) }
+func (*ChatConversationList) ProtoMessage()    {}
+func (*ChatConversationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{19}
+}
+func (m *ChatConversationList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatConversationList.Unmarshal(m, b)
+}
+func (m *ChatConversationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatConversationList.Marshal(b, m, deterministic)
+}
+func (dst *ChatConversationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatConversationList.Merge(dst, src)
+}
+func (m *ChatConversationList) XXX_Size() int {
+	return xxx_messageInfo_ChatConversationList.Size(m)
+}
+func (m *ChatConversationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatConversationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatConversationList proto.InternalMessageInfo
+
+func (m *ChatConversationList) GetConversations() []*ChatConversation {
+	if m != nil {
+		return m.Conversations
+	}
+	return nil
+}
+
+type ChatReadRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peerId,proto3" json:"peerId,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatReadRequest) Reset()         { *m = ChatReadRequest{} }
+func (m *ChatReadRequest) String() string { return proto.CompactTextString(m) }
+func (*ChatReadRequest) ProtoMessage()    {}
+func (*ChatReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{20}
+}
+func (m *ChatReadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatReadRequest.Unmarshal(m, b)
+}
+func (m *ChatReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatReadRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChatReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatReadRequest.Merge(dst, src)
+}
+func (m *ChatReadRequest) XXX_Size() int {
+	return xxx_messageInfo_ChatReadRequest.Size(m)
+}
+func (m *ChatReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatReadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatReadRequest proto.InternalMessageInfo
+
+func (m *ChatReadRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ChatReadRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+type WalletAddress struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletAddress) Reset()         { *m = WalletAddress{} }
+func (m *WalletAddress) String() string { return proto.CompactTextString(m) }
+func (*WalletAddress) ProtoMessage()    {}
+func (*WalletAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{21}
+}
+func (m *WalletAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletAddress.Unmarshal(m, b)
+}
+func (m *WalletAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletAddress.Marshal(b, m, deterministic)
+}
+func (dst *WalletAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletAddress.Merge(dst, src)
+}
+func (m *WalletAddress) XXX_Size() int {
+	return xxx_messageInfo_WalletAddress.Size(m)
+}
+func (m *WalletAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletAddress proto.InternalMessageInfo
+
+func (m *WalletAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type WalletBalance struct {
+	Confirmed            int64    `protobuf:"varint,1,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Unconfirmed          int64    `protobuf:"varint,2,opt,name=unconfirmed,proto3" json:"unconfirmed,omitempty"`
+	Height               uint32   `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletBalance) Reset()         { *m = WalletBalance{} }
+func (m *WalletBalance) String() string { return proto.CompactTextString(m) }
+func (*WalletBalance) ProtoMessage()    {}
+func (*WalletBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{22}
+}
+func (m *WalletBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalance.Unmarshal(m, b)
+}
+func (m *WalletBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalance.Marshal(b, m, deterministic)
+}
+func (dst *WalletBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalance.Merge(dst, src)
+}
+func (m *WalletBalance) XXX_Size() int {
+	return xxx_messageInfo_WalletBalance.Size(m)
+}
+func (m *WalletBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletBalance proto.InternalMessageInfo
+
+func (m *WalletBalance) GetConfirmed() int64 {
+	if m != nil {
+		return m.Confirmed
+	}
+	return 0
+}
+
+func (m *WalletBalance) GetUnconfirmed() int64 {
+	if m != nil {
+		return m.Unconfirmed
+	}
+	return 0
+}
+
+func (m *WalletBalance) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type SpendRequest struct {
+	Address              string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               int64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeLevel             SpendRequest_FeeLevel `protobuf:"varint,3,opt,name=feeLevel,proto3,enum=SpendRequest_FeeLevel" json:"feeLevel,omitempty"`
+	Memo                 string                `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SpendRequest) Reset()         { *m = SpendRequest{} }
+func (m *SpendRequest) String() string { return proto.CompactTextString(m) }
+func (*SpendRequest) ProtoMessage()    {}
+func (*SpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{23}
+}
+func (m *SpendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpendRequest.Unmarshal(m, b)
+}
+func (m *SpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpendRequest.Marshal(b, m, deterministic)
+}
+func (dst *SpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendRequest.Merge(dst, src)
+}
+func (m *SpendRequest) XXX_Size() int {
+	return xxx_messageInfo_SpendRequest.Size(m)
+}
+func (m *SpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendRequest proto.InternalMessageInfo
+
+func (m *SpendRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpendRequest) GetFeeLevel() SpendRequest_FeeLevel {
+	if m != nil {
+		return m.FeeLevel
+	}
+	return SpendRequest_NORMAL
+}
+
+func (m *SpendRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+type SpendResponse struct {
+	Txid                 string               `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Amount               int64                `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ConfirmedBalance     int64                `protobuf:"varint,3,opt,name=confirmedBalance,proto3" json:"confirmedBalance,omitempty"`
+	UnconfirmedBalance   int64                `protobuf:"varint,4,opt,name=unconfirmedBalance,proto3" json:"unconfirmedBalance,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Memo                 string               `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SpendResponse) Reset()         { *m = SpendResponse{} }
+func (m *SpendResponse) String() string { return proto.CompactTextString(m) }
+func (*SpendResponse) ProtoMessage()    {}
+func (*SpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{24}
+}
+func (m *SpendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpendResponse.Unmarshal(m, b)
+}
+func (m *SpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpendResponse.Marshal(b, m, deterministic)
+}
+func (dst *SpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendResponse.Merge(dst, src)
+}
+func (m *SpendResponse) XXX_Size() int {
+	return xxx_messageInfo_SpendResponse.Size(m)
+}
+func (m *SpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendResponse proto.InternalMessageInfo
+
+func (m *SpendResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *SpendResponse) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpendResponse) GetConfirmedBalance() int64 {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return 0
+}
+
+func (m *SpendResponse) GetUnconfirmedBalance() int64 {
+	if m != nil {
+		return m.UnconfirmedBalance
+	}
+	return 0
+}
+
+func (m *SpendResponse) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *SpendResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+type NotificationsRequest struct {
+	// Notification types to stream. All are streamed if empty.
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	// Resume after the event with this ID, as with the Last-Event-ID of
+	// /ob/events
+	LastEventId          uint64   `protobuf:"varint,2,opt,name=lastEventId,proto3" json:"lastEventId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotificationsRequest) Reset()         { *m = NotificationsRequest{} }
+func (m *NotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*NotificationsRequest) ProtoMessage()    {}
+func (*NotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{25}
+}
+func (m *NotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationsRequest.Unmarshal(m, b)
+}
+func (m *NotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *NotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationsRequest.Merge(dst, src)
+}
+func (m *NotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_NotificationsRequest.Size(m)
+}
+func (m *NotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationsRequest proto.InternalMessageInfo
+
+func (m *NotificationsRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *NotificationsRequest) GetLastEventId() uint64 {
+	if m != nil {
+		return m.LastEventId
+	}
+	return 0
+}
+
+type NotificationEvent struct {
+	// ID of the persisted event, or 0 for transient notifications
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// The notification as sent to websocket clients. A stream resuming
+	// after events which are no longer kept starts with a "truncated" event
+	// holding the oldest ID kept.
+	Json                 string   `protobuf:"bytes,3,opt,name=json,proto3" json:"json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotificationEvent) Reset()         { *m = NotificationEvent{} }
+func (m *NotificationEvent) String() string { return proto.CompactTextString(m) }
+func (*NotificationEvent) ProtoMessage()    {}
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_service_21ac6dfd753e8a6e, []int{26}
+}
+func (m *NotificationEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationEvent.Unmarshal(m, b)
+}
+func (m *NotificationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationEvent.Marshal(b, m, deterministic)
+}
+func (dst *NotificationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationEvent.Merge(dst, src)
+}
+func (m *NotificationEvent) XXX_Size() int {
+	return xxx_messageInfo_NotificationEvent.Size(m)
+}
+func (m *NotificationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationEvent proto.InternalMessageInfo
+
+func (m *NotificationEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *NotificationEvent) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *NotificationEvent) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "Empty")
+	proto.RegisterType((*ListingRequest)(nil), "ListingRequest")
+	proto.RegisterType((*ListingIndex)(nil), "ListingIndex")
+	proto.RegisterType((*ListingIndexEntry)(nil), "ListingIndexEntry")
+	proto.RegisterType((*ListingIndexEntry_Thumbnail)(nil), "ListingIndexEntry.Thumbnail")
+	proto.RegisterType((*ListingIndexEntry_Price)(nil), "ListingIndexEntry.Price")
+	proto.RegisterType((*PurchaseRequest)(nil), "PurchaseRequest")
+	proto.RegisterType((*PurchaseRequest_Item)(nil), "PurchaseRequest.Item")
+	proto.RegisterType((*PurchaseRequest_Item_Option)(nil), "PurchaseRequest.Item.Option")
+	proto.RegisterType((*PurchaseRequest_Item_Shipping)(nil), "PurchaseRequest.Item.Shipping")
+	proto.RegisterType((*PurchaseResponse)(nil), "PurchaseResponse")
+	proto.RegisterType((*OrderRequest)(nil), "OrderRequest")
+	proto.RegisterType((*OrderConfirmationRequest)(nil), "OrderConfirmationRequest")
+	proto.RegisterType((*OrderQuery)(nil), "OrderQuery")
+	proto.RegisterType((*OrderSummary)(nil), "OrderSummary")
+	proto.RegisterType((*OrderList)(nil), "OrderList")
+	proto.RegisterType((*CaseSummary)(nil), "CaseSummary")
+	proto.RegisterType((*CaseList)(nil), "CaseList")
+	proto.RegisterType((*ChatMessageRequest)(nil), "ChatMessageRequest")
+	proto.RegisterType((*ChatMessageResponse)(nil), "ChatMessageResponse")
+	proto.RegisterType((*ChatMessagesRequest)(nil), "ChatMessagesRequest")
+	proto.RegisterType((*ChatMessage)(nil), "ChatMessage")
+	proto.RegisterType((*ChatMessageList)(nil), "ChatMessageList")
+	proto.RegisterType((*ChatConversation)(nil), "ChatConversation")
+	proto.RegisterType((*ChatConversationList)(nil), "ChatConversationList")
+	proto.RegisterType((*ChatReadRequest)(nil), "ChatReadRequest")
+	proto.RegisterType((*WalletAddress)(nil), "WalletAddress")
+	proto.RegisterType((*WalletBalance)(nil), "WalletBalance")
+	proto.RegisterType((*SpendRequest)(nil), "SpendRequest")
+	proto.RegisterType((*SpendResponse)(nil), "SpendResponse")
+	proto.RegisterType((*NotificationsRequest)(nil), "NotificationsRequest")
+	proto.RegisterType((*NotificationEvent)(nil), "NotificationEvent")
+	proto.RegisterEnum("SpendRequest_FeeLevel", SpendRequest_FeeLevel_name, SpendRequest_FeeLevel_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OpenBazaarClient is the client API for OpenBazaar service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OpenBazaarClient interface {
+	// Listings
+	GetListings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListingIndex, error)
+	GetListing(ctx context.Context, in *ListingRequest, opts ...grpc.CallOption) (*SignedListing, error)
+	CreateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*ListingRequest, error)
+	UpdateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*Empty, error)
+	DeleteListing(ctx context.Context, in *ListingRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Orders
+	Purchase(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*PurchaseResponse, error)
+	GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderRespApi, error)
+	ConfirmOrder(ctx context.Context, in *OrderConfirmationRequest, opts ...grpc.CallOption) (*Empty, error)
+	CancelOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error)
+	FulfillOrder(ctx context.Context, in *OrderFulfillment, opts ...grpc.CallOption) (*Empty, error)
+	RefundOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Sales, purchases and cases
+	GetSales(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*OrderList, error)
+	GetPurchases(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*OrderList, error)
+	GetCases(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*CaseList, error)
+	GetCase(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*CaseRespApi, error)
+	// Chat
+	SendChatMessage(ctx context.Context, in *ChatMessageRequest, opts ...grpc.CallOption) (*ChatMessageResponse, error)
+	GetChatMessages(ctx context.Context, in *ChatMessagesRequest, opts ...grpc.CallOption) (*ChatMessageList, error)
+	GetChatConversations(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChatConversationList, error)
+	MarkChatAsRead(ctx context.Context, in *ChatReadRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Wallet
+	GetAddress(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WalletAddress, error)
+	GetBalance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WalletBalance, error)
+	Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendResponse, error)
+	// Notifications streams the notifications sent to the websocket and
+	// /ob/events clients
+	Notifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (OpenBazaar_NotificationsClient, error)
+}
+
+type openBazaarClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOpenBazaarClient(cc *grpc.ClientConn) OpenBazaarClient {
+	return &openBazaarClient{cc}
+}
+
+func (c *openBazaarClient) GetListings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListingIndex, error) {
+	out := new(ListingIndex)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetListings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetListing(ctx context.Context, in *ListingRequest, opts ...grpc.CallOption) (*SignedListing, error) {
+	out := new(SignedListing)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) CreateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*ListingRequest, error) {
+	out := new(ListingRequest)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/CreateListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) UpdateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/UpdateListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) DeleteListing(ctx context.Context, in *ListingRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/DeleteListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) Purchase(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*PurchaseResponse, error) {
+	out := new(PurchaseResponse)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/Purchase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderRespApi, error) {
+	out := new(OrderRespApi)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) ConfirmOrder(ctx context.Context, in *OrderConfirmationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/ConfirmOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) CancelOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) FulfillOrder(ctx context.Context, in *OrderFulfillment, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/FulfillOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) RefundOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/RefundOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetSales(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*OrderList, error) {
+	out := new(OrderList)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetSales", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetPurchases(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*OrderList, error) {
+	out := new(OrderList)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetPurchases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetCases(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*CaseList, error) {
+	out := new(CaseList)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetCases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetCase(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*CaseRespApi, error) {
+	out := new(CaseRespApi)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) SendChatMessage(ctx context.Context, in *ChatMessageRequest, opts ...grpc.CallOption) (*ChatMessageResponse, error) {
+	out := new(ChatMessageResponse)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/SendChatMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetChatMessages(ctx context.Context, in *ChatMessagesRequest, opts ...grpc.CallOption) (*ChatMessageList, error) {
+	out := new(ChatMessageList)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetChatMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetChatConversations(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChatConversationList, error) {
+	out := new(ChatConversationList)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetChatConversations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) MarkChatAsRead(ctx context.Context, in *ChatReadRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/MarkChatAsRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetAddress(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WalletAddress, error) {
+	out := new(WalletAddress)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) GetBalance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WalletBalance, error) {
+	out := new(WalletBalance)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendResponse, error) {
+	out := new(SpendResponse)
+	err := c.cc.Invoke(ctx, "/OpenBazaar/Spend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openBazaarClient) Notifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (OpenBazaar_NotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_OpenBazaar_serviceDesc.Streams[0], "/OpenBazaar/Notifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &openBazaarNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OpenBazaar_NotificationsClient interface {
+	Recv() (*NotificationEvent, error)
+	grpc.ClientStream
+}
+
+type openBazaarNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *openBazaarNotificationsClient) Recv() (*NotificationEvent, error) {
+	m := new(NotificationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// OpenBazaarServer is the server API for OpenBazaar service.
+type OpenBazaarServer interface {
+	// Listings
+	GetListings(context.Context, *Empty) (*ListingIndex, error)
+	GetListing(context.Context, *ListingRequest) (*SignedListing, error)
+	CreateListing(context.Context, *Listing) (*ListingRequest, error)
+	UpdateListing(context.Context, *Listing) (*Empty, error)
+	DeleteListing(context.Context, *ListingRequest) (*Empty, error)
+	// Orders
+	Purchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error)
+	GetOrder(context.Context, *OrderRequest) (*OrderRespApi, error)
+	ConfirmOrder(context.Context, *OrderConfirmationRequest) (*Empty, error)
+	CancelOrder(context.Context, *OrderRequest) (*Empty, error)
+	FulfillOrder(context.Context, *OrderFulfillment) (*Empty, error)
+	RefundOrder(context.Context, *OrderRequest) (*Empty, error)
+	// Sales, purchases and cases
+	GetSales(context.Context, *OrderQuery) (*OrderList, error)
+	GetPurchases(context.Context, *OrderQuery) (*OrderList, error)
+	GetCases(context.Context, *OrderQuery) (*CaseList, error)
+	GetCase(context.Context, *OrderRequest) (*CaseRespApi, error)
+	// Chat
+	SendChatMessage(context.Context, *ChatMessageRequest) (*ChatMessageResponse, error)
+	GetChatMessages(context.Context, *ChatMessagesRequest) (*ChatMessageList, error)
+	GetChatConversations(context.Context, *Empty) (*ChatConversationList, error)
+	MarkChatAsRead(context.Context, *ChatReadRequest) (*Empty, error)
+	// Wallet
+	GetAddress(context.Context, *Empty) (*WalletAddress, error)
+	GetBalance(context.Context, *Empty) (*WalletBalance, error)
+	Spend(context.Context, *SpendRequest) (*SpendResponse, error)
+	// Notifications streams the notifications sent to the websocket and
+	// /ob/events clients
+	Notifications(*NotificationsRequest, OpenBazaar_NotificationsServer) error
+}
+
+func RegisterOpenBazaarServer(s *grpc.Server, srv OpenBazaarServer) {
+	s.RegisterService(&_OpenBazaar_serviceDesc, srv)
+}
+
+func _OpenBazaar_GetListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetListings(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetListing(ctx, req.(*ListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_CreateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Listing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).CreateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/CreateListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).CreateListing(ctx, req.(*Listing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_UpdateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Listing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).UpdateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/UpdateListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).UpdateListing(ctx, req.(*Listing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_DeleteListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).DeleteListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/DeleteListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).DeleteListing(ctx, req.(*ListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_Purchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).Purchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/Purchase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).Purchase(ctx, req.(*PurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderConfirmationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).ConfirmOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/ConfirmOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).ConfirmOrder(ctx, req.(*OrderConfirmationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).CancelOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderFulfillment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).FulfillOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/FulfillOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).FulfillOrder(ctx, req.(*OrderFulfillment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/RefundOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).RefundOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetSales",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetSales(ctx, req.(*OrderQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetPurchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetPurchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetPurchases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetPurchases(ctx, req.(*OrderQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetCases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetCases(ctx, req.(*OrderQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetCase(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).SendChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/SendChatMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).SendChatMessage(ctx, req.(*ChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetChatMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetChatMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetChatMessages(ctx, req.(*ChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetChatConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetChatConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetChatConversations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetChatConversations(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_MarkChatAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).MarkChatAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/MarkChatAsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).MarkChatAsRead(ctx, req.(*ChatReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetAddress(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).GetBalance(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_Spend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenBazaarServer).Spend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenBazaar/Spend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenBazaarServer).Spend(ctx, req.(*SpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenBazaar_Notifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpenBazaarServer).Notifications(m, &openBazaarNotificationsServer{stream})
+}
+
+type OpenBazaar_NotificationsServer interface {
+	Send(*NotificationEvent) error
+	grpc.ServerStream
+}
+
+type openBazaarNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *openBazaarNotificationsServer) Send(m *NotificationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _OpenBazaar_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenBazaar",
+	HandlerType: (*OpenBazaarServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetListings",
+			Handler:    _OpenBazaar_GetListings_Handler,
+		},
+		{
+			MethodName: "GetListing",
+			Handler:    _OpenBazaar_GetListing_Handler,
+		},
+		{
+			MethodName: "CreateListing",
+			Handler:    _OpenBazaar_CreateListing_Handler,
+		},
+		{
+			MethodName: "UpdateListing",
+			Handler:    _OpenBazaar_UpdateListing_Handler,
+		},
+		{
+			MethodName: "DeleteListing",
+			Handler:    _OpenBazaar_DeleteListing_Handler,
+		},
+		{
+			MethodName: "Purchase",
+			Handler:    _OpenBazaar_Purchase_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _OpenBazaar_GetOrder_Handler,
+		},
+		{
+			MethodName: "ConfirmOrder",
+			Handler:    _OpenBazaar_ConfirmOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OpenBazaar_CancelOrder_Handler,
+		},
+		{
+			MethodName: "FulfillOrder",
+			Handler:    _OpenBazaar_FulfillOrder_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _OpenBazaar_RefundOrder_Handler,
+		},
+		{
+			MethodName: "GetSales",
+			Handler:    _OpenBazaar_GetSales_Handler,
+		},
+		{
+			MethodName: "GetPurchases",
+			Handler:    _OpenBazaar_GetPurchases_Handler,
+		},
+		{
+			MethodName: "GetCases",
+			Handler:    _OpenBazaar_GetCases_Handler,
+		},
+		{
+			MethodName: "GetCase",
+			Handler:    _OpenBazaar_GetCase_Handler,
+		},
+		{
+			MethodName: "SendChatMessage",
+			Handler:    _OpenBazaar_SendChatMessage_Handler,
+		},
+		{
+			MethodName: "GetChatMessages",
+			Handler:    _OpenBazaar_GetChatMessages_Handler,
+		},
+		{
+			MethodName: "GetChatConversations",
+			Handler:    _OpenBazaar_GetChatConversations_Handler,
+		},
+		{
+			MethodName: "MarkChatAsRead",
+			Handler:    _OpenBazaar_MarkChatAsRead_Handler,
+		},
+		{
+			MethodName: "GetAddress",
+			Handler:    _OpenBazaar_GetAddress_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _OpenBazaar_GetBalance_Handler,
+		},
+		{
+			MethodName: "Spend",
+			Handler:    _OpenBazaar_Spend_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Notifications",
+			Handler:       _OpenBazaar_Notifications_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+func init() { proto.RegisterFile("service.proto", fileDescriptor_service_21ac6dfd753e8a6e) }
+
+var fileDescriptor_service_21ac6dfd753e8a6e = []byte{
+	// 2145 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x72, 0x1b, 0x49,
+	0xf5, 0xff, 0x4b, 0x96, 0xe4, 0xd1, 0xd1, 0x87, 0xed, 0x8e, 0x93, 0x9a, 0xbf, 0x2a, 0xb5, 0x78,
+	0x87, 0x10, 0xb4, 0xa1, 0x76, 0x02, 0xde, 0x02, 0x96, 0x65, 0x8b, 0x2a, 0x47, 0x64, 0xb3, 0xae,
+	0x8a, 0xad, 0xd0, 0x36, 0x45, 0xc1, 0x0d, 0xb4, 0x67, 0x5a, 0xd2, 0x2c, 0xf3, 0x95, 0xe9, 0x1e,
+	0x13, 0x71, 0x05, 0x57, 0xdc, 0xf2, 0x16, 0xbc, 0x03, 0xc5, 0x6b, 0xf0, 0x00, 0x5c, 0xf0, 0x0c,
+	0xdc, 0x70, 0x41, 0xf5, 0xd7, 0x4c, 0x8f, 0x24, 0x27, 0x95, 0xe5, 0x86, 0xbb, 0x3e, 0xbf, 0x3e,
+	0xd3, 0x7d, 0xfa, 0xf4, 0xef, 0x7c, 0xf4, 0xc0, 0x88, 0xd1, 0xe2, 0x36, 0x0a, 0xa8, 0x9f, 0x17,
+	0x19, 0xcf, 0x26, 0x7d, 0x92, 0x47, 0x7a, 0x78, 0x10, 0x64, 0x29, 0x2f, 0x48, 0xc0, 0x99, 0x06,
+	0x86, 0x59, 0x11, 0xd2, 0xc2, 0x48, 0xdf, 0x58, 0x66, 0xd9, 0x32, 0xa6, 0x4f, 0xa5, 0x74, 0x53,
+	0x2e, 0x9e, 0xf2, 0x28, 0xa1, 0x8c, 0x93, 0x24, 0x57, 0x0a, 0xde, 0x3e, 0x74, 0x9f, 0x27, 0x39,
+	0x5f, 0x7b, 0x8f, 0x60, 0xfc, 0x32, 0x62, 0x3c, 0x4a, 0x97, 0x98, 0xbe, 0x2e, 0x29, 0xe3, 0x08,
+	0x41, 0x87, 0xc5, 0xe5, 0xd2, 0x6d, 0x9d, 0xb4, 0xa6, 0x7d, 0x2c, 0xc7, 0xde, 0x4f, 0x60, 0xa8,
+	0xb5, 0xce, 0xd3, 0x90, 0xbe, 0x41, 0x3e, 0x38, 0xb1, 0x92, 0x99, 0xdb, 0x3a, 0xd9, 0x9b, 0x0e,
+	0x4e, 0x91, 0x6f, 0x2b, 0x3c, 0x4f, 0x79, 0xb1, 0xc6, 0x95, 0x8e, 0xf7, 0x97, 0x1e, 0x1c, 0x6d,
+	0xcd, 0x8b, 0x9d, 0x56, 0x84, 0xad, 0xcc, 0x4e, 0x62, 0x5c, 0xed, 0xde, 0xae, 0x77, 0x47, 0xc7,
+	0xd0, 0xe5, 0x11, 0x8f, 0xa9, 0xbb, 0x27, 0x41, 0x25, 0xa0, 0x0f, 0x00, 0x02, 0xc2, 0xe9, 0x32,
+	0x2b, 0x22, 0xca, 0xdc, 0xce, 0xc9, 0xde, 0xb4, 0x8f, 0x2d, 0x44, 0xac, 0xc4, 0xc9, 0x92, 0xb9,
+	0x5d, 0x39, 0x23, 0xc7, 0x02, 0x4b, 0xd9, 0xe2, 0x77, 0x6e, 0xef, 0xa4, 0x35, 0x75, 0xb0, 0x1c,
+	0x23, 0x0f, 0x86, 0xc6, 0x99, 0xd7, 0xeb, 0x9c, 0xba, 0xfb, 0x72, 0x93, 0x06, 0x86, 0x4e, 0x60,
+	0x10, 0x52, 0x16, 0x14, 0x51, 0xce, 0xa3, 0x2c, 0x75, 0x1d, 0xa9, 0x62, 0x43, 0xe8, 0x33, 0xe8,
+	0xf3, 0x55, 0x99, 0xdc, 0xa4, 0x24, 0x8a, 0xdd, 0xfe, 0x49, 0x6b, 0x3a, 0x38, 0x7d, 0xb8, 0xed,
+	0x12, 0xff, 0xda, 0xe8, 0xe0, 0x5a, 0x1d, 0xf9, 0xd0, 0xcd, 0x8b, 0x28, 0xa0, 0x2e, 0xc8, 0xef,
+	0xdc, 0x1d, 0xdf, 0xbd, 0x12, 0xf3, 0x58, 0xa9, 0x21, 0x17, 0xf6, 0xd9, 0x2a, 0xca, 0xd9, 0x75,
+	0xe6, 0x0e, 0xe4, 0xe1, 0x8c, 0x28, 0xce, 0xb2, 0x28, 0x28, 0xbd, 0x5a, 0x45, 0x79, 0x1e, 0xa5,
+	0x4b, 0x77, 0x28, 0xa7, 0x1b, 0x18, 0x9a, 0x80, 0x13, 0x93, 0x74, 0x59, 0x92, 0x25, 0x75, 0x47,
+	0xf2, 0x20, 0x95, 0x8c, 0x1e, 0x42, 0xdf, 0x8c, 0x99, 0x3b, 0x96, 0x1f, 0xd7, 0x00, 0x7a, 0x04,
+	0x23, 0x72, 0x4b, 0x0b, 0xb2, 0xa4, 0x98, 0x08, 0xfb, 0xdc, 0x83, 0x93, 0xd6, 0xb4, 0x8d, 0x9b,
+	0xa0, 0xf0, 0x55, 0x21, 0x47, 0xb3, 0xac, 0x4c, 0xb9, 0x7b, 0x78, 0xd2, 0x9a, 0x8e, 0xb0, 0x0d,
+	0x89, 0x9b, 0x4b, 0xb2, 0x90, 0x16, 0x84, 0x67, 0x05, 0x73, 0x8f, 0xd4, 0xcd, 0xd5, 0x08, 0xf2,
+	0x01, 0x91, 0x20, 0xa0, 0x39, 0xa7, 0xe1, 0xac, 0x2c, 0x0a, 0x9a, 0x06, 0xe2, 0x86, 0x91, 0xd4,
+	0xdb, 0x31, 0x23, 0x4e, 0x14, 0x64, 0x51, 0x2a, 0x6f, 0xef, 0x9e, 0x3a, 0x91, 0x91, 0x27, 0x17,
+	0xd0, 0xaf, 0x7c, 0x2e, 0x29, 0x11, 0xa5, 0x6b, 0x43, 0x38, 0x31, 0x16, 0xe4, 0x62, 0x09, 0x89,
+	0x63, 0xcd, 0x38, 0x25, 0xa0, 0x07, 0xd0, 0x4b, 0x68, 0x18, 0x95, 0x89, 0xe6, 0x9c, 0x96, 0x26,
+	0xbf, 0x86, 0xae, 0xbc, 0x0a, 0xc9, 0x1a, 0x65, 0xc1, 0x7a, 0x96, 0x85, 0x54, 0x2f, 0xd9, 0xc0,
+	0xc4, 0x22, 0x24, 0x91, 0x4e, 0x10, 0x6b, 0x77, 0xb0, 0x96, 0x84, 0xbd, 0x49, 0x16, 0x46, 0x8b,
+	0x88, 0x16, 0x72, 0xf9, 0x36, 0xae, 0x64, 0xef, 0x5f, 0x5d, 0x38, 0x78, 0x55, 0x16, 0xc1, 0x8a,
+	0x30, 0x6a, 0x22, 0xf2, 0x01, 0xf4, 0xc4, 0x05, 0x5f, 0x67, 0x7a, 0x17, 0x2d, 0x09, 0x1e, 0x90,
+	0x30, 0x2c, 0x28, 0x63, 0xda, 0x78, 0x23, 0x8a, 0x83, 0x06, 0x11, 0x5f, 0x6b, 0xe3, 0xe5, 0x58,
+	0x1e, 0x94, 0x13, 0x4e, 0xdd, 0x8e, 0x3e, 0xa8, 0x10, 0xc4, 0x5d, 0xe4, 0x19, 0xe3, 0x24, 0x96,
+	0xa7, 0xe8, 0xca, 0x29, 0x0b, 0x11, 0xb7, 0x19, 0x08, 0xa3, 0x0b, 0x75, 0xcc, 0x9e, 0x62, 0xbe,
+	0x05, 0x09, 0x4f, 0xe8, 0x6d, 0x2f, 0x33, 0x4e, 0x99, 0x89, 0x1f, 0x1b, 0x13, 0xbc, 0xaa, 0xee,
+	0x57, 0x47, 0x4f, 0x0d, 0xa0, 0xef, 0x40, 0x37, 0xe2, 0x34, 0x61, 0x6e, 0x5f, 0xa6, 0x92, 0xfb,
+	0xfe, 0x86, 0x03, 0xfc, 0x73, 0x4e, 0x13, 0xac, 0x74, 0xd0, 0x29, 0x1c, 0x93, 0x98, 0xd3, 0x22,
+	0x25, 0x9c, 0xce, 0xb2, 0x94, 0x93, 0x80, 0x9f, 0xa7, 0x8b, 0x4c, 0xc6, 0x4e, 0x1f, 0xef, 0x9c,
+	0x13, 0xc4, 0x2d, 0xe8, 0xa2, 0x4c, 0xc3, 0x33, 0xed, 0xae, 0x81, 0x54, 0x6e, 0x82, 0x93, 0x7f,
+	0xb7, 0xa1, 0x23, 0x76, 0x12, 0x67, 0xd6, 0x99, 0xeb, 0xcb, 0x3a, 0x3d, 0xd9, 0x90, 0xb8, 0xc1,
+	0xd7, 0x25, 0x49, 0xb9, 0xf0, 0xb1, 0xba, 0xdb, 0x4a, 0x46, 0x3f, 0x80, 0xfd, 0x4c, 0xe6, 0x04,
+	0xe6, 0xee, 0xc9, 0xf3, 0x3c, 0xdc, 0x79, 0x1e, 0x7f, 0x2e, 0x95, 0xb0, 0x51, 0x46, 0x9f, 0x81,
+	0xc3, 0x4c, 0xdc, 0x76, 0x64, 0x22, 0xf8, 0x60, 0xf7, 0x87, 0x26, 0x92, 0x71, 0xa5, 0x2f, 0xee,
+	0x3b, 0xa1, 0x49, 0xa6, 0xef, 0x4f, 0x8e, 0x05, 0x3b, 0x82, 0xac, 0xcc, 0x85, 0x1d, 0x3d, 0x95,
+	0x25, 0xb4, 0x88, 0x1e, 0xc3, 0x38, 0x27, 0xeb, 0x84, 0xa6, 0xdc, 0xf8, 0x43, 0xdd, 0xd9, 0x06,
+	0x3a, 0x39, 0x85, 0x9e, 0x32, 0x52, 0xe6, 0x4d, 0x92, 0x18, 0x96, 0xcb, 0xb1, 0xe0, 0xd3, 0x2d,
+	0x89, 0x4b, 0x6a, 0x02, 0x47, 0x0a, 0x93, 0x4f, 0xc1, 0xb9, 0xb2, 0xac, 0xda, 0xfa, 0x4a, 0xe4,
+	0x2e, 0x55, 0xd4, 0x0c, 0x67, 0xb5, 0xe8, 0xfd, 0xb9, 0x05, 0x87, 0xf5, 0x79, 0x99, 0xb0, 0x54,
+	0xaa, 0xcb, 0xc2, 0x76, 0x1e, 0xea, 0x55, 0x8c, 0xb8, 0xe3, 0x10, 0xed, 0x5d, 0x87, 0xb0, 0x82,
+	0x70, 0xaf, 0x11, 0x84, 0x1e, 0x0c, 0x6f, 0x69, 0x1a, 0x66, 0xc5, 0x3c, 0x8d, 0xa3, 0x54, 0x45,
+	0x85, 0x83, 0x1b, 0x98, 0x37, 0x85, 0xe1, 0x5c, 0x6c, 0x67, 0x02, 0xf1, 0x4e, 0x6b, 0xbc, 0x97,
+	0xe0, 0x4a, 0xcd, 0x59, 0x96, 0x2e, 0xa2, 0x22, 0x21, 0xf2, 0x6a, 0xdf, 0xf5, 0x95, 0xb0, 0xad,
+	0xa0, 0x5f, 0xd1, 0x40, 0x25, 0x08, 0x07, 0x6b, 0xc9, 0xfb, 0x7b, 0x0b, 0x40, 0x2e, 0xf7, 0xb3,
+	0x92, 0x16, 0x6b, 0xf4, 0x31, 0x0c, 0xe4, 0x17, 0x57, 0x22, 0x62, 0x55, 0xc1, 0x1d, 0x9f, 0x0e,
+	0xfc, 0x79, 0x85, 0x61, 0x7b, 0x5e, 0x84, 0x34, 0xa3, 0xa4, 0x08, 0x56, 0xd7, 0xb4, 0x48, 0xb4,
+	0x57, 0x2c, 0x04, 0x4d, 0xe1, 0x80, 0x65, 0x05, 0x7f, 0xb6, 0x3e, 0x63, 0x01, 0x4d, 0x43, 0xc1,
+	0xb7, 0x3d, 0xb9, 0xfd, 0x26, 0x2c, 0x57, 0x92, 0x10, 0xa6, 0x24, 0xd4, 0x1e, 0xb2, 0x10, 0x41,
+	0x81, 0x38, 0x4a, 0x22, 0x2e, 0x79, 0xd7, 0xc5, 0x4a, 0x10, 0xe7, 0xa5, 0x6f, 0x82, 0xb8, 0x0c,
+	0xa9, 0x21, 0x9e, 0x16, 0xbd, 0x3f, 0x76, 0xb4, 0x43, 0xaf, 0xca, 0x24, 0x21, 0xc5, 0xfa, 0x2d,
+	0xae, 0xd9, 0xd5, 0x07, 0x7c, 0x0a, 0xfd, 0xaa, 0x8f, 0x91, 0x26, 0x0f, 0x4e, 0x27, 0xbe, 0xea,
+	0x74, 0x7c, 0xd3, 0xe9, 0xf8, 0xd7, 0x46, 0x03, 0xd7, 0xca, 0x75, 0x07, 0xd1, 0xb1, 0x3b, 0x88,
+	0x87, 0x76, 0xcd, 0x56, 0xa1, 0x53, 0x03, 0xf2, 0x9b, 0x8c, 0x93, 0x58, 0xe6, 0xbc, 0x0e, 0x56,
+	0x82, 0xb8, 0xb2, 0x9c, 0x4a, 0x83, 0x55, 0xcc, 0x68, 0x49, 0xe0, 0x2b, 0x92, 0x86, 0x31, 0xd5,
+	0xe9, 0x4d, 0x4b, 0x82, 0x66, 0x26, 0x4a, 0x2f, 0x45, 0x2c, 0xf4, 0x55, 0x76, 0xb4, 0x31, 0x79,
+	0x21, 0x5a, 0x36, 0x5c, 0x56, 0xd9, 0x6c, 0x13, 0x6e, 0x54, 0xba, 0x41, 0xb3, 0xd2, 0x89, 0xac,
+	0xa5, 0xa9, 0x3f, 0xcb, 0xa2, 0xd4, 0x1d, 0xaa, 0xac, 0x65, 0x41, 0xe8, 0x43, 0x53, 0x01, 0x44,
+	0xd9, 0xdf, 0x60, 0x90, 0x9a, 0x11, 0x6e, 0x2f, 0xc4, 0x5d, 0x8f, 0x55, 0x83, 0x24, 0xc6, 0x56,
+	0xf2, 0xa6, 0xa1, 0x2c, 0xf9, 0x0e, 0xae, 0x01, 0x51, 0xac, 0xcb, 0x54, 0xe8, 0xcd, 0x56, 0x84,
+	0x5f, 0x50, 0xc6, 0x64, 0xef, 0xa0, 0xaa, 0xfe, 0x8e, 0x19, 0x0f, 0x43, 0x5f, 0x6e, 0x2b, 0x7a,
+	0x1c, 0xf4, 0x2d, 0xe8, 0xa9, 0xbe, 0x55, 0x77, 0x91, 0x23, 0xdf, 0xa6, 0x07, 0xd6, 0x93, 0x82,
+	0x87, 0xaf, 0x45, 0x24, 0xcc, 0xaa, 0x62, 0x3a, 0xc2, 0x16, 0xe2, 0xfd, 0xa1, 0x03, 0x83, 0x19,
+	0x61, 0xd4, 0xd0, 0xea, 0x01, 0xf4, 0x02, 0xc2, 0x68, 0xc5, 0x2a, 0x2d, 0xfd, 0xcf, 0x92, 0xca,
+	0x85, 0xfd, 0x9b, 0x72, 0x6d, 0xb1, 0xca, 0x88, 0xe2, 0x52, 0xe5, 0xf0, 0x4b, 0x9b, 0x5b, 0x36,
+	0x24, 0x28, 0xa1, 0x72, 0xd6, 0x79, 0xa8, 0xc9, 0x55, 0xc9, 0x75, 0x8e, 0xd3, 0x9f, 0x2b, 0x56,
+	0x35, 0xb0, 0xff, 0x92, 0x52, 0xc6, 0xbe, 0x79, 0x4e, 0x53, 0x1a, 0x4a, 0x62, 0x39, 0xd8, 0x86,
+	0x6a, 0xd2, 0x8d, 0xdf, 0x49, 0xba, 0x03, 0x8b, 0x74, 0xef, 0x4b, 0xab, 0x4b, 0x70, 0x04, 0x03,
+	0x24, 0xab, 0x3c, 0xe8, 0x8a, 0x0b, 0x37, 0xa4, 0x1a, 0xfa, 0x16, 0x37, 0xb0, 0x9a, 0x7a, 0x27,
+	0xa5, 0x7e, 0x03, 0xc8, 0x5a, 0xdf, 0xea, 0xc4, 0x74, 0xf4, 0xb7, 0x1a, 0xd1, 0x2f, 0xaa, 0x5a,
+	0x79, 0x53, 0x65, 0xf2, 0x3e, 0x36, 0xa2, 0x98, 0x49, 0xd4, 0x1a, 0xba, 0x19, 0x33, 0xa2, 0xf7,
+	0x09, 0xdc, 0x6b, 0xec, 0xa0, 0x2b, 0x9e, 0x88, 0x36, 0x05, 0x55, 0xbb, 0xd4, 0x80, 0xb7, 0x6e,
+	0x7c, 0xc4, 0xbe, 0xbe, 0x5d, 0x13, 0x70, 0xb2, 0xc5, 0x82, 0x51, 0x7e, 0x1e, 0x6a, 0xc3, 0x2a,
+	0xb9, 0x4e, 0xeb, 0x1d, 0x2b, 0xad, 0x7b, 0xff, 0x68, 0xc1, 0xc0, 0xda, 0xfb, 0xed, 0x86, 0x5a,
+	0x16, 0xb5, 0xef, 0xb2, 0x68, 0xef, 0x4e, 0x4f, 0x75, 0x1a, 0x9e, 0xaa, 0xf8, 0xd1, 0xb5, 0xf8,
+	0x21, 0xec, 0x2f, 0xf9, 0x32, 0x13, 0xd5, 0x4b, 0xbd, 0xe6, 0x2a, 0xb9, 0x19, 0xd2, 0xfb, 0xef,
+	0x11, 0xd2, 0xde, 0x8f, 0xe1, 0xc0, 0x3a, 0xa2, 0x24, 0xd3, 0x14, 0x9c, 0xc4, 0xd0, 0xaf, 0xe2,
+	0x93, 0x75, 0x6f, 0xd5, 0xac, 0xf7, 0xd7, 0x16, 0x1c, 0x8a, 0x99, 0x59, 0x96, 0xde, 0xd2, 0x82,
+	0xc9, 0x1e, 0xe0, 0xce, 0x9b, 0x79, 0x00, 0x3d, 0xc5, 0x62, 0xcd, 0x3d, 0x2d, 0xc9, 0xde, 0x93,
+	0x30, 0xb3, 0xba, 0xf6, 0x91, 0x0d, 0x35, 0x4f, 0xd7, 0x79, 0x9f, 0x84, 0x65, 0xfb, 0xac, 0xdb,
+	0xf4, 0x99, 0x37, 0x87, 0xe3, 0x4d, 0xdb, 0xe5, 0xf1, 0x7f, 0x08, 0xa3, 0xc0, 0xc2, 0x8c, 0x0f,
+	0x8e, 0xfc, 0x4d, 0x6d, 0xdc, 0xd4, 0xf3, 0x66, 0xca, 0x95, 0xa2, 0x4f, 0xf8, 0xda, 0x2c, 0xf5,
+	0x3e, 0x82, 0xd1, 0x2f, 0x48, 0x1c, 0xd3, 0xaa, 0x9b, 0xb3, 0x9e, 0x3c, 0xad, 0xc6, 0x93, 0xc7,
+	0x5b, 0x1a, 0xd5, 0x67, 0x24, 0x26, 0x69, 0x20, 0xf9, 0x19, 0xa8, 0x6e, 0x8c, 0xaa, 0x0d, 0xf7,
+	0x70, 0x0d, 0x08, 0x3f, 0x97, 0x69, 0x3d, 0xdf, 0x96, 0xf3, 0x36, 0x24, 0x2b, 0x3a, 0x8d, 0x96,
+	0x2b, 0x45, 0xd4, 0x11, 0xd6, 0x92, 0xf7, 0xb7, 0x16, 0x0c, 0xaf, 0x72, 0x9a, 0x86, 0x56, 0x7f,
+	0xb7, 0xdb, 0xa6, 0x8d, 0x07, 0xe0, 0x5e, 0xd5, 0x7b, 0x9e, 0x82, 0xb3, 0xa0, 0xf4, 0x25, 0xbd,
+	0xa5, 0xb1, 0x5c, 0x7c, 0x7c, 0xfa, 0xc0, 0xb7, 0x97, 0xf4, 0xbf, 0xd0, 0xb3, 0xb8, 0xd2, 0xab,
+	0x5a, 0xfc, 0x4e, 0xdd, 0xe2, 0x7b, 0xa7, 0xe0, 0x18, 0x4d, 0x04, 0xd0, 0xbb, 0x9c, 0xe3, 0x8b,
+	0xb3, 0x97, 0x87, 0xff, 0x87, 0x86, 0xe0, 0xbc, 0xc2, 0xe7, 0x73, 0x7c, 0x7e, 0xfd, 0xcb, 0xc3,
+	0x96, 0x90, 0x9e, 0xcf, 0xe6, 0x97, 0xf3, 0x8b, 0xf3, 0xd9, 0x61, 0xdb, 0xfb, 0x67, 0x0b, 0x46,
+	0x7a, 0x2f, 0x9d, 0x71, 0xc4, 0xab, 0xf8, 0x4d, 0x14, 0x56, 0xaf, 0xe2, 0x37, 0x51, 0x78, 0xa7,
+	0xe5, 0x4f, 0xe0, 0xb0, 0xf2, 0x90, 0x76, 0xb4, 0x3c, 0xc1, 0x1e, 0xde, 0xc2, 0x55, 0x0a, 0xdf,
+	0xd2, 0xee, 0x48, 0xed, 0x1d, 0x33, 0x4d, 0x62, 0x77, 0xdf, 0x87, 0xd8, 0xc6, 0x37, 0x3d, 0xcb,
+	0x37, 0xaf, 0xe0, 0xf8, 0x32, 0xe3, 0xd1, 0x22, 0x0a, 0x14, 0x21, 0x2d, 0x12, 0xf2, 0x2c, 0x8f,
+	0x02, 0xc5, 0xe4, 0x3e, 0xd6, 0x92, 0x09, 0xbc, 0xe7, 0xb7, 0x34, 0xe5, 0x3a, 0x6b, 0x75, 0xb0,
+	0x0d, 0x79, 0x17, 0x70, 0x64, 0xaf, 0x28, 0x61, 0x34, 0x86, 0xb6, 0x76, 0x5d, 0x07, 0xb7, 0xa3,
+	0x50, 0x15, 0xf8, 0x3c, 0x0a, 0xcc, 0xab, 0x48, 0x0a, 0xc2, 0xc0, 0xaf, 0x58, 0x96, 0x9a, 0xf7,
+	0xb8, 0x18, 0x9f, 0xfe, 0xc9, 0x01, 0x10, 0x35, 0xf2, 0x19, 0xf9, 0x3d, 0x21, 0x05, 0x7a, 0x04,
+	0x83, 0x17, 0x94, 0xeb, 0x3f, 0x3f, 0x0c, 0xf5, 0x7c, 0xf9, 0x7f, 0x6e, 0x32, 0x6a, 0xfc, 0x0c,
+	0x42, 0x1f, 0x03, 0xd4, 0x5a, 0xe8, 0xc0, 0x6f, 0xfe, 0xbb, 0x9b, 0x8c, 0xfd, 0xab, 0x68, 0x99,
+	0xd2, 0xd0, 0x28, 0x3c, 0x81, 0xd1, 0xac, 0xa0, 0x84, 0x53, 0x03, 0x38, 0xe6, 0x8b, 0xc9, 0xe6,
+	0xb7, 0xe8, 0x43, 0x18, 0xfd, 0x3c, 0x0f, 0x77, 0xea, 0x6a, 0x63, 0xd0, 0x14, 0x46, 0x3f, 0xa5,
+	0x31, 0xad, 0x55, 0xb6, 0x0c, 0x30, 0x9a, 0x4f, 0xc1, 0x31, 0x6f, 0x39, 0x74, 0xb8, 0xf9, 0x8c,
+	0x9d, 0x1c, 0xf9, 0x5b, 0x0f, 0xbd, 0x29, 0x38, 0x2f, 0x28, 0x97, 0xbd, 0x01, 0xd2, 0x5d, 0xa0,
+	0xd1, 0xae, 0x44, 0x96, 0x9f, 0xe5, 0x11, 0xfa, 0x1e, 0x0c, 0xf5, 0x2b, 0x4b, 0x69, 0xff, 0xbf,
+	0x7f, 0xd7, 0xcb, 0xab, 0xb2, 0xe6, 0x91, 0x68, 0x0f, 0xd3, 0x80, 0xc6, 0x3b, 0xd7, 0x37, 0x5a,
+	0x1f, 0xc1, 0xf0, 0x8b, 0x32, 0x5e, 0x44, 0xb1, 0x56, 0x3b, 0x52, 0x6a, 0x1a, 0x13, 0x2d, 0x8f,
+	0xbd, 0x20, 0x96, 0xff, 0x0e, 0xde, 0xba, 0xe0, 0x37, 0xe5, 0x99, 0xae, 0x48, 0x4c, 0x19, 0x1a,
+	0xf8, 0xf5, 0x83, 0x6e, 0x02, 0x7e, 0xdd, 0x02, 0x7f, 0x1b, 0x86, 0x2f, 0x28, 0x37, 0xfe, 0x78,
+	0x8b, 0xa2, 0x27, 0x57, 0x9b, 0x6d, 0x2b, 0xf5, 0xfd, 0xaa, 0xf3, 0x79, 0x0c, 0xfb, 0x5a, 0x67,
+	0xd3, 0x26, 0xd5, 0x04, 0x19, 0x1f, 0x7e, 0x0e, 0x07, 0x57, 0x34, 0xb5, 0x3b, 0x28, 0x74, 0xcf,
+	0xdf, 0xee, 0x77, 0x26, 0xc7, 0xfe, 0xae, 0x16, 0xe5, 0x47, 0x70, 0x20, 0x76, 0xa9, 0x67, 0x18,
+	0x6a, 0x28, 0x9a, 0x58, 0x9b, 0x1c, 0xfa, 0x9b, 0xd5, 0xf4, 0xfb, 0x70, 0xac, 0x3f, 0xb5, 0x6b,
+	0x47, 0x4d, 0xf7, 0xfb, 0xfe, 0xce, 0x2a, 0xf4, 0x04, 0xc6, 0x17, 0xa4, 0xf8, 0xad, 0x98, 0x3b,
+	0x63, 0xf2, 0xe9, 0xa9, 0x96, 0xb6, 0xaa, 0x8b, 0x75, 0x37, 0xf0, 0xa2, 0x2e, 0x18, 0x66, 0xe1,
+	0xb1, 0xdf, 0x2c, 0x24, 0x4a, 0xcb, 0xa4, 0x9e, 0x4d, 0x2d, 0x83, 0x3f, 0x86, 0xae, 0xcc, 0x95,
+	0x68, 0xd4, 0xc8, 0xcf, 0x93, 0xb1, 0x11, 0xb5, 0x47, 0x3e, 0x87, 0x51, 0x23, 0xd9, 0xa0, 0xfb,
+	0xfe, 0xae, 0xe4, 0x33, 0x41, 0xfe, 0x56, 0x06, 0xf9, 0x6e, 0xeb, 0x59, 0xe7, 0x57, 0xed, 0xfc,
+	0xe6, 0xa6, 0x27, 0x73, 0xdc, 0x27, 0xff, 0x19, 0x00, 0x95, 0xca, 0x6b, 0x6d, 0xf5, 0x17, 0x00,
+	0x00,
+}
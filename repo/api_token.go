@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"time"
+)
+
+const (
+	// apiTokenLength is the number of random bytes of an API token
+	apiTokenLength = 32
+
+	// MaxAPITokenNameLength is the longest allowed API token name
+	MaxAPITokenNameLength = 64
+)
+
+var (
+	// ErrAPITokenAlreadyExists - duplicate API token name err
+	ErrAPITokenAlreadyExists = errors.New("an API token with this name already exists")
+	// ErrAPITokenDoesNotExist - non-existent API token err
+	ErrAPITokenDoesNotExist = errors.New("API token doesn't exist")
+	// ErrAPITokenInvalidName - API token name err
+	ErrAPITokenInvalidName = errors.New("name must be 1 to 64 letters, digits, dots, dashes or underscores")
+	// ErrAPITokenInvalidScope - unknown API token scope err
+	ErrAPITokenInvalidScope = errors.New("scopes must be one of read-only, listings-write, orders, wallet-spend or admin")
+	// ErrAPITokenNoScopes - API token without scopes err
+	ErrAPITokenNoScopes = errors.New("at least one scope is required")
+	// ErrAPITokenExpiryInPast - API token expiry is not in the future
+	ErrAPITokenExpiryInPast = errors.New("expires must be in the future")
+	// ErrAPITokenInvalid - unknown or expired API token err
+	ErrAPITokenInvalid = errors.New("invalid or expired API token")
+)
+
+var apiTokenNameRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// CreateAPIToken saves a new API token and returns its secret. The secret is
+// not stored and can't be recovered afterwards.
+func CreateAPIToken(store APITokenStore, name string, scopes []APITokenScope, expires *time.Time) (string, *APIToken, error) {
+	if len(name) > MaxAPITokenNameLength || !apiTokenNameRegexp.MatchString(name) {
+		return "", nil, ErrAPITokenInvalidName
+	}
+	if len(scopes) == 0 {
+		return "", nil, ErrAPITokenNoScopes
+	}
+	for _, s := range scopes {
+		if !s.IsValid() {
+			return "", nil, ErrAPITokenInvalidScope
+		}
+	}
+	now := time.Now().Truncate(time.Second)
+	if expires != nil && !expires.After(now) {
+		return "", nil, ErrAPITokenExpiryInPast
+	}
+	tokens, err := store.GetAll()
+	if err != nil {
+		return "", nil, err
+	}
+	for _, t := range tokens {
+		if t.Name == name {
+			return "", nil, ErrAPITokenAlreadyExists
+		}
+	}
+
+	b := make([]byte, apiTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	secret := hex.EncodeToString(b)
+	token := APIToken{
+		Name:      name,
+		TokenHash: HashAPIToken(secret),
+		Scopes:    scopes,
+		CreatedAt: now,
+		Expires:   expires,
+	}
+	if err := store.Put(token); err != nil {
+		return "", nil, err
+	}
+	return secret, &token, nil
+}
+
+// RevokeAPIToken deletes the API token with the given name
+func RevokeAPIToken(store APITokenStore, name string) error {
+	deleted, err := store.Delete(name)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrAPITokenDoesNotExist
+	}
+	return nil
+}
+
+// AuthenticateAPIToken returns the unexpired API token with the secret
+func AuthenticateAPIToken(store APITokenStore, secret string) (*APIToken, error) {
+	token, err := store.Get(HashAPIToken(secret))
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || !token.IsActive(time.Now()) {
+		return nil, ErrAPITokenInvalid
+	}
+	return token, nil
+}
+
+// HashAPIToken returns the hex encoded SHA256 hash of an API token secret
+func HashAPIToken(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+// IsValid returns whether the scope is one of APITokenScopes
+func (s APITokenScope) IsValid() bool {
+	for _, scope := range APITokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
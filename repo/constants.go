@@ -32,6 +32,7 @@ const (
 	NotifierTypeProcessingErrorNotification   NotificationType = "processingError"
 	NotifierTypeRefundNotification            NotificationType = "refund"
 	NotifierTypeStatusUpdateNotification      NotificationType = "statusUpdate"
+	NotifierTypeStoreUpdateNotification       NotificationType = "storeUpdate"
 	NotifierTypeTestNotification              NotificationType = "testNotification"
 	NotifierTypeUnfollowNotification          NotificationType = "unfollow"
 	NotifierTypeVendorDisputeTimeout          NotificationType = "vendorDisputeTimeout"
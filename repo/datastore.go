@@ -1,9 +1,11 @@
 package repo
 
 import (
+	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
 	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
 
 	"database/sql"
+	"errors"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/wallet-interface"
@@ -11,6 +13,10 @@ import (
 	"time"
 )
 
+// ErrInvalidCursor is returned for a page cursor which wasn't returned with
+// a previous page
+var ErrInvalidCursor = errors.New("invalid cursor")
+
 type Datastore interface {
 	Config() Config
 	Followers() FollowerStore
@@ -27,6 +33,16 @@ type Datastore interface {
 	Coupons() CouponStore
 	TxMetadata() TransactionMetadataStore
 	ModeratedStores() ModeratedStore
+	ScheduledListings() ScheduledListingStore
+	ArchivedListings() ArchivedListingStore
+	Search() SearchStore
+	Bans() BanStore
+	Sessions() SessionStore
+	ReceivedMessages() ReceivedMessageStore
+	APITokens() APITokenStore
+	AuthLog() AuthLogStore
+	Events() EventStore
+	IdempotencyKeys() IdempotencyKeyStore
 	Ping() error
 	Close()
 }
@@ -68,6 +84,10 @@ type FollowerStore interface {
 	   The offset and limit arguments can be used to for lazy loading. */
 	Get(offsetId string, limit int) ([]Follower, error)
 
+	// GetPage returns up to limit followers after the cursor, newest first,
+	// and the cursor of the next page, which is empty on the last page
+	GetPage(cursor string, limit int) ([]Follower, string, error)
+
 	// Delete a follower from the database
 	Delete(follower string) error
 
@@ -88,6 +108,10 @@ type FollowingStore interface {
 	   The offset and limit arguments can be used to for lazy loading. */
 	Get(offsetId string, limit int) ([]string, error)
 
+	// GetPage returns up to limit following peers after the cursor, newest
+	// first, and the cursor of the next page, which is empty on the last page
+	GetPage(cursor string, limit int) ([]string, string, error)
+
 	// Delete a peer from the database
 	Delete(peer string) error
 
@@ -126,6 +150,9 @@ type PointerStore interface {
 	// Delete a pointer from the database
 	Delete(id peer.ID) error
 
+	// UpdateAddr replaces the address of a pointer, keeping its timestamp
+	UpdateAddr(id peer.ID, addr ma.Multiaddr) error
+
 	// Delete all pointers of a given purpose
 	DeleteAll(purpose ipfs.Purpose) error
 
@@ -205,9 +232,16 @@ type PurchaseStore interface {
 	// Return the metadata for all purchases. Also returns the original size of the query.
 	GetAll(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, limit int, exclude []string) ([]Purchase, int, error)
 
+	// Return the page of purchases after the cursor, the original size of the
+	// query and the cursor of the next page, which is empty on the last page
+	GetPage(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, cursor string, limit int) ([]Purchase, int, string, error)
+
 	// Return the number of purchases in the database
 	Count() int
 
+	// Return the number of purchases in each state
+	CountByState() (map[pb.OrderState]int, error)
+
 	// GetPurchasesForDisputeTimeoutNotification returns []*PurchaseRecord including
 	// each record which needs buyerDisputeTimeout Notifications to be generated.
 	GetPurchasesForDisputeTimeoutNotification() ([]*PurchaseRecord, error)
@@ -250,6 +284,10 @@ type SaleStore interface {
 	// Return the metadata for all sales. Also returns the original size of the query.
 	GetAll(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, limit int, exclude []string) ([]Sale, int, error)
 
+	// Return the page of sales after the cursor, the original size of the
+	// query and the cursor of the next page, which is empty on the last page
+	GetPage(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, cursor string, limit int) ([]Sale, int, string, error)
+
 	// Return unfunded orders which failed to detect funding because the chain was synced passed the block containing the transaction when the order was recorded.
 	GetNeedsResync() ([]UnfundedSale, error)
 
@@ -259,6 +297,9 @@ type SaleStore interface {
 	// Return the number of sales in the database
 	Count() int
 
+	// Return the number of sales in each state
+	CountByState() (map[pb.OrderState]int, error)
+
 	// GetSalesForDisputeTimeoutNotification returns []*SaleRecord including
 	// each record which needs Notifications to be generated.
 	GetSalesForDisputeTimeoutNotification() ([]*SaleRecord, error)
@@ -303,9 +344,16 @@ type CaseStore interface {
 	// Return the metadata for all cases given the search terms. Also returns the original size of the query.
 	GetAll(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, limit int, exclude []string) ([]Case, int, error)
 
+	// Return the page of cases after the cursor, the original size of the
+	// query and the cursor of the next page, which is empty on the last page
+	GetPage(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, cursor string, limit int) ([]Case, int, string, error)
+
 	// Return the number of cases in the database
 	Count() int
 
+	// Return the number of cases in each state
+	CountByState() (map[pb.OrderState]int, error)
+
 	// GetDisputesForDisputeExpiryNotification returns []*DisputeCaseRecord including
 	// each record which needs Notifications to be generated.
 	GetDisputesForDisputeExpiryNotification() ([]*DisputeCaseRecord, error)
@@ -323,9 +371,17 @@ type ChatStore interface {
 	// Returns a list of open conversations
 	GetConversations() []ChatConversation
 
+	// Returns the page of open conversations after the cursor, most recent
+	// first, and the cursor of the next page
+	GetConversationsPage(cursor string, limit int) ([]ChatConversation, string, error)
+
 	// A list of messages given a peer ID and a subject
 	GetMessages(peerID string, subject string, offsetID string, limit int) []ChatMessage
 
+	// The page of messages given a peer ID and a subject after the cursor,
+	// newest first, and the cursor of the next page
+	GetMessagesPage(peerID string, subject string, cursor string, limit int) ([]ChatMessage, string, error)
+
 	// Mark all chat messages for a peer as read. Returns the Id of the last seen message and
 	// whether any messages were updated.
 	// If message Id is specified it will only mark that message and earlier as read.
@@ -356,6 +412,10 @@ type NotificationStore interface {
 	// Fetch notifications from database
 	GetAll(offsetID string, limit int, typeFilter []string) ([]*Notification, int, error)
 
+	// Fetch the page of notifications after the cursor, the number of
+	// notifications after it and the cursor of the next page
+	GetPage(cursor string, limit int, typeFilter []string) ([]*Notification, int, string, error)
+
 	// Returns the unread count for all notifications
 	GetUnreadCount() (int, error)
 
@@ -406,6 +466,196 @@ type ModeratedStore interface {
 	Delete(peerId string) error
 }
 
+type ScheduledListingStore interface {
+	Queryable
+
+	// Put a listing draft which should be published at the given time
+	Put(listing *pb.Listing, publishAt time.Time) error
+
+	// Get a scheduled listing given its slug
+	Get(slug string) (*ScheduledListing, error)
+
+	// GetAll returns every scheduled listing ordered by publish time
+	GetAll() ([]ScheduledListing, error)
+
+	// GetDue returns the scheduled listings which should be published at or before the given time,
+	// leaving out those which failed to publish
+	GetDue(now time.Time) ([]ScheduledListing, error)
+
+	// SetPublishError records why a scheduled listing failed to publish
+	SetPublishError(slug string, reason string) error
+
+	// Delete a scheduled listing from the database
+	Delete(slug string) error
+}
+
+type ArchivedListingStore interface {
+	Queryable
+
+	// Put an expired listing and the inventory of its variants into the archive
+	Put(listing *pb.SignedListing, inventory map[int]int64, archivedAt time.Time) error
+
+	// Get an archived listing given its slug
+	Get(slug string) (*ArchivedListing, error)
+
+	// GetAll returns every archived listing, most recently archived first
+	GetAll() ([]ArchivedListing, error)
+
+	// Delete a listing from the archive
+	Delete(slug string) error
+}
+
+type SearchStore interface {
+	Queryable
+
+	// Put a single listing into the search index, replacing any previous
+	// version of the same listing. The peer of the listing is kept in the
+	// index until DeletePeersBefore is called after its IndexedAt time.
+	Put(listing SearchListing) error
+
+	// ReplacePeer replaces every indexed listing of the peer, records the
+	// root hash the listings were loaded from and keeps the peer indexed
+	// until DeletePeersBefore is called after now
+	ReplacePeer(peerID string, root string, listings []SearchListing) error
+
+	// GetPeers returns the root hash of every indexed peer keyed by peer ID
+	GetPeers() (map[string]string, error)
+
+	// DeletePeersBefore removes the peers, and their listings, which were
+	// last indexed or browsed before the given time, except followed peers
+	DeletePeersBefore(before time.Time) error
+
+	// Search the index. Listings matching the search terms best come first,
+	// then the most recently indexed listings.
+	Search(query SearchQuery) ([]SearchListing, error)
+}
+
+type BanStore interface {
+	Queryable
+
+	// Put a ban, replacing any existing ban of the peer with the same scope
+	Put(ban Ban) error
+
+	// Get returns every ban of a peer
+	Get(peerID string) ([]Ban, error)
+
+	// GetAll returns every ban, most recent first
+	GetAll() ([]Ban, error)
+
+	// Delete the ban of a peer with the given scope, or every ban of the peer
+	// if the scope is empty
+	Delete(peerID string, scope BanScope) error
+}
+
+type SessionStore interface {
+	Queryable
+
+	// Get the serialized session state for a peer, or nil if there is none
+	Get(peerID string) ([]byte, error)
+
+	// Put the serialized session state for a peer
+	Put(peerID string, state []byte) error
+
+	// Delete the session with a peer
+	Delete(peerID string) error
+
+	// PutPreKey saves a signed prekey
+	PutPreKey(preKey PreKey) error
+
+	// GetPreKey returns the signed prekey with the given ID, or nil if it
+	// doesn't exist
+	GetPreKey(id uint32) (*PreKey, error)
+
+	// GetLatestPreKey returns the most recent signed prekey, or nil if there
+	// is none
+	GetLatestPreKey() (*PreKey, error)
+
+	// DeletePreKeysBefore deletes the signed prekeys created before the time
+	DeletePreKeysBefore(t time.Time) error
+}
+
+type ReceivedMessageStore interface {
+	Queryable
+
+	// Put records the content hash of a processed message. Returns false if
+	// the hash was already recorded.
+	Put(hash string, peerID string, messageType pb.Message_MessageType, timestamp time.Time) (bool, error)
+
+	// Has returns whether a message with the content hash was processed
+	Has(hash string) (bool, error)
+
+	// Delete forgets the content hash of a message
+	Delete(hash string) error
+
+	// DeleteBefore deletes the hashes recorded before the time
+	DeleteBefore(t time.Time) error
+}
+
+type APITokenStore interface {
+	Queryable
+
+	// Put an API token, failing if a token with the same name exists
+	Put(token APIToken) error
+
+	// Get returns the API token with the given token hash, or nil if there
+	// is none
+	Get(tokenHash string) (*APIToken, error)
+
+	// GetAll returns every API token, most recent first
+	GetAll() ([]APIToken, error)
+
+	// Delete the API token with the given name. Returns false if there was
+	// no such token.
+	Delete(name string) (bool, error)
+}
+
+type AuthLogStore interface {
+	Queryable
+
+	// Put an authentication event
+	Put(event AuthEvent) error
+
+	// Get returns up to limit authentication events, most recent first,
+	// optionally filtered by IP
+	Get(ip string, limit int) ([]AuthEvent, error)
+
+	// DeleteBefore deletes the events recorded before the time
+	DeleteBefore(t time.Time) error
+}
+
+type EventStore interface {
+	Queryable
+
+	// Put an event and return its ID. IDs increase monotonically and are
+	// never reused.
+	Put(topic string, data []byte, timestamp time.Time) (uint64, error)
+
+	// GetSince returns up to limit events with an ID above the given one in
+	// ascending order, optionally filtered by topic
+	GetSince(id uint64, topics []string, limit int) ([]Event, error)
+
+	// OldestID returns the ID of the oldest event, or 0 if there are none
+	OldestID() (uint64, error)
+
+	// Truncate deletes all but the latest keep events
+	Truncate(keep int) error
+}
+
+type IdempotencyKeyStore interface {
+	Queryable
+
+	// Put the response to a request made with an idempotency key. An
+	// existing response for the key is replaced.
+	Put(response IdempotentResponse) error
+
+	// Get the response stored for the idempotency key. Returns nil if there
+	// is none.
+	Get(key string) (*IdempotentResponse, error)
+
+	// DeleteBefore deletes the responses stored before the time
+	DeleteBefore(t time.Time) error
+}
+
 type KeyStore interface {
 	Queryable
 	wallet.Keys
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type APITokensDB struct {
+	modelStore
+}
+
+func NewAPITokenStore(db *sql.DB, lock *sync.Mutex) repo.APITokenStore {
+	return &APITokensDB{modelStore{db, lock}}
+}
+
+func (a *APITokensDB) Put(token repo.APIToken) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, s := range token.Scopes {
+		scopes = append(scopes, string(s))
+	}
+	var expires int64
+	if token.Expires != nil {
+		expires = token.Expires.Unix()
+	}
+	_, err := a.db.Exec("insert into apitokens(name, tokenHash, scopes, createdAt, expires) values(?,?,?,?,?)",
+		token.Name, token.TokenHash, strings.Join(scopes, ","), token.CreatedAt.Unix(), expires)
+	return err
+}
+
+func (a *APITokensDB) Get(tokenHash string) (*repo.APIToken, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	rows, err := a.db.Query("select name, tokenHash, scopes, createdAt, expires from apitokens where tokenHash=?", tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := scanAPITokens(rows)
+	if err != nil || len(tokens) == 0 {
+		return nil, err
+	}
+	return &tokens[0], nil
+}
+
+func (a *APITokensDB) GetAll() ([]repo.APIToken, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	rows, err := a.db.Query("select name, tokenHash, scopes, createdAt, expires from apitokens order by createdAt desc")
+	if err != nil {
+		return nil, err
+	}
+	return scanAPITokens(rows)
+}
+
+func (a *APITokensDB) Delete(name string) (bool, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	res, err := a.db.Exec("delete from apitokens where name=?", name)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func scanAPITokens(rows *sql.Rows) ([]repo.APIToken, error) {
+	defer rows.Close()
+	var ret []repo.APIToken
+	for rows.Next() {
+		var (
+			token              repo.APIToken
+			scopes             string
+			createdAt, expires int64
+		)
+		if err := rows.Scan(&token.Name, &token.TokenHash, &scopes, &createdAt, &expires); err != nil {
+			return nil, err
+		}
+		for _, s := range strings.Split(scopes, ",") {
+			if s != "" {
+				token.Scopes = append(token.Scopes, repo.APITokenScope(s))
+			}
+		}
+		token.CreatedAt = time.Unix(createdAt, 0)
+		if expires > 0 {
+			t := time.Unix(expires, 0)
+			token.Expires = &t
+		}
+		ret = append(ret, token)
+	}
+	return ret, rows.Err()
+}
@@ -0,0 +1,102 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewAPITokenStore() (repo.APITokenStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAPITokenStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestAPITokensDB_PutAndGet(t *testing.T) {
+	tokenDB, teardown, err := buildNewAPITokenStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	createdAt := time.Now().Truncate(time.Second)
+	expires := createdAt.Add(time.Hour)
+	if err := tokenDB.Put(repo.APIToken{
+		Name:      "fulfillment",
+		TokenHash: "hash1",
+		Scopes:    []repo.APITokenScope{repo.APITokenScopeReadOnly, repo.APITokenScopeOrders},
+		CreatedAt: createdAt,
+		Expires:   &expires,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tokenDB.Put(repo.APIToken{Name: "fulfillment", TokenHash: "hash2", CreatedAt: createdAt}); err == nil {
+		t.Error("Expected a token with a duplicate name to be rejected")
+	}
+
+	token, err := tokenDB.Get("hash1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == nil {
+		t.Fatal("Expected token to be returned")
+	}
+	if token.Name != "fulfillment" || len(token.Scopes) != 2 || token.Scopes[1] != repo.APITokenScopeOrders ||
+		!token.CreatedAt.Equal(createdAt) || !token.Expires.Equal(expires) {
+		t.Errorf("Unexpected token %+v", token)
+	}
+	if token, err := tokenDB.Get("unknown"); err != nil || token != nil {
+		t.Error("Expected unknown token hash to return nil")
+	}
+}
+
+func TestAPITokensDB_GetAllAndDelete(t *testing.T) {
+	tokenDB, teardown, err := buildNewAPITokenStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	if err := tokenDB.Put(repo.APIToken{Name: "old", TokenHash: "hash1", CreatedAt: now.Add(-time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tokenDB.Put(repo.APIToken{Name: "new", TokenHash: "hash2", Scopes: []repo.APITokenScope{repo.APITokenScopeAdmin}, CreatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := tokenDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 || tokens[0].Name != "new" || tokens[1].Name != "old" || tokens[1].Expires != nil {
+		t.Errorf("Unexpected tokens %+v", tokens)
+	}
+
+	deleted, err := tokenDB.Delete("old")
+	if err != nil || !deleted {
+		t.Error("Expected token to be deleted")
+	}
+	deleted, err = tokenDB.Delete("old")
+	if err != nil || deleted {
+		t.Error("Expected deleting a missing token to return false")
+	}
+	if token, _ := tokenDB.Get("hash1"); token != nil {
+		t.Error("Expected deleted token to be gone")
+	}
+}
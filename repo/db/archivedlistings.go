@@ -0,0 +1,145 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type ArchivedListingsDB struct {
+	modelStore
+}
+
+func NewArchivedListingStore(db *sql.DB, lock *sync.Mutex) repo.ArchivedListingStore {
+	return &ArchivedListingsDB{modelStore{db, lock}}
+}
+
+func (a *ArchivedListingsDB) Put(listing *pb.SignedListing, inventory map[int]int64, archivedAt time.Time) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(listing)
+	if err != nil {
+		return err
+	}
+	inv, err := json.Marshal(inventory)
+	if err != nil {
+		return err
+	}
+
+	tx, err := a.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into archivedlistings(slug, listing, archivedAt, inventory) values(?,?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(listing.Listing.Slug, out, archivedAt.Unix(), inv)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (a *ArchivedListingsDB) Get(slug string) (*repo.ArchivedListing, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	stmt, err := a.db.Prepare("select slug, listing, archivedAt, inventory from archivedlistings where slug=?")
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var (
+		serializedListing   string
+		serializedInventory []byte
+		archivedAt          int64
+		ret                 repo.ArchivedListing
+	)
+	err = stmt.QueryRow(slug).Scan(&ret.Slug, &serializedListing, &archivedAt, &serializedInventory)
+	if err != nil {
+		return nil, err
+	}
+	ret.ArchivedAt = time.Unix(archivedAt, 0)
+	ret.Listing = new(pb.SignedListing)
+	if err := jsonpb.UnmarshalString(serializedListing, ret.Listing); err != nil {
+		return nil, err
+	}
+	if ret.Inventory, err = unmarshalArchivedInventory(serializedInventory); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func (a *ArchivedListingsDB) GetAll() ([]repo.ArchivedListing, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	rows, err := a.db.Query("select slug, listing, archivedAt, inventory from archivedlistings order by archivedAt desc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []repo.ArchivedListing
+	for rows.Next() {
+		var (
+			slug, serializedListing string
+			serializedInventory     []byte
+			archivedAt              int64
+		)
+		if err := rows.Scan(&slug, &serializedListing, &archivedAt, &serializedInventory); err != nil {
+			return nil, err
+		}
+		listing := new(pb.SignedListing)
+		if err := jsonpb.UnmarshalString(serializedListing, listing); err != nil {
+			log.Errorf("unmarshal archived listing (%s): %s", slug, err.Error())
+			continue
+		}
+		inventory, err := unmarshalArchivedInventory(serializedInventory)
+		if err != nil {
+			log.Errorf("unmarshal archived inventory (%s): %s", slug, err.Error())
+			continue
+		}
+		ret = append(ret, repo.ArchivedListing{
+			Slug:       slug,
+			ArchivedAt: time.Unix(archivedAt, 0),
+			Listing:    listing,
+			Inventory:  inventory,
+		})
+	}
+	return ret, rows.Err()
+}
+
+func (a *ArchivedListingsDB) Delete(slug string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, err := a.db.Exec("delete from archivedlistings where slug=?", slug)
+	return err
+}
+
+// unmarshalArchivedInventory decodes the inventory of an archived listing.
+// Listings archived before inventory was kept have none.
+func unmarshalArchivedInventory(serialized []byte) (map[int]int64, error) {
+	inventory := make(map[int]int64)
+	if len(serialized) == 0 {
+		return inventory, nil
+	}
+	if err := json.Unmarshal(serialized, &inventory); err != nil {
+		return nil, err
+	}
+	return inventory, nil
+}
@@ -0,0 +1,95 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+)
+
+func buildNewArchivedListingStore() (repo.ArchivedListingStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewArchivedListingStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestArchivedListingsDB_PutAndGet(t *testing.T) {
+	archiveDB, teardown, err := buildNewArchivedListingStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	archivedAt := time.Now().Truncate(time.Second)
+	sl := &pb.SignedListing{Listing: factory.NewListing("seasonal"), Signature: []byte("sig")}
+	if err := archiveDB.Put(sl, map[int]int64{0: 5, 1: -1}, archivedAt); err != nil {
+		t.Fatal(err)
+	}
+	a, err := archiveDB.Get("seasonal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Slug != "seasonal" || a.Listing.Listing.Slug != "seasonal" {
+		t.Error("Returned incorrect slug")
+	}
+	if !a.ArchivedAt.Equal(archivedAt) {
+		t.Errorf("Expected archivedAt %s, got %s", archivedAt, a.ArchivedAt)
+	}
+	if string(a.Listing.Signature) != "sig" {
+		t.Error("Returned incorrect signature")
+	}
+	if len(a.Inventory) != 2 || a.Inventory[0] != 5 || a.Inventory[1] != -1 {
+		t.Errorf("Returned incorrect inventory %v", a.Inventory)
+	}
+}
+
+func TestArchivedListingsDB_GetAllAndDelete(t *testing.T) {
+	archiveDB, teardown, err := buildNewArchivedListingStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	archiveDB.Put(&pb.SignedListing{Listing: factory.NewListing("old")}, nil, now.Add(-time.Hour))
+	archiveDB.Put(&pb.SignedListing{Listing: factory.NewListing("new")}, map[int]int64{0: 3}, now)
+
+	all, err := archiveDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].Slug != "new" || all[1].Slug != "old" {
+		t.Fatal("Returned incorrect archived listings")
+	}
+	if all[0].Inventory[0] != 3 || len(all[1].Inventory) != 0 {
+		t.Error("Returned incorrect inventory")
+	}
+
+	if err := archiveDB.Delete("old"); err != nil {
+		t.Error(err)
+	}
+	all, err = archiveDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Error("Failed to delete archived listing")
+	}
+}
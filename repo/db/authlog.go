@@ -0,0 +1,73 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type AuthLogDB struct {
+	modelStore
+}
+
+func NewAuthLogStore(db *sql.DB, lock *sync.Mutex) repo.AuthLogStore {
+	return &AuthLogDB{modelStore{db, lock}}
+}
+
+func (a *AuthLogDB) Put(event repo.AuthEvent) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	success := 0
+	if event.Success {
+		success = 1
+	}
+	_, err := a.db.Exec("insert into authlog(timestamp, ip, method, credential, path, success, reason) values(?,?,?,?,?,?,?)",
+		event.Timestamp.Unix(), event.IP, string(event.Method), event.Credential, event.Path, success, event.Reason)
+	return err
+}
+
+func (a *AuthLogDB) Get(ip string, limit int) ([]repo.AuthEvent, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if ip == "" {
+		rows, err = a.db.Query("select id, timestamp, ip, method, credential, path, success, reason from authlog order by timestamp desc, id desc limit ?", limit)
+	} else {
+		rows, err = a.db.Query("select id, timestamp, ip, method, credential, path, success, reason from authlog where ip=? order by timestamp desc, id desc limit ?", ip, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []repo.AuthEvent
+	for rows.Next() {
+		var (
+			event     repo.AuthEvent
+			method    string
+			timestamp int64
+			success   int
+		)
+		if err := rows.Scan(&event.ID, &timestamp, &event.IP, &method, &event.Credential, &event.Path, &success, &event.Reason); err != nil {
+			return nil, err
+		}
+		event.Timestamp = time.Unix(timestamp, 0)
+		event.Method = repo.AuthMethod(method)
+		event.Success = success == 1
+		ret = append(ret, event)
+	}
+	return ret, rows.Err()
+}
+
+func (a *AuthLogDB) DeleteBefore(t time.Time) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, err := a.db.Exec("delete from authlog where timestamp<?", t.Unix())
+	return err
+}
@@ -0,0 +1,97 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewAuthLogStore() (repo.AuthLogStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAuthLogStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestAuthLogDB_PutAndGet(t *testing.T) {
+	authLogDB, teardown, err := buildNewAuthLogStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now().Truncate(time.Second)
+	events := []repo.AuthEvent{
+		{Timestamp: now.Add(-time.Minute), IP: "10.0.0.1", Method: repo.AuthMethodBasic, Credential: "admin", Path: "/ob/profile", Reason: "invalid credentials"},
+		{Timestamp: now, IP: "10.0.0.1", Method: repo.AuthMethodBasic, Credential: "admin", Path: "/ob/profile", Success: true},
+		{Timestamp: now, IP: "10.0.0.2", Method: repo.AuthMethodToken, Credential: "fulfillment", Path: "/ob/sales", Success: true},
+	}
+	for _, e := range events {
+		if err := authLogDB.Put(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := authLogDB.Get("", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 || all[0].IP != "10.0.0.2" || all[2].Success {
+		t.Errorf("Unexpected events %+v", all)
+	}
+	byIP, err := authLogDB.Get("10.0.0.1", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byIP) != 2 || !byIP[0].Success || byIP[1].Reason != "invalid credentials" || byIP[1].Method != repo.AuthMethodBasic || !byIP[1].Timestamp.Equal(now.Add(-time.Minute)) {
+		t.Errorf("Unexpected events %+v", byIP)
+	}
+	limited, err := authLogDB.Get("", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limited) != 1 {
+		t.Errorf("Expected 1 event, got %d", len(limited))
+	}
+}
+
+func TestAuthLogDB_DeleteBefore(t *testing.T) {
+	authLogDB, teardown, err := buildNewAuthLogStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	if err := authLogDB.Put(repo.AuthEvent{Timestamp: now.Add(-time.Hour * 48), IP: "10.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := authLogDB.Put(repo.AuthEvent{Timestamp: now, IP: "10.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := authLogDB.DeleteBefore(now.Add(-time.Hour * 24)); err != nil {
+		t.Fatal(err)
+	}
+	events, err := authLogDB.Get("", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Errorf("Expected old event to be deleted, got %d events", len(events))
+	}
+}
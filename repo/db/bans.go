@@ -0,0 +1,84 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type BansDB struct {
+	modelStore
+}
+
+func NewBanStore(db *sql.DB, lock *sync.Mutex) repo.BanStore {
+	return &BansDB{modelStore{db, lock}}
+}
+
+func (b *BansDB) Put(ban repo.Ban) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	var expires int64
+	if ban.Expires != nil {
+		expires = ban.Expires.Unix()
+	}
+	_, err := b.db.Exec("insert or replace into bans(peerID, scope, reason, createdAt, expires) values(?,?,?,?,?)",
+		ban.PeerID, string(ban.Scope), ban.Reason, ban.CreatedAt.Unix(), expires)
+	return err
+}
+
+func (b *BansDB) Get(peerID string) ([]repo.Ban, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	rows, err := b.db.Query("select peerID, scope, reason, createdAt, expires from bans where peerID=? order by createdAt desc", peerID)
+	if err != nil {
+		return nil, err
+	}
+	return scanBans(rows)
+}
+
+func (b *BansDB) GetAll() ([]repo.Ban, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	rows, err := b.db.Query("select peerID, scope, reason, createdAt, expires from bans order by createdAt desc")
+	if err != nil {
+		return nil, err
+	}
+	return scanBans(rows)
+}
+
+func (b *BansDB) Delete(peerID string, scope repo.BanScope) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if scope == "" {
+		_, err := b.db.Exec("delete from bans where peerID=?", peerID)
+		return err
+	}
+	_, err := b.db.Exec("delete from bans where peerID=? and scope=?", peerID, string(scope))
+	return err
+}
+
+func scanBans(rows *sql.Rows) ([]repo.Ban, error) {
+	defer rows.Close()
+	var ret []repo.Ban
+	for rows.Next() {
+		var (
+			ban                repo.Ban
+			scope              string
+			createdAt, expires int64
+		)
+		if err := rows.Scan(&ban.PeerID, &scope, &ban.Reason, &createdAt, &expires); err != nil {
+			return nil, err
+		}
+		ban.Scope = repo.BanScope(scope)
+		ban.CreatedAt = time.Unix(createdAt, 0)
+		if expires > 0 {
+			t := time.Unix(expires, 0)
+			ban.Expires = &t
+		}
+		ret = append(ret, ban)
+	}
+	return ret, rows.Err()
+}
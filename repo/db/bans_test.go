@@ -0,0 +1,102 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewBanStore() (repo.BanStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewBanStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestBansDB_PutAndGet(t *testing.T) {
+	banDB, teardown, err := buildNewBanStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	createdAt := time.Now().Truncate(time.Second)
+	expires := createdAt.Add(time.Hour)
+	if err := banDB.Put(repo.Ban{PeerID: "peer1", Scope: repo.BanScopeChat, Reason: "spam", CreatedAt: createdAt, Expires: &expires}); err != nil {
+		t.Fatal(err)
+	}
+	if err := banDB.Put(repo.Ban{PeerID: "peer1", Scope: repo.BanScopeFollow, CreatedAt: createdAt.Add(-time.Minute)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := banDB.Put(repo.Ban{PeerID: "peer1", Scope: repo.BanScopeChat, Reason: "abuse", CreatedAt: createdAt, Expires: &expires}); err != nil {
+		t.Fatal(err)
+	}
+
+	bans, err := banDB.Get("peer1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 2 {
+		t.Fatalf("Expected 2 bans, got %d", len(bans))
+	}
+	if bans[0].Scope != repo.BanScopeChat || bans[0].Reason != "abuse" || !bans[0].CreatedAt.Equal(createdAt) || !bans[0].Expires.Equal(expires) {
+		t.Errorf("Unexpected ban %+v", bans[0])
+	}
+	if bans[1].Scope != repo.BanScopeFollow || bans[1].Expires != nil {
+		t.Errorf("Expected permanent follow ban, got %+v", bans[1])
+	}
+}
+
+func TestBansDB_GetAllAndDelete(t *testing.T) {
+	banDB, teardown, err := buildNewBanStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	for _, ban := range []repo.Ban{
+		{PeerID: "peer1", Scope: repo.BanScopeChat, CreatedAt: now},
+		{PeerID: "peer1", Scope: repo.BanScopeOrder, CreatedAt: now},
+		{PeerID: "peer2", Scope: repo.BanScopeAll, CreatedAt: now},
+	} {
+		if err := banDB.Put(ban); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := banDB.Delete("peer1", repo.BanScopeChat); err != nil {
+		t.Fatal(err)
+	}
+	bans, err := banDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 2 {
+		t.Errorf("Expected 2 bans, got %d", len(bans))
+	}
+	if err := banDB.Delete("peer1", ""); err != nil {
+		t.Fatal(err)
+	}
+	bans, err = banDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 1 || bans[0].PeerID != "peer2" {
+		t.Errorf("Expected only peer2 to remain banned, got %+v", bans)
+	}
+}
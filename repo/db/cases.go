@@ -207,32 +207,64 @@ func (c *CasesDB) Delete(orderID string) error {
 func (c *CasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, limit int, exclude []string) ([]repo.Case, int, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	q := casesQuery(stateFilter, searchTerm, sortByAscending, sortByRead)
+	q.exclude = exclude
+	q.limit = limit
+	cases, _, count, err := c.queryCases(q)
+	return cases, count, err
+}
+
+func (c *CasesDB) GetPage(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, cursor string, limit int) ([]repo.Case, int, string, error) {
+	after, err := parsePageCursor(cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	q := casesQuery(stateFilter, searchTerm, sortByAscending, sortByRead)
+	q.after = after
+	q.limit = pageFetchLimit(limit)
+	cases, cursors, count, err := c.queryCases(q)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	n, next := trimPage(cursors, limit)
+	return cases[:n], count, next, nil
+}
 
-	q := query{
+func casesQuery(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool) query {
+	return query{
 		table:           "cases",
-		columns:         []string{"caseID", "timestamp", "buyerContract", "vendorContract", "buyerOpened", "state", "read", "coinType", "paymentCoin"},
+		columns:         []string{"caseID", "timestamp", "buyerContract", "vendorContract", "buyerOpened", "state", "read", "coinType", "paymentCoin", "rowid"},
 		stateFilter:     stateFilter,
 		searchTerm:      searchTerm,
 		searchColumns:   []string{"caseID", "timestamp", "claim"},
 		sortByAscending: sortByAscending,
 		sortByRead:      sortByRead,
 		id:              "caseID",
-		exclude:         exclude,
-		limit:           limit,
 	}
+}
+
+// queryCases returns the cases selected by the query, together with their
+// page cursors, and the number of cases matching it
+func (c *CasesDB) queryCases(q query) ([]repo.Case, []pageCursor, int, error) {
 	stm, args := filterQuery(q)
 	rows, err := c.db.Query(stm, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	defer rows.Close()
-	var ret []repo.Case
+	var (
+		ret     []repo.Case
+		cursors []pageCursor
+	)
 	for rows.Next() {
 		var caseID, coinType, paymentCoin string
 		var buyerContract, vendorContract []byte
 		var timestamp, buyerOpenedInt, stateInt, readInt int
-		if err := rows.Scan(&caseID, &timestamp, &buyerContract, &vendorContract, &buyerOpenedInt, &stateInt, &readInt, &coinType, &paymentCoin); err != nil {
-			return ret, 0, err
+		var rowID int64
+		if err := rows.Scan(&caseID, &timestamp, &buyerContract, &vendorContract, &buyerOpenedInt, &stateInt, &readInt, &coinType, &paymentCoin, &rowID); err != nil {
+			return nil, nil, 0, err
 		}
 		read := false
 		if readInt > 0 {
@@ -295,18 +327,20 @@ func (c *CasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 			State:        pb.OrderState(stateInt).String(),
 			Read:         read,
 		})
+		cursors = append(cursors, pageCursor{readInt, int64(timestamp), rowID})
 	}
 	q.columns = []string{"Count(*)"}
 	q.limit = -1
 	q.exclude = []string{}
+	q.after = nil
 	stm, args = filterQuery(q)
 	row := c.db.QueryRow(stm, args...)
 	var count int
 	err = row.Scan(&count)
 	if err != nil {
-		return ret, 0, err
+		return nil, nil, 0, err
 	}
-	return ret, count, nil
+	return ret, cursors, count, nil
 }
 
 func (c *CasesDB) GetCaseMetadata(caseID string) (buyerContract, vendorContract *pb.RicardianContract, buyerValidationErrors, vendorValidationErrors []string, state pb.OrderState, read bool, timestamp time.Time, buyerOpened bool, claim string, resolution *pb.DisputeResolution, err error) {
@@ -480,6 +514,12 @@ func (c *CasesDB) Count() int {
 	return count
 }
 
+func (c *CasesDB) CountByState() (map[pb.OrderState]int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return countByState(c.db, "cases")
+}
+
 // GetDisputesForDisputeExpiryNotification returns []*repo.DisputeCaseRecord including
 // each record which needs Notifications to be generated. Currently,
 // notifications are generated at 0, 15, 30, 44, and 45 days after opening.
@@ -57,6 +57,30 @@ func TestCasesDB_Count(t *testing.T) {
 	}
 }
 
+func TestCasesDB_CountByState(t *testing.T) {
+	var (
+		casesdb, teardown, err = buildNewCaseStore()
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if err := casesdb.Put("caseID1", pb.OrderState_DISPUTED, true, "blah", "", "btc"); err != nil {
+		t.Fatal(err)
+	}
+	if err := casesdb.Put("caseID2", pb.OrderState_RESOLVED, false, "blah", "", "btc"); err != nil {
+		t.Fatal(err)
+	}
+	counts, err := casesdb.CountByState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 2 || counts[pb.OrderState_DISPUTED] != 1 || counts[pb.OrderState_RESOLVED] != 1 {
+		t.Errorf("Returned incorrect counts by state: %v", counts)
+	}
+}
+
 func TestPutCase(t *testing.T) {
 	var (
 		casesdb, teardown, err = buildNewCaseStore()
@@ -79,33 +79,84 @@ func (c *ChatDB) GetConversations() []repo.ChatConversation {
 	}
 	defer rows.Close()
 	for _, peerId := range ids {
-		stm := "select Count(*) from chat where peerID='" + peerId + "' and read=0 and subject='' and outgoing=0;"
-		row := c.db.QueryRow(stm)
-		var count int
-		row.Scan(&count)
-		stm = "select max(timestamp), message, outgoing from chat where peerID='" + peerId + "' and subject=''"
-		row = c.db.QueryRow(stm)
-		var m string
-		var ts int
-		var outInt int
-		row.Scan(&ts, &m, &outInt)
-		outgoing := false
-		if outInt > 0 {
-			outgoing = true
-		}
-		timestamp := time.Unix(int64(ts), 0)
-		convo := repo.ChatConversation{
-			PeerId:    peerId,
-			Unread:    count,
-			Last:      m,
-			Timestamp: timestamp,
-			Outgoing:  outgoing,
-		}
-		ret = append(ret, convo)
+		ret = append(ret, c.conversation(peerId))
 	}
 	return ret
 }
 
+func (c *ChatDB) GetConversationsPage(cursor string, limit int) ([]repo.ChatConversation, string, error) {
+	after, err := parsePageCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Conversations are ordered by their last message
+	stm := "select peerID, max(timestamp) as ts, max(rowid) as r from chat where subject='' group by peerID"
+	args := []interface{}{}
+	if after != nil {
+		stm += " having ts<? or (ts=? and r<?)"
+		args = append(args, after.timestamp, after.timestamp, after.rowID)
+	}
+	stm += " order by ts desc, r desc limit ?;"
+	args = append(args, pageFetchLimit(limit))
+	rows, err := c.db.Query(stm, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	var (
+		ids     []string
+		cursors []pageCursor
+	)
+	for rows.Next() {
+		var (
+			peerId    string
+			timestamp int64
+			rowID     int64
+		)
+		if err := rows.Scan(&peerId, &timestamp, &rowID); err != nil {
+			rows.Close()
+			return nil, "", err
+		}
+		ids = append(ids, peerId)
+		cursors = append(cursors, pageCursor{timestamp: timestamp, rowID: rowID})
+	}
+	rows.Close()
+	n, next := trimPage(cursors, limit)
+	ret := []repo.ChatConversation{}
+	for _, peerId := range ids[:n] {
+		ret = append(ret, c.conversation(peerId))
+	}
+	return ret, next, nil
+}
+
+// conversation returns the summary of the conversation with the peer
+func (c *ChatDB) conversation(peerId string) repo.ChatConversation {
+	stm := "select Count(*) from chat where peerID='" + peerId + "' and read=0 and subject='' and outgoing=0;"
+	row := c.db.QueryRow(stm)
+	var count int
+	row.Scan(&count)
+	stm = "select max(timestamp), message, outgoing from chat where peerID='" + peerId + "' and subject=''"
+	row = c.db.QueryRow(stm)
+	var m string
+	var ts int
+	var outInt int
+	row.Scan(&ts, &m, &outInt)
+	outgoing := false
+	if outInt > 0 {
+		outgoing = true
+	}
+	timestamp := time.Unix(int64(ts), 0)
+	return repo.ChatConversation{
+		PeerId:    peerId,
+		Unread:    count,
+		Last:      m,
+		Timestamp: timestamp,
+		Outgoing:  outgoing,
+	}
+}
+
 func (c *ChatDB) GetMessages(peerID string, subject string, offsetId string, limit int) []repo.ChatMessage {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -160,6 +211,59 @@ func (c *ChatDB) GetMessages(peerID string, subject string, offsetId string, lim
 	return ret
 }
 
+func (c *ChatDB) GetMessagesPage(peerID string, subject string, cursor string, limit int) ([]repo.ChatMessage, string, error) {
+	after, err := parsePageCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stm := "select rowid, messageID, peerID, message, read, timestamp, outgoing from chat where subject=?"
+	args := []interface{}{subject}
+	if peerID != "" {
+		stm += " and peerID=?"
+		args = append(args, peerID)
+	}
+	if after != nil {
+		stm += " and (timestamp<? or (timestamp=? and rowid<?))"
+		args = append(args, after.timestamp, after.timestamp, after.rowID)
+	}
+	stm += " order by timestamp desc, rowid desc limit ?;"
+	args = append(args, pageFetchLimit(limit))
+	rows, err := c.db.Query(stm, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var (
+		ret     []repo.ChatMessage
+		cursors []pageCursor
+	)
+	for rows.Next() {
+		var (
+			rowID                              int64
+			msgID, pid, message                string
+			readInt, timestampInt, outgoingInt int
+		)
+		if err := rows.Scan(&rowID, &msgID, &pid, &message, &readInt, &timestampInt, &outgoingInt); err != nil {
+			return nil, "", err
+		}
+		ret = append(ret, repo.ChatMessage{
+			PeerId:    pid,
+			MessageId: msgID,
+			Subject:   subject,
+			Message:   message,
+			Read:      readInt == 1,
+			Timestamp: time.Unix(int64(timestampInt), 0),
+			Outgoing:  outgoingInt == 1,
+		})
+		cursors = append(cursors, pageCursor{timestamp: int64(timestampInt), rowID: rowID})
+	}
+	n, next := trimPage(cursors, limit)
+	return ret[:n], next, rows.Err()
+}
+
 func (c *ChatDB) MarkAsRead(peerID string, subject string, outgoing bool, messageId string) (string, bool, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -37,6 +37,16 @@ type SQLiteDatastore struct {
 	coupons         repo.CouponStore
 	txMetadata      repo.TransactionMetadataStore
 	moderatedStores repo.ModeratedStore
+	scheduled       repo.ScheduledListingStore
+	archived        repo.ArchivedListingStore
+	search          repo.SearchStore
+	bans            repo.BanStore
+	sessions        repo.SessionStore
+	received        repo.ReceivedMessageStore
+	apiTokens       repo.APITokenStore
+	authLog         repo.AuthLogStore
+	events          repo.EventStore
+	idempotencyKeys repo.IdempotencyKeyStore
 	db              *sql.DB
 	lock            *sync.Mutex
 }
@@ -82,6 +92,16 @@ func NewSQLiteDatastore(db *sql.DB, l *sync.Mutex, coinType wallet.CoinType) *SQ
 		coupons:         NewCouponStore(db, l),
 		txMetadata:      NewTransactionMetadataStore(db, l),
 		moderatedStores: NewModeratedStore(db, l),
+		scheduled:       NewScheduledListingStore(db, l),
+		archived:        NewArchivedListingStore(db, l),
+		search:          NewSearchStore(db, l),
+		bans:            NewBanStore(db, l),
+		sessions:        NewSessionStore(db, l),
+		received:        NewReceivedMessageStore(db, l),
+		apiTokens:       NewAPITokenStore(db, l),
+		authLog:         NewAuthLogStore(db, l),
+		events:          NewEventStore(db, l),
+		idempotencyKeys: NewIdempotencyKeyStore(db, l),
 		db:              db,
 		lock:            l,
 	}
@@ -175,6 +195,46 @@ func (d *SQLiteDatastore) ModeratedStores() repo.ModeratedStore {
 	return d.moderatedStores
 }
 
+func (d *SQLiteDatastore) ScheduledListings() repo.ScheduledListingStore {
+	return d.scheduled
+}
+
+func (d *SQLiteDatastore) ArchivedListings() repo.ArchivedListingStore {
+	return d.archived
+}
+
+func (d *SQLiteDatastore) Search() repo.SearchStore {
+	return d.search
+}
+
+func (d *SQLiteDatastore) Bans() repo.BanStore {
+	return d.bans
+}
+
+func (d *SQLiteDatastore) Sessions() repo.SessionStore {
+	return d.sessions
+}
+
+func (d *SQLiteDatastore) ReceivedMessages() repo.ReceivedMessageStore {
+	return d.received
+}
+
+func (d *SQLiteDatastore) APITokens() repo.APITokenStore {
+	return d.apiTokens
+}
+
+func (d *SQLiteDatastore) AuthLog() repo.AuthLogStore {
+	return d.authLog
+}
+
+func (d *SQLiteDatastore) Events() repo.EventStore {
+	return d.events
+}
+
+func (d *SQLiteDatastore) IdempotencyKeys() repo.IdempotencyKeyStore {
+	return d.idempotencyKeys
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, password string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type EventsDB struct {
+	modelStore
+}
+
+func NewEventStore(db *sql.DB, lock *sync.Mutex) repo.EventStore {
+	return &EventsDB{modelStore{db, lock}}
+}
+
+func (e *EventsDB) Put(topic string, data []byte, timestamp time.Time) (uint64, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	res, err := e.db.Exec("insert into events(topic, timestamp, data) values(?,?,?)", topic, timestamp.Unix(), data)
+	if err != nil {
+		return 0, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(id), nil
+}
+
+func (e *EventsDB) GetSince(id uint64, topics []string, limit int) ([]repo.Event, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	stm := "select id, topic, timestamp, data from events where id>?"
+	args := []interface{}{id}
+	if len(topics) > 0 {
+		stm += " and topic in (?" + strings.Repeat(",?", len(topics)-1) + ")"
+		for _, t := range topics {
+			args = append(args, t)
+		}
+	}
+	stm += " order by id asc limit ?"
+	args = append(args, limit)
+	rows, err := e.db.Query(stm, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []repo.Event
+	for rows.Next() {
+		var (
+			event     repo.Event
+			timestamp int64
+		)
+		if err := rows.Scan(&event.ID, &event.Topic, &timestamp, &event.Data); err != nil {
+			return nil, err
+		}
+		event.Timestamp = time.Unix(timestamp, 0)
+		ret = append(ret, event)
+	}
+	return ret, rows.Err()
+}
+
+func (e *EventsDB) OldestID() (uint64, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	var id sql.NullInt64
+	if err := e.db.QueryRow("select min(id) from events").Scan(&id); err != nil {
+		return 0, err
+	}
+	return uint64(id.Int64), nil
+}
+
+func (e *EventsDB) Truncate(keep int) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	_, err := e.db.Exec("delete from events where id<=(select max(id) from events)-?", keep)
+	return err
+}
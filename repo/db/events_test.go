@@ -0,0 +1,108 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewEventStore() (repo.EventStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewEventStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestEventsDB_PutAndGetSince(t *testing.T) {
+	eventDB, teardown, err := buildNewEventStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	oldest, err := eventDB.OldestID()
+	if err != nil || oldest != 0 {
+		t.Errorf("Expected no oldest event, got %d (%v)", oldest, err)
+	}
+	var ids []uint64
+	for _, topic := range []string{"order", "chatMessage", "payment", "order"} {
+		id, err := eventDB.Put(topic, []byte(`{"topic": "`+topic+`"}`), time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) > 0 && id <= ids[len(ids)-1] {
+			t.Errorf("Expected IDs to increase, got %d after %d", id, ids[len(ids)-1])
+		}
+		ids = append(ids, id)
+	}
+
+	events, err := eventDB.GetSince(ids[0], nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 || events[0].ID != ids[1] || events[0].Topic != "chatMessage" || string(events[0].Data) != `{"topic": "chatMessage"}` {
+		t.Errorf("Unexpected events %+v", events)
+	}
+	events, err = eventDB.GetSince(0, []string{"order", "payment"}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 || events[1].Topic != "payment" {
+		t.Errorf("Expected events to be filtered by topic, got %+v", events)
+	}
+	events, err = eventDB.GetSince(0, nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[1].ID != ids[1] {
+		t.Errorf("Expected events to be limited, got %+v", events)
+	}
+}
+
+func TestEventsDB_Truncate(t *testing.T) {
+	eventDB, teardown, err := buildNewEventStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	var ids []uint64
+	for i := 0; i < 5; i++ {
+		id, err := eventDB.Put("order", nil, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	if err := eventDB.Truncate(2); err != nil {
+		t.Fatal(err)
+	}
+	oldest, err := eventDB.OldestID()
+	if err != nil || oldest != ids[3] {
+		t.Errorf("Expected oldest event to be %d, got %d (%v)", ids[3], oldest, err)
+	}
+
+	// IDs are not reused after truncating
+	if err := eventDB.Truncate(0); err != nil {
+		t.Fatal(err)
+	}
+	id, err := eventDB.Put("order", nil, time.Now())
+	if err != nil || id <= ids[4] {
+		t.Errorf("Expected ID above %d, got %d (%v)", ids[4], id, err)
+	}
+}
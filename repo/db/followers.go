@@ -2,7 +2,6 @@ package db
 
 import (
 	"database/sql"
-	"strconv"
 	"sync"
 
 	"github.com/OpenBazaar/openbazaar-go/repo"
@@ -35,14 +34,14 @@ func (f *FollowerDB) Put(follower string, proof []byte) error {
 func (f *FollowerDB) Get(offsetId string, limit int) ([]repo.Follower, error) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
-	var stm string
+	stm := "select peerID, proof from followers order by rowid desc limit ?"
+	args := []interface{}{limit}
 	if offsetId != "" {
-		stm = "select peerID, proof from followers order by rowid desc limit " + strconv.Itoa(limit) + " offset ((select coalesce(max(rowid)+1, 0) from followers)-(select rowid from followers where peerID='" + offsetId + "'))"
-	} else {
-		stm = "select peerID, proof from followers order by rowid desc limit " + strconv.Itoa(limit) + " offset 0"
+		stm = "select peerID, proof from followers where rowid<(select rowid from followers where peerID=?) order by rowid desc limit ?"
+		args = []interface{}{offsetId, limit}
 	}
 	var ret []repo.Follower
-	rows, err := f.db.Query(stm)
+	rows, err := f.db.Query(stm, args...)
 	if err != nil {
 		return ret, err
 	}
@@ -57,6 +56,44 @@ func (f *FollowerDB) Get(offsetId string, limit int) ([]repo.Follower, error) {
 	return ret, nil
 }
 
+func (f *FollowerDB) GetPage(cursor string, limit int) ([]repo.Follower, string, error) {
+	after, err := parsePageCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	stm := "select rowid, peerID, proof from followers order by rowid desc limit ?"
+	args := []interface{}{pageFetchLimit(limit)}
+	if after != nil {
+		stm = "select rowid, peerID, proof from followers where rowid<? order by rowid desc limit ?"
+		args = []interface{}{after.rowID, pageFetchLimit(limit)}
+	}
+	rows, err := f.db.Query(stm, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var (
+		ret     []repo.Follower
+		cursors []pageCursor
+	)
+	for rows.Next() {
+		var (
+			rowID  int64
+			peerID string
+			proof  []byte
+		)
+		if err := rows.Scan(&rowID, &peerID, &proof); err != nil {
+			return nil, "", err
+		}
+		ret = append(ret, repo.Follower{peerID, proof})
+		cursors = append(cursors, pageCursor{rowID: rowID})
+	}
+	n, next := trimPage(cursors, limit)
+	return ret[:n], next, rows.Err()
+}
+
 func (f *FollowerDB) Delete(follower string) error {
 	f.lock.Lock()
 	defer f.lock.Unlock()
@@ -166,6 +166,92 @@ func TestGetFollowers(t *testing.T) {
 	}
 }
 
+func TestGetFollowersAfterDelete(t *testing.T) {
+	fdb, teardown, err := buildNewFollowerStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	for i := 0; i < 10; i++ {
+		fdb.Put(strconv.Itoa(i), []byte("proof"))
+	}
+	fdb.Delete("8")
+	fdb.Delete("3")
+
+	followers, err := fdb.Get(strconv.Itoa(7), 3)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(followers) != 3 {
+		t.Fatalf("Expected 3 followers, got %d", len(followers))
+	}
+	for i, expected := range []string{"6", "5", "4"} {
+		if followers[i].PeerId != expected {
+			t.Errorf("Returned %s expected %s", followers[i].PeerId, expected)
+		}
+	}
+
+	followers, err = fdb.Get("unknown", 3)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(followers) != 0 {
+		t.Errorf("Expected no followers after an unknown offset, got %d", len(followers))
+	}
+}
+
+func TestGetFollowersPage(t *testing.T) {
+	fdb, teardown, err := buildNewFollowerStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	for i := 0; i < 10; i++ {
+		fdb.Put(strconv.Itoa(i), []byte("proof"))
+	}
+	followers, cursor, err := fdb.GetPage("", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expected := range []string{"9", "8", "7"} {
+		if followers[i].PeerId != expected {
+			t.Errorf("Returned %s expected %s", followers[i].PeerId, expected)
+		}
+	}
+	if cursor == "" {
+		t.Fatal("Expected a cursor for the next page")
+	}
+
+	// Removing the last follower of the page doesn't end the list
+	fdb.Delete("7")
+	followers, cursor, err = fdb.GetPage(cursor, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expected := range []string{"6", "5", "4"} {
+		if followers[i].PeerId != expected {
+			t.Errorf("Returned %s expected %s", followers[i].PeerId, expected)
+		}
+	}
+
+	followers, cursor, err = fdb.GetPage(cursor, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(followers) != 4 {
+		t.Errorf("Expected 4 followers, got %d", len(followers))
+	}
+	if cursor != "" {
+		t.Errorf("Expected no cursor after the last page, got %s", cursor)
+	}
+
+	if _, _, err := fdb.GetPage("7", 3); err != repo.ErrInvalidCursor {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+}
+
 func TestFollowsMe(t *testing.T) {
 	fdb, teardown, err := buildNewFollowerStore()
 	if err != nil {
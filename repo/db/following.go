@@ -2,7 +2,6 @@ package db
 
 import (
 	"database/sql"
-	"strconv"
 	"sync"
 
 	"github.com/OpenBazaar/openbazaar-go/repo"
@@ -34,14 +33,14 @@ func (f *FollowingDB) Put(follower string) error {
 func (f *FollowingDB) Get(offsetId string, limit int) ([]string, error) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
-	var stm string
+	stm := "select peerID from following order by rowid desc limit ?"
+	args := []interface{}{limit}
 	if offsetId != "" {
-		stm = "select peerID from following order by rowid desc limit " + strconv.Itoa(limit) + " offset ((select coalesce(max(rowid)+1, 0) from following)-(select rowid from following where peerID='" + offsetId + "'))"
-	} else {
-		stm = "select peerID from following order by rowid desc limit " + strconv.Itoa(limit) + " offset 0"
+		stm = "select peerID from following where rowid<(select rowid from following where peerID=?) order by rowid desc limit ?"
+		args = []interface{}{offsetId, limit}
 	}
 	var ret []string
-	rows, err := f.db.Query(stm)
+	rows, err := f.db.Query(stm, args...)
 	if err != nil {
 		return ret, err
 	}
@@ -54,6 +53,43 @@ func (f *FollowingDB) Get(offsetId string, limit int) ([]string, error) {
 	return ret, nil
 }
 
+func (f *FollowingDB) GetPage(cursor string, limit int) ([]string, string, error) {
+	after, err := parsePageCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	stm := "select rowid, peerID from following order by rowid desc limit ?"
+	args := []interface{}{pageFetchLimit(limit)}
+	if after != nil {
+		stm = "select rowid, peerID from following where rowid<? order by rowid desc limit ?"
+		args = []interface{}{after.rowID, pageFetchLimit(limit)}
+	}
+	rows, err := f.db.Query(stm, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var (
+		ret     []string
+		cursors []pageCursor
+	)
+	for rows.Next() {
+		var (
+			rowID  int64
+			peerID string
+		)
+		if err := rows.Scan(&rowID, &peerID); err != nil {
+			return nil, "", err
+		}
+		ret = append(ret, peerID)
+		cursors = append(cursors, pageCursor{rowID: rowID})
+	}
+	n, next := trimPage(cursors, limit)
+	return ret[:n], next, rows.Err()
+}
+
 func (f *FollowingDB) Delete(follower string) error {
 	f.lock.Lock()
 	defer f.lock.Unlock()
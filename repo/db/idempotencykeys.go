@@ -0,0 +1,50 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type IdempotencyKeysDB struct {
+	modelStore
+}
+
+func NewIdempotencyKeyStore(db *sql.DB, lock *sync.Mutex) repo.IdempotencyKeyStore {
+	return &IdempotencyKeysDB{modelStore{db, lock}}
+}
+
+func (i *IdempotencyKeysDB) Put(response repo.IdempotentResponse) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	_, err := i.db.Exec("insert or replace into idempotencykeys(key, fingerprint, statusCode, response, createdAt) values(?,?,?,?,?)",
+		response.Key, response.Fingerprint, response.StatusCode, response.Body, response.CreatedAt.Unix())
+	return err
+}
+
+func (i *IdempotencyKeysDB) Get(key string) (*repo.IdempotentResponse, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	var (
+		response  repo.IdempotentResponse
+		createdAt int64
+	)
+	err := i.db.QueryRow("select key, fingerprint, statusCode, response, createdAt from idempotencykeys where key=?", key).
+		Scan(&response.Key, &response.Fingerprint, &response.StatusCode, &response.Body, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	response.CreatedAt = time.Unix(createdAt, 0)
+	return &response, nil
+}
+
+func (i *IdempotencyKeysDB) DeleteBefore(t time.Time) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	_, err := i.db.Exec("delete from idempotencykeys where createdAt<?", t.Unix())
+	return err
+}
@@ -0,0 +1,103 @@
+package db_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewIdempotencyKeyStore() (repo.IdempotencyKeyStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewIdempotencyKeyStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestIdempotencyKeysDB_PutAndGet(t *testing.T) {
+	keyDB, teardown, err := buildNewIdempotencyKeyStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	response, err := keyDB.Get("missing")
+	if err != nil || response != nil {
+		t.Fatalf("Expected no response for an unknown key, got %v (%v)", response, err)
+	}
+
+	now := time.Now().Truncate(time.Second)
+	put := repo.IdempotentResponse{
+		Key:         "retry-1",
+		Fingerprint: "abc",
+		StatusCode:  200,
+		Body:        []byte(`{"txid": "1234"}`),
+		CreatedAt:   now,
+	}
+	if err := keyDB.Put(put); err != nil {
+		t.Fatal(err)
+	}
+	response, err = keyDB.Get("retry-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response == nil {
+		t.Fatal("Expected the stored response")
+	}
+	if response.Fingerprint != put.Fingerprint || response.StatusCode != put.StatusCode ||
+		!bytes.Equal(response.Body, put.Body) || !response.CreatedAt.Equal(now) {
+		t.Errorf("Expected %v, got %v", put, *response)
+	}
+
+	put.StatusCode = 400
+	if err := keyDB.Put(put); err != nil {
+		t.Fatal(err)
+	}
+	response, err = keyDB.Get("retry-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != 400 {
+		t.Errorf("Expected the response to be replaced, got status %d", response.StatusCode)
+	}
+}
+
+func TestIdempotencyKeysDB_DeleteBefore(t *testing.T) {
+	keyDB, teardown, err := buildNewIdempotencyKeyStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	if err := keyDB.Put(repo.IdempotentResponse{Key: "old", Fingerprint: "a", StatusCode: 200, CreatedAt: now.Add(-48 * time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := keyDB.Put(repo.IdempotentResponse{Key: "new", Fingerprint: "b", StatusCode: 200, CreatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	if err := keyDB.DeleteBefore(now.Add(-24 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if response, err := keyDB.Get("old"); err != nil || response != nil {
+		t.Errorf("Expected the old response to be deleted, got %v (%v)", response, err)
+	}
+	if response, err := keyDB.Get("new"); err != nil || response == nil {
+		t.Errorf("Expected the new response to be kept, got %v (%v)", response, err)
+	}
+}
@@ -101,25 +101,11 @@ func (n *NotficationsDB) GetAll(offsetId string, limit int, typeFilter []string)
 			log.Errorf("notifications: GetAll: scanning: %s\n", err.Error())
 			continue
 		}
-		var notification = &repo.Notification{}
-		err := json.Unmarshal(data, notification)
+		notification, err := unmarshalNotification(data, timestampInt, readInt)
 		if err != nil {
 			log.Errorf("notifications: GetAll: unmarshalling: %s\n", err.Error())
 			continue
 		}
-
-		// TODO: These should get removed when (*Notification).MarshalJSON begins to include
-		// these values. Overriding them here allows for the marshalled representation of
-		// the ID field to become out of sync with the DB version of ID, which is overridden
-		// here. (Making Notification.NotifierData.GetID() != Notification.GetID())
-		var read bool
-		if readInt == 1 {
-			read = true
-		}
-		notification.IsRead = read
-		notification.CreatedAt = time.Unix(int64(timestampInt), 0).UTC()
-		// END
-
 		ret = append(ret, notification)
 	}
 	row := n.db.QueryRow(cstm, args...)
@@ -131,6 +117,88 @@ func (n *NotficationsDB) GetAll(offsetId string, limit int, typeFilter []string)
 	return ret, count, nil
 }
 
+func (n *NotficationsDB) GetPage(cursor string, limit int, typeFilter []string) ([]*repo.Notification, int, string, error) {
+	after, err := parsePageCursor(cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	var (
+		clauses []string
+		args    []interface{}
+	)
+	if after != nil {
+		clauses = append(clauses, "rowid<?")
+		args = append(args, after.rowID)
+	}
+	if len(typeFilter) > 0 {
+		placeholders := make([]string, 0, len(typeFilter))
+		for _, t := range typeFilter {
+			placeholders = append(placeholders, "?")
+			args = append(args, strings.ToLower(t))
+		}
+		clauses = append(clauses, "type in ("+strings.Join(placeholders, ",")+")")
+	}
+	var filter string
+	if len(clauses) > 0 {
+		filter = " where " + strings.Join(clauses, " and ")
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	rows, err := n.db.Query("select rowid, serializedNotification, timestamp, read from notifications"+filter+" order by rowid desc limit "+strconv.Itoa(pageFetchLimit(limit))+";", args...)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	defer rows.Close()
+	var (
+		ret     []*repo.Notification
+		cursors []pageCursor
+	)
+	for rows.Next() {
+		var (
+			rowID        int64
+			data         []byte
+			readInt      int
+			timestampInt int
+		)
+		if err := rows.Scan(&rowID, &data, &timestampInt, &readInt); err != nil {
+			return nil, 0, "", err
+		}
+		notification, err := unmarshalNotification(data, timestampInt, readInt)
+		if err != nil {
+			log.Errorf("notifications: GetPage: unmarshalling: %s\n", err.Error())
+			continue
+		}
+		ret = append(ret, notification)
+		cursors = append(cursors, pageCursor{rowID: rowID})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, "", err
+	}
+	var count int
+	if err := n.db.QueryRow("select Count(*) from notifications"+filter+";", args...).Scan(&count); err != nil {
+		return nil, 0, "", err
+	}
+	l, next := trimPage(cursors, limit)
+	return ret[:l], count, next, nil
+}
+
+func unmarshalNotification(data []byte, timestampInt, readInt int) (*repo.Notification, error) {
+	var notification = &repo.Notification{}
+	if err := json.Unmarshal(data, notification); err != nil {
+		return nil, err
+	}
+
+	// TODO: These should get removed when (*Notification).MarshalJSON begins to include
+	// these values. Overriding them here allows for the marshalled representation of
+	// the ID field to become out of sync with the DB version of ID, which is overridden
+	// here. (Making Notification.NotifierData.GetID() != Notification.GetID())
+	notification.IsRead = readInt == 1
+	notification.CreatedAt = time.Unix(int64(timestampInt), 0).UTC()
+	// END
+	return notification, nil
+}
+
 func (n *NotficationsDB) MarkAsRead(notifID string) error {
 	n.lock.Lock()
 	defer n.lock.Unlock()
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
 	ps "gx/ipfs/QmXauCuJzmzapetmC6W4TuDJLL1yFFrVzSHoWv8YdbmnxH/go-libp2p-peerstore"
 	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
@@ -56,6 +57,13 @@ func (p *PointersDB) Delete(id peer.ID) error {
 	return nil
 }
 
+func (p *PointersDB) UpdateAddr(id peer.ID, addr ma.Multiaddr) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, err := p.db.Exec("update pointers set address=? where pointerID=?", addr.String(), id.Pretty())
+	return err
+}
+
 func (p *PointersDB) DeleteAll(purpose ipfs.Purpose) error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -86,7 +94,7 @@ func (p *PointersDB) GetAll() ([]ipfs.Pointer, error) {
 		if err := rows.Scan(&pointerID, &key, &address, &cancelID, &purpose, &timestamp); err != nil {
 			return ret, err
 		}
-		maAddr, err := ma.NewMultiaddr(address)
+		maAddr, err := sto.ParseAddr(address)
 		if err != nil {
 			return ret, err
 		}
@@ -141,7 +149,7 @@ func (p *PointersDB) GetByPurpose(purpose ipfs.Purpose) ([]ipfs.Pointer, error)
 		if err := rows.Scan(&pointerID, &key, &address, &cancelID, &purpose, &timestamp); err != nil {
 			return ret, err
 		}
-		maAddr, err := ma.NewMultiaddr(address)
+		maAddr, err := sto.ParseAddr(address)
 		if err != nil {
 			return ret, err
 		}
@@ -192,7 +200,7 @@ func (p *PointersDB) Get(id peer.ID) (ipfs.Pointer, error) {
 	if err := row.Scan(&pointerID, &key, &address, &cancelID, &purpose, &timestamp); err != nil {
 		return pointer, err
 	}
-	maAddr, err := ma.NewMultiaddr(address)
+	maAddr, err := sto.ParseAddr(address)
 	if err != nil {
 		return pointer, err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/repo/db"
 	"github.com/OpenBazaar/openbazaar-go/schema"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 )
 
 func mustNewPointer() ipfs.Pointer {
@@ -279,3 +280,63 @@ func TestPointersDB_Get(t *testing.T) {
 		t.Error("Get pointers returned incorrect data")
 	}
 }
+
+func TestPointersDB_GetHTTPSAddr(t *testing.T) {
+	pdb, teardown, err := buildNewPointerStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	url := "https://www.dropbox.com/s/6ibgbx0jqgu7xkw/ciphertext?dl=1"
+	addr, err := sto.EncodeHTTPSAddr(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := mustNewPointer()
+	m.Value.Addrs = []ma.Multiaddr{addr}
+	if err := pdb.Put(m); err != nil {
+		t.Fatal(err)
+	}
+	p, err := pdb.Get(m.Value.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := sto.DecodeHTTPSAddr(p.Value.Addrs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != url {
+		t.Errorf("expected %s, got %s", url, decoded)
+	}
+}
+
+func TestPointersDB_UpdateAddr(t *testing.T) {
+	pdb, teardown, err := buildNewPointerStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	m := mustNewPointer()
+	if err := pdb.Put(m); err != nil {
+		t.Fatal(err)
+	}
+	addr, err := sto.EncodeHTTPSAddr("https://example.com/message")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pdb.UpdateAddr(m.Value.ID, addr); err != nil {
+		t.Fatal(err)
+	}
+	p, err := pdb.Get(m.Value.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Value.Addrs) != 1 || !p.Value.Addrs[0].Equal(addr) {
+		t.Errorf("expected address %s, got %v", addr, p.Value.Addrs)
+	}
+	if p.Timestamp.Unix() != m.Timestamp.Unix() {
+		t.Errorf("expected timestamp %s to be kept, got %s", m.Timestamp, p.Timestamp)
+	}
+}
@@ -141,32 +141,64 @@ func (p *PurchasesDB) Delete(orderID string) error {
 func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, limit int, exclude []string) ([]repo.Purchase, int, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
+	q := purchasesQuery(stateFilter, searchTerm, sortByAscending, sortByRead)
+	q.exclude = exclude
+	q.limit = limit
+	purchases, _, count, err := p.queryPurchases(q)
+	return purchases, count, err
+}
+
+func (p *PurchasesDB) GetPage(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, cursor string, limit int) ([]repo.Purchase, int, string, error) {
+	after, err := parsePageCursor(cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	q := purchasesQuery(stateFilter, searchTerm, sortByAscending, sortByRead)
+	q.after = after
+	q.limit = pageFetchLimit(limit)
+	purchases, cursors, count, err := p.queryPurchases(q)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	n, next := trimPage(cursors, limit)
+	return purchases[:n], count, next, nil
+}
 
-	q := query{
+func purchasesQuery(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool) query {
+	return query{
 		table:           "purchases",
-		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorHandle", "shippingName", "shippingAddress", "state", "read", "coinType", "paymentCoin"},
+		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorHandle", "shippingName", "shippingAddress", "state", "read", "coinType", "paymentCoin", "rowid"},
 		stateFilter:     stateFilter,
 		searchTerm:      searchTerm,
 		searchColumns:   []string{"orderID", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorHandle", "shippingName", "shippingAddress", "paymentAddr"},
 		sortByAscending: sortByAscending,
 		sortByRead:      sortByRead,
 		id:              "orderID",
-		exclude:         exclude,
-		limit:           limit,
 	}
+}
+
+// queryPurchases returns the purchases selected by the query, together with their
+// page cursors, and the number of purchases matching it
+func (p *PurchasesDB) queryPurchases(q query) ([]repo.Purchase, []pageCursor, int, error) {
 	stm, args := filterQuery(q)
 	rows, err := p.db.Query(stm, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	defer rows.Close()
-	var ret []repo.Purchase
+	var (
+		ret     []repo.Purchase
+		cursors []pageCursor
+	)
 	for rows.Next() {
 		var orderID, title, thumbnail, vendorID, vendorHandle, shippingName, shippingAddr, coinType, paymentCoin string
 		var contract []byte
 		var timestamp, total, stateInt, readInt int
-		if err := rows.Scan(&orderID, &contract, &timestamp, &total, &title, &thumbnail, &vendorID, &vendorHandle, &shippingName, &shippingAddr, &stateInt, &readInt, &coinType, &paymentCoin); err != nil {
-			return ret, 0, err
+		var rowID int64
+		if err := rows.Scan(&orderID, &contract, &timestamp, &total, &title, &thumbnail, &vendorID, &vendorHandle, &shippingName, &shippingAddr, &stateInt, &readInt, &coinType, &paymentCoin, &rowID); err != nil {
+			return nil, nil, 0, err
 		}
 		read := false
 		if readInt > 0 {
@@ -175,7 +207,7 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 
 		rc := new(pb.RicardianContract)
 		if err := jsonpb.UnmarshalString(string(contract), rc); err != nil {
-			return ret, 0, err
+			return nil, nil, 0, err
 		}
 		var slug string
 		var moderated bool
@@ -203,18 +235,20 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 			Moderated:       moderated,
 			Read:            read,
 		})
+		cursors = append(cursors, pageCursor{readInt, int64(timestamp), rowID})
 	}
 	q.columns = []string{"Count(*)"}
 	q.limit = -1
 	q.exclude = []string{}
+	q.after = nil
 	stm, args = filterQuery(q)
 	row := p.db.QueryRow(stm, args...)
 	var count int
 	err = row.Scan(&count)
 	if err != nil {
-		return ret, 0, err
+		return nil, nil, 0, err
 	}
-	return ret, count, nil
+	return ret, cursors, count, nil
 }
 
 func (p *PurchasesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
@@ -296,6 +330,12 @@ func (p *PurchasesDB) Count() int {
 	return count
 }
 
+func (p *PurchasesDB) CountByState() (map[pb.OrderState]int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return countByState(p.db, "purchases")
+}
+
 func (p *PurchasesDB) GetPurchasesForDisputeExpiryNotification() ([]*repo.PurchaseRecord, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -1,11 +1,75 @@
 package db
 
 import (
-	"github.com/OpenBazaar/openbazaar-go/pb"
+	"database/sql"
+	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 )
 
+// pageCursor is the position of the last row of a page. Lists are ordered by
+// rowid, or by timestamp and then rowid, so the next page starts after the
+// position even if that row was deleted.
+type pageCursor struct {
+	read      int
+	timestamp int64
+	rowID     int64
+}
+
+// parsePageCursor parses a cursor returned with a previous page, or returns
+// nil for the first page
+func parsePageCursor(cursor string) (*pageCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 3 {
+		return nil, repo.ErrInvalidCursor
+	}
+	var (
+		c   pageCursor
+		err error
+	)
+	if c.read, err = strconv.Atoi(parts[0]); err != nil {
+		return nil, repo.ErrInvalidCursor
+	}
+	if c.timestamp, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return nil, repo.ErrInvalidCursor
+	}
+	if c.rowID, err = strconv.ParseInt(parts[2], 10, 64); err != nil {
+		return nil, repo.ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+func (c pageCursor) String() string {
+	return fmt.Sprintf("%d:%d:%d", c.read, c.timestamp, c.rowID)
+}
+
+// pageFetchLimit returns the number of rows to read for a page of limit rows,
+// one more so the page knows whether another one follows it
+func pageFetchLimit(limit int) int {
+	if limit < 0 {
+		return -1
+	}
+	return limit + 1
+}
+
+// trimPage returns how many of the rows read for a page of limit rows are in
+// the page, and the cursor of the next page, which is empty on the last page
+func trimPage(cursors []pageCursor, limit int) (int, string) {
+	switch {
+	case limit < 0 || len(cursors) <= limit:
+		return len(cursors), ""
+	case limit == 0:
+		return 0, ""
+	}
+	return limit, cursors[limit-1].String()
+}
+
 type query struct {
 	table           string
 	columns         []string
@@ -16,6 +80,7 @@ type query struct {
 	sortByRead      bool
 	id              string
 	exclude         []string
+	after           *pageCursor
 	limit           int
 }
 
@@ -89,7 +154,26 @@ func filterQuery(q query) (stm string, args []interface{}) {
 			exclude = " where " + exclude
 		}
 	}
-	stm = "select " + queryColumns + " from " + q.table + filter + search + exclude + " order by " + readSort + "timestamp " + order + " limit " + strconv.Itoa(q.limit) + ";"
+	var after string
+	var afterArgs []interface{}
+	if q.after != nil {
+		cmp := "<"
+		if q.sortByAscending {
+			cmp = ">"
+		}
+		after = "(timestamp" + cmp + "? or (timestamp=? and rowid" + cmp + "?))"
+		afterArgs = []interface{}{q.after.timestamp, q.after.timestamp, q.after.rowID}
+		if q.sortByRead {
+			after = "(read>? or (read=? and " + after + "))"
+			afterArgs = append([]interface{}{q.after.read, q.after.read}, afterArgs...)
+		}
+		if filter != "" || search != "" || exclude != "" {
+			after = " and " + after
+		} else {
+			after = " where " + after
+		}
+	}
+	stm = "select " + queryColumns + " from " + q.table + filter + search + exclude + after + " order by " + readSort + "timestamp " + order + ", rowid " + order + " limit " + strconv.Itoa(q.limit) + ";"
 
 	for _, s := range states {
 		args = append(args, s)
@@ -102,5 +186,24 @@ func filterQuery(q query) (stm string, args []interface{}) {
 			args = append(args, s)
 		}
 	}
+	args = append(args, afterArgs...)
 	return stm, args
 }
+
+// countByState returns the number of rows of the table in each order state
+func countByState(db *sql.DB, table string) (map[pb.OrderState]int, error) {
+	rows, err := db.Query("select state, Count(*) from " + table + " group by state")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ret := make(map[pb.OrderState]int)
+	for rows.Next() {
+		var state, count int
+		if err := rows.Scan(&state, &count); err != nil {
+			return nil, err
+		}
+		ret[pb.OrderState(state)] = count
+	}
+	return ret, rows.Err()
+}
@@ -18,7 +18,7 @@ func Test_filterQuery(t *testing.T) {
 		sortByAscending: false,
 		limit:           -1,
 	})
-	if stm != "select orderID, timestamp from purchases where (orderID || timestamp || title) like ? order by timestamp desc limit -1;" {
+	if stm != "select orderID, timestamp from purchases where (orderID || timestamp || title) like ? order by timestamp desc, rowid desc limit -1;" {
 		t.Error("Incorrect statement")
 	}
 	if len(args) != 1 {
@@ -37,7 +37,7 @@ func Test_filterQuery(t *testing.T) {
 		sortByAscending: false,
 		limit:           -1,
 	})
-	if stm != "select orderID, timestamp from purchases where orderID not in (?,?) order by timestamp desc limit -1;" {
+	if stm != "select orderID, timestamp from purchases where orderID not in (?,?) order by timestamp desc, rowid desc limit -1;" {
 		t.Error("Incorrect statement")
 	}
 	if len(args) != 2 {
@@ -56,7 +56,7 @@ func Test_filterQuery(t *testing.T) {
 		sortByAscending: false,
 		limit:           -1,
 	})
-	if stm != "select orderID, timestamp from purchases where state in (?,?) order by timestamp desc limit -1;" {
+	if stm != "select orderID, timestamp from purchases where state in (?,?) order by timestamp desc, rowid desc limit -1;" {
 		t.Error("Incorrect statement")
 	}
 	if len(args) != 2 {
@@ -75,7 +75,7 @@ func Test_filterQuery(t *testing.T) {
 		sortByAscending: true,
 		limit:           -1,
 	})
-	if stm != "select orderID, timestamp from purchases where state in (?,?) order by timestamp asc limit -1;" {
+	if stm != "select orderID, timestamp from purchases where state in (?,?) order by timestamp asc, rowid asc limit -1;" {
 		t.Error("Incorrect statement")
 	}
 	if len(args) != 2 {
@@ -95,7 +95,7 @@ func Test_filterQuery(t *testing.T) {
 		sortByRead:      true,
 		limit:           -1,
 	})
-	if stm != "select orderID, timestamp from purchases where state in (?,?) order by read asc, timestamp asc limit -1;" {
+	if stm != "select orderID, timestamp from purchases where state in (?,?) order by read asc, timestamp asc, rowid asc limit -1;" {
 		t.Error("Incorrect statement")
 	}
 	if len(args) != 2 {
@@ -114,7 +114,7 @@ func Test_filterQuery(t *testing.T) {
 		sortByAscending: false,
 		limit:           -1,
 	})
-	if stm != "select orderID, timestamp from purchases where state in (?,?) and orderID not in (?,?) order by timestamp desc limit -1;" {
+	if stm != "select orderID, timestamp from purchases where state in (?,?) and orderID not in (?,?) order by timestamp desc, rowid desc limit -1;" {
 		t.Error("Incorrect statement")
 	}
 	if len(args) != 4 {
@@ -133,11 +133,45 @@ func Test_filterQuery(t *testing.T) {
 		sortByAscending: false,
 		limit:           -1,
 	})
-	if stm != "select orderID, timestamp from purchases where state in (?,?) and (orderID || timestamp || title) like ? order by timestamp desc limit -1;" {
+	if stm != "select orderID, timestamp from purchases where state in (?,?) and (orderID || timestamp || title) like ? order by timestamp desc, rowid desc limit -1;" {
 		t.Error("Incorrect statement")
 	}
 	if len(args) != 3 {
 		t.Error("Incorrect args")
 	}
 
+	// Test page cursor
+	stm, args = filterQuery(query{
+		table:           "purchases",
+		columns:         []string{"orderID", "timestamp"},
+		stateFilter:     []pb.OrderState{pb.OrderState_PENDING},
+		id:              "orderID",
+		sortByAscending: false,
+		after:           &pageCursor{timestamp: 100, rowID: 7},
+		limit:           11,
+	})
+	if stm != "select orderID, timestamp from purchases where state in (?) and (timestamp<? or (timestamp=? and rowid<?)) order by timestamp desc, rowid desc limit 11;" {
+		t.Error("Incorrect statement")
+	}
+	if len(args) != 4 {
+		t.Error("Incorrect args")
+	}
+
+	// Test page cursor sorted by read
+	stm, args = filterQuery(query{
+		table:           "purchases",
+		columns:         []string{"orderID", "timestamp"},
+		stateFilter:     []pb.OrderState{},
+		id:              "orderID",
+		sortByAscending: true,
+		sortByRead:      true,
+		after:           &pageCursor{read: 0, timestamp: 100, rowID: 7},
+		limit:           11,
+	})
+	if stm != "select orderID, timestamp from purchases where (read>? or (read=? and (timestamp>? or (timestamp=? and rowid>?)))) order by read asc, timestamp asc, rowid asc limit 11;" {
+		t.Error("Incorrect statement")
+	}
+	if len(args) != 5 {
+		t.Error("Incorrect args")
+	}
 }
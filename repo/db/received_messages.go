@@ -0,0 +1,60 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type ReceivedMessagesDB struct {
+	modelStore
+}
+
+func NewReceivedMessageStore(db *sql.DB, lock *sync.Mutex) repo.ReceivedMessageStore {
+	return &ReceivedMessagesDB{modelStore{db, lock}}
+}
+
+func (r *ReceivedMessagesDB) Put(hash string, peerID string, messageType pb.Message_MessageType, timestamp time.Time) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	res, err := r.db.Exec("insert or ignore into receivedmessages(hash, peerID, messageType, timestamp) values(?,?,?,?)", hash, peerID, int(messageType), timestamp.Unix())
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *ReceivedMessagesDB) Has(hash string) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var h string
+	err := r.db.QueryRow("select hash from receivedmessages where hash=?", hash).Scan(&h)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *ReceivedMessagesDB) Delete(hash string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err := r.db.Exec("delete from receivedmessages where hash=?", hash)
+	return err
+}
+
+func (r *ReceivedMessagesDB) DeleteBefore(t time.Time) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err := r.db.Exec("delete from receivedmessages where timestamp<?", t.Unix())
+	return err
+}
@@ -0,0 +1,87 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewReceivedMessageStore() (repo.ReceivedMessageStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewReceivedMessageStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestReceivedMessagesDB_PutHas(t *testing.T) {
+	receivedDB, teardown, err := buildNewReceivedMessageStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	has, err := receivedDB.Has("hash1")
+	if err != nil || has {
+		t.Error("Expected unknown hash to be missing")
+	}
+	inserted, err := receivedDB.Put("hash1", "peer1", pb.Message_REFUND, time.Now())
+	if err != nil || !inserted {
+		t.Error("Expected first put to insert the hash")
+	}
+	inserted, err = receivedDB.Put("hash1", "peer1", pb.Message_REFUND, time.Now())
+	if err != nil || inserted {
+		t.Error("Expected duplicate put not to insert the hash")
+	}
+	has, err = receivedDB.Has("hash1")
+	if err != nil || !has {
+		t.Error("Expected hash to be recorded")
+	}
+	if err := receivedDB.Delete("hash1"); err != nil {
+		t.Fatal(err)
+	}
+	inserted, err = receivedDB.Put("hash1", "peer1", pb.Message_REFUND, time.Now())
+	if err != nil || !inserted {
+		t.Error("Expected deleted hash to be inserted again")
+	}
+}
+
+func TestReceivedMessagesDB_DeleteBefore(t *testing.T) {
+	receivedDB, teardown, err := buildNewReceivedMessageStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	if _, err := receivedDB.Put("old", "peer1", pb.Message_CHAT, now.Add(-time.Hour*48)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := receivedDB.Put("new", "peer1", pb.Message_CHAT, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := receivedDB.DeleteBefore(now.Add(-time.Hour * 24)); err != nil {
+		t.Fatal(err)
+	}
+	if has, _ := receivedDB.Has("old"); has {
+		t.Error("Expected old hash to be deleted")
+	}
+	if has, _ := receivedDB.Has("new"); !has {
+		t.Error("Expected recent hash to be kept")
+	}
+}
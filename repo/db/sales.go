@@ -148,31 +148,64 @@ func (s *SalesDB) Delete(orderID string) error {
 func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, limit int, exclude []string) ([]repo.Sale, int, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	q := query{
+	q := salesQuery(stateFilter, searchTerm, sortByAscending, sortByRead)
+	q.exclude = exclude
+	q.limit = limit
+	sales, _, count, err := s.querySales(q)
+	return sales, count, err
+}
+
+func (s *SalesDB) GetPage(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, cursor string, limit int) ([]repo.Sale, int, string, error) {
+	after, err := parsePageCursor(cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	q := salesQuery(stateFilter, searchTerm, sortByAscending, sortByRead)
+	q.after = after
+	q.limit = pageFetchLimit(limit)
+	sales, cursors, count, err := s.querySales(q)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	n, next := trimPage(cursors, limit)
+	return sales[:n], count, next, nil
+}
+
+func salesQuery(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool) query {
+	return query{
 		table:           "sales",
-		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerHandle", "shippingName", "shippingAddress", "state", "read", "coinType", "paymentCoin"},
+		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerHandle", "shippingName", "shippingAddress", "state", "read", "coinType", "paymentCoin", "rowid"},
 		stateFilter:     stateFilter,
 		searchTerm:      searchTerm,
 		searchColumns:   []string{"orderID", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerHandle", "shippingName", "shippingAddress", "paymentAddr"},
 		sortByAscending: sortByAscending,
 		sortByRead:      sortByRead,
 		id:              "orderID",
-		exclude:         exclude,
-		limit:           limit,
 	}
+}
+
+// querySales returns the sales selected by the query, together with their
+// page cursors, and the number of sales matching it
+func (s *SalesDB) querySales(q query) ([]repo.Sale, []pageCursor, int, error) {
 	stm, args := filterQuery(q)
 	rows, err := s.db.Query(stm, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	defer rows.Close()
-	var ret []repo.Sale
+	var (
+		ret     []repo.Sale
+		cursors []pageCursor
+	)
 	for rows.Next() {
 		var orderID, title, thumbnail, buyerID, buyerHandle, shippingName, shippingAddr, coinType, paymentCoin string
 		var timestamp, total, stateInt, readInt int
+		var rowID int64
 		var contract []byte
-		if err := rows.Scan(&orderID, &contract, &timestamp, &total, &title, &thumbnail, &buyerID, &buyerHandle, &shippingName, &shippingAddr, &stateInt, &readInt, &coinType, &paymentCoin); err != nil {
-			return ret, 0, err
+		if err := rows.Scan(&orderID, &contract, &timestamp, &total, &title, &thumbnail, &buyerID, &buyerHandle, &shippingName, &shippingAddr, &stateInt, &readInt, &coinType, &paymentCoin, &rowID); err != nil {
+			return nil, nil, 0, err
 		}
 		read := false
 		if readInt > 0 {
@@ -181,7 +214,7 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 
 		rc := new(pb.RicardianContract)
 		if err := jsonpb.UnmarshalString(string(contract), rc); err != nil {
-			return ret, 0, err
+			return nil, nil, 0, err
 		}
 		var slug string
 		if len(rc.VendorListings) > 0 {
@@ -210,18 +243,20 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 			Read:            read,
 			Moderated:       moderated,
 		})
+		cursors = append(cursors, pageCursor{readInt, int64(timestamp), rowID})
 	}
 	q.columns = []string{"Count(*)"}
 	q.limit = -1
 	q.exclude = []string{}
+	q.after = nil
 	stm, args = filterQuery(q)
 	row := s.db.QueryRow(stm, args...)
 	var count int
 	err = row.Scan(&count)
 	if err != nil {
-		return ret, 0, err
+		return nil, nil, 0, err
 	}
-	return ret, count, nil
+	return ret, cursors, count, nil
 }
 
 func (s *SalesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
@@ -303,6 +338,12 @@ func (s *SalesDB) Count() int {
 	return count
 }
 
+func (s *SalesDB) CountByState() (map[pb.OrderState]int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return countByState(s.db, "sales")
+}
+
 func (s *SalesDB) GetNeedsResync() ([]repo.UnfundedSale, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
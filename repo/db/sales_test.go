@@ -55,6 +55,32 @@ func TestSalesDB_Count(t *testing.T) {
 	}
 }
 
+func TestSalesDB_CountByState(t *testing.T) {
+	var saldb, teardown, err = buildNewSaleStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	contract := factory.NewContract()
+	for orderID, state := range map[string]pb.OrderState{
+		"orderID1": pb.OrderState_PENDING,
+		"orderID2": pb.OrderState_PENDING,
+		"orderID3": pb.OrderState_FULFILLED,
+	} {
+		if err := saldb.Put(orderID, *contract, state, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	counts, err := saldb.CountByState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 2 || counts[pb.OrderState_PENDING] != 2 || counts[pb.OrderState_FULFILLED] != 1 {
+		t.Errorf("Returned incorrect counts by state: %v", counts)
+	}
+}
+
 func TestPutSale(t *testing.T) {
 	var saldb, teardown, err = buildNewSaleStore()
 	if err != nil {
@@ -452,6 +478,58 @@ func TestSalesDB_GetAll(t *testing.T) {
 	}
 }
 
+func TestSalesDB_GetPage(t *testing.T) {
+	var saldb, teardown, err = buildNewSaleStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	for i, orderID := range []string{"orderID", "orderID2", "orderID3", "orderID4"} {
+		c := factory.NewContract()
+		ts, _ := ptypes.TimestampProto(now.Add(time.Duration(i) * time.Minute))
+		c.BuyerOrder.Timestamp = ts
+		saldb.Put(orderID, *c, 0, false)
+	}
+
+	sales, ct, cursor, err := saldb.GetPage([]pb.OrderState{}, "", false, false, "", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sales) != 2 || sales[0].OrderId != "orderID4" || sales[1].OrderId != "orderID3" {
+		t.Error("Returned incorrect first page of sales")
+	}
+	if ct != 4 {
+		t.Error("Returned incorrect number of query sales")
+	}
+	if cursor == "" {
+		t.Fatal("Expected a cursor for the next page")
+	}
+
+	// Removing the last sale of the page doesn't end the list
+	if err := saldb.Delete("orderID3"); err != nil {
+		t.Fatal(err)
+	}
+	sales, ct, cursor, err = saldb.GetPage([]pb.OrderState{}, "", false, false, cursor, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sales) != 2 || sales[0].OrderId != "orderID2" || sales[1].OrderId != "orderID" {
+		t.Error("Returned incorrect second page of sales")
+	}
+	if ct != 3 {
+		t.Error("Returned incorrect number of query sales")
+	}
+	if cursor != "" {
+		t.Errorf("Expected no cursor after the last page, got %s", cursor)
+	}
+
+	if _, _, _, err := saldb.GetPage([]pb.OrderState{}, "", false, false, "orderID3", 2); err != repo.ErrInvalidCursor {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+}
+
 func TestSalesDB_SetNeedsResync(t *testing.T) {
 	var saldb, teardown, err = buildNewSaleStore()
 	if err != nil {
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type ScheduledListingsDB struct {
+	modelStore
+}
+
+func NewScheduledListingStore(db *sql.DB, lock *sync.Mutex) repo.ScheduledListingStore {
+	return &ScheduledListingsDB{modelStore{db, lock}}
+}
+
+func (s *ScheduledListingsDB) Put(listing *pb.Listing, publishAt time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(listing)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into scheduledlistings(slug, listing, publishAt, publishError) values(?,?,?,null)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(listing.Slug, out, publishAt.Unix())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *ScheduledListingsDB) Get(slug string) (*repo.ScheduledListing, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	stmt, err := s.db.Prepare("select slug, listing, publishAt, publishError from scheduledlistings where slug=?")
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var (
+		serializedListing string
+		publishAt         int64
+		publishError      sql.NullString
+		ret               repo.ScheduledListing
+	)
+	err = stmt.QueryRow(slug).Scan(&ret.Slug, &serializedListing, &publishAt, &publishError)
+	if err != nil {
+		return nil, err
+	}
+	ret.PublishAt = time.Unix(publishAt, 0)
+	ret.PublishError = publishError.String
+	ret.Listing = new(pb.Listing)
+	if err := jsonpb.UnmarshalString(serializedListing, ret.Listing); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func (s *ScheduledListingsDB) GetAll() ([]repo.ScheduledListing, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.query("select slug, listing, publishAt, publishError from scheduledlistings order by publishAt asc")
+}
+
+func (s *ScheduledListingsDB) GetDue(now time.Time) ([]repo.ScheduledListing, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.query("select slug, listing, publishAt, publishError from scheduledlistings where publishAt <= ? and publishError is null order by publishAt asc", now.Unix())
+}
+
+func (s *ScheduledListingsDB) SetPublishError(slug string, reason string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("update scheduledlistings set publishError=? where slug=?", reason, slug)
+	return err
+}
+
+func (s *ScheduledListingsDB) Delete(slug string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from scheduledlistings where slug=?", slug)
+	return err
+}
+
+func (s *ScheduledListingsDB) query(stm string, args ...interface{}) ([]repo.ScheduledListing, error) {
+	rows, err := s.db.Query(stm, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []repo.ScheduledListing
+	for rows.Next() {
+		var (
+			slug, serializedListing string
+			publishAt               int64
+			publishError            sql.NullString
+		)
+		if err := rows.Scan(&slug, &serializedListing, &publishAt, &publishError); err != nil {
+			return nil, err
+		}
+		listing := new(pb.Listing)
+		if err := jsonpb.UnmarshalString(serializedListing, listing); err != nil {
+			log.Errorf("unmarshal scheduled listing (%s): %s", slug, err.Error())
+			continue
+		}
+		ret = append(ret, repo.ScheduledListing{
+			Slug:         slug,
+			PublishAt:    time.Unix(publishAt, 0),
+			PublishError: publishError.String,
+			Listing:      listing,
+		})
+	}
+	return ret, rows.Err()
+}
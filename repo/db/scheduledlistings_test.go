@@ -0,0 +1,140 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+)
+
+func buildNewScheduledListingStore() (repo.ScheduledListingStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewScheduledListingStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestScheduledListingsDB_PutAndGet(t *testing.T) {
+	scheduledDB, teardown, err := buildNewScheduledListingStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := scheduledDB.Put(factory.NewListing("flash-sale"), publishAt); err != nil {
+		t.Fatal(err)
+	}
+	s, err := scheduledDB.Get("flash-sale")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Slug != "flash-sale" || s.Listing.Slug != "flash-sale" {
+		t.Error("Returned incorrect slug")
+	}
+	if !s.PublishAt.Equal(publishAt) {
+		t.Errorf("Expected publishAt %s, got %s", publishAt, s.PublishAt)
+	}
+	if s.Listing.Item.Title != factory.NewListing("flash-sale").Item.Title {
+		t.Error("Returned incorrect listing")
+	}
+}
+
+func TestScheduledListingsDB_GetDue(t *testing.T) {
+	scheduledDB, teardown, err := buildNewScheduledListingStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	scheduledDB.Put(factory.NewListing("later"), now.Add(time.Hour))
+	scheduledDB.Put(factory.NewListing("earlier"), now.Add(-time.Hour))
+	scheduledDB.Put(factory.NewListing("now"), now)
+
+	due, err := scheduledDB.GetDue(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 2 {
+		t.Fatalf("Expected 2 due listings, got %d", len(due))
+	}
+	if due[0].Slug != "earlier" || due[1].Slug != "now" {
+		t.Error("Due listings returned out of order")
+	}
+
+	all, err := scheduledDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 {
+		t.Errorf("Expected 3 scheduled listings, got %d", len(all))
+	}
+}
+
+func TestScheduledListingsDB_SetPublishError(t *testing.T) {
+	scheduledDB, teardown, err := buildNewScheduledListingStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	scheduledDB.Put(factory.NewListing("invalid"), now.Add(-time.Hour))
+	scheduledDB.Put(factory.NewListing("valid"), now.Add(-time.Hour))
+	if err := scheduledDB.SetPublishError("invalid", "Slug must not be empty"); err != nil {
+		t.Fatal(err)
+	}
+
+	due, err := scheduledDB.GetDue(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].Slug != "valid" {
+		t.Errorf("Expected only the valid listing to be due, got %v", due)
+	}
+	s, err := scheduledDB.Get("invalid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.PublishError != "Slug must not be empty" {
+		t.Errorf("Returned incorrect publish error %q", s.PublishError)
+	}
+
+	// Rescheduling the listing clears the error
+	scheduledDB.Put(factory.NewListing("invalid"), now.Add(-time.Hour))
+	if due, _ := scheduledDB.GetDue(now); len(due) != 2 {
+		t.Errorf("Expected the rescheduled listing to be due, got %v", due)
+	}
+}
+
+func TestScheduledListingsDB_Delete(t *testing.T) {
+	scheduledDB, teardown, err := buildNewScheduledListingStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	scheduledDB.Put(factory.NewListing("flash-sale"), time.Now())
+	if err := scheduledDB.Delete("flash-sale"); err != nil {
+		t.Error(err)
+	}
+	if _, err := scheduledDB.Get("flash-sale"); err == nil {
+		t.Error("Failed to delete scheduled listing")
+	}
+}
@@ -0,0 +1,278 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type SearchDB struct {
+	modelStore
+}
+
+func NewSearchStore(db *sql.DB, lock *sync.Mutex) repo.SearchStore {
+	return &SearchDB{modelStore{db, lock}}
+}
+
+func (s *SearchDB) Put(listing repo.SearchListing) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("delete from searchlistings where peerID=? and slug=?", listing.PeerID, listing.Slug); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := insertSearchListing(tx, listing); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("insert or ignore into searchpeers(peerID, root, updatedAt) values(?,?,?)", listing.PeerID, "", listing.IndexedAt.Unix()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("update searchpeers set updatedAt=? where peerID=?", listing.IndexedAt.Unix(), listing.PeerID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *SearchDB) ReplacePeer(peerID string, root string, listings []repo.SearchListing) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("delete from searchlistings where peerID=?", peerID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, listing := range listings {
+		listing.PeerID = peerID
+		if err := insertSearchListing(tx, listing); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	now := time.Now().Unix()
+	if _, err := tx.Exec("insert or ignore into searchpeers(peerID, root, updatedAt) values(?,?,?)", peerID, root, now); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("update searchpeers set root=?, updatedAt=? where peerID=?", root, now, peerID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *SearchDB) DeletePeersBefore(before time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	const expired = "select peerID from searchpeers where updatedAt < ? and peerID not in (select peerID from following)"
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("delete from searchlistings where peerID in ("+expired+")", before.Unix()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("delete from searchpeers where peerID in ("+expired+")", before.Unix()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *SearchDB) GetPeers() (map[string]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	rows, err := s.db.Query("select peerID, root from searchpeers")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := make(map[string]string)
+	for rows.Next() {
+		var peerID, root string
+		if err := rows.Scan(&peerID, &root); err != nil {
+			return nil, err
+		}
+		ret[peerID] = root
+	}
+	return ret, rows.Err()
+}
+
+func (s *SearchDB) Search(query repo.SearchQuery) ([]repo.SearchListing, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	if match := searchMatchExpression(query); match != "" {
+		conditions = append(conditions, "searchlistings match ?")
+		args = append(args, match)
+	}
+	if query.Currency != "" {
+		conditions = append(conditions, "upper(pricingCurrency) = ?")
+		args = append(args, strings.ToUpper(query.Currency))
+	}
+	if query.PriceMax > 0 {
+		conditions = append(conditions, "price <= ?")
+		args = append(args, int64(query.PriceMax))
+	}
+	if query.ShipsTo != "" {
+		conditions = append(conditions, "(shipsTo like ? or shipsTo like ?)")
+		args = append(args, `%"`+strings.ToUpper(query.ShipsTo)+`"%`, `%"ALL"%`)
+	}
+	// Matches are ranked after they're loaded, so they're only limited then
+	terms := searchTokens(query.Terms)
+	limit := query.Limit
+	if limit <= 0 || len(terms) > 0 {
+		limit = -1
+	}
+
+	stm := "select peerID, slug, hash, title, description, tags, categories, shipsTo, pricingCurrency, price, thumbnail, indexedAt from searchlistings"
+	if len(conditions) > 0 {
+		stm += " where " + strings.Join(conditions, " and ")
+	}
+	stm += " order by indexedAt desc limit ?"
+	args = append(args, limit)
+
+	rows, err := s.db.Query(stm, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := []repo.SearchListing{}
+	for rows.Next() {
+		var (
+			l                         repo.SearchListing
+			tags, categories, shipsTo string
+			price, indexedAt          int64
+		)
+		if err := rows.Scan(&l.PeerID, &l.Slug, &l.Hash, &l.Title, &l.Description, &tags, &categories, &shipsTo, &l.PricingCurrency, &price, &l.Thumbnail, &indexedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(tags), &l.Tags); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(categories), &l.Categories); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(shipsTo), &l.ShipsTo); err != nil {
+			return nil, err
+		}
+		l.Price = uint64(price)
+		l.IndexedAt = time.Unix(indexedAt, 0)
+		ret = append(ret, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(terms) > 0 {
+		sort.SliceStable(ret, func(i, j int) bool {
+			return searchScore(ret[i], terms) > searchScore(ret[j], terms)
+		})
+		if query.Limit > 0 && len(ret) > query.Limit {
+			ret = ret[:query.Limit]
+		}
+	}
+	return ret, nil
+}
+
+// searchScore ranks how well a listing matches the search terms. A term
+// found in the title counts more than one found in the tags or categories,
+// which count more than one found in the description.
+func searchScore(listing repo.SearchListing, terms []string) int {
+	var score int
+	for _, term := range terms {
+		switch {
+		case hasTokenPrefix(listing.Title, term):
+			score += 3
+		case hasTokenPrefix(strings.Join(listing.Tags, " "), term),
+			hasTokenPrefix(strings.Join(listing.Categories, " "), term):
+			score += 2
+		case hasTokenPrefix(listing.Description, term):
+			score++
+		}
+	}
+	return score
+}
+
+func hasTokenPrefix(s, prefix string) bool {
+	for _, token := range searchTokens(s) {
+		if strings.HasPrefix(token, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func insertSearchListing(tx *sql.Tx, listing repo.SearchListing) error {
+	tags, err := json.Marshal(nonNilStrings(listing.Tags))
+	if err != nil {
+		return err
+	}
+	categories, err := json.Marshal(nonNilStrings(listing.Categories))
+	if err != nil {
+		return err
+	}
+	shipsTo, err := json.Marshal(nonNilStrings(listing.ShipsTo))
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("insert into searchlistings(peerID, slug, hash, title, description, tags, categories, shipsTo, pricingCurrency, price, thumbnail, indexedAt) values(?,?,?,?,?,?,?,?,?,?,?,?)",
+		listing.PeerID, listing.Slug, listing.Hash, listing.Title, listing.Description, string(tags), string(categories), string(shipsTo), listing.PricingCurrency, int64(listing.Price), listing.Thumbnail, listing.IndexedAt.Unix())
+	return err
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// searchMatchExpression builds an FTS match expression from the query. Only
+// letters and numbers are kept from the user input so it can never contain
+// FTS operators or syntax errors.
+func searchMatchExpression(query repo.SearchQuery) string {
+	var terms []string
+	for _, token := range searchTokens(query.Terms) {
+		terms = append(terms, token+"*")
+	}
+	for _, tag := range query.Tags {
+		// Column filters can't be combined with phrase queries so every
+		// token of the tag must appear in the tags column instead
+		for _, token := range searchTokens(tag) {
+			terms = append(terms, "tags:"+token)
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
+func searchTokens(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
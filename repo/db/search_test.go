@@ -0,0 +1,222 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewSearchStore() (repo.SearchStore, func(), error) {
+	searchDB, _, teardown, err := buildNewSearchAndFollowingStores()
+	return searchDB, teardown, err
+}
+
+func buildNewSearchAndFollowingStores() (repo.SearchStore, repo.FollowingStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	lock := new(sync.Mutex)
+	return db.NewSearchStore(database, lock), db.NewFollowingStore(database, lock), appSchema.DestroySchemaDirectories, nil
+}
+
+func searchSlugs(listings []repo.SearchListing) []string {
+	slugs := []string{}
+	for _, l := range listings {
+		slugs = append(slugs, l.Slug)
+	}
+	return slugs
+}
+
+func TestSearchDB_Search(t *testing.T) {
+	searchDB, teardown, err := buildNewSearchStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now().Truncate(time.Second)
+	err = searchDB.ReplacePeer("peer1", "root1", []repo.SearchListing{
+		{Slug: "red-shirt", Title: "Red cotton shirt", Description: "Soft shirt", Tags: []string{"clothing", "red shirt"}, ShipsTo: []string{"UNITED_STATES"}, PricingCurrency: "USD", Price: 1500, IndexedAt: now.Add(-time.Minute)},
+		{Slug: "blue-mug", Title: "Blue mug", Description: "Ceramic", Tags: []string{"kitchen"}, ShipsTo: []string{"ALL"}, PricingCurrency: "USD", Price: 900, IndexedAt: now},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = searchDB.Put(repo.SearchListing{PeerID: "peer2", Slug: "shirt-pack", Title: "Three shirts", Tags: []string{"clothing"}, ShipsTo: []string{"GERMANY"}, PricingCurrency: "EUR", Price: 3000, IndexedAt: now.Add(-time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query    repo.SearchQuery
+		expected []string
+	}{
+		{repo.SearchQuery{}, []string{"blue-mug", "red-shirt", "shirt-pack"}},
+		{repo.SearchQuery{Terms: "shirt"}, []string{"red-shirt", "shirt-pack"}},
+		{repo.SearchQuery{Terms: "COTTON shirt"}, []string{"red-shirt"}},
+		{repo.SearchQuery{Terms: "cer"}, []string{"blue-mug"}},
+		{repo.SearchQuery{Terms: `"OR NOT*`}, []string{}},
+		{repo.SearchQuery{Tags: []string{"clothing"}}, []string{"red-shirt", "shirt-pack"}},
+		{repo.SearchQuery{Tags: []string{"red shirt"}}, []string{"red-shirt"}},
+		{repo.SearchQuery{PriceMax: 1500}, []string{"blue-mug", "red-shirt"}},
+		{repo.SearchQuery{Currency: "eur"}, []string{"shirt-pack"}},
+		{repo.SearchQuery{Currency: "USD", PriceMax: 5000}, []string{"blue-mug", "red-shirt"}},
+		{repo.SearchQuery{ShipsTo: "germany"}, []string{"blue-mug", "shirt-pack"}},
+		{repo.SearchQuery{Terms: "shirt", ShipsTo: "UNITED_STATES", PriceMax: 2000}, []string{"red-shirt"}},
+		{repo.SearchQuery{Limit: 1}, []string{"blue-mug"}},
+	}
+	for _, test := range tests {
+		results, err := searchDB.Search(test.query)
+		if err != nil {
+			t.Errorf("search %+v: %s", test.query, err)
+			continue
+		}
+		slugs := searchSlugs(results)
+		if len(slugs) != len(test.expected) {
+			t.Errorf("search %+v: expected %v, got %v", test.query, test.expected, slugs)
+			continue
+		}
+		for i := range slugs {
+			if slugs[i] != test.expected[i] {
+				t.Errorf("search %+v: expected %v, got %v", test.query, test.expected, slugs)
+				break
+			}
+		}
+	}
+
+	results, err := searchDB.Search(repo.SearchQuery{Terms: "mug"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].PeerID != "peer1" || results[0].Price != 900 || results[0].Tags[0] != "kitchen" || !results[0].IndexedAt.Equal(now) {
+		t.Errorf("unexpected search result: %+v", results)
+	}
+
+	// Listings matching the terms in their title rank above newer listings
+	// matching them in their description, before the limit applies
+	err = searchDB.Put(repo.SearchListing{PeerID: "peer3", Slug: "plain-mug", Title: "Plain mug", Description: "Mug with a shirt print", IndexedAt: now.Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err = searchDB.Search(repo.SearchQuery{Terms: "shirt", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slugs := searchSlugs(results); len(slugs) != 2 || slugs[0] != "red-shirt" || slugs[1] != "shirt-pack" {
+		t.Errorf("expected title matches first, got %v", slugs)
+	}
+}
+
+func TestSearchDB_DeletePeersBefore(t *testing.T) {
+	searchDB, followingDB, teardown, err := buildNewSearchAndFollowingStores()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	for peerID, browsedAt := range map[string]time.Time{
+		"browsed":   now.Add(-time.Hour),
+		"expired":   now.Add(-48 * time.Hour),
+		"followed":  now.Add(-48 * time.Hour),
+		"reindexed": now.Add(-48 * time.Hour),
+	} {
+		if err := searchDB.Put(repo.SearchListing{PeerID: peerID, Slug: "listing", Title: "Listing", IndexedAt: browsedAt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := followingDB.Put("followed"); err != nil {
+		t.Fatal(err)
+	}
+	// Reindexing a peer keeps it from expiring
+	if err := searchDB.ReplacePeer("reindexed", "root", []repo.SearchListing{{Slug: "listing", Title: "Listing", IndexedAt: now}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := searchDB.DeletePeersBefore(now.Add(-24 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	peers, err := searchDB.GetPeers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := peers["expired"]; ok || len(peers) != 3 {
+		t.Errorf("expected only the expired peer to be removed, got %v", peers)
+	}
+	results, err := searchDB.Search(repo.SearchQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range results {
+		if l.PeerID == "expired" {
+			t.Errorf("expected the listings of the expired peer to be removed, got %+v", l)
+		}
+	}
+	if len(results) != 3 {
+		t.Errorf("expected three listings to remain, got %d", len(results))
+	}
+}
+
+func TestSearchDB_ReplacePeer(t *testing.T) {
+	searchDB, teardown, err := buildNewSearchStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if err := searchDB.Put(repo.SearchListing{PeerID: "peer1", Slug: "old", Title: "Old listing", IndexedAt: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+	peers, err := searchDB.GetPeers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root, ok := peers["peer1"]; !ok || root != "" {
+		t.Errorf("expected peer1 to be indexed without a root, got %v", peers)
+	}
+
+	if err := searchDB.ReplacePeer("peer1", "root2", []repo.SearchListing{{Slug: "new", Title: "New listing", IndexedAt: time.Now()}}); err != nil {
+		t.Fatal(err)
+	}
+	results, err := searchDB.Search(repo.SearchQuery{Terms: "listing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slugs := searchSlugs(results); len(slugs) != 1 || slugs[0] != "new" {
+		t.Errorf("expected only the new listing to remain, got %v", slugs)
+	}
+	peers, err = searchDB.GetPeers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peers["peer1"] != "root2" {
+		t.Errorf("expected root to be updated, got %v", peers)
+	}
+
+	// Putting a single listing keeps the known root
+	if err := searchDB.Put(repo.SearchListing{PeerID: "peer1", Slug: "new", Title: "New listing", IndexedAt: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+	peers, err = searchDB.GetPeers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peers["peer1"] != "root2" {
+		t.Errorf("expected root to be kept, got %v", peers)
+	}
+}
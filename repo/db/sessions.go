@@ -0,0 +1,84 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type SessionsDB struct {
+	modelStore
+}
+
+func NewSessionStore(db *sql.DB, lock *sync.Mutex) repo.SessionStore {
+	return &SessionsDB{modelStore{db, lock}}
+}
+
+func (s *SessionsDB) Get(peerID string) ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var state []byte
+	err := s.db.QueryRow("select state from sessions where peerID=?", peerID).Scan(&state)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return state, err
+}
+
+func (s *SessionsDB) Put(peerID string, state []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("insert or replace into sessions(peerID, state, updatedAt) values(?,?,?)", peerID, state, time.Now().Unix())
+	return err
+}
+
+func (s *SessionsDB) Delete(peerID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from sessions where peerID=?", peerID)
+	return err
+}
+
+func (s *SessionsDB) PutPreKey(preKey repo.PreKey) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("insert or replace into prekeys(id, privateKey, publicKey, signature, createdAt) values(?,?,?,?,?)",
+		preKey.ID, preKey.PrivateKey, preKey.PublicKey, preKey.Signature, preKey.CreatedAt.Unix())
+	return err
+}
+
+func (s *SessionsDB) GetPreKey(id uint32) (*repo.PreKey, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return scanPreKey(s.db.QueryRow("select id, privateKey, publicKey, signature, createdAt from prekeys where id=?", id))
+}
+
+func (s *SessionsDB) GetLatestPreKey() (*repo.PreKey, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return scanPreKey(s.db.QueryRow("select id, privateKey, publicKey, signature, createdAt from prekeys order by id desc limit 1"))
+}
+
+func (s *SessionsDB) DeletePreKeysBefore(t time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from prekeys where createdAt<?", t.Unix())
+	return err
+}
+
+func scanPreKey(row *sql.Row) (*repo.PreKey, error) {
+	var (
+		preKey    repo.PreKey
+		createdAt int64
+	)
+	err := row.Scan(&preKey.ID, &preKey.PrivateKey, &preKey.PublicKey, &preKey.Signature, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	preKey.CreatedAt = time.Unix(createdAt, 0)
+	return &preKey, nil
+}
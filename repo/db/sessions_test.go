@@ -0,0 +1,92 @@
+package db_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewSessionStore() (repo.SessionStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewSessionStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestSessionsDB_PutGetDelete(t *testing.T) {
+	sessionDB, teardown, err := buildNewSessionStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	state, err := sessionDB.Get("peer1")
+	if err != nil || state != nil {
+		t.Fatalf("Expected no session, got %v (%v)", state, err)
+	}
+	if err := sessionDB.Put("peer1", []byte("state")); err != nil {
+		t.Fatal(err)
+	}
+	state, err = sessionDB.Get("peer1")
+	if err != nil || string(state) != "state" {
+		t.Errorf("Expected stored state, got %s (%v)", state, err)
+	}
+	if err := sessionDB.Delete("peer1"); err != nil {
+		t.Fatal(err)
+	}
+	if state, _ = sessionDB.Get("peer1"); state != nil {
+		t.Error("Expected session to be deleted")
+	}
+}
+
+func TestSessionsDB_PreKeys(t *testing.T) {
+	sessionDB, teardown, err := buildNewSessionStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	latest, err := sessionDB.GetLatestPreKey()
+	if err != nil || latest != nil {
+		t.Fatalf("Expected no prekey, got %v (%v)", latest, err)
+	}
+	now := time.Now().Truncate(time.Second)
+	for i, createdAt := range []time.Time{now.Add(-time.Hour * 24 * 40), now} {
+		preKey := repo.PreKey{ID: uint32(i + 1), PrivateKey: []byte{byte(i)}, PublicKey: []byte{byte(i + 10)}, Signature: []byte("sig"), CreatedAt: createdAt}
+		if err := sessionDB.PutPreKey(preKey); err != nil {
+			t.Fatal(err)
+		}
+	}
+	latest, err = sessionDB.GetLatestPreKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.ID != 2 || !latest.CreatedAt.Equal(now) || !bytes.Equal(latest.PublicKey, []byte{11}) {
+		t.Errorf("Unexpected latest prekey %+v", latest)
+	}
+	if err := sessionDB.DeletePreKeysBefore(now.Add(-time.Hour * 24 * 30)); err != nil {
+		t.Fatal(err)
+	}
+	if preKey, err := sessionDB.GetPreKey(1); err != nil || preKey != nil {
+		t.Errorf("Expected old prekey to be deleted, got %v (%v)", preKey, err)
+	}
+	if preKey, err := sessionDB.GetPreKey(2); err != nil || preKey == nil {
+		t.Errorf("Expected latest prekey to remain, got %v (%v)", preKey, err)
+	}
+}
@@ -17,7 +17,7 @@ import (
 	"github.com/tyler-smith/go-bip39"
 )
 
-const RepoVersion = "15"
+const RepoVersion = "26"
 
 var log = logging.MustGetLogger("repo")
 var ErrRepoExists = errors.New("IPFS configuration file exists. Reinitializing would overwrite your keys. Use -f to force overwrite.")
@@ -200,9 +200,10 @@ func addConfigExtensions(repoRoot string) error {
 		}
 
 		a = schema.APIConfig{
-			Enabled:     true,
-			AllowedIPs:  []string{},
-			HTTPHeaders: nil,
+			Enabled:              true,
+			AllowedIPs:           []string{},
+			HTTPHeaders:          nil,
+			IdempotencyKeyWindow: "24h",
 		}
 
 		ds = schema.DataSharing{
@@ -213,7 +214,8 @@ func addConfigExtensions(repoRoot string) error {
 		t = schema.TorConfig{}
 
 		resolvers = schema.ResolverConfig{
-			Id: "https://resolver.onename.com/",
+			Id:  "https://resolver.onename.com/",
+			Eth: "https://cloudflare-eth.com/",
 		}
 	)
 	if err := r.SetConfigKey("Wallet", w); err != nil {
@@ -231,6 +233,9 @@ func addConfigExtensions(repoRoot string) error {
 	if err := r.SetConfigKey("Bootstrap-testnet", schema.BootstrapAddressesTestnet); err != nil {
 		return err
 	}
+	if err := r.SetConfigKey("S3", schema.S3Config{Region: "us-east-1"}); err != nil {
+		return err
+	}
 	if err := r.SetConfigKey("Dropbox-api-token", ""); err != nil {
 		return err
 	}
@@ -31,6 +31,17 @@ var Migrations = []Migration{
 	migrations.Migration012{},
 	migrations.Migration013{},
 	migrations.Migration014{},
+	migrations.Migration015{},
+	migrations.Migration016{},
+	migrations.Migration017{},
+	migrations.Migration018{},
+	migrations.Migration019{},
+	migrations.Migration020{},
+	migrations.Migration021{},
+	migrations.Migration022{},
+	migrations.Migration023{},
+	migrations.Migration024{},
+	migrations.Migration025{},
 }
 
 // MigrateUp looks at the currently active migration version
@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+type Migration015 struct{}
+
+func (Migration015) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		createScheduledListingsSQL      = "create table scheduledlistings (slug text primary key not null, listing blob, publishAt integer);"
+		createScheduledListingsIndexSQL = "create index index_scheduledlistings on scheduledlistings (publishAt);"
+		createArchivedListingsSQL       = "create table archivedlistings (slug text primary key not null, listing blob, archivedAt integer);"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{createScheduledListingsSQL, createScheduledListingsIndexSQL, createArchivedListingsSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 16)
+}
+
+func (Migration015) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		dropScheduledListingsIndexSQL = "drop index if exists index_scheduledlistings;"
+		dropScheduledListingsSQL      = "drop table if exists scheduledlistings;"
+		dropArchivedListingsSQL       = "drop table if exists archivedlistings;"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{dropScheduledListingsIndexSQL, dropScheduledListingsSQL, dropArchivedListingsSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 15)
+}
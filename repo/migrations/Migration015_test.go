@@ -0,0 +1,64 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration015(t *testing.T) {
+	// Setup
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+
+	var (
+		dbPassword  = "foobarbaz"
+		repoVerPath = appSchema.DataPathJoin("repover")
+	)
+
+	db, err := sql.Open("sqlite3", appSchema.DatabasePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err = db.Exec("pragma key = 'foobarbaz';"); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(repoVerPath, []byte("15"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	// Test migration up
+	if err := (migrations.Migration015{}).Up(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("insert into scheduledlistings(slug, listing, publishAt) values(?,?,?)", "slug", "{}", 1); err != nil {
+		t.Error("Expected scheduledlistings table to exist:", err)
+	}
+	if _, err = db.Exec("insert into archivedlistings(slug, listing, archivedAt) values(?,?,?)", "slug", "{}", 1); err != nil {
+		t.Error("Expected archivedlistings table to exist:", err)
+	}
+	assertCorrectRepoVer(t, repoVerPath, "16")
+
+	// Test migration down
+	if err := (migrations.Migration015{}).Down(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("select slug from scheduledlistings;"); err == nil {
+		t.Error("Expected scheduledlistings table to be dropped")
+	}
+	if _, err = db.Exec("select slug from archivedlistings;"); err == nil {
+		t.Error("Expected archivedlistings table to be dropped")
+	}
+	assertCorrectRepoVer(t, repoVerPath, "15")
+}
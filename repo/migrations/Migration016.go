@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+type Migration016 struct{}
+
+func (Migration016) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		createSearchListingsSQL = "create virtual table searchlistings using fts4(peerID, slug, hash, title, description, tags, categories, shipsTo, pricingCurrency, price, thumbnail, indexedAt, notindexed=peerID, notindexed=slug, notindexed=hash, notindexed=shipsTo, notindexed=pricingCurrency, notindexed=price, notindexed=thumbnail, notindexed=indexedAt, tokenize=unicode61);"
+		createSearchPeersSQL    = "create table searchpeers (peerID text primary key not null, root text, updatedAt integer);"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{createSearchListingsSQL, createSearchPeersSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 17)
+}
+
+func (Migration016) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		dropSearchListingsSQL = "drop table if exists searchlistings;"
+		dropSearchPeersSQL    = "drop table if exists searchpeers;"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{dropSearchListingsSQL, dropSearchPeersSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 16)
+}
@@ -0,0 +1,64 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration016(t *testing.T) {
+	// Setup
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+
+	var (
+		dbPassword  = "foobarbaz"
+		repoVerPath = appSchema.DataPathJoin("repover")
+	)
+
+	db, err := sql.Open("sqlite3", appSchema.DatabasePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err = db.Exec("pragma key = 'foobarbaz';"); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(repoVerPath, []byte("16"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	// Test migration up
+	if err := (migrations.Migration016{}).Up(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("insert into searchlistings(peerID, slug, title) values(?,?,?)", "peer", "slug", "title"); err != nil {
+		t.Error("Expected searchlistings table to exist:", err)
+	}
+	if _, err = db.Exec("insert into searchpeers(peerID, root, updatedAt) values(?,?,?)", "peer", "root", 1); err != nil {
+		t.Error("Expected searchpeers table to exist:", err)
+	}
+	assertCorrectRepoVer(t, repoVerPath, "17")
+
+	// Test migration down
+	if err := (migrations.Migration016{}).Down(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("select slug from searchlistings;"); err == nil {
+		t.Error("Expected searchlistings table to be dropped")
+	}
+	if _, err = db.Exec("select peerID from searchpeers;"); err == nil {
+		t.Error("Expected searchpeers table to be dropped")
+	}
+	assertCorrectRepoVer(t, repoVerPath, "16")
+}
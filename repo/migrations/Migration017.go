@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+type Migration017 struct{}
+
+func (Migration017) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const createBansSQL = "create table bans (peerID text not null, scope text not null, reason text, createdAt integer, expires integer, primary key (peerID, scope));"
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(createBansSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 18)
+}
+
+func (Migration017) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const dropBansSQL = "drop table if exists bans;"
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(dropBansSQL)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 17)
+}
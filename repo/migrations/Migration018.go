@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+type Migration018 struct{}
+
+func (Migration018) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		createSessionsSQL = "create table sessions (peerID text primary key not null, state blob, updatedAt integer);"
+		createPreKeysSQL  = "create table prekeys (id integer primary key not null, privateKey blob, publicKey blob, signature blob, createdAt integer);"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{createSessionsSQL, createPreKeysSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 19)
+}
+
+func (Migration018) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		dropSessionsSQL = "drop table if exists sessions;"
+		dropPreKeysSQL  = "drop table if exists prekeys;"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{dropSessionsSQL, dropPreKeysSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 18)
+}
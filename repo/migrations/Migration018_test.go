@@ -0,0 +1,64 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration018(t *testing.T) {
+	// Setup
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+
+	var (
+		dbPassword  = "foobarbaz"
+		repoVerPath = appSchema.DataPathJoin("repover")
+	)
+
+	db, err := sql.Open("sqlite3", appSchema.DatabasePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err = db.Exec("pragma key = 'foobarbaz';"); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(repoVerPath, []byte("18"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	// Test migration up
+	if err := (migrations.Migration018{}).Up(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("insert into sessions(peerID, state, updatedAt) values(?,?,?)", "peer", []byte("state"), 1); err != nil {
+		t.Error("Expected sessions table to exist:", err)
+	}
+	if _, err = db.Exec("insert into prekeys(id, privateKey, publicKey, signature, createdAt) values(?,?,?,?,?)", 1, []byte("priv"), []byte("pub"), []byte("sig"), 1); err != nil {
+		t.Error("Expected prekeys table to exist:", err)
+	}
+	assertCorrectRepoVer(t, repoVerPath, "19")
+
+	// Test migration down
+	if err := (migrations.Migration018{}).Down(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("select peerID from sessions;"); err == nil {
+		t.Error("Expected sessions table to be dropped")
+	}
+	if _, err = db.Exec("select id from prekeys;"); err == nil {
+		t.Error("Expected prekeys table to be dropped")
+	}
+	assertCorrectRepoVer(t, repoVerPath, "18")
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+type Migration019 struct{}
+
+func (Migration019) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		createReceivedMessagesSQL = "create table receivedmessages (hash text primary key not null, peerID text, messageType integer, timestamp integer);"
+		createIndexTimestampSQL   = "create index index_receivedmessages_timestamp on receivedmessages (timestamp);"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{createReceivedMessagesSQL, createIndexTimestampSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 20)
+}
+
+func (Migration019) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		dropIndexTimestampSQL   = "drop index if exists index_receivedmessages_timestamp;"
+		dropReceivedMessagesSQL = "drop table if exists receivedmessages;"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{dropIndexTimestampSQL, dropReceivedMessagesSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 19)
+}
@@ -0,0 +1,58 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration019(t *testing.T) {
+	// Setup
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+
+	var (
+		dbPassword  = "foobarbaz"
+		repoVerPath = appSchema.DataPathJoin("repover")
+	)
+
+	db, err := sql.Open("sqlite3", appSchema.DatabasePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err = db.Exec("pragma key = 'foobarbaz';"); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(repoVerPath, []byte("19"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	// Test migration up
+	if err := (migrations.Migration019{}).Up(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("insert into receivedmessages(hash, peerID, messageType, timestamp) values(?,?,?,?)", "hash", "peer", 13, 1); err != nil {
+		t.Error("Expected receivedmessages table to exist:", err)
+	}
+	assertCorrectRepoVer(t, repoVerPath, "20")
+
+	// Test migration down
+	if err := (migrations.Migration019{}).Down(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("select hash from receivedmessages;"); err == nil {
+		t.Error("Expected receivedmessages table to be dropped")
+	}
+	assertCorrectRepoVer(t, repoVerPath, "19")
+}
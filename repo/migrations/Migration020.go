@@ -0,0 +1,146 @@
+package migrations
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/net/onion"
+)
+
+// Migration020 replaces the v2 onion service of the repo with a v3 one. Tor
+// no longer publishes v2 onion services.
+type Migration020 struct{}
+
+// Releases before v3 support read every key file in the repo as an RSA key,
+// so v3 keys are set aside under this extension when migrating down
+const migration020V3KeyBackupExtension = ".onion_v3_key"
+
+func (Migration020) Up(repoPath string, dbPassword string, testnet bool) error {
+	var v3ID string
+	ids, err := onion.KeyIDs(repoPath)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if onion.IsV3Address(id) {
+			v3ID = id
+		}
+	}
+	if v3ID == "" {
+		v3ID, err = migration020RestoreV3Key(repoPath)
+		if err != nil {
+			return err
+		}
+	}
+	if v3ID == "" {
+		v3ID, err = onion.CreateV3Key(repoPath, rand.Reader)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := migration020RewriteSwarm(repoPath, "/onion/", func(port int) string {
+		return onion.Multiaddr(v3ID, port)
+	}); err != nil {
+		return err
+	}
+	return writeRepoVer(repoPath, 21)
+}
+
+func (Migration020) Down(repoPath string, dbPassword string, testnet bool) error {
+	var v2ID string
+	ids, err := onion.KeyIDs(repoPath)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		switch {
+		case onion.IsV2Address(id):
+			v2ID = id
+		case onion.IsV3Address(id):
+			if err := os.Rename(path.Join(repoPath, id+onion.KeyFileExtension), path.Join(repoPath, id+migration020V3KeyBackupExtension)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := migration020RewriteSwarm(repoPath, "/onion3/", func(port int) string {
+		if v2ID == "" {
+			return ""
+		}
+		return onion.Multiaddr(v2ID, port)
+	}); err != nil {
+		return err
+	}
+	return writeRepoVer(repoPath, 20)
+}
+
+func migration020RestoreV3Key(repoPath string) (string, error) {
+	files, err := ioutil.ReadDir(repoPath)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		id := strings.TrimSuffix(file.Name(), migration020V3KeyBackupExtension)
+		if id == file.Name() || !onion.IsV3Address(id) {
+			continue
+		}
+		if err := os.Rename(path.Join(repoPath, file.Name()), path.Join(repoPath, id+onion.KeyFileExtension)); err != nil {
+			return "", err
+		}
+		return id, nil
+	}
+	return "", nil
+}
+
+// migration020RewriteSwarm replaces the swarm addresses with the prefix by the
+// address returned for their port, or removes them if it returns nothing
+func migration020RewriteSwarm(repoPath, prefix string, replace func(port int) string) error {
+	configFile, err := ioutil.ReadFile(path.Join(repoPath, "config"))
+	if err != nil {
+		return err
+	}
+	var cfgIface interface{}
+	json.Unmarshal(configFile, &cfgIface)
+	cfg, ok := cfgIface.(map[string]interface{})
+	if !ok {
+		return errors.New("Invalid config file")
+	}
+	addresses, ok := cfg["Addresses"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	swarm, ok := addresses["Swarm"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	newSwarm := []interface{}{}
+	for _, a := range swarm {
+		addr, ok := a.(string)
+		if !ok || !strings.HasPrefix(addr, prefix) {
+			newSwarm = append(newSwarm, a)
+			continue
+		}
+		port, err := strconv.Atoi(addr[strings.LastIndex(addr, ":")+1:])
+		if err != nil {
+			return err
+		}
+		if replaced := replace(port); replaced != "" {
+			newSwarm = append(newSwarm, replaced)
+		}
+	}
+	addresses["Swarm"] = newSwarm
+
+	out, err := json.MarshalIndent(cfg, "", "   ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(repoPath, "config"), out, 0600)
+}
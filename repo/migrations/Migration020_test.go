@@ -0,0 +1,99 @@
+package migrations_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/net/onion"
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+)
+
+const preMigration020Config = `{
+    "Addresses": {
+        "Swarm": [
+            "/ip4/0.0.0.0/tcp/4001",
+            "/onion/facebookcorewwwi:4003"
+        ]
+    }
+}`
+
+func migration020Swarm(t *testing.T, repoPath string) []string {
+	b, err := ioutil.ReadFile(path.Join(repoPath, "config"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cfg struct {
+		Addresses struct {
+			Swarm []string
+		}
+	}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	return cfg.Addresses.Swarm
+}
+
+func migration020V3ID(t *testing.T, repoPath string) string {
+	ids, err := onion.KeyIDs(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v3ID string
+	for _, id := range ids {
+		if onion.IsV3Address(id) {
+			v3ID = id
+		}
+	}
+	return v3ID
+}
+
+func TestMigration020(t *testing.T) {
+	repoPath, err := ioutil.TempDir("", "migration020")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repoPath)
+	if err := ioutil.WriteFile(path.Join(repoPath, "config"), []byte(preMigration020Config), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(repoPath, "facebookcorewwwi.onion_key"), []byte("v2 key"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	var m migrations.Migration020
+	if err := m.Up(repoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+	v3ID := migration020V3ID(t, repoPath)
+	if v3ID == "" {
+		t.Fatal("expected a v3 onion key to be created")
+	}
+	swarm := migration020Swarm(t, repoPath)
+	if len(swarm) != 2 || swarm[0] != "/ip4/0.0.0.0/tcp/4001" || swarm[1] != "/onion3/"+v3ID+":4003" {
+		t.Errorf("expected the onion address to be migrated to v3, got %v", swarm)
+	}
+	assertCorrectRepoVer(t, path.Join(repoPath, "repover"), "21")
+
+	if err := m.Down(repoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+	if migration020V3ID(t, repoPath) != "" {
+		t.Error("expected the v3 onion key to be set aside")
+	}
+	swarm = migration020Swarm(t, repoPath)
+	if len(swarm) != 2 || swarm[1] != "/onion/facebookcorewwwi:4003" {
+		t.Errorf("expected the onion address to be migrated to v2, got %v", swarm)
+	}
+	assertCorrectRepoVer(t, path.Join(repoPath, "repover"), "20")
+
+	// Migrating up again keeps the onion address
+	if err := m.Up(repoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+	if id := migration020V3ID(t, repoPath); id != v3ID {
+		t.Errorf("expected the v3 onion key %s to be restored, got %s", v3ID, id)
+	}
+}
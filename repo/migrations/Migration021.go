@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration021 adds the table of scoped API tokens
+type Migration021 struct{}
+
+func (Migration021) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const createAPITokensSQL = "create table apitokens (name text primary key not null, tokenHash text unique not null, scopes text, createdAt integer, expires integer);"
+	if _, err := db.Exec(createAPITokensSQL); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 22)
+}
+
+func (Migration021) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const dropAPITokensSQL = "drop table if exists apitokens;"
+	if _, err := db.Exec(dropAPITokensSQL); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 21)
+}
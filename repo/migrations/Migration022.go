@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// Migration022 adds the log of API authentication events
+type Migration022 struct{}
+
+func (Migration022) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		createAuthLogSQL = "create table authlog (id integer primary key autoincrement, timestamp integer, ip text, method text, credential text, path text, success integer, reason text);"
+		createIndexIPSQL = "create index index_authlog_ip on authlog (ip, timestamp);"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{createAuthLogSQL, createIndexIPSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 23)
+}
+
+func (Migration022) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		dropIndexIPSQL = "drop index if exists index_authlog_ip;"
+		dropAuthLogSQL = "drop table if exists authlog;"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{dropIndexIPSQL, dropAuthLogSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 22)
+}
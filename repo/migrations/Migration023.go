@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration023 adds the table of persisted events replayed by the event
+// stream
+type Migration023 struct{}
+
+func (Migration023) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const createEventsSQL = "create table events (id integer primary key autoincrement, topic text not null, timestamp integer, data blob);"
+	if _, err := db.Exec(createEventsSQL); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 24)
+}
+
+func (Migration023) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const dropEventsSQL = "drop table if exists events;"
+	if _, err := db.Exec(dropEventsSQL); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 23)
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration024 adds the table of responses to API requests made with an
+// Idempotency-Key header
+type Migration024 struct{}
+
+func (Migration024) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const createIdempotencyKeysSQL = "create table idempotencykeys (key text primary key not null, fingerprint text not null, statusCode integer, response blob, createdAt integer);"
+	if _, err := db.Exec(createIdempotencyKeysSQL); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 25)
+}
+
+func (Migration024) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const dropIdempotencyKeysSQL = "drop table if exists idempotencykeys;"
+	if _, err := db.Exec(dropIdempotencyKeysSQL); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 24)
+}
@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// Migration025 keeps the inventory of archived listings so it can be
+// restored, and the reason a scheduled listing failed to publish so it isn't
+// retried
+type Migration025 struct{}
+
+func (Migration025) Up(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		alterArchivedListingsSQL  = "alter table archivedlistings add column inventory blob;"
+		alterScheduledListingsSQL = "alter table scheduledlistings add column publishError text;"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{alterArchivedListingsSQL, alterScheduledListingsSQL} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating up: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 26)
+}
+
+func (Migration025) Down(repoPath string, dbPassword string, testnet bool) error {
+	db, err := OpenDB(repoPath, dbPassword, testnet)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+	defer db.Close()
+
+	const (
+		renameArchivedListingsSQL  = "alter table archivedlistings rename to archivedlistings_old;"
+		createArchivedListingsSQL  = "create table archivedlistings (slug text primary key not null, listing blob, archivedAt integer);"
+		copyArchivedListingsSQL    = "insert into archivedlistings select slug, listing, archivedAt from archivedlistings_old;"
+		dropArchivedListingsSQL    = "drop table archivedlistings_old;"
+		dropScheduledIndexSQL      = "drop index if exists index_scheduledlistings;"
+		renameScheduledListingsSQL = "alter table scheduledlistings rename to scheduledlistings_old;"
+		createScheduledListingsSQL = "create table scheduledlistings (slug text primary key not null, listing blob, publishAt integer);"
+		createScheduledIndexSQL    = "create index index_scheduledlistings on scheduledlistings (publishAt);"
+		copyScheduledListingsSQL   = "insert into scheduledlistings select slug, listing, publishAt from scheduledlistings_old;"
+		dropScheduledListingsSQL   = "drop table scheduledlistings_old;"
+	)
+	if err := withTransaction(db, func(tx *sql.Tx) error {
+		for _, stmt := range []string{
+			renameArchivedListingsSQL, createArchivedListingsSQL, copyArchivedListingsSQL, dropArchivedListingsSQL,
+			dropScheduledIndexSQL, renameScheduledListingsSQL, createScheduledListingsSQL, createScheduledIndexSQL, copyScheduledListingsSQL, dropScheduledListingsSQL,
+		} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("migrating down: %s", err.Error())
+	}
+
+	return writeRepoVer(repoPath, 25)
+}
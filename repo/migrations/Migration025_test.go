@@ -0,0 +1,79 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration025(t *testing.T) {
+	// Setup
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+
+	var (
+		dbPassword  = "foobarbaz"
+		repoVerPath = appSchema.DataPathJoin("repover")
+	)
+
+	db, err := sql.Open("sqlite3", appSchema.DatabasePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err = db.Exec("pragma key = 'foobarbaz';"); err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		"create table scheduledlistings (slug text primary key not null, listing blob, publishAt integer);",
+		"create index index_scheduledlistings on scheduledlistings (publishAt);",
+		"create table archivedlistings (slug text primary key not null, listing blob, archivedAt integer);",
+		"insert into scheduledlistings(slug, listing, publishAt) values('draft', '{}', 1);",
+		"insert into archivedlistings(slug, listing, archivedAt) values('expired', '{}', 1);",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = ioutil.WriteFile(repoVerPath, []byte("25"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	// Test migration up
+	if err := (migrations.Migration025{}).Up(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("update archivedlistings set inventory = '{}' where slug = 'expired';"); err != nil {
+		t.Error("Expected archivedlistings to have an inventory column:", err)
+	}
+	if _, err = db.Exec("update scheduledlistings set publishError = 'invalid' where slug = 'draft';"); err != nil {
+		t.Error("Expected scheduledlistings to have a publishError column:", err)
+	}
+	assertCorrectRepoVer(t, repoVerPath, "26")
+
+	// Test migration down
+	if err := (migrations.Migration025{}).Down(appSchema.DataPath(), dbPassword, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("select inventory from archivedlistings;"); err == nil {
+		t.Error("Expected inventory column to be dropped")
+	}
+	if _, err = db.Exec("select publishError from scheduledlistings;"); err == nil {
+		t.Error("Expected publishError column to be dropped")
+	}
+	var count int
+	if err := db.QueryRow("select (select count(*) from scheduledlistings) + (select count(*) from archivedlistings);").Scan(&count); err != nil || count != 2 {
+		t.Errorf("Expected the listings to be kept, got %d (%v)", count, err)
+	}
+	assertCorrectRepoVer(t, repoVerPath, "25")
+}
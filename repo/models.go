@@ -2,6 +2,8 @@ package repo
 
 import (
 	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
 )
 
 type SettingsData struct {
@@ -136,3 +138,164 @@ type UnfundedSale struct {
 	OrderId   string
 	Timestamp time.Time
 }
+
+type ScheduledListing struct {
+	Slug         string      `json:"slug"`
+	PublishAt    time.Time   `json:"publishAt"`
+	PublishError string      `json:"publishError,omitempty"`
+	Listing      *pb.Listing `json:"-"`
+}
+
+type SearchListing struct {
+	PeerID          string    `json:"peerID"`
+	Slug            string    `json:"slug"`
+	Hash            string    `json:"hash"`
+	Title           string    `json:"title"`
+	Description     string    `json:"description"`
+	Tags            []string  `json:"tags"`
+	Categories      []string  `json:"categories"`
+	ShipsTo         []string  `json:"shipsTo"`
+	PricingCurrency string    `json:"pricingCurrency"`
+	Price           uint64    `json:"price"`
+	Thumbnail       string    `json:"thumbnail"`
+	IndexedAt       time.Time `json:"indexedAt"`
+}
+
+type SearchQuery struct {
+	Terms    string
+	Tags     []string
+	Currency string
+	PriceMax uint64
+	ShipsTo  string
+	Limit    int
+}
+
+type BanScope string
+
+const (
+	// BanScopeAll drops every message from the peer
+	BanScopeAll BanScope = "all"
+	// BanScopeChat drops chat messages from the peer
+	BanScopeChat BanScope = "chat"
+	// BanScopeFollow drops follows and unfollows from the peer
+	BanScopeFollow BanScope = "follow"
+	// BanScopeOrder drops new orders from the peer. Messages about existing
+	// orders, such as disputes, are still accepted.
+	BanScopeOrder BanScope = "order"
+)
+
+// BanScopes lists every valid ban scope
+var BanScopes = []BanScope{BanScopeAll, BanScopeChat, BanScopeFollow, BanScopeOrder}
+
+type Ban struct {
+	PeerID    string     `json:"peerID"`
+	Scope     BanScope   `json:"scope"`
+	Reason    string     `json:"reason"`
+	CreatedAt time.Time  `json:"createdAt"`
+	Expires   *time.Time `json:"expires,omitempty"`
+}
+
+// IsActive returns whether the ban has not yet expired
+func (b Ban) IsActive(now time.Time) bool {
+	return b.Expires == nil || now.Before(*b.Expires)
+}
+
+type PreKey struct {
+	ID         uint32
+	PrivateKey []byte
+	PublicKey  []byte
+	Signature  []byte
+	CreatedAt  time.Time
+}
+
+type ArchivedListing struct {
+	Slug       string            `json:"slug"`
+	ArchivedAt time.Time         `json:"archivedAt"`
+	Listing    *pb.SignedListing `json:"-"`
+	Inventory  map[int]int64     `json:"-"`
+}
+
+type APITokenScope string
+
+const (
+	// APITokenScopeReadOnly allows the GET requests which don't return
+	// secrets, leaving out the settings, config, wallet mnemonic, API token
+	// and auth log endpoints
+	APITokenScopeReadOnly APITokenScope = "read-only"
+	// APITokenScopeListingsWrite allows changes to listings, posts and their
+	// images
+	APITokenScopeListingsWrite APITokenScope = "listings-write"
+	// APITokenScopeOrders allows managing orders and disputes, but not the
+	// requests which move funds such as refunds or releasing escrow
+	APITokenScopeOrders APITokenScope = "orders"
+	// APITokenScopeWalletSpend allows requests which move funds
+	APITokenScopeWalletSpend APITokenScope = "wallet-spend"
+	// APITokenScopeAdmin allows every request
+	APITokenScopeAdmin APITokenScope = "admin"
+)
+
+// APITokenScopes lists every valid API token scope
+var APITokenScopes = []APITokenScope{APITokenScopeReadOnly, APITokenScopeListingsWrite, APITokenScopeOrders, APITokenScopeWalletSpend, APITokenScopeAdmin}
+
+// APIToken is a named API credential. Only the hash of the token is stored.
+type APIToken struct {
+	Name      string          `json:"name"`
+	TokenHash string          `json:"-"`
+	Scopes    []APITokenScope `json:"scopes"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Expires   *time.Time      `json:"expires,omitempty"`
+}
+
+// IsActive returns whether the token has not yet expired
+func (t APIToken) IsActive(now time.Time) bool {
+	return t.Expires == nil || now.Before(*t.Expires)
+}
+
+// HasScope returns whether the token was granted the scope. Admin tokens
+// have every scope.
+func (t APIToken) HasScope(scope APITokenScope) bool {
+	for _, s := range t.Scopes {
+		if s == scope || s == APITokenScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+type AuthMethod string
+
+const (
+	AuthMethodCookie AuthMethod = "cookie"
+	AuthMethodBasic  AuthMethod = "basic"
+	AuthMethodToken  AuthMethod = "token"
+)
+
+// AuthEvent records a successful or failed authentication with the API
+type AuthEvent struct {
+	ID         int        `json:"id"`
+	Timestamp  time.Time  `json:"timestamp"`
+	IP         string     `json:"ip"`
+	Method     AuthMethod `json:"method"`
+	Credential string     `json:"credential,omitempty"`
+	Path       string     `json:"path"`
+	Success    bool       `json:"success"`
+	Reason     string     `json:"reason,omitempty"`
+}
+
+// Event is a notification persisted for the event stream
+type Event struct {
+	ID        uint64
+	Topic     string
+	Timestamp time.Time
+	Data      []byte
+}
+
+// IdempotentResponse is the stored result of an API request made with an
+// Idempotency-Key header
+type IdempotentResponse struct {
+	Key         string
+	Fingerprint string
+	StatusCode  int
+	Body        []byte
+	CreatedAt   time.Time
+}
@@ -795,6 +795,29 @@ func (n StatusNotification) GetID() string                               { retur
 func (n StatusNotification) GetType() NotificationType                   { return NotifierTypeStatusUpdateNotification }
 func (n StatusNotification) GetSMTPTitleAndBody() (string, string, bool) { return "", "", false }
 
+// StoreUpdateNotification tells the user a followed store changed. It is
+// emitted from pubsub announcements and is not persisted.
+type StoreUpdateNotification struct {
+	Type       NotificationType `json:"type"`
+	PeerId     string           `json:"peerId"`
+	RootHash   string           `json:"rootHash"`
+	ChangeType string           `json:"changeType"`
+	Slug       string           `json:"slug"`
+	Title      string           `json:"title"`
+}
+
+func (n StoreUpdateNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n StoreUpdateNotification) WebsocketData() ([]byte, error) { return n.Data() }
+func (n StoreUpdateNotification) GetID() string                  { return "" } // Not persisted, ID is ignored
+func (n StoreUpdateNotification) GetType() NotificationType {
+	return NotifierTypeStoreUpdateNotification
+}
+func (n StoreUpdateNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	return "", "", false
+}
+
 type ChatMessage struct {
 	MessageId string    `json:"messageId"`
 	PeerId    string    `json:"peerId"`
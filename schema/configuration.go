@@ -17,6 +17,10 @@ type APIConfig struct {
 	SSL           bool
 	SSLCert       string
 	SSLKey        string
+
+	// IdempotencyKeyWindow is how long responses to requests made with an
+	// Idempotency-Key header are kept, as a duration string
+	IdempotencyKeyWindow string `json:",omitempty"`
 }
 
 type TorConfig struct {
@@ -60,6 +64,18 @@ type CoinConfig struct {
 type DataSharing struct {
 	AcceptStoreRequests bool
 	PushTo              []string
+	FallbackPushTo      []string `json:",omitempty"`
+}
+
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	PathStyle       bool
+	PublicRead      bool
+	PresignExpiry   string
 }
 
 var MalformedConfigError error = errors.New("Config file is malformed")
@@ -195,6 +211,18 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 		SSLKey:        keyFileStr,
 	}
 
+	// The idempotency key window was added later and is optional
+	if window, ok := api["IdempotencyKeyWindow"]; ok {
+		windowStr, ok := window.(string)
+		if !ok {
+			return nil, MalformedConfigError
+		}
+		if _, err := time.ParseDuration(windowStr); err != nil {
+			return nil, MalformedConfigError
+		}
+		apiConfig.IdempotencyKeyWindow = windowStr
+	}
+
 	return apiConfig, nil
 }
 
@@ -375,6 +403,22 @@ func GetDropboxApiToken(cfgBytes []byte) (string, error) {
 	return tokenStr, nil
 }
 
+func GetS3Config(cfgBytes []byte) (*S3Config, error) {
+	var cfg map[string]json.RawMessage
+	if err := json.Unmarshal(cfgBytes, &cfg); err != nil {
+		return nil, MalformedConfigError
+	}
+	s3Cfg := new(S3Config)
+	raw, ok := cfg["S3"]
+	if !ok {
+		return s3Cfg, nil
+	}
+	if err := json.Unmarshal(raw, s3Cfg); err != nil {
+		return nil, MalformedConfigError
+	}
+	return s3Cfg, nil
+}
+
 func GetRepublishInterval(cfgBytes []byte) (time.Duration, error) {
 	var cfgIface interface{}
 	json.Unmarshal(cfgBytes, &cfgIface)
@@ -447,6 +491,21 @@ func GetDataSharing(cfgBytes []byte) (*DataSharing, error) {
 		}
 		dataSharing.PushTo = append(dataSharing.PushTo, ndStr)
 	}
+
+	// Fallback push nodes are optional
+	if fallbackcfg, ok := ds["FallbackPushTo"]; ok {
+		fallbackList, ok := fallbackcfg.([]interface{})
+		if !ok {
+			return dataSharing, MalformedConfigError
+		}
+		for _, nd := range fallbackList {
+			ndStr, ok := nd.(string)
+			if !ok {
+				return dataSharing, MalformedConfigError
+			}
+			dataSharing.FallbackPushTo = append(dataSharing.FallbackPushTo, ndStr)
+		}
+	}
 	return dataSharing, nil
 }
 
@@ -511,5 +570,14 @@ func GetResolverConfig(cfgBytes []byte) (*ResolverConfig, error) {
 		Id: idStr,
 	}
 
+	// The .eth resolver was added later and is optional
+	if eth, ok := resolverMap[".eth"]; ok {
+		ethStr, ok := eth.(string)
+		if !ok {
+			return nil, MalformedConfigError
+		}
+		resolvers.Eth = ethStr
+	}
+
 	return resolvers, nil
 }
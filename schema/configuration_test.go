@@ -39,6 +39,9 @@ func TestGetApiConfig(t *testing.T) {
 	if config.SSLKey == "" {
 		t.Error("Expected test SSL key, got ", config.SSLKey)
 	}
+	if config.IdempotencyKeyWindow != "12h" {
+		t.Error("Expected test idempotency key window, got ", config.IdempotencyKeyWindow)
+	}
 	if err != nil {
 		t.Error("GetAPIAuthentication threw an unexpected error")
 	}
@@ -231,6 +234,9 @@ func TestGetResolverConfig(t *testing.T) {
 	if resolvers.Id != "https://resolver.onename.com/" {
 		t.Error("resolverUrl does not equal expected value")
 	}
+	if resolvers.Eth != "https://cloudflare-eth.com/" {
+		t.Error("eth resolver does not equal expected value")
+	}
 }
 
 func configFixture() []byte {
@@ -333,6 +339,7 @@ func configFixture() []byte {
     "CORS": "*",
     "Enabled": true,
     "HTTPHeaders": null,
+    "IdempotencyKeyWindow": "12h",
     "Password": "TestPassword",
     "SSL": true,
     "SSLCert": "/path/to/ssl.cert",
@@ -350,7 +357,8 @@ func configFixture() []byte {
   },
   "RepublishInterval": "24h",
   "Resolvers": {
-    ".id": "https://resolver.onename.com/"
+    ".id": "https://resolver.onename.com/",
+    ".eth": "https://cloudflare-eth.com/"
   },
   "SupernodeRouting": {
     "Servers": null
@@ -36,6 +36,21 @@ const (
 	CreateTableCouponsSQL                   = "create table coupons (slug text, code text, hash text);"
 	CreateIndexCouponsSQL                   = "create index index_coupons on coupons (slug);"
 	CreateTableModeratedStoresSQL           = "create table moderatedstores (peerID text primary key not null);"
+	CreateTableScheduledListingsSQL         = "create table scheduledlistings (slug text primary key not null, listing blob, publishAt integer, publishError text);"
+	CreateIndexScheduledListingsSQL         = "create index index_scheduledlistings on scheduledlistings (publishAt);"
+	CreateTableArchivedListingsSQL          = "create table archivedlistings (slug text primary key not null, listing blob, archivedAt integer, inventory blob);"
+	CreateTableSearchListingsSQL            = "create virtual table searchlistings using fts4(peerID, slug, hash, title, description, tags, categories, shipsTo, pricingCurrency, price, thumbnail, indexedAt, notindexed=peerID, notindexed=slug, notindexed=hash, notindexed=shipsTo, notindexed=pricingCurrency, notindexed=price, notindexed=thumbnail, notindexed=indexedAt, tokenize=unicode61);"
+	CreateTableSearchPeersSQL               = "create table searchpeers (peerID text primary key not null, root text, updatedAt integer);"
+	CreateTableBansSQL                      = "create table bans (peerID text not null, scope text not null, reason text, createdAt integer, expires integer, primary key (peerID, scope));"
+	CreateTableSessionsSQL                  = "create table sessions (peerID text primary key not null, state blob, updatedAt integer);"
+	CreateTablePreKeysSQL                   = "create table prekeys (id integer primary key not null, privateKey blob, publicKey blob, signature blob, createdAt integer);"
+	CreateTableReceivedMessagesSQL          = "create table receivedmessages (hash text primary key not null, peerID text, messageType integer, timestamp integer);"
+	CreateIndexReceivedMessagesTimestampSQL = "create index index_receivedmessages_timestamp on receivedmessages (timestamp);"
+	CreateTableAPITokensSQL                 = "create table apitokens (name text primary key not null, tokenHash text unique not null, scopes text, createdAt integer, expires integer);"
+	CreateTableAuthLogSQL                   = "create table authlog (id integer primary key autoincrement, timestamp integer, ip text, method text, credential text, path text, success integer, reason text);"
+	CreateIndexAuthLogIPSQL                 = "create index index_authlog_ip on authlog (ip, timestamp);"
+	CreateTableEventsSQL                    = "create table events (id integer primary key autoincrement, topic text not null, timestamp integer, data blob);"
+	CreateTableIdempotencyKeysSQL           = "create table idempotencykeys (key text primary key not null, fingerprint text not null, statusCode integer, response blob, createdAt integer);"
 	// End SQL Statements
 
 	// Configuration defaults
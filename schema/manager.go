@@ -302,6 +302,21 @@ func InitializeDatabaseSQL(encryptionPassword string) string {
 		CreateTableCouponsSQL,
 		CreateIndexCouponsSQL,
 		CreateTableModeratedStoresSQL,
+		CreateTableScheduledListingsSQL,
+		CreateIndexScheduledListingsSQL,
+		CreateTableArchivedListingsSQL,
+		CreateTableSearchListingsSQL,
+		CreateTableSearchPeersSQL,
+		CreateTableBansSQL,
+		CreateTableSessionsSQL,
+		CreateTablePreKeysSQL,
+		CreateTableReceivedMessagesSQL,
+		CreateIndexReceivedMessagesTimestampSQL,
+		CreateTableAPITokensSQL,
+		CreateTableAuthLogSQL,
+		CreateIndexAuthLogIPSQL,
+		CreateTableEventsSQL,
+		CreateTableIdempotencyKeysSQL,
 	}
 	return strings.Join(initializeStatement, " ")
 }
@@ -0,0 +1,197 @@
+package net
+
+import (
+	"bytes"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
+	mh "gx/ipfs/QmZyZDi491cCNTLfAhwcaDii2Kg4pwKRkhqQzURGDvY6ua/go-multihash"
+	"gx/ipfs/QmcZfnkapfECQGcLZaf9B79NRg7cRa9EnZh4LSbkCzwNvY/go-cid"
+)
+
+// ProtocolHTTPSURL is the multiaddr protocol of the address of a message
+// stored at a URL. The URL is the value of the address, which is written
+// /https-url/<url>. The code is in the private use range of the multicodec
+// table, so the address is only used within the node. Pointers carry it in
+// the /ipfs/<multihash>/https layout of PointerAddrBytes.
+const ProtocolHTTPSURL = 0x3001bb
+
+func init() {
+	if err := ma.AddProtocol(ma.Protocol{
+		Code:       ProtocolHTTPSURL,
+		Size:       ma.LengthPrefixedVarSize,
+		Name:       "https-url",
+		VCode:      ma.CodeToVarint(ProtocolHTTPSURL),
+		Path:       true,
+		Transcoder: ma.NewTranscoderFromFunctions(urlStB, urlBtS),
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// urlStB encodes the URL of an https-url address. As it's a path protocol
+// the URL is passed with a leading slash.
+func urlStB(s string) ([]byte, error) {
+	s = strings.TrimPrefix(s, "/")
+	if s == "" {
+		return nil, errors.New("empty url")
+	}
+	return append(ma.CodeToVarint(len(s)), s...), nil
+}
+
+func urlBtS(b []byte) (string, error) {
+	size, n, err := ma.ReadVarintCode(b)
+	if err != nil {
+		return "", err
+	}
+	if len(b)-n != size || size == 0 {
+		return "", errors.New("inconsistent lengths")
+	}
+	return string(b[n:]), nil
+}
+
+// EncodeHTTPSAddr returns the /https-url/<url> address of a message stored
+// at the URL
+func EncodeHTTPSAddr(url string) (ma.Multiaddr, error) {
+	value, err := urlStB(url)
+	if err != nil {
+		return nil, err
+	}
+	return ma.NewMultiaddrBytes(append(ma.CodeToVarint(ProtocolHTTPSURL), value...))
+}
+
+// PointerAddrBytes returns the bytes of an address as it's published in a
+// pointer. The URL of an https-url address is carried as the digest of the
+// multihash of an /ipfs/<multihash>/https address. This is the layout every
+// version decodes, so it is emitted until a new one is negotiated.
+func PointerAddrBytes(addr ma.Multiaddr) []byte {
+	b := addr.Bytes()
+	code := ma.CodeToVarint(ProtocolHTTPSURL)
+	if !bytes.HasPrefix(b, code) {
+		return b
+	}
+	url, err := urlBtS(b[len(code):])
+	if err != nil {
+		return b
+	}
+	m, err := mh.Encode([]byte(url), mh.SHA1)
+	if err != nil {
+		return b
+	}
+	legacy := ma.CodeToVarint(ma.P_IPFS)
+	legacy = append(legacy, ma.CodeToVarint(len(m))...)
+	legacy = append(legacy, m...)
+	return append(legacy, ma.CodeToVarint(ma.P_HTTPS)...)
+}
+
+// NormalizeAddr returns the address of a pointer received from the network,
+// converting the /ipfs/<multihash>/https layout, which the multiaddr library
+// can't print, to an https-url address
+func NormalizeAddr(addr ma.Multiaddr) ma.Multiaddr {
+	b := addr.Bytes()
+	ipfs, https := ma.CodeToVarint(ma.P_IPFS), ma.CodeToVarint(ma.P_HTTPS)
+	if !bytes.HasPrefix(b, ipfs) || !bytes.HasSuffix(b, https) {
+		return addr
+	}
+	b = b[len(ipfs) : len(b)-len(https)]
+	size, n, err := ma.ReadVarintCode(b)
+	if err != nil || len(b)-n != size {
+		return addr
+	}
+	normalized, err := legacyHTTPSAddr(b[n:])
+	if err != nil {
+		return addr
+	}
+	return normalized
+}
+
+// ParseAddr parses the string form of a pointer address, including the
+// /ipfs/<multihash>/https addresses saved by earlier versions
+func ParseAddr(s string) (ma.Multiaddr, error) {
+	addr, err := ma.NewMultiaddr(s)
+	if err == nil {
+		return addr, nil
+	}
+	trimmed := strings.TrimSuffix(s, "/")
+	if !strings.HasPrefix(trimmed, "/ipfs/") || !strings.HasSuffix(trimmed, "/https") {
+		return nil, err
+	}
+	m, merr := mh.FromB58String(strings.TrimSuffix(strings.TrimPrefix(trimmed, "/ipfs/"), "/https"))
+	if merr != nil {
+		return nil, err
+	}
+	return legacyHTTPSAddr(m)
+}
+
+// legacyHTTPSAddr returns the https-url address of the URL carried by the
+// multihash of an /ipfs/<multihash>/https address
+func legacyHTTPSAddr(b []byte) (ma.Multiaddr, error) {
+	d, err := mh.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeHTTPSAddr(string(d.Digest))
+}
+
+// IsHTTPSAddr returns whether the address carries the URL of a stored
+// message, either as an https-url address, in the layout of PointerAddrBytes
+// or as /https/ipfs/<cid>
+func IsHTTPSAddr(addr ma.Multiaddr) bool {
+	protocols := NormalizeAddr(addr).Protocols()
+	if len(protocols) == 1 {
+		return protocols[0].Code == ProtocolHTTPSURL
+	}
+	return len(protocols) == 2 && protocols[0].Code == ma.P_HTTPS && protocols[1].Code == ma.P_IPFS
+}
+
+// DecodeHTTPSAddr returns the URL carried by an address for which
+// IsHTTPSAddr is true
+func DecodeHTTPSAddr(addr ma.Multiaddr) (string, error) {
+	if !IsHTTPSAddr(addr) {
+		return "", errors.New("not an https storage address")
+	}
+	addr = NormalizeAddr(addr)
+	if url, err := addr.ValueForProtocol(ProtocolHTTPSURL); err == nil {
+		return url, nil
+	}
+	enc, err := addr.ValueForProtocol(ma.P_IPFS)
+	if err != nil {
+		return "", err
+	}
+	c, err := cid.Decode(enc)
+	if err != nil {
+		return "", err
+	}
+	d, err := mh.Decode(c.Hash())
+	if err != nil {
+		return "", err
+	}
+	return string(d.Digest), nil
+}
+
+// HTTPSAddrExpiry returns when the presigned URL carried by the address
+// expires. It returns false for addresses without an expiry.
+func HTTPSAddrExpiry(addr ma.Multiaddr) (time.Time, bool) {
+	readURL, err := DecodeHTTPSAddr(addr)
+	if err != nil {
+		return time.Time{}, false
+	}
+	u, err := url.Parse(readURL)
+	if err != nil {
+		return time.Time{}, false
+	}
+	query := u.Query()
+	signedAt, err := time.Parse("20060102T150405Z", query.Get("X-Amz-Date"))
+	if err != nil {
+		return time.Time{}, false
+	}
+	expires, err := strconv.ParseInt(query.Get("X-Amz-Expires"), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return signedAt.Add(time.Duration(expires) * time.Second), true
+}
@@ -6,8 +6,8 @@ import (
 	"encoding/hex"
 	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
 	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
-	mh "gx/ipfs/QmZyZDi491cCNTLfAhwcaDii2Kg4pwKRkhqQzURGDvY6ua/go-multihash"
 
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/dropbox/dropbox-sdk-go-unofficial"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/files"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/sharing"
@@ -49,18 +49,5 @@ func (s *DropBoxStorage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr,
 
 	// Create encoded multiaddr
 	url := res.Url[:len(res.Url)-1] + "1"
-	b, err := mh.Encode([]byte(url), mh.SHA1)
-	if err != nil {
-		return nil, err
-	}
-	m, err := mh.Cast(b)
-	if err != nil {
-		return nil, err
-	}
-
-	addr, err := ma.NewMultiaddr("/ipfs/" + m.B58String() + "/https/")
-	if err != nil {
-		return nil, err
-	}
-	return addr, nil
+	return sto.EncodeHTTPSAddr(url)
 }
@@ -0,0 +1,274 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	ma "gx/ipfs/QmWWQ2Txc2c6tqjsBpzg5Ar652cHPGNsQQp2SejkNmkUMb/go-multiaddr"
+	peer "gx/ipfs/QmZoWKhxUmZ2seW4BzX6fJkNR8hh9PsGModr7q171yq2SS/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
+)
+
+const (
+	// DefaultPresignExpiry is how long presigned read URLs stay valid. This
+	// is the longest expiry S3 allows, which is shorter than the 30 days
+	// pointers are republished for, so the republisher presigns the URL
+	// again before it expires.
+	DefaultPresignExpiry = time.Hour * 24 * 7
+
+	algorithm       = "AWS4-HMAC-SHA256"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// S3Storage stores offline messages in a bucket of any S3 compatible object
+// store such as AWS S3 or MinIO. Recipients download messages through a
+// public or presigned URL and messages are deleted once the recipient
+// acknowledges them. Buckets should also carry a lifecycle expiration rule
+// to remove messages which are never acknowledged.
+type S3Storage struct {
+	endpoint      *url.URL
+	region        string
+	bucket        string
+	accessKey     string
+	secretKey     string
+	pathStyle     bool
+	publicRead    bool
+	presignExpiry time.Duration
+	client        *http.Client
+	now           func() time.Time
+}
+
+func NewS3Storage(cfg schema.S3Config) (*S3Storage, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("S3 endpoint and bucket must be set")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("S3 access key ID and secret access key must be set")
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Scheme != "https" && endpoint.Scheme != "http" {
+		return nil, errors.New("S3 endpoint must be an http or https URL")
+	}
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	expiry := DefaultPresignExpiry
+	if cfg.PresignExpiry != "" {
+		expiry, err = time.ParseDuration(cfg.PresignExpiry)
+		if err != nil {
+			return nil, err
+		}
+		if expiry <= 0 || expiry > DefaultPresignExpiry {
+			return nil, fmt.Errorf("S3 presign expiry must be between 1s and %s", DefaultPresignExpiry)
+		}
+	}
+	return &S3Storage{
+		endpoint:      endpoint,
+		region:        region,
+		bucket:        cfg.Bucket,
+		accessKey:     cfg.AccessKeyID,
+		secretKey:     cfg.SecretAccessKey,
+		pathStyle:     cfg.PathStyle,
+		publicRead:    cfg.PublicRead,
+		presignExpiry: expiry,
+		client:        &http.Client{Timeout: time.Second * 30},
+		now:           time.Now,
+	}, nil
+}
+
+func (s *S3Storage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr, error) {
+	hash := sha256.Sum256(ciphertext)
+	key := hex.EncodeToString(hash[:])
+
+	// Upload ciphertext
+	headers := map[string]string{"Content-Type": "application/octet-stream"}
+	if s.publicRead {
+		headers["x-amz-acl"] = "public-read"
+	}
+	if err := s.do("PUT", key, ciphertext, headers); err != nil {
+		return nil, err
+	}
+
+	// Create encoded multiaddr
+	readURL := s.objectURL(key).String()
+	if !s.publicRead {
+		readURL = s.presign("GET", key, s.presignExpiry)
+	}
+	return sto.EncodeHTTPSAddr(readURL)
+}
+
+// Delete removes a stored message once the recipient has acknowledged it
+func (s *S3Storage) Delete(addr ma.Multiaddr) error {
+	key, err := s.objectKey(addr)
+	if err != nil {
+		return err
+	}
+	return s.do("DELETE", key, nil, nil)
+}
+
+// Refresh presigns the read URL of a stored message again, so its pointer
+// can be republished after the previous URL expires
+func (s *S3Storage) Refresh(addr ma.Multiaddr) (ma.Multiaddr, error) {
+	key, err := s.objectKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	if s.publicRead {
+		return addr, nil
+	}
+	return sto.EncodeHTTPSAddr(s.presign("GET", key, s.presignExpiry))
+}
+
+// objectKey returns the key of the message stored at the address
+func (s *S3Storage) objectKey(addr ma.Multiaddr) (string, error) {
+	readURL, err := sto.DecodeHTTPSAddr(addr)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(readURL)
+	if err != nil {
+		return "", err
+	}
+	key := u.Path[strings.LastIndex(u.Path, "/")+1:]
+	if u.Host != s.objectURL(key).Host || s.objectURL(key).Path != u.Path {
+		return "", errors.New("offline message is not stored in this bucket")
+	}
+	return key, nil
+}
+
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	u.RawQuery = ""
+	basePath := strings.TrimSuffix(u.Path, "/")
+	if s.pathStyle {
+		u.Path = basePath + "/" + s.bucket + "/" + key
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = basePath + "/" + key
+	}
+	return &u
+}
+
+func (s *S3Storage) scope(t time.Time) string {
+	return t.Format("20060102") + "/" + s.region + "/s3/aws4_request"
+}
+
+func (s *S3Storage) signingKey(t time.Time) []byte {
+	k := hmacSHA256([]byte("AWS4"+s.secretKey), t.Format("20060102"))
+	k = hmacSHA256(k, s.region)
+	k = hmacSHA256(k, "s3")
+	return hmacSHA256(k, "aws4_request")
+}
+
+func (s *S3Storage) signature(t time.Time, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		algorithm,
+		t.Format("20060102T150405Z"),
+		s.scope(t),
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+	return hex.EncodeToString(hmacSHA256(s.signingKey(t), stringToSign))
+}
+
+// do sends a request signed with an Authorization header
+func (s *S3Storage) do(method, key string, body []byte, headers map[string]string) error {
+	t := s.now().UTC()
+	u := s.objectURL(key)
+	payloadHash := sha256.Sum256(body)
+
+	signed := map[string]string{
+		"host":                 u.Host,
+		"x-amz-content-sha256": hex.EncodeToString(payloadHash[:]),
+		"x-amz-date":           t.Format("20060102T150405Z"),
+	}
+	for k, v := range headers {
+		signed[strings.ToLower(k)] = v
+	}
+	names := make([]string, 0, len(signed))
+	for k := range signed {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders bytes.Buffer
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + strings.TrimSpace(signed[k]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		signed["x-amz-content-sha256"],
+	}, "\n")
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for _, k := range names {
+		if k != "host" {
+			req.Header.Set(k, signed[k])
+		}
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, s.accessKey, s.scope(t), signedHeaders, s.signature(t, canonicalRequest)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("S3 %s %s failed: %s %s", method, key, resp.Status, string(msg))
+	}
+	return nil
+}
+
+// presign returns a URL carrying its own signature in the query string
+func (s *S3Storage) presign(method, key string, expires time.Duration) string {
+	t := s.now().UTC()
+	u := s.objectURL(key)
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", algorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+s.scope(t))
+	query.Set("X-Amz-Date", t.Format("20060102T150405Z"))
+	query.Set("X-Amz-Expires", fmt.Sprintf("%d", int64(expires/time.Second)))
+	query.Set("X-Amz-SignedHeaders", "host")
+	canonicalQuery := strings.Replace(query.Encode(), "+", "%20", -1)
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		canonicalQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+	u.RawQuery = canonicalQuery + "&X-Amz-Signature=" + s.signature(t, canonicalRequest)
+	return u.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -16,11 +16,11 @@ import (
 type SelfHostedStorage struct {
 	repoPath  string
 	ipfsNode  *core.IpfsNode
-	pushNodes []peer.ID
+	pushNodes func() []peer.ID
 	store     func(peerId string, ids []cid.Cid) error
 }
 
-func NewSelfHostedStorage(repoPath string, n *core.IpfsNode, pushNodes []peer.ID, store func(peerId string, ids []cid.Cid) error) *SelfHostedStorage {
+func NewSelfHostedStorage(repoPath string, n *core.IpfsNode, pushNodes func() []peer.ID, store func(peerId string, ids []cid.Cid) error) *SelfHostedStorage {
 	return &SelfHostedStorage{
 		repoPath:  repoPath,
 		ipfsNode:  n,
@@ -50,7 +50,7 @@ func (s *SelfHostedStorage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiad
 	if err != nil {
 		return nil, err
 	}
-	for _, peer := range s.pushNodes {
+	for _, peer := range s.pushNodes() {
 		go s.store(peer.Pretty(), []cid.Cid{*id})
 	}
 	maAddr, err := ma.NewMultiaddr("/ipfs/" + addr + "/")
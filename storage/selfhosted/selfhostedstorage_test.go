@@ -17,7 +17,7 @@ func TestSelfHostedStorage_Store(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	storage := NewSelfHostedStorage("./", ctx, []peer.ID{}, func(peerID string, cids []cid.Cid) error { return nil })
+	storage := NewSelfHostedStorage("./", ctx, func() []peer.ID { return nil }, func(peerID string, cids []cid.Cid) error { return nil })
 	pid, err := peer.IDB58Decode("QmNp85zy9RLrQ5oQD4hPyS39ezrrXpcaa7R4Y9kxdWQLLQ")
 	if err != nil {
 		t.Error(err)
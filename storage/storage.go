@@ -17,8 +17,23 @@ type OfflineMessagingStorage interface {
 	   Some storage possibilities include:
 	   IPFS Seeding -> assumes this node remains online all the time
 	   Dropbox -> go dropbox drivers are available
+	   S3 -> any S3 compatible object store such as AWS or MinIO
 	   Custom Options -> create your own free or paid service.
 
 	   Note all messages are encrypted before passed in here. */
 	Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr, error)
 }
+
+// OfflineMessageCleaner is implemented by storage backends which can delete a
+// stored message once the recipient has sent an OFFLINE_ACK for it
+type OfflineMessageCleaner interface {
+	Delete(addr ma.Multiaddr) error
+}
+
+// OfflineMessageRefresher is implemented by storage backends whose message
+// addresses expire, such as presigned URLs. Refresh returns a new address
+// for the stored message, which the pointer republisher publishes in place
+// of the old one.
+type OfflineMessageRefresher interface {
+	Refresh(addr ma.Multiaddr) (ma.Multiaddr, error)
+}
@@ -83,11 +83,16 @@ func NewNode() (*core.OpenBazaarNode, error) {
 
 	// Put it all together in an OpenBazaarNode
 	node := &core.OpenBazaarNode{
-		RepoPath:   GetRepoPath(),
-		IpfsNode:   ipfsNode,
-		Datastore:  repository.DB,
-		Wallet:     wallet,
-		BanManager: net.NewBanManager([]peer.ID{}),
+		RepoPath:         GetRepoPath(),
+		IpfsNode:         ipfsNode,
+		Datastore:        repository.DB,
+		Wallet:           wallet,
+		BanManager:       net.NewBanManager([]peer.ID{}),
+		AbuseManager:     net.NewAbuseManager(),
+		PeerCapabilities: net.NewCapabilityCache(),
+		Deduplicator:     net.NewMessageDeduplicator(repository.DB.ReceivedMessages()),
+		PushNodeManager:  net.NewPushNodeManager(nil, nil),
+		Sessions:         net.NewSessionManager(sk, repository.DB.Sessions()),
 	}
 
 	node.Service = service.New(node, repository.DB)